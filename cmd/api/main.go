@@ -1,14 +1,27 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
+	"qr_backend/internal/access"
+	"qr_backend/internal/analytics"
 	"qr_backend/internal/config"
 	"qr_backend/internal/database"
+	"qr_backend/internal/importer"
+	"qr_backend/internal/jobs"
+	"qr_backend/internal/malware"
 	"qr_backend/internal/router"
+	"qr_backend/internal/signing"
+	"qr_backend/internal/storage"
+	"qr_backend/internal/upload"
+	"qr_backend/internal/webhook"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors" // Add this import
@@ -35,6 +48,46 @@ func main() {
 		}
 	}()
 
+	// Start background jobs; they stop when main returns
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start the scan ingestion queue, then roll up and purge old analytics records
+	if err := analytics.Init(cfg.Analytics); err != nil {
+		log.Fatal("Failed to start analytics ingestion:", err)
+	}
+	analytics.StartRetention(ctx, cfg.Analytics)
+
+	// Validate and store uploads under the configured limits, and collect orphaned files
+	if err := storage.Init(cfg); err != nil {
+		log.Fatal("Failed to configure storage:", err)
+	}
+	upload.Init(cfg)
+	upload.StartGC(ctx, cfg.Storage)
+
+	// Quarantine new uploads until the malware scanner passes them
+	if err := malware.Init(cfg.Malware); err != nil {
+		log.Fatal("Failed to configure malware scanning:", err)
+	}
+	malware.Start(ctx)
+
+	// Deliver webhooks and watch for expiring QR codes
+	webhook.Start(ctx, cfg.Webhook)
+
+	// Sign the cookies that unlock password-protected codes
+	if err := access.Init(cfg.Access); err != nil {
+		log.Fatal("Failed to configure password protection:", err)
+	}
+
+	// Encrypt the keys signing QR code payloads at rest
+	if err := signing.Init(ctx, cfg.Signing); err != nil {
+		log.Fatal("Failed to configure payload signing:", err)
+	}
+
+	// Run background jobs such as large imports
+	importer.Register()
+	jobs.Start(ctx, cfg.Jobs)
+
 	// Initialize the template engine with absolute path for robustness
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -49,6 +102,16 @@ func main() {
 	app := fiber.New(fiber.Config{
 		AppName: "QR Code Management Platform",
 		Views:   engine,
+		// Leave room for multipart overhead and form fields around the file.
+		// Larger galleries are uploaded over several requests.
+		BodyLimit: int(cfg.Upload.MaxSize) + 1024*1024,
+		// Behind a reverse proxy, client IPs used for password lockouts,
+		// fingerprints and analytics, and the owner of uploads, come from its
+		// headers, but only on requests that arrive from a trusted proxy
+		ProxyHeader:             cfg.Server.ProxyHeader,
+		EnableTrustedProxyCheck: cfg.Server.ProxyHeader != "" || cfg.Storage.OwnerHeader != "",
+		TrustedProxies:          cfg.Server.TrustedProxies,
+		EnableIPValidation:      true,
 	})
 
 	// Add Fiber logger middleware
@@ -67,13 +130,34 @@ func main() {
 	}))
 
 	// Setup routes
-	router.SetupRoutes(app)
+	router.SetupRoutes(app, cfg)
 
 	// Rest of your existing code...
 	serverAddr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
 	log.Printf("Starting server on %s in %s mode", serverAddr, cfg.Server.Environment)
 
+	// Stop accepting requests on SIGINT/SIGTERM so queued work can be flushed
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+		log.Println("Shutting down server...")
+		if err := app.ShutdownWithTimeout(10 * time.Second); err != nil {
+			log.Printf("Error shutting down server: %v", err)
+		}
+	}()
+
 	if err := app.Listen(serverAddr); err != nil {
 		log.Printf("Server is shutting down: %v", err)
 	}
+
+	// Flush queued scans before the database is closed
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer shutdownCancel()
+	if err := analytics.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error flushing analytics: %v", err)
+	}
+	if err := jobs.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error stopping background jobs: %v", err)
+	}
 }
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"qr_backend/ent/checkpoint"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Checkpoint is the model entity for the Checkpoint schema.
+type Checkpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// At holds the value of the "at" field.
+	At           time.Time `json:"at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checkpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID:
+			values[i] = new(sql.NullInt64)
+		case checkpoint.FieldName:
+			values[i] = new(sql.NullString)
+		case checkpoint.FieldAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checkpoint fields.
+func (c *Checkpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case checkpoint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case checkpoint.FieldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field at", values[i])
+			} else if value.Valid {
+				c.At = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checkpoint.
+// This includes values selected through modifiers, order, etc.
+func (c *Checkpoint) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Checkpoint.
+// Note that you need to call Checkpoint.Unwrap() before calling this method if this Checkpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Checkpoint) Update() *CheckpointUpdateOne {
+	return NewCheckpointClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Checkpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Checkpoint) Unwrap() *Checkpoint {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Checkpoint is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Checkpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checkpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("at=")
+	builder.WriteString(c.At.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Checkpoints is a parsable slice of Checkpoint.
+type Checkpoints []*Checkpoint
@@ -11,10 +11,17 @@ import (
 
 	"qr_backend/ent/migrate"
 
+	"qr_backend/ent/checkpoint"
 	"qr_backend/ent/filereference"
+	"qr_backend/ent/job"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/signingkey"
+	"qr_backend/ent/webhook"
+	"qr_backend/ent/webhookdelivery"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -27,14 +34,28 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Checkpoint is the client for interacting with the Checkpoint builders.
+	Checkpoint *CheckpointClient
 	// FileReference is the client for interacting with the FileReference builders.
 	FileReference *FileReferenceClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// QRCode is the client for interacting with the QRCode builders.
 	QRCode *QRCodeClient
 	// QRCodeAnalytics is the client for interacting with the QRCodeAnalytics builders.
 	QRCodeAnalytics *QRCodeAnalyticsClient
+	// QRCodeAnalyticsDaily is the client for interacting with the QRCodeAnalyticsDaily builders.
+	QRCodeAnalyticsDaily *QRCodeAnalyticsDailyClient
 	// QRCodeGroup is the client for interacting with the QRCodeGroup builders.
 	QRCodeGroup *QRCodeGroupClient
+	// QRCodeRedemption is the client for interacting with the QRCodeRedemption builders.
+	QRCodeRedemption *QRCodeRedemptionClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,10 +67,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Checkpoint = NewCheckpointClient(c.config)
 	c.FileReference = NewFileReferenceClient(c.config)
+	c.Job = NewJobClient(c.config)
 	c.QRCode = NewQRCodeClient(c.config)
 	c.QRCodeAnalytics = NewQRCodeAnalyticsClient(c.config)
+	c.QRCodeAnalyticsDaily = NewQRCodeAnalyticsDailyClient(c.config)
 	c.QRCodeGroup = NewQRCodeGroupClient(c.config)
+	c.QRCodeRedemption = NewQRCodeRedemptionClient(c.config)
+	c.SigningKey = NewSigningKeyClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -140,12 +168,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		FileReference:   NewFileReferenceClient(cfg),
-		QRCode:          NewQRCodeClient(cfg),
-		QRCodeAnalytics: NewQRCodeAnalyticsClient(cfg),
-		QRCodeGroup:     NewQRCodeGroupClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Checkpoint:           NewCheckpointClient(cfg),
+		FileReference:        NewFileReferenceClient(cfg),
+		Job:                  NewJobClient(cfg),
+		QRCode:               NewQRCodeClient(cfg),
+		QRCodeAnalytics:      NewQRCodeAnalyticsClient(cfg),
+		QRCodeAnalyticsDaily: NewQRCodeAnalyticsDailyClient(cfg),
+		QRCodeGroup:          NewQRCodeGroupClient(cfg),
+		QRCodeRedemption:     NewQRCodeRedemptionClient(cfg),
+		SigningKey:           NewSigningKeyClient(cfg),
+		Webhook:              NewWebhookClient(cfg),
+		WebhookDelivery:      NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -163,19 +198,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		FileReference:   NewFileReferenceClient(cfg),
-		QRCode:          NewQRCodeClient(cfg),
-		QRCodeAnalytics: NewQRCodeAnalyticsClient(cfg),
-		QRCodeGroup:     NewQRCodeGroupClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		Checkpoint:           NewCheckpointClient(cfg),
+		FileReference:        NewFileReferenceClient(cfg),
+		Job:                  NewJobClient(cfg),
+		QRCode:               NewQRCodeClient(cfg),
+		QRCodeAnalytics:      NewQRCodeAnalyticsClient(cfg),
+		QRCodeAnalyticsDaily: NewQRCodeAnalyticsDailyClient(cfg),
+		QRCodeGroup:          NewQRCodeGroupClient(cfg),
+		QRCodeRedemption:     NewQRCodeRedemptionClient(cfg),
+		SigningKey:           NewSigningKeyClient(cfg),
+		Webhook:              NewWebhookClient(cfg),
+		WebhookDelivery:      NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		FileReference.
+//		Checkpoint.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -197,37 +239,190 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.FileReference.Use(hooks...)
-	c.QRCode.Use(hooks...)
-	c.QRCodeAnalytics.Use(hooks...)
-	c.QRCodeGroup.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Checkpoint, c.FileReference, c.Job, c.QRCode, c.QRCodeAnalytics,
+		c.QRCodeAnalyticsDaily, c.QRCodeGroup, c.QRCodeRedemption, c.SigningKey,
+		c.Webhook, c.WebhookDelivery,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.FileReference.Intercept(interceptors...)
-	c.QRCode.Intercept(interceptors...)
-	c.QRCodeAnalytics.Intercept(interceptors...)
-	c.QRCodeGroup.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Checkpoint, c.FileReference, c.Job, c.QRCode, c.QRCodeAnalytics,
+		c.QRCodeAnalyticsDaily, c.QRCodeGroup, c.QRCodeRedemption, c.SigningKey,
+		c.Webhook, c.WebhookDelivery,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CheckpointMutation:
+		return c.Checkpoint.mutate(ctx, m)
 	case *FileReferenceMutation:
 		return c.FileReference.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
 	case *QRCodeMutation:
 		return c.QRCode.mutate(ctx, m)
 	case *QRCodeAnalyticsMutation:
 		return c.QRCodeAnalytics.mutate(ctx, m)
+	case *QRCodeAnalyticsDailyMutation:
+		return c.QRCodeAnalyticsDaily.mutate(ctx, m)
 	case *QRCodeGroupMutation:
 		return c.QRCodeGroup.mutate(ctx, m)
+	case *QRCodeRedemptionMutation:
+		return c.QRCodeRedemption.mutate(ctx, m)
+	case *SigningKeyMutation:
+		return c.SigningKey.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// CheckpointClient is a client for the Checkpoint schema.
+type CheckpointClient struct {
+	config
+}
+
+// NewCheckpointClient returns a client for the Checkpoint from the given config.
+func NewCheckpointClient(c config) *CheckpointClient {
+	return &CheckpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `checkpoint.Hooks(f(g(h())))`.
+func (c *CheckpointClient) Use(hooks ...Hook) {
+	c.hooks.Checkpoint = append(c.hooks.Checkpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `checkpoint.Intercept(f(g(h())))`.
+func (c *CheckpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Checkpoint = append(c.inters.Checkpoint, interceptors...)
+}
+
+// Create returns a builder for creating a Checkpoint entity.
+func (c *CheckpointClient) Create() *CheckpointCreate {
+	mutation := newCheckpointMutation(c.config, OpCreate)
+	return &CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Checkpoint entities.
+func (c *CheckpointClient) CreateBulk(builders ...*CheckpointCreate) *CheckpointCreateBulk {
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CheckpointClient) MapCreateBulk(slice any, setFunc func(*CheckpointCreate, int)) *CheckpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CheckpointCreateBulk{err: fmt.Errorf("calling to CheckpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CheckpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CheckpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Checkpoint.
+func (c *CheckpointClient) Update() *CheckpointUpdate {
+	mutation := newCheckpointMutation(c.config, OpUpdate)
+	return &CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CheckpointClient) UpdateOne(ch *Checkpoint) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpoint(ch))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CheckpointClient) UpdateOneID(id int) *CheckpointUpdateOne {
+	mutation := newCheckpointMutation(c.config, OpUpdateOne, withCheckpointID(id))
+	return &CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Checkpoint.
+func (c *CheckpointClient) Delete() *CheckpointDelete {
+	mutation := newCheckpointMutation(c.config, OpDelete)
+	return &CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CheckpointClient) DeleteOne(ch *Checkpoint) *CheckpointDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CheckpointClient) DeleteOneID(id int) *CheckpointDeleteOne {
+	builder := c.Delete().Where(checkpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CheckpointDeleteOne{builder}
+}
+
+// Query returns a query builder for Checkpoint.
+func (c *CheckpointClient) Query() *CheckpointQuery {
+	return &CheckpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCheckpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Checkpoint entity by its id.
+func (c *CheckpointClient) Get(ctx context.Context, id int) (*Checkpoint, error) {
+	return c.Query().Where(checkpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CheckpointClient) GetX(ctx context.Context, id int) *Checkpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CheckpointClient) Hooks() []Hook {
+	return c.hooks.Checkpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *CheckpointClient) Interceptors() []Interceptor {
+	return c.inters.Checkpoint
+}
+
+func (c *CheckpointClient) mutate(ctx context.Context, m *CheckpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CheckpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CheckpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CheckpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CheckpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Checkpoint mutation op: %q", m.Op())
+	}
+}
+
 // FileReferenceClient is a client for the FileReference schema.
 type FileReferenceClient struct {
 	config
@@ -377,6 +572,139 @@ func (c *FileReferenceClient) mutate(ctx context.Context, m *FileReferenceMutati
 	}
 }
 
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id int) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id int) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id int) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id int) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
 // QRCodeClient is a client for the QRCode schema.
 type QRCodeClient struct {
 	config
@@ -533,6 +861,54 @@ func (c *QRCodeClient) QueryAnalyticsRecords(qc *QRCode) *QRCodeAnalyticsQuery {
 	return query
 }
 
+// QueryDailyAnalytics queries the daily_analytics edge of a QRCode.
+func (c *QRCodeClient) QueryDailyAnalytics(qc *QRCode) *QRCodeAnalyticsDailyQuery {
+	query := (&QRCodeAnalyticsDailyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, id),
+			sqlgraph.To(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.DailyAnalyticsTable, qrcode.DailyAnalyticsColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a QRCode.
+func (c *QRCodeClient) QueryWebhooks(qc *QRCode) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.WebhooksTable, qrcode.WebhooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRedemptions queries the redemptions edge of a QRCode.
+func (c *QRCodeClient) QueryRedemptions(qc *QRCode) *QRCodeRedemptionQuery {
+	query := (&QRCodeRedemptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, id),
+			sqlgraph.To(qrcoderedemption.Table, qrcoderedemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.RedemptionsTable, qrcode.RedemptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(qc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *QRCodeClient) Hooks() []Hook {
 	return c.hooks.QRCode
@@ -707,107 +1083,107 @@ func (c *QRCodeAnalyticsClient) mutate(ctx context.Context, m *QRCodeAnalyticsMu
 	}
 }
 
-// QRCodeGroupClient is a client for the QRCodeGroup schema.
-type QRCodeGroupClient struct {
+// QRCodeAnalyticsDailyClient is a client for the QRCodeAnalyticsDaily schema.
+type QRCodeAnalyticsDailyClient struct {
 	config
 }
 
-// NewQRCodeGroupClient returns a client for the QRCodeGroup from the given config.
-func NewQRCodeGroupClient(c config) *QRCodeGroupClient {
-	return &QRCodeGroupClient{config: c}
+// NewQRCodeAnalyticsDailyClient returns a client for the QRCodeAnalyticsDaily from the given config.
+func NewQRCodeAnalyticsDailyClient(c config) *QRCodeAnalyticsDailyClient {
+	return &QRCodeAnalyticsDailyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `qrcodegroup.Hooks(f(g(h())))`.
-func (c *QRCodeGroupClient) Use(hooks ...Hook) {
-	c.hooks.QRCodeGroup = append(c.hooks.QRCodeGroup, hooks...)
+// A call to `Use(f, g, h)` equals to `qrcodeanalyticsdaily.Hooks(f(g(h())))`.
+func (c *QRCodeAnalyticsDailyClient) Use(hooks ...Hook) {
+	c.hooks.QRCodeAnalyticsDaily = append(c.hooks.QRCodeAnalyticsDaily, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `qrcodegroup.Intercept(f(g(h())))`.
-func (c *QRCodeGroupClient) Intercept(interceptors ...Interceptor) {
-	c.inters.QRCodeGroup = append(c.inters.QRCodeGroup, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `qrcodeanalyticsdaily.Intercept(f(g(h())))`.
+func (c *QRCodeAnalyticsDailyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QRCodeAnalyticsDaily = append(c.inters.QRCodeAnalyticsDaily, interceptors...)
 }
 
-// Create returns a builder for creating a QRCodeGroup entity.
-func (c *QRCodeGroupClient) Create() *QRCodeGroupCreate {
-	mutation := newQRCodeGroupMutation(c.config, OpCreate)
-	return &QRCodeGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a QRCodeAnalyticsDaily entity.
+func (c *QRCodeAnalyticsDailyClient) Create() *QRCodeAnalyticsDailyCreate {
+	mutation := newQRCodeAnalyticsDailyMutation(c.config, OpCreate)
+	return &QRCodeAnalyticsDailyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of QRCodeGroup entities.
-func (c *QRCodeGroupClient) CreateBulk(builders ...*QRCodeGroupCreate) *QRCodeGroupCreateBulk {
-	return &QRCodeGroupCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of QRCodeAnalyticsDaily entities.
+func (c *QRCodeAnalyticsDailyClient) CreateBulk(builders ...*QRCodeAnalyticsDailyCreate) *QRCodeAnalyticsDailyCreateBulk {
+	return &QRCodeAnalyticsDailyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *QRCodeGroupClient) MapCreateBulk(slice any, setFunc func(*QRCodeGroupCreate, int)) *QRCodeGroupCreateBulk {
+func (c *QRCodeAnalyticsDailyClient) MapCreateBulk(slice any, setFunc func(*QRCodeAnalyticsDailyCreate, int)) *QRCodeAnalyticsDailyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &QRCodeGroupCreateBulk{err: fmt.Errorf("calling to QRCodeGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QRCodeAnalyticsDailyCreateBulk{err: fmt.Errorf("calling to QRCodeAnalyticsDailyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*QRCodeGroupCreate, rv.Len())
+	builders := make([]*QRCodeAnalyticsDailyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &QRCodeGroupCreateBulk{config: c.config, builders: builders}
+	return &QRCodeAnalyticsDailyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for QRCodeGroup.
-func (c *QRCodeGroupClient) Update() *QRCodeGroupUpdate {
-	mutation := newQRCodeGroupMutation(c.config, OpUpdate)
-	return &QRCodeGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for QRCodeAnalyticsDaily.
+func (c *QRCodeAnalyticsDailyClient) Update() *QRCodeAnalyticsDailyUpdate {
+	mutation := newQRCodeAnalyticsDailyMutation(c.config, OpUpdate)
+	return &QRCodeAnalyticsDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *QRCodeGroupClient) UpdateOne(qcg *QRCodeGroup) *QRCodeGroupUpdateOne {
-	mutation := newQRCodeGroupMutation(c.config, OpUpdateOne, withQRCodeGroup(qcg))
-	return &QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QRCodeAnalyticsDailyClient) UpdateOne(qcad *QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyUpdateOne {
+	mutation := newQRCodeAnalyticsDailyMutation(c.config, OpUpdateOne, withQRCodeAnalyticsDaily(qcad))
+	return &QRCodeAnalyticsDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *QRCodeGroupClient) UpdateOneID(id int) *QRCodeGroupUpdateOne {
-	mutation := newQRCodeGroupMutation(c.config, OpUpdateOne, withQRCodeGroupID(id))
-	return &QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QRCodeAnalyticsDailyClient) UpdateOneID(id int) *QRCodeAnalyticsDailyUpdateOne {
+	mutation := newQRCodeAnalyticsDailyMutation(c.config, OpUpdateOne, withQRCodeAnalyticsDailyID(id))
+	return &QRCodeAnalyticsDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for QRCodeGroup.
-func (c *QRCodeGroupClient) Delete() *QRCodeGroupDelete {
-	mutation := newQRCodeGroupMutation(c.config, OpDelete)
-	return &QRCodeGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for QRCodeAnalyticsDaily.
+func (c *QRCodeAnalyticsDailyClient) Delete() *QRCodeAnalyticsDailyDelete {
+	mutation := newQRCodeAnalyticsDailyMutation(c.config, OpDelete)
+	return &QRCodeAnalyticsDailyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *QRCodeGroupClient) DeleteOne(qcg *QRCodeGroup) *QRCodeGroupDeleteOne {
-	return c.DeleteOneID(qcg.ID)
+func (c *QRCodeAnalyticsDailyClient) DeleteOne(qcad *QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyDeleteOne {
+	return c.DeleteOneID(qcad.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *QRCodeGroupClient) DeleteOneID(id int) *QRCodeGroupDeleteOne {
-	builder := c.Delete().Where(qrcodegroup.ID(id))
+func (c *QRCodeAnalyticsDailyClient) DeleteOneID(id int) *QRCodeAnalyticsDailyDeleteOne {
+	builder := c.Delete().Where(qrcodeanalyticsdaily.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &QRCodeGroupDeleteOne{builder}
+	return &QRCodeAnalyticsDailyDeleteOne{builder}
 }
 
-// Query returns a query builder for QRCodeGroup.
-func (c *QRCodeGroupClient) Query() *QRCodeGroupQuery {
-	return &QRCodeGroupQuery{
+// Query returns a query builder for QRCodeAnalyticsDaily.
+func (c *QRCodeAnalyticsDailyClient) Query() *QRCodeAnalyticsDailyQuery {
+	return &QRCodeAnalyticsDailyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeQRCodeGroup},
+		ctx:    &QueryContext{Type: TypeQRCodeAnalyticsDaily},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a QRCodeGroup entity by its id.
-func (c *QRCodeGroupClient) Get(ctx context.Context, id int) (*QRCodeGroup, error) {
-	return c.Query().Where(qrcodegroup.ID(id)).Only(ctx)
+// Get returns a QRCodeAnalyticsDaily entity by its id.
+func (c *QRCodeAnalyticsDailyClient) Get(ctx context.Context, id int) (*QRCodeAnalyticsDaily, error) {
+	return c.Query().Where(qrcodeanalyticsdaily.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *QRCodeGroupClient) GetX(ctx context.Context, id int) *QRCodeGroup {
+func (c *QRCodeAnalyticsDailyClient) GetX(ctx context.Context, id int) *QRCodeAnalyticsDaily {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -815,53 +1191,801 @@ func (c *QRCodeGroupClient) GetX(ctx context.Context, id int) *QRCodeGroup {
 	return obj
 }
 
-// QueryQrcodes queries the qrcodes edge of a QRCodeGroup.
-func (c *QRCodeGroupClient) QueryQrcodes(qcg *QRCodeGroup) *QRCodeQuery {
+// QueryQrCode queries the qr_code edge of a QRCodeAnalyticsDaily.
+func (c *QRCodeAnalyticsDailyClient) QueryQrCode(qcad *QRCodeAnalyticsDaily) *QRCodeQuery {
 	query := (&QRCodeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := qcg.ID
+		id := qcad.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(qrcodegroup.Table, qrcodegroup.FieldID, id),
+			sqlgraph.From(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.FieldID, id),
 			sqlgraph.To(qrcode.Table, qrcode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, qrcodegroup.QrcodesTable, qrcodegroup.QrcodesColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, qrcodeanalyticsdaily.QrCodeTable, qrcodeanalyticsdaily.QrCodeColumn),
 		)
-		fromV = sqlgraph.Neighbors(qcg.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(qcad.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *QRCodeGroupClient) Hooks() []Hook {
-	return c.hooks.QRCodeGroup
+func (c *QRCodeAnalyticsDailyClient) Hooks() []Hook {
+	return c.hooks.QRCodeAnalyticsDaily
 }
 
 // Interceptors returns the client interceptors.
-func (c *QRCodeGroupClient) Interceptors() []Interceptor {
-	return c.inters.QRCodeGroup
+func (c *QRCodeAnalyticsDailyClient) Interceptors() []Interceptor {
+	return c.inters.QRCodeAnalyticsDaily
 }
 
-func (c *QRCodeGroupClient) mutate(ctx context.Context, m *QRCodeGroupMutation) (Value, error) {
+func (c *QRCodeAnalyticsDailyClient) mutate(ctx context.Context, m *QRCodeAnalyticsDailyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&QRCodeGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRCodeAnalyticsDailyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&QRCodeGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRCodeAnalyticsDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&QRCodeAnalyticsDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&QRCodeGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&QRCodeAnalyticsDailyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown QRCodeGroup mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown QRCodeAnalyticsDaily mutation op: %q", m.Op())
+	}
+}
+
+// QRCodeGroupClient is a client for the QRCodeGroup schema.
+type QRCodeGroupClient struct {
+	config
+}
+
+// NewQRCodeGroupClient returns a client for the QRCodeGroup from the given config.
+func NewQRCodeGroupClient(c config) *QRCodeGroupClient {
+	return &QRCodeGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `qrcodegroup.Hooks(f(g(h())))`.
+func (c *QRCodeGroupClient) Use(hooks ...Hook) {
+	c.hooks.QRCodeGroup = append(c.hooks.QRCodeGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `qrcodegroup.Intercept(f(g(h())))`.
+func (c *QRCodeGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QRCodeGroup = append(c.inters.QRCodeGroup, interceptors...)
+}
+
+// Create returns a builder for creating a QRCodeGroup entity.
+func (c *QRCodeGroupClient) Create() *QRCodeGroupCreate {
+	mutation := newQRCodeGroupMutation(c.config, OpCreate)
+	return &QRCodeGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QRCodeGroup entities.
+func (c *QRCodeGroupClient) CreateBulk(builders ...*QRCodeGroupCreate) *QRCodeGroupCreateBulk {
+	return &QRCodeGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QRCodeGroupClient) MapCreateBulk(slice any, setFunc func(*QRCodeGroupCreate, int)) *QRCodeGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QRCodeGroupCreateBulk{err: fmt.Errorf("calling to QRCodeGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QRCodeGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QRCodeGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QRCodeGroup.
+func (c *QRCodeGroupClient) Update() *QRCodeGroupUpdate {
+	mutation := newQRCodeGroupMutation(c.config, OpUpdate)
+	return &QRCodeGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QRCodeGroupClient) UpdateOne(qcg *QRCodeGroup) *QRCodeGroupUpdateOne {
+	mutation := newQRCodeGroupMutation(c.config, OpUpdateOne, withQRCodeGroup(qcg))
+	return &QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QRCodeGroupClient) UpdateOneID(id int) *QRCodeGroupUpdateOne {
+	mutation := newQRCodeGroupMutation(c.config, OpUpdateOne, withQRCodeGroupID(id))
+	return &QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QRCodeGroup.
+func (c *QRCodeGroupClient) Delete() *QRCodeGroupDelete {
+	mutation := newQRCodeGroupMutation(c.config, OpDelete)
+	return &QRCodeGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QRCodeGroupClient) DeleteOne(qcg *QRCodeGroup) *QRCodeGroupDeleteOne {
+	return c.DeleteOneID(qcg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QRCodeGroupClient) DeleteOneID(id int) *QRCodeGroupDeleteOne {
+	builder := c.Delete().Where(qrcodegroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QRCodeGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for QRCodeGroup.
+func (c *QRCodeGroupClient) Query() *QRCodeGroupQuery {
+	return &QRCodeGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQRCodeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QRCodeGroup entity by its id.
+func (c *QRCodeGroupClient) Get(ctx context.Context, id int) (*QRCodeGroup, error) {
+	return c.Query().Where(qrcodegroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QRCodeGroupClient) GetX(ctx context.Context, id int) *QRCodeGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQrcodes queries the qrcodes edge of a QRCodeGroup.
+func (c *QRCodeGroupClient) QueryQrcodes(qcg *QRCodeGroup) *QRCodeQuery {
+	query := (&QRCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qcg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcodegroup.Table, qrcodegroup.FieldID, id),
+			sqlgraph.To(qrcode.Table, qrcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcodegroup.QrcodesTable, qrcodegroup.QrcodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(qcg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QRCodeGroupClient) Hooks() []Hook {
+	return c.hooks.QRCodeGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *QRCodeGroupClient) Interceptors() []Interceptor {
+	return c.inters.QRCodeGroup
+}
+
+func (c *QRCodeGroupClient) mutate(ctx context.Context, m *QRCodeGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QRCodeGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QRCodeGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QRCodeGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QRCodeGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QRCodeGroup mutation op: %q", m.Op())
+	}
+}
+
+// QRCodeRedemptionClient is a client for the QRCodeRedemption schema.
+type QRCodeRedemptionClient struct {
+	config
+}
+
+// NewQRCodeRedemptionClient returns a client for the QRCodeRedemption from the given config.
+func NewQRCodeRedemptionClient(c config) *QRCodeRedemptionClient {
+	return &QRCodeRedemptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `qrcoderedemption.Hooks(f(g(h())))`.
+func (c *QRCodeRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.QRCodeRedemption = append(c.hooks.QRCodeRedemption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `qrcoderedemption.Intercept(f(g(h())))`.
+func (c *QRCodeRedemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QRCodeRedemption = append(c.inters.QRCodeRedemption, interceptors...)
+}
+
+// Create returns a builder for creating a QRCodeRedemption entity.
+func (c *QRCodeRedemptionClient) Create() *QRCodeRedemptionCreate {
+	mutation := newQRCodeRedemptionMutation(c.config, OpCreate)
+	return &QRCodeRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QRCodeRedemption entities.
+func (c *QRCodeRedemptionClient) CreateBulk(builders ...*QRCodeRedemptionCreate) *QRCodeRedemptionCreateBulk {
+	return &QRCodeRedemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QRCodeRedemptionClient) MapCreateBulk(slice any, setFunc func(*QRCodeRedemptionCreate, int)) *QRCodeRedemptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QRCodeRedemptionCreateBulk{err: fmt.Errorf("calling to QRCodeRedemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QRCodeRedemptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QRCodeRedemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QRCodeRedemption.
+func (c *QRCodeRedemptionClient) Update() *QRCodeRedemptionUpdate {
+	mutation := newQRCodeRedemptionMutation(c.config, OpUpdate)
+	return &QRCodeRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QRCodeRedemptionClient) UpdateOne(qcr *QRCodeRedemption) *QRCodeRedemptionUpdateOne {
+	mutation := newQRCodeRedemptionMutation(c.config, OpUpdateOne, withQRCodeRedemption(qcr))
+	return &QRCodeRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QRCodeRedemptionClient) UpdateOneID(id int) *QRCodeRedemptionUpdateOne {
+	mutation := newQRCodeRedemptionMutation(c.config, OpUpdateOne, withQRCodeRedemptionID(id))
+	return &QRCodeRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QRCodeRedemption.
+func (c *QRCodeRedemptionClient) Delete() *QRCodeRedemptionDelete {
+	mutation := newQRCodeRedemptionMutation(c.config, OpDelete)
+	return &QRCodeRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QRCodeRedemptionClient) DeleteOne(qcr *QRCodeRedemption) *QRCodeRedemptionDeleteOne {
+	return c.DeleteOneID(qcr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QRCodeRedemptionClient) DeleteOneID(id int) *QRCodeRedemptionDeleteOne {
+	builder := c.Delete().Where(qrcoderedemption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QRCodeRedemptionDeleteOne{builder}
+}
+
+// Query returns a query builder for QRCodeRedemption.
+func (c *QRCodeRedemptionClient) Query() *QRCodeRedemptionQuery {
+	return &QRCodeRedemptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQRCodeRedemption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QRCodeRedemption entity by its id.
+func (c *QRCodeRedemptionClient) Get(ctx context.Context, id int) (*QRCodeRedemption, error) {
+	return c.Query().Where(qrcoderedemption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QRCodeRedemptionClient) GetX(ctx context.Context, id int) *QRCodeRedemption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQrCode queries the qr_code edge of a QRCodeRedemption.
+func (c *QRCodeRedemptionClient) QueryQrCode(qcr *QRCodeRedemption) *QRCodeQuery {
+	query := (&QRCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qcr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcoderedemption.Table, qrcoderedemption.FieldID, id),
+			sqlgraph.To(qrcode.Table, qrcode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, qrcoderedemption.QrCodeTable, qrcoderedemption.QrCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(qcr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QRCodeRedemptionClient) Hooks() []Hook {
+	return c.hooks.QRCodeRedemption
+}
+
+// Interceptors returns the client interceptors.
+func (c *QRCodeRedemptionClient) Interceptors() []Interceptor {
+	return c.inters.QRCodeRedemption
+}
+
+func (c *QRCodeRedemptionClient) mutate(ctx context.Context, m *QRCodeRedemptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QRCodeRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QRCodeRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QRCodeRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QRCodeRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QRCodeRedemption mutation op: %q", m.Op())
+	}
+}
+
+// SigningKeyClient is a client for the SigningKey schema.
+type SigningKeyClient struct {
+	config
+}
+
+// NewSigningKeyClient returns a client for the SigningKey from the given config.
+func NewSigningKeyClient(c config) *SigningKeyClient {
+	return &SigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingkey.Hooks(f(g(h())))`.
+func (c *SigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.SigningKey = append(c.hooks.SigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signingkey.Intercept(f(g(h())))`.
+func (c *SigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningKey = append(c.inters.SigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a SigningKey entity.
+func (c *SigningKeyClient) Create() *SigningKeyCreate {
+	mutation := newSigningKeyMutation(c.config, OpCreate)
+	return &SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningKey entities.
+func (c *SigningKeyClient) CreateBulk(builders ...*SigningKeyCreate) *SigningKeyCreateBulk {
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SigningKeyClient) MapCreateBulk(slice any, setFunc func(*SigningKeyCreate, int)) *SigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SigningKeyCreateBulk{err: fmt.Errorf("calling to SigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SigningKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SigningKey.
+func (c *SigningKeyClient) Update() *SigningKeyUpdate {
+	mutation := newSigningKeyMutation(c.config, OpUpdate)
+	return &SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SigningKeyClient) UpdateOne(sk *SigningKey) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKey(sk))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SigningKeyClient) UpdateOneID(id int) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKeyID(id))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SigningKey.
+func (c *SigningKeyClient) Delete() *SigningKeyDelete {
+	mutation := newSigningKeyMutation(c.config, OpDelete)
+	return &SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SigningKeyClient) DeleteOne(sk *SigningKey) *SigningKeyDeleteOne {
+	return c.DeleteOneID(sk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SigningKeyClient) DeleteOneID(id int) *SigningKeyDeleteOne {
+	builder := c.Delete().Where(signingkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SigningKey.
+func (c *SigningKeyClient) Query() *SigningKeyQuery {
+	return &SigningKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSigningKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SigningKey entity by its id.
+func (c *SigningKeyClient) Get(ctx context.Context, id int) (*SigningKey, error) {
+	return c.Query().Where(signingkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SigningKeyClient) GetX(ctx context.Context, id int) *SigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SigningKeyClient) Hooks() []Hook {
+	return c.hooks.SigningKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *SigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.SigningKey
+}
+
+func (c *SigningKeyClient) mutate(ctx context.Context, m *SigningKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SigningKey mutation op: %q", m.Op())
+	}
+}
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id int) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id int) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id int) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id int) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQrCode queries the qr_code edge of a Webhook.
+func (c *WebhookClient) QueryQrCode(w *Webhook) *QRCodeQuery {
+	query := (&QRCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(qrcode.Table, qrcode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.QrCodeTable, webhook.QrCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		FileReference, QRCode, QRCodeAnalytics, QRCodeGroup []ent.Hook
+		Checkpoint, FileReference, Job, QRCode, QRCodeAnalytics, QRCodeAnalyticsDaily,
+		QRCodeGroup, QRCodeRedemption, SigningKey, Webhook, WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		FileReference, QRCode, QRCodeAnalytics, QRCodeGroup []ent.Interceptor
+		Checkpoint, FileReference, Job, QRCode, QRCodeAnalytics, QRCodeAnalyticsDaily,
+		QRCodeGroup, QRCodeRedemption, SigningKey, Webhook,
+		WebhookDelivery []ent.Interceptor
 	}
 )
@@ -6,10 +6,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"qr_backend/ent/checkpoint"
 	"qr_backend/ent/filereference"
+	"qr_backend/ent/job"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/signingkey"
+	"qr_backend/ent/webhook"
+	"qr_backend/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -76,10 +83,17 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			filereference.Table:   filereference.ValidColumn,
-			qrcode.Table:          qrcode.ValidColumn,
-			qrcodeanalytics.Table: qrcodeanalytics.ValidColumn,
-			qrcodegroup.Table:     qrcodegroup.ValidColumn,
+			checkpoint.Table:           checkpoint.ValidColumn,
+			filereference.Table:        filereference.ValidColumn,
+			job.Table:                  job.ValidColumn,
+			qrcode.Table:               qrcode.ValidColumn,
+			qrcodeanalytics.Table:      qrcodeanalytics.ValidColumn,
+			qrcodeanalyticsdaily.Table: qrcodeanalyticsdaily.ValidColumn,
+			qrcodegroup.Table:          qrcodegroup.ValidColumn,
+			qrcoderedemption.Table:     qrcoderedemption.ValidColumn,
+			signingkey.Table:           signingkey.ValidColumn,
+			webhook.Table:              webhook.ValidColumn,
+			webhookdelivery.Table:      webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,10 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"qr_backend/ent/filereference"
 	"qr_backend/ent/qrcode"
+	"qr_backend/ent/schema"
+	"qr_backend/pkg/pdfinfo"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +29,38 @@ type FileReference struct {
 	Size int64 `json:"size,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
+	// Backend holds the value of the "backend" field.
+	Backend string `json:"backend,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Variants holds the value of the "variants" field.
+	Variants []schema.ImageVariant `json:"variants,omitempty"`
+	// VariantsSize holds the value of the "variants_size" field.
+	VariantsSize int64 `json:"variants_size,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Pdf holds the value of the "pdf" field.
+	Pdf *pdfinfo.Info `json:"pdf,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// ScanStatus holds the value of the "scan_status" field.
+	ScanStatus filereference.ScanStatus `json:"scan_status,omitempty"`
+	// ScanResult holds the value of the "scan_result" field.
+	ScanResult string `json:"scan_result,omitempty"`
+	// ScanAttempts holds the value of the "scan_attempts" field.
+	ScanAttempts int `json:"scan_attempts,omitempty"`
+	// ScannedAt holds the value of the "scanned_at" field.
+	ScannedAt *time.Time `json:"scanned_at,omitempty"`
+	// NextScanAt holds the value of the "next_scan_at" field.
+	NextScanAt *time.Time `json:"next_scan_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileReferenceQuery when eager-loading is set.
 	Edges             FileReferenceEdges `json:"edges"`
@@ -57,10 +93,14 @@ func (*FileReference) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case filereference.FieldID, filereference.FieldSize:
+		case filereference.FieldVariants, filereference.FieldPdf:
+			values[i] = new([]byte)
+		case filereference.FieldID, filereference.FieldSize, filereference.FieldWidth, filereference.FieldHeight, filereference.FieldVariantsSize, filereference.FieldVersion, filereference.FieldScanAttempts:
 			values[i] = new(sql.NullInt64)
-		case filereference.FieldFilename, filereference.FieldURL, filereference.FieldType:
+		case filereference.FieldFilename, filereference.FieldURL, filereference.FieldType, filereference.FieldBackend, filereference.FieldKey, filereference.FieldChecksum, filereference.FieldOwner, filereference.FieldScanStatus, filereference.FieldScanResult:
 			values[i] = new(sql.NullString)
+		case filereference.FieldCreatedAt, filereference.FieldScannedAt, filereference.FieldNextScanAt:
+			values[i] = new(sql.NullTime)
 		case filereference.ForeignKeys[0]: // qr_code_file_refs
 			values[i] = new(sql.NullInt64)
 		default:
@@ -108,6 +148,109 @@ func (fr *FileReference) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fr.Type = value.String
 			}
+		case filereference.FieldBackend:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field backend", values[i])
+			} else if value.Valid {
+				fr.Backend = value.String
+			}
+		case filereference.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				fr.Key = value.String
+			}
+		case filereference.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				fr.Checksum = value.String
+			}
+		case filereference.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				fr.Width = int(value.Int64)
+			}
+		case filereference.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				fr.Height = int(value.Int64)
+			}
+		case filereference.FieldVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fr.Variants); err != nil {
+					return fmt.Errorf("unmarshal field variants: %w", err)
+				}
+			}
+		case filereference.FieldVariantsSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field variants_size", values[i])
+			} else if value.Valid {
+				fr.VariantsSize = value.Int64
+			}
+		case filereference.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				fr.Owner = value.String
+			}
+		case filereference.FieldPdf:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fr.Pdf); err != nil {
+					return fmt.Errorf("unmarshal field pdf: %w", err)
+				}
+			}
+		case filereference.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				fr.Version = int(value.Int64)
+			}
+		case filereference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				fr.CreatedAt = new(time.Time)
+				*fr.CreatedAt = value.Time
+			}
+		case filereference.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				fr.ScanStatus = filereference.ScanStatus(value.String)
+			}
+		case filereference.FieldScanResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_result", values[i])
+			} else if value.Valid {
+				fr.ScanResult = value.String
+			}
+		case filereference.FieldScanAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_attempts", values[i])
+			} else if value.Valid {
+				fr.ScanAttempts = int(value.Int64)
+			}
+		case filereference.FieldScannedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_at", values[i])
+			} else if value.Valid {
+				fr.ScannedAt = new(time.Time)
+				*fr.ScannedAt = value.Time
+			}
+		case filereference.FieldNextScanAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_scan_at", values[i])
+			} else if value.Valid {
+				fr.NextScanAt = new(time.Time)
+				*fr.NextScanAt = value.Time
+			}
 		case filereference.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field qr_code_file_refs", value)
@@ -167,6 +310,60 @@ func (fr *FileReference) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fr.Type)
+	builder.WriteString(", ")
+	builder.WriteString("backend=")
+	builder.WriteString(fr.Backend)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(fr.Key)
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(fr.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Height))
+	builder.WriteString(", ")
+	builder.WriteString("variants=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Variants))
+	builder.WriteString(", ")
+	builder.WriteString("variants_size=")
+	builder.WriteString(fmt.Sprintf("%v", fr.VariantsSize))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(fr.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("pdf=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Pdf))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", fr.Version))
+	builder.WriteString(", ")
+	if v := fr.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scan_status=")
+	builder.WriteString(fmt.Sprintf("%v", fr.ScanStatus))
+	builder.WriteString(", ")
+	builder.WriteString("scan_result=")
+	builder.WriteString(fr.ScanResult)
+	builder.WriteString(", ")
+	builder.WriteString("scan_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", fr.ScanAttempts))
+	builder.WriteString(", ")
+	if v := fr.ScannedAt; v != nil {
+		builder.WriteString("scanned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := fr.NextScanAt; v != nil {
+		builder.WriteString("next_scan_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,9 @@
 package filereference
 
 import (
+	"fmt"
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,6 +23,38 @@ const (
 	FieldSize = "size"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldBackend holds the string denoting the backend field in the database.
+	FieldBackend = "backend"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldVariants holds the string denoting the variants field in the database.
+	FieldVariants = "variants"
+	// FieldVariantsSize holds the string denoting the variants_size field in the database.
+	FieldVariantsSize = "variants_size"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldPdf holds the string denoting the pdf field in the database.
+	FieldPdf = "pdf"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldScanResult holds the string denoting the scan_result field in the database.
+	FieldScanResult = "scan_result"
+	// FieldScanAttempts holds the string denoting the scan_attempts field in the database.
+	FieldScanAttempts = "scan_attempts"
+	// FieldScannedAt holds the string denoting the scanned_at field in the database.
+	FieldScannedAt = "scanned_at"
+	// FieldNextScanAt holds the string denoting the next_scan_at field in the database.
+	FieldNextScanAt = "next_scan_at"
 	// EdgeQrCode holds the string denoting the qr_code edge name in mutations.
 	EdgeQrCode = "qr_code"
 	// Table holds the table name of the filereference in the database.
@@ -40,6 +75,22 @@ var Columns = []string{
 	FieldURL,
 	FieldSize,
 	FieldType,
+	FieldBackend,
+	FieldKey,
+	FieldChecksum,
+	FieldWidth,
+	FieldHeight,
+	FieldVariants,
+	FieldVariantsSize,
+	FieldOwner,
+	FieldPdf,
+	FieldVersion,
+	FieldCreatedAt,
+	FieldScanStatus,
+	FieldScanResult,
+	FieldScanAttempts,
+	FieldScannedAt,
+	FieldNextScanAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "file_references"
@@ -68,8 +119,47 @@ var (
 	FilenameValidator func(string) error
 	// URLValidator is a validator for the "url" field. It is called by the builders before save.
 	URLValidator func(string) error
+	// DefaultBackend holds the default value on creation for the "backend" field.
+	DefaultBackend string
+	// DefaultVariantsSize holds the default value on creation for the "variants_size" field.
+	DefaultVariantsSize int64
+	// DefaultOwner holds the default value on creation for the "owner" field.
+	DefaultOwner string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultScanAttempts holds the default value on creation for the "scan_attempts" field.
+	DefaultScanAttempts int
+)
+
+// ScanStatus defines the type for the "scan_status" enum field.
+type ScanStatus string
+
+// ScanStatusSkipped is the default value of the ScanStatus enum.
+const DefaultScanStatus = ScanStatusSkipped
+
+// ScanStatus values.
+const (
+	ScanStatusSkipped  ScanStatus = "skipped"
+	ScanStatusPending  ScanStatus = "pending"
+	ScanStatusClean    ScanStatus = "clean"
+	ScanStatusInfected ScanStatus = "infected"
+	ScanStatusFailed   ScanStatus = "failed"
 )
 
+func (ss ScanStatus) String() string {
+	return string(ss)
+}
+
+// ScanStatusValidator is a validator for the "scan_status" field enum values. It is called by the builders before save.
+func ScanStatusValidator(ss ScanStatus) error {
+	switch ss {
+	case ScanStatusSkipped, ScanStatusPending, ScanStatusClean, ScanStatusInfected, ScanStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("filereference: invalid enum value for scan_status field: %q", ss)
+	}
+}
+
 // OrderOption defines the ordering options for the FileReference queries.
 type OrderOption func(*sql.Selector)
 
@@ -98,6 +188,76 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByBackend orders the results by the backend field.
+func ByBackend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackend, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByVariantsSize orders the results by the variants_size field.
+func ByVariantsSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantsSize, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByScanResult orders the results by the scan_result field.
+func ByScanResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanResult, opts...).ToFunc()
+}
+
+// ByScanAttempts orders the results by the scan_attempts field.
+func ByScanAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanAttempts, opts...).ToFunc()
+}
+
+// ByScannedAt orders the results by the scanned_at field.
+func ByScannedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScannedAt, opts...).ToFunc()
+}
+
+// ByNextScanAt orders the results by the next_scan_at field.
+func ByNextScanAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextScanAt, opts...).ToFunc()
+}
+
 // ByQrCodeField orders the results by qr_code field.
 func ByQrCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
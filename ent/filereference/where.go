@@ -4,6 +4,7 @@ package filereference
 
 import (
 	"qr_backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -74,6 +75,71 @@ func Type(v string) predicate.FileReference {
 	return predicate.FileReference(sql.FieldEQ(FieldType, v))
 }
 
+// Backend applies equality check predicate on the "backend" field. It's identical to BackendEQ.
+func Backend(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldBackend, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldKey, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldChecksum, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldHeight, v))
+}
+
+// VariantsSize applies equality check predicate on the "variants_size" field. It's identical to VariantsSizeEQ.
+func VariantsSize(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldVariantsSize, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldOwner, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ScanResult applies equality check predicate on the "scan_result" field. It's identical to ScanResultEQ.
+func ScanResult(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScanResult, v))
+}
+
+// ScanAttempts applies equality check predicate on the "scan_attempts" field. It's identical to ScanAttemptsEQ.
+func ScanAttempts(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScanAttempts, v))
+}
+
+// ScannedAt applies equality check predicate on the "scanned_at" field. It's identical to ScannedAtEQ.
+func ScannedAt(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// NextScanAt applies equality check predicate on the "next_scan_at" field. It's identical to NextScanAtEQ.
+func NextScanAt(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldNextScanAt, v))
+}
+
 // FilenameEQ applies the EQ predicate on the "filename" field.
 func FilenameEQ(v string) predicate.FileReference {
 	return predicate.FileReference(sql.FieldEQ(FieldFilename, v))
@@ -309,6 +375,781 @@ func TypeContainsFold(v string) predicate.FileReference {
 	return predicate.FileReference(sql.FieldContainsFold(FieldType, v))
 }
 
+// BackendEQ applies the EQ predicate on the "backend" field.
+func BackendEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldBackend, v))
+}
+
+// BackendNEQ applies the NEQ predicate on the "backend" field.
+func BackendNEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldBackend, v))
+}
+
+// BackendIn applies the In predicate on the "backend" field.
+func BackendIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldBackend, vs...))
+}
+
+// BackendNotIn applies the NotIn predicate on the "backend" field.
+func BackendNotIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldBackend, vs...))
+}
+
+// BackendGT applies the GT predicate on the "backend" field.
+func BackendGT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldBackend, v))
+}
+
+// BackendGTE applies the GTE predicate on the "backend" field.
+func BackendGTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldBackend, v))
+}
+
+// BackendLT applies the LT predicate on the "backend" field.
+func BackendLT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldBackend, v))
+}
+
+// BackendLTE applies the LTE predicate on the "backend" field.
+func BackendLTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldBackend, v))
+}
+
+// BackendContains applies the Contains predicate on the "backend" field.
+func BackendContains(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContains(FieldBackend, v))
+}
+
+// BackendHasPrefix applies the HasPrefix predicate on the "backend" field.
+func BackendHasPrefix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasPrefix(FieldBackend, v))
+}
+
+// BackendHasSuffix applies the HasSuffix predicate on the "backend" field.
+func BackendHasSuffix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasSuffix(FieldBackend, v))
+}
+
+// BackendEqualFold applies the EqualFold predicate on the "backend" field.
+func BackendEqualFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEqualFold(FieldBackend, v))
+}
+
+// BackendContainsFold applies the ContainsFold predicate on the "backend" field.
+func BackendContainsFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContainsFold(FieldBackend, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyIsNil applies the IsNil predicate on the "key" field.
+func KeyIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldKey))
+}
+
+// KeyNotNil applies the NotNil predicate on the "key" field.
+func KeyNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldKey))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumIsNil applies the IsNil predicate on the "checksum" field.
+func ChecksumIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldChecksum))
+}
+
+// ChecksumNotNil applies the NotNil predicate on the "checksum" field.
+func ChecksumNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldChecksum))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldWidth, v))
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldWidth))
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldWidth))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldHeight, v))
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldHeight))
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldHeight))
+}
+
+// VariantsIsNil applies the IsNil predicate on the "variants" field.
+func VariantsIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldVariants))
+}
+
+// VariantsNotNil applies the NotNil predicate on the "variants" field.
+func VariantsNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldVariants))
+}
+
+// VariantsSizeEQ applies the EQ predicate on the "variants_size" field.
+func VariantsSizeEQ(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldVariantsSize, v))
+}
+
+// VariantsSizeNEQ applies the NEQ predicate on the "variants_size" field.
+func VariantsSizeNEQ(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldVariantsSize, v))
+}
+
+// VariantsSizeIn applies the In predicate on the "variants_size" field.
+func VariantsSizeIn(vs ...int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldVariantsSize, vs...))
+}
+
+// VariantsSizeNotIn applies the NotIn predicate on the "variants_size" field.
+func VariantsSizeNotIn(vs ...int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldVariantsSize, vs...))
+}
+
+// VariantsSizeGT applies the GT predicate on the "variants_size" field.
+func VariantsSizeGT(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldVariantsSize, v))
+}
+
+// VariantsSizeGTE applies the GTE predicate on the "variants_size" field.
+func VariantsSizeGTE(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldVariantsSize, v))
+}
+
+// VariantsSizeLT applies the LT predicate on the "variants_size" field.
+func VariantsSizeLT(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldVariantsSize, v))
+}
+
+// VariantsSizeLTE applies the LTE predicate on the "variants_size" field.
+func VariantsSizeLTE(v int64) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldVariantsSize, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// PdfIsNil applies the IsNil predicate on the "pdf" field.
+func PdfIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldPdf))
+}
+
+// PdfNotNil applies the NotNil predicate on the "pdf" field.
+func PdfNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldPdf))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionIsNil applies the IsNil predicate on the "version" field.
+func VersionIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldVersion))
+}
+
+// VersionNotNil applies the NotNil predicate on the "version" field.
+func VersionNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldVersion))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v ScanStatus) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v ScanStatus) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...ScanStatus) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...ScanStatus) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanResultEQ applies the EQ predicate on the "scan_result" field.
+func ScanResultEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScanResult, v))
+}
+
+// ScanResultNEQ applies the NEQ predicate on the "scan_result" field.
+func ScanResultNEQ(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldScanResult, v))
+}
+
+// ScanResultIn applies the In predicate on the "scan_result" field.
+func ScanResultIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldScanResult, vs...))
+}
+
+// ScanResultNotIn applies the NotIn predicate on the "scan_result" field.
+func ScanResultNotIn(vs ...string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldScanResult, vs...))
+}
+
+// ScanResultGT applies the GT predicate on the "scan_result" field.
+func ScanResultGT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldScanResult, v))
+}
+
+// ScanResultGTE applies the GTE predicate on the "scan_result" field.
+func ScanResultGTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldScanResult, v))
+}
+
+// ScanResultLT applies the LT predicate on the "scan_result" field.
+func ScanResultLT(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldScanResult, v))
+}
+
+// ScanResultLTE applies the LTE predicate on the "scan_result" field.
+func ScanResultLTE(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldScanResult, v))
+}
+
+// ScanResultContains applies the Contains predicate on the "scan_result" field.
+func ScanResultContains(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContains(FieldScanResult, v))
+}
+
+// ScanResultHasPrefix applies the HasPrefix predicate on the "scan_result" field.
+func ScanResultHasPrefix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasPrefix(FieldScanResult, v))
+}
+
+// ScanResultHasSuffix applies the HasSuffix predicate on the "scan_result" field.
+func ScanResultHasSuffix(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldHasSuffix(FieldScanResult, v))
+}
+
+// ScanResultIsNil applies the IsNil predicate on the "scan_result" field.
+func ScanResultIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldScanResult))
+}
+
+// ScanResultNotNil applies the NotNil predicate on the "scan_result" field.
+func ScanResultNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldScanResult))
+}
+
+// ScanResultEqualFold applies the EqualFold predicate on the "scan_result" field.
+func ScanResultEqualFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEqualFold(FieldScanResult, v))
+}
+
+// ScanResultContainsFold applies the ContainsFold predicate on the "scan_result" field.
+func ScanResultContainsFold(v string) predicate.FileReference {
+	return predicate.FileReference(sql.FieldContainsFold(FieldScanResult, v))
+}
+
+// ScanAttemptsEQ applies the EQ predicate on the "scan_attempts" field.
+func ScanAttemptsEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScanAttempts, v))
+}
+
+// ScanAttemptsNEQ applies the NEQ predicate on the "scan_attempts" field.
+func ScanAttemptsNEQ(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldScanAttempts, v))
+}
+
+// ScanAttemptsIn applies the In predicate on the "scan_attempts" field.
+func ScanAttemptsIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldScanAttempts, vs...))
+}
+
+// ScanAttemptsNotIn applies the NotIn predicate on the "scan_attempts" field.
+func ScanAttemptsNotIn(vs ...int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldScanAttempts, vs...))
+}
+
+// ScanAttemptsGT applies the GT predicate on the "scan_attempts" field.
+func ScanAttemptsGT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldScanAttempts, v))
+}
+
+// ScanAttemptsGTE applies the GTE predicate on the "scan_attempts" field.
+func ScanAttemptsGTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldScanAttempts, v))
+}
+
+// ScanAttemptsLT applies the LT predicate on the "scan_attempts" field.
+func ScanAttemptsLT(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldScanAttempts, v))
+}
+
+// ScanAttemptsLTE applies the LTE predicate on the "scan_attempts" field.
+func ScanAttemptsLTE(v int) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldScanAttempts, v))
+}
+
+// ScannedAtEQ applies the EQ predicate on the "scanned_at" field.
+func ScannedAtEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldScannedAt, v))
+}
+
+// ScannedAtNEQ applies the NEQ predicate on the "scanned_at" field.
+func ScannedAtNEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldScannedAt, v))
+}
+
+// ScannedAtIn applies the In predicate on the "scanned_at" field.
+func ScannedAtIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtNotIn applies the NotIn predicate on the "scanned_at" field.
+func ScannedAtNotIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldScannedAt, vs...))
+}
+
+// ScannedAtGT applies the GT predicate on the "scanned_at" field.
+func ScannedAtGT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldScannedAt, v))
+}
+
+// ScannedAtGTE applies the GTE predicate on the "scanned_at" field.
+func ScannedAtGTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldScannedAt, v))
+}
+
+// ScannedAtLT applies the LT predicate on the "scanned_at" field.
+func ScannedAtLT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldScannedAt, v))
+}
+
+// ScannedAtLTE applies the LTE predicate on the "scanned_at" field.
+func ScannedAtLTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldScannedAt, v))
+}
+
+// ScannedAtIsNil applies the IsNil predicate on the "scanned_at" field.
+func ScannedAtIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldScannedAt))
+}
+
+// ScannedAtNotNil applies the NotNil predicate on the "scanned_at" field.
+func ScannedAtNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldScannedAt))
+}
+
+// NextScanAtEQ applies the EQ predicate on the "next_scan_at" field.
+func NextScanAtEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldEQ(FieldNextScanAt, v))
+}
+
+// NextScanAtNEQ applies the NEQ predicate on the "next_scan_at" field.
+func NextScanAtNEQ(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNEQ(FieldNextScanAt, v))
+}
+
+// NextScanAtIn applies the In predicate on the "next_scan_at" field.
+func NextScanAtIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldIn(FieldNextScanAt, vs...))
+}
+
+// NextScanAtNotIn applies the NotIn predicate on the "next_scan_at" field.
+func NextScanAtNotIn(vs ...time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotIn(FieldNextScanAt, vs...))
+}
+
+// NextScanAtGT applies the GT predicate on the "next_scan_at" field.
+func NextScanAtGT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGT(FieldNextScanAt, v))
+}
+
+// NextScanAtGTE applies the GTE predicate on the "next_scan_at" field.
+func NextScanAtGTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldGTE(FieldNextScanAt, v))
+}
+
+// NextScanAtLT applies the LT predicate on the "next_scan_at" field.
+func NextScanAtLT(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLT(FieldNextScanAt, v))
+}
+
+// NextScanAtLTE applies the LTE predicate on the "next_scan_at" field.
+func NextScanAtLTE(v time.Time) predicate.FileReference {
+	return predicate.FileReference(sql.FieldLTE(FieldNextScanAt, v))
+}
+
+// NextScanAtIsNil applies the IsNil predicate on the "next_scan_at" field.
+func NextScanAtIsNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldIsNull(FieldNextScanAt))
+}
+
+// NextScanAtNotNil applies the NotNil predicate on the "next_scan_at" field.
+func NextScanAtNotNil() predicate.FileReference {
+	return predicate.FileReference(sql.FieldNotNull(FieldNextScanAt))
+}
+
 // HasQrCode applies the HasEdge predicate on the "qr_code" edge.
 func HasQrCode() predicate.FileReference {
 	return predicate.FileReference(func(s *sql.Selector) {
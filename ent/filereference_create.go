@@ -8,6 +8,9 @@ import (
 	"fmt"
 	"qr_backend/ent/filereference"
 	"qr_backend/ent/qrcode"
+	"qr_backend/ent/schema"
+	"qr_backend/pkg/pdfinfo"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -44,6 +47,214 @@ func (frc *FileReferenceCreate) SetType(s string) *FileReferenceCreate {
 	return frc
 }
 
+// SetBackend sets the "backend" field.
+func (frc *FileReferenceCreate) SetBackend(s string) *FileReferenceCreate {
+	frc.mutation.SetBackend(s)
+	return frc
+}
+
+// SetNillableBackend sets the "backend" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableBackend(s *string) *FileReferenceCreate {
+	if s != nil {
+		frc.SetBackend(*s)
+	}
+	return frc
+}
+
+// SetKey sets the "key" field.
+func (frc *FileReferenceCreate) SetKey(s string) *FileReferenceCreate {
+	frc.mutation.SetKey(s)
+	return frc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableKey(s *string) *FileReferenceCreate {
+	if s != nil {
+		frc.SetKey(*s)
+	}
+	return frc
+}
+
+// SetChecksum sets the "checksum" field.
+func (frc *FileReferenceCreate) SetChecksum(s string) *FileReferenceCreate {
+	frc.mutation.SetChecksum(s)
+	return frc
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableChecksum(s *string) *FileReferenceCreate {
+	if s != nil {
+		frc.SetChecksum(*s)
+	}
+	return frc
+}
+
+// SetWidth sets the "width" field.
+func (frc *FileReferenceCreate) SetWidth(i int) *FileReferenceCreate {
+	frc.mutation.SetWidth(i)
+	return frc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableWidth(i *int) *FileReferenceCreate {
+	if i != nil {
+		frc.SetWidth(*i)
+	}
+	return frc
+}
+
+// SetHeight sets the "height" field.
+func (frc *FileReferenceCreate) SetHeight(i int) *FileReferenceCreate {
+	frc.mutation.SetHeight(i)
+	return frc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableHeight(i *int) *FileReferenceCreate {
+	if i != nil {
+		frc.SetHeight(*i)
+	}
+	return frc
+}
+
+// SetVariants sets the "variants" field.
+func (frc *FileReferenceCreate) SetVariants(sv []schema.ImageVariant) *FileReferenceCreate {
+	frc.mutation.SetVariants(sv)
+	return frc
+}
+
+// SetVariantsSize sets the "variants_size" field.
+func (frc *FileReferenceCreate) SetVariantsSize(i int64) *FileReferenceCreate {
+	frc.mutation.SetVariantsSize(i)
+	return frc
+}
+
+// SetNillableVariantsSize sets the "variants_size" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableVariantsSize(i *int64) *FileReferenceCreate {
+	if i != nil {
+		frc.SetVariantsSize(*i)
+	}
+	return frc
+}
+
+// SetOwner sets the "owner" field.
+func (frc *FileReferenceCreate) SetOwner(s string) *FileReferenceCreate {
+	frc.mutation.SetOwner(s)
+	return frc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableOwner(s *string) *FileReferenceCreate {
+	if s != nil {
+		frc.SetOwner(*s)
+	}
+	return frc
+}
+
+// SetPdf sets the "pdf" field.
+func (frc *FileReferenceCreate) SetPdf(pd *pdfinfo.Info) *FileReferenceCreate {
+	frc.mutation.SetPdf(pd)
+	return frc
+}
+
+// SetVersion sets the "version" field.
+func (frc *FileReferenceCreate) SetVersion(i int) *FileReferenceCreate {
+	frc.mutation.SetVersion(i)
+	return frc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableVersion(i *int) *FileReferenceCreate {
+	if i != nil {
+		frc.SetVersion(*i)
+	}
+	return frc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (frc *FileReferenceCreate) SetCreatedAt(t time.Time) *FileReferenceCreate {
+	frc.mutation.SetCreatedAt(t)
+	return frc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableCreatedAt(t *time.Time) *FileReferenceCreate {
+	if t != nil {
+		frc.SetCreatedAt(*t)
+	}
+	return frc
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (frc *FileReferenceCreate) SetScanStatus(fs filereference.ScanStatus) *FileReferenceCreate {
+	frc.mutation.SetScanStatus(fs)
+	return frc
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableScanStatus(fs *filereference.ScanStatus) *FileReferenceCreate {
+	if fs != nil {
+		frc.SetScanStatus(*fs)
+	}
+	return frc
+}
+
+// SetScanResult sets the "scan_result" field.
+func (frc *FileReferenceCreate) SetScanResult(s string) *FileReferenceCreate {
+	frc.mutation.SetScanResult(s)
+	return frc
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableScanResult(s *string) *FileReferenceCreate {
+	if s != nil {
+		frc.SetScanResult(*s)
+	}
+	return frc
+}
+
+// SetScanAttempts sets the "scan_attempts" field.
+func (frc *FileReferenceCreate) SetScanAttempts(i int) *FileReferenceCreate {
+	frc.mutation.SetScanAttempts(i)
+	return frc
+}
+
+// SetNillableScanAttempts sets the "scan_attempts" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableScanAttempts(i *int) *FileReferenceCreate {
+	if i != nil {
+		frc.SetScanAttempts(*i)
+	}
+	return frc
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (frc *FileReferenceCreate) SetScannedAt(t time.Time) *FileReferenceCreate {
+	frc.mutation.SetScannedAt(t)
+	return frc
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableScannedAt(t *time.Time) *FileReferenceCreate {
+	if t != nil {
+		frc.SetScannedAt(*t)
+	}
+	return frc
+}
+
+// SetNextScanAt sets the "next_scan_at" field.
+func (frc *FileReferenceCreate) SetNextScanAt(t time.Time) *FileReferenceCreate {
+	frc.mutation.SetNextScanAt(t)
+	return frc
+}
+
+// SetNillableNextScanAt sets the "next_scan_at" field if the given value is not nil.
+func (frc *FileReferenceCreate) SetNillableNextScanAt(t *time.Time) *FileReferenceCreate {
+	if t != nil {
+		frc.SetNextScanAt(*t)
+	}
+	return frc
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (frc *FileReferenceCreate) SetQrCodeID(id int) *FileReferenceCreate {
 	frc.mutation.SetQrCodeID(id)
@@ -70,6 +281,7 @@ func (frc *FileReferenceCreate) Mutation() *FileReferenceMutation {
 
 // Save creates the FileReference in the database.
 func (frc *FileReferenceCreate) Save(ctx context.Context) (*FileReference, error) {
+	frc.defaults()
 	return withHooks(ctx, frc.sqlSave, frc.mutation, frc.hooks)
 }
 
@@ -95,6 +307,34 @@ func (frc *FileReferenceCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (frc *FileReferenceCreate) defaults() {
+	if _, ok := frc.mutation.Backend(); !ok {
+		v := filereference.DefaultBackend
+		frc.mutation.SetBackend(v)
+	}
+	if _, ok := frc.mutation.VariantsSize(); !ok {
+		v := filereference.DefaultVariantsSize
+		frc.mutation.SetVariantsSize(v)
+	}
+	if _, ok := frc.mutation.Owner(); !ok {
+		v := filereference.DefaultOwner
+		frc.mutation.SetOwner(v)
+	}
+	if _, ok := frc.mutation.CreatedAt(); !ok {
+		v := filereference.DefaultCreatedAt()
+		frc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := frc.mutation.ScanStatus(); !ok {
+		v := filereference.DefaultScanStatus
+		frc.mutation.SetScanStatus(v)
+	}
+	if _, ok := frc.mutation.ScanAttempts(); !ok {
+		v := filereference.DefaultScanAttempts
+		frc.mutation.SetScanAttempts(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (frc *FileReferenceCreate) check() error {
 	if _, ok := frc.mutation.Filename(); !ok {
@@ -119,6 +359,26 @@ func (frc *FileReferenceCreate) check() error {
 	if _, ok := frc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileReference.type"`)}
 	}
+	if _, ok := frc.mutation.Backend(); !ok {
+		return &ValidationError{Name: "backend", err: errors.New(`ent: missing required field "FileReference.backend"`)}
+	}
+	if _, ok := frc.mutation.VariantsSize(); !ok {
+		return &ValidationError{Name: "variants_size", err: errors.New(`ent: missing required field "FileReference.variants_size"`)}
+	}
+	if _, ok := frc.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "FileReference.owner"`)}
+	}
+	if _, ok := frc.mutation.ScanStatus(); !ok {
+		return &ValidationError{Name: "scan_status", err: errors.New(`ent: missing required field "FileReference.scan_status"`)}
+	}
+	if v, ok := frc.mutation.ScanStatus(); ok {
+		if err := filereference.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "FileReference.scan_status": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.ScanAttempts(); !ok {
+		return &ValidationError{Name: "scan_attempts", err: errors.New(`ent: missing required field "FileReference.scan_attempts"`)}
+	}
 	return nil
 }
 
@@ -161,6 +421,70 @@ func (frc *FileReferenceCreate) createSpec() (*FileReference, *sqlgraph.CreateSp
 		_spec.SetField(filereference.FieldType, field.TypeString, value)
 		_node.Type = value
 	}
+	if value, ok := frc.mutation.Backend(); ok {
+		_spec.SetField(filereference.FieldBackend, field.TypeString, value)
+		_node.Backend = value
+	}
+	if value, ok := frc.mutation.Key(); ok {
+		_spec.SetField(filereference.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := frc.mutation.Checksum(); ok {
+		_spec.SetField(filereference.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := frc.mutation.Width(); ok {
+		_spec.SetField(filereference.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := frc.mutation.Height(); ok {
+		_spec.SetField(filereference.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := frc.mutation.Variants(); ok {
+		_spec.SetField(filereference.FieldVariants, field.TypeJSON, value)
+		_node.Variants = value
+	}
+	if value, ok := frc.mutation.VariantsSize(); ok {
+		_spec.SetField(filereference.FieldVariantsSize, field.TypeInt64, value)
+		_node.VariantsSize = value
+	}
+	if value, ok := frc.mutation.Owner(); ok {
+		_spec.SetField(filereference.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := frc.mutation.Pdf(); ok {
+		_spec.SetField(filereference.FieldPdf, field.TypeJSON, value)
+		_node.Pdf = value
+	}
+	if value, ok := frc.mutation.Version(); ok {
+		_spec.SetField(filereference.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := frc.mutation.CreatedAt(); ok {
+		_spec.SetField(filereference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := frc.mutation.ScanStatus(); ok {
+		_spec.SetField(filereference.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = value
+	}
+	if value, ok := frc.mutation.ScanResult(); ok {
+		_spec.SetField(filereference.FieldScanResult, field.TypeString, value)
+		_node.ScanResult = value
+	}
+	if value, ok := frc.mutation.ScanAttempts(); ok {
+		_spec.SetField(filereference.FieldScanAttempts, field.TypeInt, value)
+		_node.ScanAttempts = value
+	}
+	if value, ok := frc.mutation.ScannedAt(); ok {
+		_spec.SetField(filereference.FieldScannedAt, field.TypeTime, value)
+		_node.ScannedAt = &value
+	}
+	if value, ok := frc.mutation.NextScanAt(); ok {
+		_spec.SetField(filereference.FieldNextScanAt, field.TypeTime, value)
+		_node.NextScanAt = &value
+	}
 	if nodes := frc.mutation.QrCodeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -199,6 +523,7 @@ func (frcb *FileReferenceCreateBulk) Save(ctx context.Context) ([]*FileReference
 	for i := range frcb.builders {
 		func(i int, root context.Context) {
 			builder := frcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*FileReferenceMutation)
 				if !ok {
@@ -9,9 +9,13 @@ import (
 	"qr_backend/ent/filereference"
 	"qr_backend/ent/predicate"
 	"qr_backend/ent/qrcode"
+	"qr_backend/ent/schema"
+	"qr_backend/pkg/pdfinfo"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -91,6 +95,301 @@ func (fru *FileReferenceUpdate) SetNillableType(s *string) *FileReferenceUpdate
 	return fru
 }
 
+// SetBackend sets the "backend" field.
+func (fru *FileReferenceUpdate) SetBackend(s string) *FileReferenceUpdate {
+	fru.mutation.SetBackend(s)
+	return fru
+}
+
+// SetNillableBackend sets the "backend" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableBackend(s *string) *FileReferenceUpdate {
+	if s != nil {
+		fru.SetBackend(*s)
+	}
+	return fru
+}
+
+// SetKey sets the "key" field.
+func (fru *FileReferenceUpdate) SetKey(s string) *FileReferenceUpdate {
+	fru.mutation.SetKey(s)
+	return fru
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableKey(s *string) *FileReferenceUpdate {
+	if s != nil {
+		fru.SetKey(*s)
+	}
+	return fru
+}
+
+// ClearKey clears the value of the "key" field.
+func (fru *FileReferenceUpdate) ClearKey() *FileReferenceUpdate {
+	fru.mutation.ClearKey()
+	return fru
+}
+
+// SetChecksum sets the "checksum" field.
+func (fru *FileReferenceUpdate) SetChecksum(s string) *FileReferenceUpdate {
+	fru.mutation.SetChecksum(s)
+	return fru
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableChecksum(s *string) *FileReferenceUpdate {
+	if s != nil {
+		fru.SetChecksum(*s)
+	}
+	return fru
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (fru *FileReferenceUpdate) ClearChecksum() *FileReferenceUpdate {
+	fru.mutation.ClearChecksum()
+	return fru
+}
+
+// SetWidth sets the "width" field.
+func (fru *FileReferenceUpdate) SetWidth(i int) *FileReferenceUpdate {
+	fru.mutation.ResetWidth()
+	fru.mutation.SetWidth(i)
+	return fru
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableWidth(i *int) *FileReferenceUpdate {
+	if i != nil {
+		fru.SetWidth(*i)
+	}
+	return fru
+}
+
+// AddWidth adds i to the "width" field.
+func (fru *FileReferenceUpdate) AddWidth(i int) *FileReferenceUpdate {
+	fru.mutation.AddWidth(i)
+	return fru
+}
+
+// ClearWidth clears the value of the "width" field.
+func (fru *FileReferenceUpdate) ClearWidth() *FileReferenceUpdate {
+	fru.mutation.ClearWidth()
+	return fru
+}
+
+// SetHeight sets the "height" field.
+func (fru *FileReferenceUpdate) SetHeight(i int) *FileReferenceUpdate {
+	fru.mutation.ResetHeight()
+	fru.mutation.SetHeight(i)
+	return fru
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableHeight(i *int) *FileReferenceUpdate {
+	if i != nil {
+		fru.SetHeight(*i)
+	}
+	return fru
+}
+
+// AddHeight adds i to the "height" field.
+func (fru *FileReferenceUpdate) AddHeight(i int) *FileReferenceUpdate {
+	fru.mutation.AddHeight(i)
+	return fru
+}
+
+// ClearHeight clears the value of the "height" field.
+func (fru *FileReferenceUpdate) ClearHeight() *FileReferenceUpdate {
+	fru.mutation.ClearHeight()
+	return fru
+}
+
+// SetVariants sets the "variants" field.
+func (fru *FileReferenceUpdate) SetVariants(sv []schema.ImageVariant) *FileReferenceUpdate {
+	fru.mutation.SetVariants(sv)
+	return fru
+}
+
+// AppendVariants appends sv to the "variants" field.
+func (fru *FileReferenceUpdate) AppendVariants(sv []schema.ImageVariant) *FileReferenceUpdate {
+	fru.mutation.AppendVariants(sv)
+	return fru
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (fru *FileReferenceUpdate) ClearVariants() *FileReferenceUpdate {
+	fru.mutation.ClearVariants()
+	return fru
+}
+
+// SetVariantsSize sets the "variants_size" field.
+func (fru *FileReferenceUpdate) SetVariantsSize(i int64) *FileReferenceUpdate {
+	fru.mutation.ResetVariantsSize()
+	fru.mutation.SetVariantsSize(i)
+	return fru
+}
+
+// SetNillableVariantsSize sets the "variants_size" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableVariantsSize(i *int64) *FileReferenceUpdate {
+	if i != nil {
+		fru.SetVariantsSize(*i)
+	}
+	return fru
+}
+
+// AddVariantsSize adds i to the "variants_size" field.
+func (fru *FileReferenceUpdate) AddVariantsSize(i int64) *FileReferenceUpdate {
+	fru.mutation.AddVariantsSize(i)
+	return fru
+}
+
+// SetOwner sets the "owner" field.
+func (fru *FileReferenceUpdate) SetOwner(s string) *FileReferenceUpdate {
+	fru.mutation.SetOwner(s)
+	return fru
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableOwner(s *string) *FileReferenceUpdate {
+	if s != nil {
+		fru.SetOwner(*s)
+	}
+	return fru
+}
+
+// SetPdf sets the "pdf" field.
+func (fru *FileReferenceUpdate) SetPdf(pd *pdfinfo.Info) *FileReferenceUpdate {
+	fru.mutation.SetPdf(pd)
+	return fru
+}
+
+// ClearPdf clears the value of the "pdf" field.
+func (fru *FileReferenceUpdate) ClearPdf() *FileReferenceUpdate {
+	fru.mutation.ClearPdf()
+	return fru
+}
+
+// SetVersion sets the "version" field.
+func (fru *FileReferenceUpdate) SetVersion(i int) *FileReferenceUpdate {
+	fru.mutation.ResetVersion()
+	fru.mutation.SetVersion(i)
+	return fru
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableVersion(i *int) *FileReferenceUpdate {
+	if i != nil {
+		fru.SetVersion(*i)
+	}
+	return fru
+}
+
+// AddVersion adds i to the "version" field.
+func (fru *FileReferenceUpdate) AddVersion(i int) *FileReferenceUpdate {
+	fru.mutation.AddVersion(i)
+	return fru
+}
+
+// ClearVersion clears the value of the "version" field.
+func (fru *FileReferenceUpdate) ClearVersion() *FileReferenceUpdate {
+	fru.mutation.ClearVersion()
+	return fru
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (fru *FileReferenceUpdate) SetScanStatus(fs filereference.ScanStatus) *FileReferenceUpdate {
+	fru.mutation.SetScanStatus(fs)
+	return fru
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableScanStatus(fs *filereference.ScanStatus) *FileReferenceUpdate {
+	if fs != nil {
+		fru.SetScanStatus(*fs)
+	}
+	return fru
+}
+
+// SetScanResult sets the "scan_result" field.
+func (fru *FileReferenceUpdate) SetScanResult(s string) *FileReferenceUpdate {
+	fru.mutation.SetScanResult(s)
+	return fru
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableScanResult(s *string) *FileReferenceUpdate {
+	if s != nil {
+		fru.SetScanResult(*s)
+	}
+	return fru
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (fru *FileReferenceUpdate) ClearScanResult() *FileReferenceUpdate {
+	fru.mutation.ClearScanResult()
+	return fru
+}
+
+// SetScanAttempts sets the "scan_attempts" field.
+func (fru *FileReferenceUpdate) SetScanAttempts(i int) *FileReferenceUpdate {
+	fru.mutation.ResetScanAttempts()
+	fru.mutation.SetScanAttempts(i)
+	return fru
+}
+
+// SetNillableScanAttempts sets the "scan_attempts" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableScanAttempts(i *int) *FileReferenceUpdate {
+	if i != nil {
+		fru.SetScanAttempts(*i)
+	}
+	return fru
+}
+
+// AddScanAttempts adds i to the "scan_attempts" field.
+func (fru *FileReferenceUpdate) AddScanAttempts(i int) *FileReferenceUpdate {
+	fru.mutation.AddScanAttempts(i)
+	return fru
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (fru *FileReferenceUpdate) SetScannedAt(t time.Time) *FileReferenceUpdate {
+	fru.mutation.SetScannedAt(t)
+	return fru
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableScannedAt(t *time.Time) *FileReferenceUpdate {
+	if t != nil {
+		fru.SetScannedAt(*t)
+	}
+	return fru
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (fru *FileReferenceUpdate) ClearScannedAt() *FileReferenceUpdate {
+	fru.mutation.ClearScannedAt()
+	return fru
+}
+
+// SetNextScanAt sets the "next_scan_at" field.
+func (fru *FileReferenceUpdate) SetNextScanAt(t time.Time) *FileReferenceUpdate {
+	fru.mutation.SetNextScanAt(t)
+	return fru
+}
+
+// SetNillableNextScanAt sets the "next_scan_at" field if the given value is not nil.
+func (fru *FileReferenceUpdate) SetNillableNextScanAt(t *time.Time) *FileReferenceUpdate {
+	if t != nil {
+		fru.SetNextScanAt(*t)
+	}
+	return fru
+}
+
+// ClearNextScanAt clears the value of the "next_scan_at" field.
+func (fru *FileReferenceUpdate) ClearNextScanAt() *FileReferenceUpdate {
+	fru.mutation.ClearNextScanAt()
+	return fru
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (fru *FileReferenceUpdate) SetQrCodeID(id int) *FileReferenceUpdate {
 	fru.mutation.SetQrCodeID(id)
@@ -160,6 +459,11 @@ func (fru *FileReferenceUpdate) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "FileReference.url": %w`, err)}
 		}
 	}
+	if v, ok := fru.mutation.ScanStatus(); ok {
+		if err := filereference.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "FileReference.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -190,6 +494,104 @@ func (fru *FileReferenceUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := fru.mutation.GetType(); ok {
 		_spec.SetField(filereference.FieldType, field.TypeString, value)
 	}
+	if value, ok := fru.mutation.Backend(); ok {
+		_spec.SetField(filereference.FieldBackend, field.TypeString, value)
+	}
+	if value, ok := fru.mutation.Key(); ok {
+		_spec.SetField(filereference.FieldKey, field.TypeString, value)
+	}
+	if fru.mutation.KeyCleared() {
+		_spec.ClearField(filereference.FieldKey, field.TypeString)
+	}
+	if value, ok := fru.mutation.Checksum(); ok {
+		_spec.SetField(filereference.FieldChecksum, field.TypeString, value)
+	}
+	if fru.mutation.ChecksumCleared() {
+		_spec.ClearField(filereference.FieldChecksum, field.TypeString)
+	}
+	if value, ok := fru.mutation.Width(); ok {
+		_spec.SetField(filereference.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.AddedWidth(); ok {
+		_spec.AddField(filereference.FieldWidth, field.TypeInt, value)
+	}
+	if fru.mutation.WidthCleared() {
+		_spec.ClearField(filereference.FieldWidth, field.TypeInt)
+	}
+	if value, ok := fru.mutation.Height(); ok {
+		_spec.SetField(filereference.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.AddedHeight(); ok {
+		_spec.AddField(filereference.FieldHeight, field.TypeInt, value)
+	}
+	if fru.mutation.HeightCleared() {
+		_spec.ClearField(filereference.FieldHeight, field.TypeInt)
+	}
+	if value, ok := fru.mutation.Variants(); ok {
+		_spec.SetField(filereference.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := fru.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filereference.FieldVariants, value)
+		})
+	}
+	if fru.mutation.VariantsCleared() {
+		_spec.ClearField(filereference.FieldVariants, field.TypeJSON)
+	}
+	if value, ok := fru.mutation.VariantsSize(); ok {
+		_spec.SetField(filereference.FieldVariantsSize, field.TypeInt64, value)
+	}
+	if value, ok := fru.mutation.AddedVariantsSize(); ok {
+		_spec.AddField(filereference.FieldVariantsSize, field.TypeInt64, value)
+	}
+	if value, ok := fru.mutation.Owner(); ok {
+		_spec.SetField(filereference.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := fru.mutation.Pdf(); ok {
+		_spec.SetField(filereference.FieldPdf, field.TypeJSON, value)
+	}
+	if fru.mutation.PdfCleared() {
+		_spec.ClearField(filereference.FieldPdf, field.TypeJSON)
+	}
+	if value, ok := fru.mutation.Version(); ok {
+		_spec.SetField(filereference.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.AddedVersion(); ok {
+		_spec.AddField(filereference.FieldVersion, field.TypeInt, value)
+	}
+	if fru.mutation.VersionCleared() {
+		_spec.ClearField(filereference.FieldVersion, field.TypeInt)
+	}
+	if fru.mutation.CreatedAtCleared() {
+		_spec.ClearField(filereference.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := fru.mutation.ScanStatus(); ok {
+		_spec.SetField(filereference.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := fru.mutation.ScanResult(); ok {
+		_spec.SetField(filereference.FieldScanResult, field.TypeString, value)
+	}
+	if fru.mutation.ScanResultCleared() {
+		_spec.ClearField(filereference.FieldScanResult, field.TypeString)
+	}
+	if value, ok := fru.mutation.ScanAttempts(); ok {
+		_spec.SetField(filereference.FieldScanAttempts, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.AddedScanAttempts(); ok {
+		_spec.AddField(filereference.FieldScanAttempts, field.TypeInt, value)
+	}
+	if value, ok := fru.mutation.ScannedAt(); ok {
+		_spec.SetField(filereference.FieldScannedAt, field.TypeTime, value)
+	}
+	if fru.mutation.ScannedAtCleared() {
+		_spec.ClearField(filereference.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := fru.mutation.NextScanAt(); ok {
+		_spec.SetField(filereference.FieldNextScanAt, field.TypeTime, value)
+	}
+	if fru.mutation.NextScanAtCleared() {
+		_spec.ClearField(filereference.FieldNextScanAt, field.TypeTime)
+	}
 	if fru.mutation.QrCodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -302,6 +704,301 @@ func (fruo *FileReferenceUpdateOne) SetNillableType(s *string) *FileReferenceUpd
 	return fruo
 }
 
+// SetBackend sets the "backend" field.
+func (fruo *FileReferenceUpdateOne) SetBackend(s string) *FileReferenceUpdateOne {
+	fruo.mutation.SetBackend(s)
+	return fruo
+}
+
+// SetNillableBackend sets the "backend" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableBackend(s *string) *FileReferenceUpdateOne {
+	if s != nil {
+		fruo.SetBackend(*s)
+	}
+	return fruo
+}
+
+// SetKey sets the "key" field.
+func (fruo *FileReferenceUpdateOne) SetKey(s string) *FileReferenceUpdateOne {
+	fruo.mutation.SetKey(s)
+	return fruo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableKey(s *string) *FileReferenceUpdateOne {
+	if s != nil {
+		fruo.SetKey(*s)
+	}
+	return fruo
+}
+
+// ClearKey clears the value of the "key" field.
+func (fruo *FileReferenceUpdateOne) ClearKey() *FileReferenceUpdateOne {
+	fruo.mutation.ClearKey()
+	return fruo
+}
+
+// SetChecksum sets the "checksum" field.
+func (fruo *FileReferenceUpdateOne) SetChecksum(s string) *FileReferenceUpdateOne {
+	fruo.mutation.SetChecksum(s)
+	return fruo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableChecksum(s *string) *FileReferenceUpdateOne {
+	if s != nil {
+		fruo.SetChecksum(*s)
+	}
+	return fruo
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (fruo *FileReferenceUpdateOne) ClearChecksum() *FileReferenceUpdateOne {
+	fruo.mutation.ClearChecksum()
+	return fruo
+}
+
+// SetWidth sets the "width" field.
+func (fruo *FileReferenceUpdateOne) SetWidth(i int) *FileReferenceUpdateOne {
+	fruo.mutation.ResetWidth()
+	fruo.mutation.SetWidth(i)
+	return fruo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableWidth(i *int) *FileReferenceUpdateOne {
+	if i != nil {
+		fruo.SetWidth(*i)
+	}
+	return fruo
+}
+
+// AddWidth adds i to the "width" field.
+func (fruo *FileReferenceUpdateOne) AddWidth(i int) *FileReferenceUpdateOne {
+	fruo.mutation.AddWidth(i)
+	return fruo
+}
+
+// ClearWidth clears the value of the "width" field.
+func (fruo *FileReferenceUpdateOne) ClearWidth() *FileReferenceUpdateOne {
+	fruo.mutation.ClearWidth()
+	return fruo
+}
+
+// SetHeight sets the "height" field.
+func (fruo *FileReferenceUpdateOne) SetHeight(i int) *FileReferenceUpdateOne {
+	fruo.mutation.ResetHeight()
+	fruo.mutation.SetHeight(i)
+	return fruo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableHeight(i *int) *FileReferenceUpdateOne {
+	if i != nil {
+		fruo.SetHeight(*i)
+	}
+	return fruo
+}
+
+// AddHeight adds i to the "height" field.
+func (fruo *FileReferenceUpdateOne) AddHeight(i int) *FileReferenceUpdateOne {
+	fruo.mutation.AddHeight(i)
+	return fruo
+}
+
+// ClearHeight clears the value of the "height" field.
+func (fruo *FileReferenceUpdateOne) ClearHeight() *FileReferenceUpdateOne {
+	fruo.mutation.ClearHeight()
+	return fruo
+}
+
+// SetVariants sets the "variants" field.
+func (fruo *FileReferenceUpdateOne) SetVariants(sv []schema.ImageVariant) *FileReferenceUpdateOne {
+	fruo.mutation.SetVariants(sv)
+	return fruo
+}
+
+// AppendVariants appends sv to the "variants" field.
+func (fruo *FileReferenceUpdateOne) AppendVariants(sv []schema.ImageVariant) *FileReferenceUpdateOne {
+	fruo.mutation.AppendVariants(sv)
+	return fruo
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (fruo *FileReferenceUpdateOne) ClearVariants() *FileReferenceUpdateOne {
+	fruo.mutation.ClearVariants()
+	return fruo
+}
+
+// SetVariantsSize sets the "variants_size" field.
+func (fruo *FileReferenceUpdateOne) SetVariantsSize(i int64) *FileReferenceUpdateOne {
+	fruo.mutation.ResetVariantsSize()
+	fruo.mutation.SetVariantsSize(i)
+	return fruo
+}
+
+// SetNillableVariantsSize sets the "variants_size" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableVariantsSize(i *int64) *FileReferenceUpdateOne {
+	if i != nil {
+		fruo.SetVariantsSize(*i)
+	}
+	return fruo
+}
+
+// AddVariantsSize adds i to the "variants_size" field.
+func (fruo *FileReferenceUpdateOne) AddVariantsSize(i int64) *FileReferenceUpdateOne {
+	fruo.mutation.AddVariantsSize(i)
+	return fruo
+}
+
+// SetOwner sets the "owner" field.
+func (fruo *FileReferenceUpdateOne) SetOwner(s string) *FileReferenceUpdateOne {
+	fruo.mutation.SetOwner(s)
+	return fruo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableOwner(s *string) *FileReferenceUpdateOne {
+	if s != nil {
+		fruo.SetOwner(*s)
+	}
+	return fruo
+}
+
+// SetPdf sets the "pdf" field.
+func (fruo *FileReferenceUpdateOne) SetPdf(pd *pdfinfo.Info) *FileReferenceUpdateOne {
+	fruo.mutation.SetPdf(pd)
+	return fruo
+}
+
+// ClearPdf clears the value of the "pdf" field.
+func (fruo *FileReferenceUpdateOne) ClearPdf() *FileReferenceUpdateOne {
+	fruo.mutation.ClearPdf()
+	return fruo
+}
+
+// SetVersion sets the "version" field.
+func (fruo *FileReferenceUpdateOne) SetVersion(i int) *FileReferenceUpdateOne {
+	fruo.mutation.ResetVersion()
+	fruo.mutation.SetVersion(i)
+	return fruo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableVersion(i *int) *FileReferenceUpdateOne {
+	if i != nil {
+		fruo.SetVersion(*i)
+	}
+	return fruo
+}
+
+// AddVersion adds i to the "version" field.
+func (fruo *FileReferenceUpdateOne) AddVersion(i int) *FileReferenceUpdateOne {
+	fruo.mutation.AddVersion(i)
+	return fruo
+}
+
+// ClearVersion clears the value of the "version" field.
+func (fruo *FileReferenceUpdateOne) ClearVersion() *FileReferenceUpdateOne {
+	fruo.mutation.ClearVersion()
+	return fruo
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (fruo *FileReferenceUpdateOne) SetScanStatus(fs filereference.ScanStatus) *FileReferenceUpdateOne {
+	fruo.mutation.SetScanStatus(fs)
+	return fruo
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableScanStatus(fs *filereference.ScanStatus) *FileReferenceUpdateOne {
+	if fs != nil {
+		fruo.SetScanStatus(*fs)
+	}
+	return fruo
+}
+
+// SetScanResult sets the "scan_result" field.
+func (fruo *FileReferenceUpdateOne) SetScanResult(s string) *FileReferenceUpdateOne {
+	fruo.mutation.SetScanResult(s)
+	return fruo
+}
+
+// SetNillableScanResult sets the "scan_result" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableScanResult(s *string) *FileReferenceUpdateOne {
+	if s != nil {
+		fruo.SetScanResult(*s)
+	}
+	return fruo
+}
+
+// ClearScanResult clears the value of the "scan_result" field.
+func (fruo *FileReferenceUpdateOne) ClearScanResult() *FileReferenceUpdateOne {
+	fruo.mutation.ClearScanResult()
+	return fruo
+}
+
+// SetScanAttempts sets the "scan_attempts" field.
+func (fruo *FileReferenceUpdateOne) SetScanAttempts(i int) *FileReferenceUpdateOne {
+	fruo.mutation.ResetScanAttempts()
+	fruo.mutation.SetScanAttempts(i)
+	return fruo
+}
+
+// SetNillableScanAttempts sets the "scan_attempts" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableScanAttempts(i *int) *FileReferenceUpdateOne {
+	if i != nil {
+		fruo.SetScanAttempts(*i)
+	}
+	return fruo
+}
+
+// AddScanAttempts adds i to the "scan_attempts" field.
+func (fruo *FileReferenceUpdateOne) AddScanAttempts(i int) *FileReferenceUpdateOne {
+	fruo.mutation.AddScanAttempts(i)
+	return fruo
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (fruo *FileReferenceUpdateOne) SetScannedAt(t time.Time) *FileReferenceUpdateOne {
+	fruo.mutation.SetScannedAt(t)
+	return fruo
+}
+
+// SetNillableScannedAt sets the "scanned_at" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableScannedAt(t *time.Time) *FileReferenceUpdateOne {
+	if t != nil {
+		fruo.SetScannedAt(*t)
+	}
+	return fruo
+}
+
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (fruo *FileReferenceUpdateOne) ClearScannedAt() *FileReferenceUpdateOne {
+	fruo.mutation.ClearScannedAt()
+	return fruo
+}
+
+// SetNextScanAt sets the "next_scan_at" field.
+func (fruo *FileReferenceUpdateOne) SetNextScanAt(t time.Time) *FileReferenceUpdateOne {
+	fruo.mutation.SetNextScanAt(t)
+	return fruo
+}
+
+// SetNillableNextScanAt sets the "next_scan_at" field if the given value is not nil.
+func (fruo *FileReferenceUpdateOne) SetNillableNextScanAt(t *time.Time) *FileReferenceUpdateOne {
+	if t != nil {
+		fruo.SetNextScanAt(*t)
+	}
+	return fruo
+}
+
+// ClearNextScanAt clears the value of the "next_scan_at" field.
+func (fruo *FileReferenceUpdateOne) ClearNextScanAt() *FileReferenceUpdateOne {
+	fruo.mutation.ClearNextScanAt()
+	return fruo
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (fruo *FileReferenceUpdateOne) SetQrCodeID(id int) *FileReferenceUpdateOne {
 	fruo.mutation.SetQrCodeID(id)
@@ -384,6 +1081,11 @@ func (fruo *FileReferenceUpdateOne) check() error {
 			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "FileReference.url": %w`, err)}
 		}
 	}
+	if v, ok := fruo.mutation.ScanStatus(); ok {
+		if err := filereference.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "FileReference.scan_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -431,6 +1133,104 @@ func (fruo *FileReferenceUpdateOne) sqlSave(ctx context.Context) (_node *FileRef
 	if value, ok := fruo.mutation.GetType(); ok {
 		_spec.SetField(filereference.FieldType, field.TypeString, value)
 	}
+	if value, ok := fruo.mutation.Backend(); ok {
+		_spec.SetField(filereference.FieldBackend, field.TypeString, value)
+	}
+	if value, ok := fruo.mutation.Key(); ok {
+		_spec.SetField(filereference.FieldKey, field.TypeString, value)
+	}
+	if fruo.mutation.KeyCleared() {
+		_spec.ClearField(filereference.FieldKey, field.TypeString)
+	}
+	if value, ok := fruo.mutation.Checksum(); ok {
+		_spec.SetField(filereference.FieldChecksum, field.TypeString, value)
+	}
+	if fruo.mutation.ChecksumCleared() {
+		_spec.ClearField(filereference.FieldChecksum, field.TypeString)
+	}
+	if value, ok := fruo.mutation.Width(); ok {
+		_spec.SetField(filereference.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.AddedWidth(); ok {
+		_spec.AddField(filereference.FieldWidth, field.TypeInt, value)
+	}
+	if fruo.mutation.WidthCleared() {
+		_spec.ClearField(filereference.FieldWidth, field.TypeInt)
+	}
+	if value, ok := fruo.mutation.Height(); ok {
+		_spec.SetField(filereference.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.AddedHeight(); ok {
+		_spec.AddField(filereference.FieldHeight, field.TypeInt, value)
+	}
+	if fruo.mutation.HeightCleared() {
+		_spec.ClearField(filereference.FieldHeight, field.TypeInt)
+	}
+	if value, ok := fruo.mutation.Variants(); ok {
+		_spec.SetField(filereference.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := fruo.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, filereference.FieldVariants, value)
+		})
+	}
+	if fruo.mutation.VariantsCleared() {
+		_spec.ClearField(filereference.FieldVariants, field.TypeJSON)
+	}
+	if value, ok := fruo.mutation.VariantsSize(); ok {
+		_spec.SetField(filereference.FieldVariantsSize, field.TypeInt64, value)
+	}
+	if value, ok := fruo.mutation.AddedVariantsSize(); ok {
+		_spec.AddField(filereference.FieldVariantsSize, field.TypeInt64, value)
+	}
+	if value, ok := fruo.mutation.Owner(); ok {
+		_spec.SetField(filereference.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := fruo.mutation.Pdf(); ok {
+		_spec.SetField(filereference.FieldPdf, field.TypeJSON, value)
+	}
+	if fruo.mutation.PdfCleared() {
+		_spec.ClearField(filereference.FieldPdf, field.TypeJSON)
+	}
+	if value, ok := fruo.mutation.Version(); ok {
+		_spec.SetField(filereference.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.AddedVersion(); ok {
+		_spec.AddField(filereference.FieldVersion, field.TypeInt, value)
+	}
+	if fruo.mutation.VersionCleared() {
+		_spec.ClearField(filereference.FieldVersion, field.TypeInt)
+	}
+	if fruo.mutation.CreatedAtCleared() {
+		_spec.ClearField(filereference.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := fruo.mutation.ScanStatus(); ok {
+		_spec.SetField(filereference.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := fruo.mutation.ScanResult(); ok {
+		_spec.SetField(filereference.FieldScanResult, field.TypeString, value)
+	}
+	if fruo.mutation.ScanResultCleared() {
+		_spec.ClearField(filereference.FieldScanResult, field.TypeString)
+	}
+	if value, ok := fruo.mutation.ScanAttempts(); ok {
+		_spec.SetField(filereference.FieldScanAttempts, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.AddedScanAttempts(); ok {
+		_spec.AddField(filereference.FieldScanAttempts, field.TypeInt, value)
+	}
+	if value, ok := fruo.mutation.ScannedAt(); ok {
+		_spec.SetField(filereference.FieldScannedAt, field.TypeTime, value)
+	}
+	if fruo.mutation.ScannedAtCleared() {
+		_spec.ClearField(filereference.FieldScannedAt, field.TypeTime)
+	}
+	if value, ok := fruo.mutation.NextScanAt(); ok {
+		_spec.SetField(filereference.FieldNextScanAt, field.TypeTime, value)
+	}
+	if fruo.mutation.NextScanAtCleared() {
+		_spec.ClearField(filereference.FieldNextScanAt, field.TypeTime)
+	}
 	if fruo.mutation.QrCodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -8,6 +8,18 @@ import (
 )
 
 var (
+	// CheckpointsColumns holds the columns for the "checkpoints" table.
+	CheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "at", Type: field.TypeTime},
+	}
+	// CheckpointsTable holds the schema information for the "checkpoints" table.
+	CheckpointsTable = &schema.Table{
+		Name:       "checkpoints",
+		Columns:    CheckpointsColumns,
+		PrimaryKey: []*schema.Column{CheckpointsColumns[0]},
+	}
 	// FileReferencesColumns holds the columns for the "file_references" table.
 	FileReferencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,6 +27,22 @@ var (
 		{Name: "url", Type: field.TypeString},
 		{Name: "size", Type: field.TypeInt64},
 		{Name: "type", Type: field.TypeString},
+		{Name: "backend", Type: field.TypeString, Default: "local"},
+		{Name: "key", Type: field.TypeString, Nullable: true},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "variants", Type: field.TypeJSON, Nullable: true},
+		{Name: "variants_size", Type: field.TypeInt64, Default: 0},
+		{Name: "owner", Type: field.TypeString, Default: ""},
+		{Name: "pdf", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scan_status", Type: field.TypeEnum, Enums: []string{"skipped", "pending", "clean", "infected", "failed"}, Default: "skipped"},
+		{Name: "scan_result", Type: field.TypeString, Nullable: true},
+		{Name: "scan_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "scanned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "next_scan_at", Type: field.TypeTime, Nullable: true},
 		{Name: "qr_code_file_refs", Type: field.TypeInt, Nullable: true},
 	}
 	// FileReferencesTable holds the schema information for the "file_references" table.
@@ -25,11 +53,72 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "file_references_qr_codes_file_refs",
-				Columns:    []*schema.Column{FileReferencesColumns[5]},
+				Columns:    []*schema.Column{FileReferencesColumns[21]},
 				RefColumns: []*schema.Column{QrCodesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filereference_key",
+				Unique:  false,
+				Columns: []*schema.Column{FileReferencesColumns[6]},
+			},
+			{
+				Name:    "filereference_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FileReferencesColumns[15]},
+			},
+			{
+				Name:    "filereference_owner",
+				Unique:  false,
+				Columns: []*schema.Column{FileReferencesColumns[12]},
+			},
+			{
+				Name:    "filereference_scan_status_next_scan_at",
+				Unique:  false,
+				Columns: []*schema.Column{FileReferencesColumns[16], FileReferencesColumns[20]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "running", "succeeded", "failed", "cancelled"}, Default: "queued"},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "processed", Type: field.TypeInt, Default: 0},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 3},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "cancel_requested", Type: field.TypeBool, Default: false},
+		{Name: "locked_by", Type: field.TypeString, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3], JobsColumns[13]},
+			},
+			{
+				Name:    "job_type",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[1]},
+			},
+		},
 	}
 	// QrCodesColumns holds the columns for the "qr_codes" table.
 	QrCodesColumns = []*schema.Column{
@@ -43,10 +132,25 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "schedule", Type: field.TypeJSON, Nullable: true},
+		{Name: "fallback_url", Type: field.TypeString, Nullable: true},
+		{Name: "fallback_message", Type: field.TypeString, Nullable: true},
+		{Name: "max_scans", Type: field.TypeInt, Nullable: true},
+		{Name: "scans_per_visitor", Type: field.TypeInt, Nullable: true},
+		{Name: "visitor_key", Type: field.TypeEnum, Enums: []string{"cookie", "fingerprint"}, Default: "cookie"},
+		{Name: "redeemed", Type: field.TypeInt, Default: 0},
+		{Name: "ended_message", Type: field.TypeString, Nullable: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "signing", Type: field.TypeEnum, Nullable: true, Enums: []string{"url", "embedded"}},
+		{Name: "signed_token", Type: field.TypeString, Nullable: true},
 		{Name: "analytics", Type: field.TypeBool, Default: false},
+		{Name: "analytics_counts_only", Type: field.TypeBool, Default: false},
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "design", Type: field.TypeJSON, Nullable: true},
+		{Name: "gs1_key", Type: field.TypeString, Nullable: true},
+		{Name: "import_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "group_id", Type: field.TypeInt, Nullable: true},
 	}
 	// QrCodesTable holds the schema information for the "qr_codes" table.
@@ -57,11 +161,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "qr_codes_qr_code_groups_qrcodes",
-				Columns:    []*schema.Column{QrCodesColumns[14]},
+				Columns:    []*schema.Column{QrCodesColumns[29]},
 				RefColumns: []*schema.Column{QrCodeGroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "qrcode_gs1_key",
+				Unique:  false,
+				Columns: []*schema.Column{QrCodesColumns[27]},
+			},
+		},
 	}
 	// QrCodeAnalyticsColumns holds the columns for the "qr_code_analytics" table.
 	QrCodeAnalyticsColumns = []*schema.Column{
@@ -71,6 +182,7 @@ var (
 		{Name: "location", Type: field.TypeString, Nullable: true},
 		{Name: "device", Type: field.TypeString, Nullable: true},
 		{Name: "scanned_at", Type: field.TypeTime},
+		{Name: "rolled_up", Type: field.TypeBool, Default: false},
 		{Name: "qr_code_analytics_records", Type: field.TypeInt, Nullable: true},
 	}
 	// QrCodeAnalyticsTable holds the schema information for the "qr_code_analytics" table.
@@ -81,11 +193,48 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "qr_code_analytics_qr_codes_analytics_records",
-				Columns:    []*schema.Column{QrCodeAnalyticsColumns[6]},
+				Columns:    []*schema.Column{QrCodeAnalyticsColumns[7]},
 				RefColumns: []*schema.Column{QrCodesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "qrcodeanalytics_rolled_up_scanned_at",
+				Unique:  false,
+				Columns: []*schema.Column{QrCodeAnalyticsColumns[6], QrCodeAnalyticsColumns[5]},
+			},
+		},
+	}
+	// QrCodeAnalyticsDailiesColumns holds the columns for the "qr_code_analytics_dailies" table.
+	QrCodeAnalyticsDailiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "scans", Type: field.TypeInt, Default: 0},
+		{Name: "unique_visitors", Type: field.TypeInt, Default: 0},
+		{Name: "devices", Type: field.TypeJSON, Nullable: true},
+		{Name: "qr_code_id", Type: field.TypeInt},
+	}
+	// QrCodeAnalyticsDailiesTable holds the schema information for the "qr_code_analytics_dailies" table.
+	QrCodeAnalyticsDailiesTable = &schema.Table{
+		Name:       "qr_code_analytics_dailies",
+		Columns:    QrCodeAnalyticsDailiesColumns,
+		PrimaryKey: []*schema.Column{QrCodeAnalyticsDailiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "qr_code_analytics_dailies_qr_codes_daily_analytics",
+				Columns:    []*schema.Column{QrCodeAnalyticsDailiesColumns[5]},
+				RefColumns: []*schema.Column{QrCodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "qrcodeanalyticsdaily_qr_code_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{QrCodeAnalyticsDailiesColumns[5], QrCodeAnalyticsDailiesColumns[1]},
+			},
+		},
 	}
 	// QrCodeGroupsColumns holds the columns for the "qr_code_groups" table.
 	QrCodeGroupsColumns = []*schema.Column{
@@ -101,12 +250,127 @@ var (
 		Columns:    QrCodeGroupsColumns,
 		PrimaryKey: []*schema.Column{QrCodeGroupsColumns[0]},
 	}
+	// QrCodeRedemptionsColumns holds the columns for the "qr_code_redemptions" table.
+	QrCodeRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "visitor", Type: field.TypeString},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+		{Name: "first_at", Type: field.TypeTime},
+		{Name: "last_at", Type: field.TypeTime},
+		{Name: "qr_code_id", Type: field.TypeInt},
+	}
+	// QrCodeRedemptionsTable holds the schema information for the "qr_code_redemptions" table.
+	QrCodeRedemptionsTable = &schema.Table{
+		Name:       "qr_code_redemptions",
+		Columns:    QrCodeRedemptionsColumns,
+		PrimaryKey: []*schema.Column{QrCodeRedemptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "qr_code_redemptions_qr_codes_redemptions",
+				Columns:    []*schema.Column{QrCodeRedemptionsColumns[5]},
+				RefColumns: []*schema.Column{QrCodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "qrcoderedemption_qr_code_id_visitor",
+				Unique:  true,
+				Columns: []*schema.Column{QrCodeRedemptionsColumns[5], QrCodeRedemptionsColumns[1]},
+			},
+		},
+	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kid", Type: field.TypeString, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "private_key", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "retired", "revoked"}, Default: "active"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "qr_code_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_qr_codes_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[8]},
+				RefColumns: []*schema.Column{QrCodesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[7]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CheckpointsTable,
 		FileReferencesTable,
+		JobsTable,
 		QrCodesTable,
 		QrCodeAnalyticsTable,
+		QrCodeAnalyticsDailiesTable,
 		QrCodeGroupsTable,
+		QrCodeRedemptionsTable,
+		SigningKeysTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
@@ -114,4 +378,8 @@ func init() {
 	FileReferencesTable.ForeignKeys[0].RefTable = QrCodesTable
 	QrCodesTable.ForeignKeys[0].RefTable = QrCodeGroupsTable
 	QrCodeAnalyticsTable.ForeignKeys[0].RefTable = QrCodesTable
+	QrCodeAnalyticsDailiesTable.ForeignKeys[0].RefTable = QrCodesTable
+	QrCodeRedemptionsTable.ForeignKeys[0].RefTable = QrCodesTable
+	WebhooksTable.ForeignKeys[0].RefTable = QrCodesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
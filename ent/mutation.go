@@ -4,13 +4,24 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"qr_backend/ent/checkpoint"
 	"qr_backend/ent/filereference"
+	"qr_backend/ent/job"
 	"qr_backend/ent/predicate"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/schema"
+	"qr_backend/ent/signingkey"
+	"qr_backend/ent/webhook"
+	"qr_backend/ent/webhookdelivery"
+	"qr_backend/pkg/pdfinfo"
+	"qr_backend/pkg/schedule"
 	"sync"
 	"time"
 
@@ -27,42 +38,44 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFileReference   = "FileReference"
-	TypeQRCode          = "QRCode"
-	TypeQRCodeAnalytics = "QRCodeAnalytics"
-	TypeQRCodeGroup     = "QRCodeGroup"
+	TypeCheckpoint           = "Checkpoint"
+	TypeFileReference        = "FileReference"
+	TypeJob                  = "Job"
+	TypeQRCode               = "QRCode"
+	TypeQRCodeAnalytics      = "QRCodeAnalytics"
+	TypeQRCodeAnalyticsDaily = "QRCodeAnalyticsDaily"
+	TypeQRCodeGroup          = "QRCodeGroup"
+	TypeQRCodeRedemption     = "QRCodeRedemption"
+	TypeSigningKey           = "SigningKey"
+	TypeWebhook              = "Webhook"
+	TypeWebhookDelivery      = "WebhookDelivery"
 )
 
-// FileReferenceMutation represents an operation that mutates the FileReference nodes in the graph.
-type FileReferenceMutation struct {
+// CheckpointMutation represents an operation that mutates the Checkpoint nodes in the graph.
+type CheckpointMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	filename       *string
-	url            *string
-	size           *int64
-	addsize        *int64
-	_type          *string
-	clearedFields  map[string]struct{}
-	qr_code        *int
-	clearedqr_code bool
-	done           bool
-	oldValue       func(context.Context) (*FileReference, error)
-	predicates     []predicate.FileReference
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	at            *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Checkpoint, error)
+	predicates    []predicate.Checkpoint
 }
 
-var _ ent.Mutation = (*FileReferenceMutation)(nil)
+var _ ent.Mutation = (*CheckpointMutation)(nil)
 
-// filereferenceOption allows management of the mutation configuration using functional options.
-type filereferenceOption func(*FileReferenceMutation)
+// checkpointOption allows management of the mutation configuration using functional options.
+type checkpointOption func(*CheckpointMutation)
 
-// newFileReferenceMutation creates new mutation for the FileReference entity.
-func newFileReferenceMutation(c config, op Op, opts ...filereferenceOption) *FileReferenceMutation {
-	m := &FileReferenceMutation{
+// newCheckpointMutation creates new mutation for the Checkpoint entity.
+func newCheckpointMutation(c config, op Op, opts ...checkpointOption) *CheckpointMutation {
+	m := &CheckpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFileReference,
+		typ:           TypeCheckpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +84,20 @@ func newFileReferenceMutation(c config, op Op, opts ...filereferenceOption) *Fil
 	return m
 }
 
-// withFileReferenceID sets the ID field of the mutation.
-func withFileReferenceID(id int) filereferenceOption {
-	return func(m *FileReferenceMutation) {
+// withCheckpointID sets the ID field of the mutation.
+func withCheckpointID(id int) checkpointOption {
+	return func(m *CheckpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FileReference
+			value *Checkpoint
 		)
-		m.oldValue = func(ctx context.Context) (*FileReference, error) {
+		m.oldValue = func(ctx context.Context) (*Checkpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FileReference.Get(ctx, id)
+					value, err = m.Client().Checkpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +106,10 @@ func withFileReferenceID(id int) filereferenceOption {
 	}
 }
 
-// withFileReference sets the old FileReference of the mutation.
-func withFileReference(node *FileReference) filereferenceOption {
-	return func(m *FileReferenceMutation) {
-		m.oldValue = func(context.Context) (*FileReference, error) {
+// withCheckpoint sets the old Checkpoint of the mutation.
+func withCheckpoint(node *Checkpoint) checkpointOption {
+	return func(m *CheckpointMutation) {
+		m.oldValue = func(context.Context) (*Checkpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +118,7 @@ func withFileReference(node *FileReference) filereferenceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileReferenceMutation) Client() *Client {
+func (m CheckpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +126,7 @@ func (m FileReferenceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileReferenceMutation) Tx() (*Tx, error) {
+func (m CheckpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,7 +137,7 @@ func (m FileReferenceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileReferenceMutation) ID() (id int, exists bool) {
+func (m *CheckpointMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -135,7 +148,7 @@ func (m *FileReferenceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileReferenceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CheckpointMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -144,224 +157,93 @@ func (m *FileReferenceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FileReference.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Checkpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFilename sets the "filename" field.
-func (m *FileReferenceMutation) SetFilename(s string) {
-	m.filename = &s
-}
-
-// Filename returns the value of the "filename" field in the mutation.
-func (m *FileReferenceMutation) Filename() (r string, exists bool) {
-	v := m.filename
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFilename returns the old "filename" field's value of the FileReference entity.
-// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileReferenceMutation) OldFilename(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
-	}
-	return oldValue.Filename, nil
-}
-
-// ResetFilename resets all changes to the "filename" field.
-func (m *FileReferenceMutation) ResetFilename() {
-	m.filename = nil
-}
-
-// SetURL sets the "url" field.
-func (m *FileReferenceMutation) SetURL(s string) {
-	m.url = &s
-}
-
-// URL returns the value of the "url" field in the mutation.
-func (m *FileReferenceMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldURL returns the old "url" field's value of the FileReference entity.
-// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileReferenceMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
-	}
-	return oldValue.URL, nil
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *FileReferenceMutation) ResetURL() {
-	m.url = nil
-}
-
-// SetSize sets the "size" field.
-func (m *FileReferenceMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetName sets the "name" field.
+func (m *CheckpointMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileReferenceMutation) Size() (r int64, exists bool) {
-	v := m.size
+// Name returns the value of the "name" field in the mutation.
+func (m *CheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the FileReference entity.
-// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileReferenceMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *CheckpointMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
-	}
-	return oldValue.Size, nil
-}
-
-// AddSize adds i to the "size" field.
-func (m *FileReferenceMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
-}
-
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileReferenceMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileReferenceMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetName resets all changes to the "name" field.
+func (m *CheckpointMutation) ResetName() {
+	m.name = nil
 }
 
-// SetType sets the "type" field.
-func (m *FileReferenceMutation) SetType(s string) {
-	m._type = &s
+// SetAt sets the "at" field.
+func (m *CheckpointMutation) SetAt(t time.Time) {
+	m.at = &t
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *FileReferenceMutation) GetType() (r string, exists bool) {
-	v := m._type
+// At returns the value of the "at" field in the mutation.
+func (m *CheckpointMutation) At() (r time.Time, exists bool) {
+	v := m.at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the FileReference entity.
-// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// OldAt returns the old "at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileReferenceMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *FileReferenceMutation) ResetType() {
-	m._type = nil
-}
-
-// SetQrCodeID sets the "qr_code" edge to the QRCode entity by id.
-func (m *FileReferenceMutation) SetQrCodeID(id int) {
-	m.qr_code = &id
-}
-
-// ClearQrCode clears the "qr_code" edge to the QRCode entity.
-func (m *FileReferenceMutation) ClearQrCode() {
-	m.clearedqr_code = true
-}
-
-// QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
-func (m *FileReferenceMutation) QrCodeCleared() bool {
-	return m.clearedqr_code
-}
-
-// QrCodeID returns the "qr_code" edge ID in the mutation.
-func (m *FileReferenceMutation) QrCodeID() (id int, exists bool) {
-	if m.qr_code != nil {
-		return *m.qr_code, true
-	}
-	return
-}
-
-// QrCodeIDs returns the "qr_code" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QrCodeID instead. It exists only for internal usage by the builders.
-func (m *FileReferenceMutation) QrCodeIDs() (ids []int) {
-	if id := m.qr_code; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldAt: %w", err)
 	}
-	return
+	return oldValue.At, nil
 }
 
-// ResetQrCode resets all changes to the "qr_code" edge.
-func (m *FileReferenceMutation) ResetQrCode() {
-	m.qr_code = nil
-	m.clearedqr_code = false
+// ResetAt resets all changes to the "at" field.
+func (m *CheckpointMutation) ResetAt() {
+	m.at = nil
 }
 
-// Where appends a list predicates to the FileReferenceMutation builder.
-func (m *FileReferenceMutation) Where(ps ...predicate.FileReference) {
+// Where appends a list predicates to the CheckpointMutation builder.
+func (m *CheckpointMutation) Where(ps ...predicate.Checkpoint) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileReferenceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CheckpointMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileReferenceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FileReference, len(ps))
+func (m *CheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checkpoint, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -369,36 +251,30 @@ func (m *FileReferenceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileReferenceMutation) Op() Op {
+func (m *CheckpointMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileReferenceMutation) SetOp(op Op) {
+func (m *CheckpointMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FileReference).
-func (m *FileReferenceMutation) Type() string {
+// Type returns the node type of this mutation (Checkpoint).
+func (m *CheckpointMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileReferenceMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.filename != nil {
-		fields = append(fields, filereference.FieldFilename)
-	}
-	if m.url != nil {
-		fields = append(fields, filereference.FieldURL)
-	}
-	if m.size != nil {
-		fields = append(fields, filereference.FieldSize)
+func (m *CheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, checkpoint.FieldName)
 	}
-	if m._type != nil {
-		fields = append(fields, filereference.FieldType)
+	if m.at != nil {
+		fields = append(fields, checkpoint.FieldAt)
 	}
 	return fields
 }
@@ -406,16 +282,12 @@ func (m *FileReferenceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileReferenceMutation) Field(name string) (ent.Value, bool) {
+func (m *CheckpointMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case filereference.FieldFilename:
-		return m.Filename()
-	case filereference.FieldURL:
-		return m.URL()
-	case filereference.FieldSize:
-		return m.Size()
-	case filereference.FieldType:
-		return m.GetType()
+	case checkpoint.FieldName:
+		return m.Name()
+	case checkpoint.FieldAt:
+		return m.At()
 	}
 	return nil, false
 }
@@ -423,252 +295,194 @@ func (m *FileReferenceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileReferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case filereference.FieldFilename:
-		return m.OldFilename(ctx)
-	case filereference.FieldURL:
-		return m.OldURL(ctx)
-	case filereference.FieldSize:
-		return m.OldSize(ctx)
-	case filereference.FieldType:
-		return m.OldType(ctx)
+	case checkpoint.FieldName:
+		return m.OldName(ctx)
+	case checkpoint.FieldAt:
+		return m.OldAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FileReference field %s", name)
+	return nil, fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileReferenceMutation) SetField(name string, value ent.Value) error {
+func (m *CheckpointMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case filereference.FieldFilename:
+	case checkpoint.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetName(v)
 		return nil
-	case filereference.FieldURL:
-		v, ok := value.(string)
+	case checkpoint.FieldAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
-		return nil
-	case filereference.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
-		return nil
-	case filereference.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FileReference field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileReferenceMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, filereference.FieldSize)
-	}
-	return fields
+func (m *CheckpointMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileReferenceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case filereference.FieldSize:
-		return m.AddedSize()
-	}
+func (m *CheckpointMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileReferenceMutation) AddField(name string, value ent.Value) error {
+func (m *CheckpointMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case filereference.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown FileReference numeric field %s", name)
+	return fmt.Errorf("unknown Checkpoint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileReferenceMutation) ClearedFields() []string {
+func (m *CheckpointMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileReferenceMutation) FieldCleared(name string) bool {
+func (m *CheckpointMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileReferenceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FileReference nullable field %s", name)
+func (m *CheckpointMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Checkpoint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileReferenceMutation) ResetField(name string) error {
+func (m *CheckpointMutation) ResetField(name string) error {
 	switch name {
-	case filereference.FieldFilename:
-		m.ResetFilename()
-		return nil
-	case filereference.FieldURL:
-		m.ResetURL()
-		return nil
-	case filereference.FieldSize:
-		m.ResetSize()
+	case checkpoint.FieldName:
+		m.ResetName()
 		return nil
-	case filereference.FieldType:
-		m.ResetType()
+	case checkpoint.FieldAt:
+		m.ResetAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FileReference field %s", name)
+	return fmt.Errorf("unknown Checkpoint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileReferenceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.qr_code != nil {
-		edges = append(edges, filereference.EdgeQrCode)
-	}
+func (m *CheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileReferenceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case filereference.EdgeQrCode:
-		if id := m.qr_code; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *CheckpointMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileReferenceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *CheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileReferenceMutation) RemovedIDs(name string) []ent.Value {
+func (m *CheckpointMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileReferenceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedqr_code {
-		edges = append(edges, filereference.EdgeQrCode)
-	}
+func (m *CheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileReferenceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case filereference.EdgeQrCode:
-		return m.clearedqr_code
-	}
+func (m *CheckpointMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileReferenceMutation) ClearEdge(name string) error {
-	switch name {
-	case filereference.EdgeQrCode:
-		m.ClearQrCode()
-		return nil
-	}
-	return fmt.Errorf("unknown FileReference unique edge %s", name)
+func (m *CheckpointMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Checkpoint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileReferenceMutation) ResetEdge(name string) error {
-	switch name {
-	case filereference.EdgeQrCode:
-		m.ResetQrCode()
-		return nil
-	}
-	return fmt.Errorf("unknown FileReference edge %s", name)
+func (m *CheckpointMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Checkpoint edge %s", name)
 }
 
-// QRCodeMutation represents an operation that mutates the QRCode nodes in the graph.
-type QRCodeMutation struct {
+// FileReferenceMutation represents an operation that mutates the FileReference nodes in the graph.
+type FileReferenceMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	_type                    *string
-	title                    *string
-	description              *string
-	redirect_url             *string
-	short_url                *string
-	content                  *map[string]interface{}
-	created_at               *time.Time
-	updated_at               *time.Time
-	expires_at               *time.Time
-	analytics                *bool
-	active                   *bool
-	tags                     *[]string
-	appendtags               []string
-	design                   *map[string]interface{}
-	clearedFields            map[string]struct{}
-	file_refs                map[int]struct{}
-	removedfile_refs         map[int]struct{}
-	clearedfile_refs         bool
-	group                    *int
-	clearedgroup             bool
-	analytics_records        map[int]struct{}
-	removedanalytics_records map[int]struct{}
-	clearedanalytics_records bool
-	done                     bool
-	oldValue                 func(context.Context) (*QRCode, error)
-	predicates               []predicate.QRCode
+	op               Op
+	typ              string
+	id               *int
+	filename         *string
+	url              *string
+	size             *int64
+	addsize          *int64
+	_type            *string
+	backend          *string
+	key              *string
+	checksum         *string
+	width            *int
+	addwidth         *int
+	height           *int
+	addheight        *int
+	variants         *[]schema.ImageVariant
+	appendvariants   []schema.ImageVariant
+	variants_size    *int64
+	addvariants_size *int64
+	owner            *string
+	pdf              **pdfinfo.Info
+	version          *int
+	addversion       *int
+	created_at       *time.Time
+	scan_status      *filereference.ScanStatus
+	scan_result      *string
+	scan_attempts    *int
+	addscan_attempts *int
+	scanned_at       *time.Time
+	next_scan_at     *time.Time
+	clearedFields    map[string]struct{}
+	qr_code          *int
+	clearedqr_code   bool
+	done             bool
+	oldValue         func(context.Context) (*FileReference, error)
+	predicates       []predicate.FileReference
 }
 
-var _ ent.Mutation = (*QRCodeMutation)(nil)
+var _ ent.Mutation = (*FileReferenceMutation)(nil)
 
-// qrcodeOption allows management of the mutation configuration using functional options.
-type qrcodeOption func(*QRCodeMutation)
+// filereferenceOption allows management of the mutation configuration using functional options.
+type filereferenceOption func(*FileReferenceMutation)
 
-// newQRCodeMutation creates new mutation for the QRCode entity.
-func newQRCodeMutation(c config, op Op, opts ...qrcodeOption) *QRCodeMutation {
-	m := &QRCodeMutation{
+// newFileReferenceMutation creates new mutation for the FileReference entity.
+func newFileReferenceMutation(c config, op Op, opts ...filereferenceOption) *FileReferenceMutation {
+	m := &FileReferenceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQRCode,
+		typ:           TypeFileReference,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -677,20 +491,20 @@ func newQRCodeMutation(c config, op Op, opts ...qrcodeOption) *QRCodeMutation {
 	return m
 }
 
-// withQRCodeID sets the ID field of the mutation.
-func withQRCodeID(id int) qrcodeOption {
-	return func(m *QRCodeMutation) {
+// withFileReferenceID sets the ID field of the mutation.
+func withFileReferenceID(id int) filereferenceOption {
+	return func(m *FileReferenceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *QRCode
+			value *FileReference
 		)
-		m.oldValue = func(ctx context.Context) (*QRCode, error) {
+		m.oldValue = func(ctx context.Context) (*FileReference, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().QRCode.Get(ctx, id)
+					value, err = m.Client().FileReference.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -699,10 +513,10 @@ func withQRCodeID(id int) qrcodeOption {
 	}
 }
 
-// withQRCode sets the old QRCode of the mutation.
-func withQRCode(node *QRCode) qrcodeOption {
-	return func(m *QRCodeMutation) {
-		m.oldValue = func(context.Context) (*QRCode, error) {
+// withFileReference sets the old FileReference of the mutation.
+func withFileReference(node *FileReference) filereferenceOption {
+	return func(m *FileReferenceMutation) {
+		m.oldValue = func(context.Context) (*FileReference, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -711,7 +525,7 @@ func withQRCode(node *QRCode) qrcodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QRCodeMutation) Client() *Client {
+func (m FileReferenceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -719,7 +533,7 @@ func (m QRCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QRCodeMutation) Tx() (*Tx, error) {
+func (m FileReferenceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -730,7 +544,7 @@ func (m QRCodeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QRCodeMutation) ID() (id int, exists bool) {
+func (m *FileReferenceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -741,7 +555,7 @@ func (m *QRCodeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QRCodeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FileReferenceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -750,767 +564,1062 @@ func (m *QRCodeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QRCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FileReference.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *QRCodeMutation) SetType(s string) {
-	m._type = &s
+// SetFilename sets the "filename" field.
+func (m *FileReferenceMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *QRCodeMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Filename returns the value of the "filename" field in the mutation.
+func (m *FileReferenceMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *FileReferenceMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Filename, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *QRCodeMutation) ResetType() {
-	m._type = nil
+// ResetFilename resets all changes to the "filename" field.
+func (m *FileReferenceMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *QRCodeMutation) SetTitle(s string) {
-	m.title = &s
+// SetURL sets the "url" field.
+func (m *FileReferenceMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *QRCodeMutation) Title() (r string, exists bool) {
-	v := m.title
+// URL returns the value of the "url" field in the mutation.
+func (m *FileReferenceMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *FileReferenceMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.URL, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *QRCodeMutation) ResetTitle() {
-	m.title = nil
+// ResetURL resets all changes to the "url" field.
+func (m *FileReferenceMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *QRCodeMutation) SetDescription(s string) {
-	m.description = &s
+// SetSize sets the "size" field.
+func (m *FileReferenceMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *QRCodeMutation) Description() (r string, exists bool) {
-	v := m.description
+// Size returns the value of the "size" field in the mutation.
+func (m *FileReferenceMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *FileReferenceMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Size, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *QRCodeMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[qrcode.FieldDescription] = struct{}{}
+// AddSize adds i to the "size" field.
+func (m *FileReferenceMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *QRCodeMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldDescription]
-	return ok
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileReferenceMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *QRCodeMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, qrcode.FieldDescription)
+// ResetSize resets all changes to the "size" field.
+func (m *FileReferenceMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetRedirectURL sets the "redirect_url" field.
-func (m *QRCodeMutation) SetRedirectURL(s string) {
-	m.redirect_url = &s
+// SetType sets the "type" field.
+func (m *FileReferenceMutation) SetType(s string) {
+	m._type = &s
 }
 
-// RedirectURL returns the value of the "redirect_url" field in the mutation.
-func (m *QRCodeMutation) RedirectURL() (r string, exists bool) {
-	v := m.redirect_url
+// GetType returns the value of the "type" field in the mutation.
+func (m *FileReferenceMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectURL returns the old "redirect_url" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldRedirectURL(ctx context.Context) (v string, err error) {
+func (m *FileReferenceMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectURL requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.RedirectURL, nil
-}
-
-// ClearRedirectURL clears the value of the "redirect_url" field.
-func (m *QRCodeMutation) ClearRedirectURL() {
-	m.redirect_url = nil
-	m.clearedFields[qrcode.FieldRedirectURL] = struct{}{}
-}
-
-// RedirectURLCleared returns if the "redirect_url" field was cleared in this mutation.
-func (m *QRCodeMutation) RedirectURLCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldRedirectURL]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetRedirectURL resets all changes to the "redirect_url" field.
-func (m *QRCodeMutation) ResetRedirectURL() {
-	m.redirect_url = nil
-	delete(m.clearedFields, qrcode.FieldRedirectURL)
+// ResetType resets all changes to the "type" field.
+func (m *FileReferenceMutation) ResetType() {
+	m._type = nil
 }
 
-// SetShortURL sets the "short_url" field.
-func (m *QRCodeMutation) SetShortURL(s string) {
-	m.short_url = &s
+// SetBackend sets the "backend" field.
+func (m *FileReferenceMutation) SetBackend(s string) {
+	m.backend = &s
 }
 
-// ShortURL returns the value of the "short_url" field in the mutation.
-func (m *QRCodeMutation) ShortURL() (r string, exists bool) {
-	v := m.short_url
+// Backend returns the value of the "backend" field in the mutation.
+func (m *FileReferenceMutation) Backend() (r string, exists bool) {
+	v := m.backend
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShortURL returns the old "short_url" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldBackend returns the old "backend" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldShortURL(ctx context.Context) (v string, err error) {
+func (m *FileReferenceMutation) OldBackend(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldBackend is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortURL requires an ID field in the mutation")
+		return v, errors.New("OldBackend requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldBackend: %w", err)
 	}
-	return oldValue.ShortURL, nil
-}
-
-// ClearShortURL clears the value of the "short_url" field.
-func (m *QRCodeMutation) ClearShortURL() {
-	m.short_url = nil
-	m.clearedFields[qrcode.FieldShortURL] = struct{}{}
-}
-
-// ShortURLCleared returns if the "short_url" field was cleared in this mutation.
-func (m *QRCodeMutation) ShortURLCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldShortURL]
-	return ok
+	return oldValue.Backend, nil
 }
 
-// ResetShortURL resets all changes to the "short_url" field.
-func (m *QRCodeMutation) ResetShortURL() {
-	m.short_url = nil
-	delete(m.clearedFields, qrcode.FieldShortURL)
+// ResetBackend resets all changes to the "backend" field.
+func (m *FileReferenceMutation) ResetBackend() {
+	m.backend = nil
 }
 
-// SetContent sets the "content" field.
-func (m *QRCodeMutation) SetContent(value map[string]interface{}) {
-	m.content = &value
+// SetKey sets the "key" field.
+func (m *FileReferenceMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *QRCodeMutation) Content() (r map[string]interface{}, exists bool) {
-	v := m.content
+// Key returns the value of the "key" field in the mutation.
+func (m *FileReferenceMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldContent(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *FileReferenceMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Key, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *QRCodeMutation) ResetContent() {
-	m.content = nil
+// ClearKey clears the value of the "key" field.
+func (m *FileReferenceMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[filereference.FieldKey] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *QRCodeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// KeyCleared returns if the "key" field was cleared in this mutation.
+func (m *FileReferenceMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldKey]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QRCodeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetKey resets all changes to the "key" field.
+func (m *FileReferenceMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, filereference.FieldKey)
+}
+
+// SetChecksum sets the "checksum" field.
+func (m *FileReferenceMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *FileReferenceMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldChecksum returns the old "checksum" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileReferenceMutation) OldChecksum(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChecksum is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldChecksum requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Checksum, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QRCodeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearChecksum clears the value of the "checksum" field.
+func (m *FileReferenceMutation) ClearChecksum() {
+	m.checksum = nil
+	m.clearedFields[filereference.FieldChecksum] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *QRCodeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ChecksumCleared returns if the "checksum" field was cleared in this mutation.
+func (m *FileReferenceMutation) ChecksumCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldChecksum]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QRCodeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *FileReferenceMutation) ResetChecksum() {
+	m.checksum = nil
+	delete(m.clearedFields, filereference.FieldChecksum)
+}
+
+// SetWidth sets the "width" field.
+func (m *FileReferenceMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *FileReferenceMutation) Width() (r int, exists bool) {
+	v := m.width
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldWidth returns the old "width" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FileReferenceMutation) OldWidth(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldWidth requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Width, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QRCodeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddWidth adds i to the "width" field.
+func (m *FileReferenceMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *QRCodeMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *FileReferenceMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *QRCodeMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// ClearWidth clears the value of the "width" field.
+func (m *FileReferenceMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[filereference.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *FileReferenceMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldWidth]
+	return ok
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *FileReferenceMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, filereference.FieldWidth)
+}
+
+// SetHeight sets the "height" field.
+func (m *FileReferenceMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *FileReferenceMutation) Height() (r int, exists bool) {
+	v := m.height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldHeight returns the old "height" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+func (m *FileReferenceMutation) OldHeight(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Height, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *QRCodeMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[qrcode.FieldExpiresAt] = struct{}{}
+// AddHeight adds i to the "height" field.
+func (m *FileReferenceMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *QRCodeMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldExpiresAt]
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *FileReferenceMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeight clears the value of the "height" field.
+func (m *FileReferenceMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[filereference.FieldHeight] = struct{}{}
+}
+
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *FileReferenceMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldHeight]
 	return ok
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *QRCodeMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, qrcode.FieldExpiresAt)
+// ResetHeight resets all changes to the "height" field.
+func (m *FileReferenceMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, filereference.FieldHeight)
 }
 
-// SetAnalytics sets the "analytics" field.
-func (m *QRCodeMutation) SetAnalytics(b bool) {
-	m.analytics = &b
+// SetVariants sets the "variants" field.
+func (m *FileReferenceMutation) SetVariants(sv []schema.ImageVariant) {
+	m.variants = &sv
+	m.appendvariants = nil
 }
 
-// Analytics returns the value of the "analytics" field in the mutation.
-func (m *QRCodeMutation) Analytics() (r bool, exists bool) {
-	v := m.analytics
+// Variants returns the value of the "variants" field in the mutation.
+func (m *FileReferenceMutation) Variants() (r []schema.ImageVariant, exists bool) {
+	v := m.variants
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnalytics returns the old "analytics" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldVariants returns the old "variants" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldAnalytics(ctx context.Context) (v bool, err error) {
+func (m *FileReferenceMutation) OldVariants(ctx context.Context) (v []schema.ImageVariant, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnalytics is only allowed on UpdateOne operations")
+		return v, errors.New("OldVariants is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnalytics requires an ID field in the mutation")
+		return v, errors.New("OldVariants requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnalytics: %w", err)
+		return v, fmt.Errorf("querying old value for OldVariants: %w", err)
 	}
-	return oldValue.Analytics, nil
+	return oldValue.Variants, nil
 }
 
-// ResetAnalytics resets all changes to the "analytics" field.
-func (m *QRCodeMutation) ResetAnalytics() {
-	m.analytics = nil
+// AppendVariants adds sv to the "variants" field.
+func (m *FileReferenceMutation) AppendVariants(sv []schema.ImageVariant) {
+	m.appendvariants = append(m.appendvariants, sv...)
 }
 
-// SetActive sets the "active" field.
-func (m *QRCodeMutation) SetActive(b bool) {
-	m.active = &b
+// AppendedVariants returns the list of values that were appended to the "variants" field in this mutation.
+func (m *FileReferenceMutation) AppendedVariants() ([]schema.ImageVariant, bool) {
+	if len(m.appendvariants) == 0 {
+		return nil, false
+	}
+	return m.appendvariants, true
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *QRCodeMutation) Active() (r bool, exists bool) {
-	v := m.active
+// ClearVariants clears the value of the "variants" field.
+func (m *FileReferenceMutation) ClearVariants() {
+	m.variants = nil
+	m.appendvariants = nil
+	m.clearedFields[filereference.FieldVariants] = struct{}{}
+}
+
+// VariantsCleared returns if the "variants" field was cleared in this mutation.
+func (m *FileReferenceMutation) VariantsCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldVariants]
+	return ok
+}
+
+// ResetVariants resets all changes to the "variants" field.
+func (m *FileReferenceMutation) ResetVariants() {
+	m.variants = nil
+	m.appendvariants = nil
+	delete(m.clearedFields, filereference.FieldVariants)
+}
+
+// SetVariantsSize sets the "variants_size" field.
+func (m *FileReferenceMutation) SetVariantsSize(i int64) {
+	m.variants_size = &i
+	m.addvariants_size = nil
+}
+
+// VariantsSize returns the value of the "variants_size" field in the mutation.
+func (m *FileReferenceMutation) VariantsSize() (r int64, exists bool) {
+	v := m.variants_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldVariantsSize returns the old "variants_size" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *FileReferenceMutation) OldVariantsSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldVariantsSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldVariantsSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldVariantsSize: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.VariantsSize, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *QRCodeMutation) ResetActive() {
-	m.active = nil
+// AddVariantsSize adds i to the "variants_size" field.
+func (m *FileReferenceMutation) AddVariantsSize(i int64) {
+	if m.addvariants_size != nil {
+		*m.addvariants_size += i
+	} else {
+		m.addvariants_size = &i
+	}
 }
 
-// SetTags sets the "tags" field.
-func (m *QRCodeMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// AddedVariantsSize returns the value that was added to the "variants_size" field in this mutation.
+func (m *FileReferenceMutation) AddedVariantsSize() (r int64, exists bool) {
+	v := m.addvariants_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *QRCodeMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// ResetVariantsSize resets all changes to the "variants_size" field.
+func (m *FileReferenceMutation) ResetVariantsSize() {
+	m.variants_size = nil
+	m.addvariants_size = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *FileReferenceMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *FileReferenceMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *FileReferenceMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.Tags, nil
+	return oldValue.Owner, nil
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *QRCodeMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// ResetOwner resets all changes to the "owner" field.
+func (m *FileReferenceMutation) ResetOwner() {
+	m.owner = nil
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *QRCodeMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+// SetPdf sets the "pdf" field.
+func (m *FileReferenceMutation) SetPdf(pd *pdfinfo.Info) {
+	m.pdf = &pd
+}
+
+// Pdf returns the value of the "pdf" field in the mutation.
+func (m *FileReferenceMutation) Pdf() (r *pdfinfo.Info, exists bool) {
+	v := m.pdf
+	if v == nil {
+		return
 	}
-	return m.appendtags, true
+	return *v, true
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *QRCodeMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[qrcode.FieldTags] = struct{}{}
+// OldPdf returns the old "pdf" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldPdf(ctx context.Context) (v *pdfinfo.Info, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPdf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPdf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPdf: %w", err)
+	}
+	return oldValue.Pdf, nil
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *QRCodeMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldTags]
+// ClearPdf clears the value of the "pdf" field.
+func (m *FileReferenceMutation) ClearPdf() {
+	m.pdf = nil
+	m.clearedFields[filereference.FieldPdf] = struct{}{}
+}
+
+// PdfCleared returns if the "pdf" field was cleared in this mutation.
+func (m *FileReferenceMutation) PdfCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldPdf]
 	return ok
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *QRCodeMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, qrcode.FieldTags)
+// ResetPdf resets all changes to the "pdf" field.
+func (m *FileReferenceMutation) ResetPdf() {
+	m.pdf = nil
+	delete(m.clearedFields, filereference.FieldPdf)
 }
 
-// SetDesign sets the "design" field.
-func (m *QRCodeMutation) SetDesign(value map[string]interface{}) {
-	m.design = &value
+// SetVersion sets the "version" field.
+func (m *FileReferenceMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Design returns the value of the "design" field in the mutation.
-func (m *QRCodeMutation) Design() (r map[string]interface{}, exists bool) {
-	v := m.design
+// Version returns the value of the "version" field in the mutation.
+func (m *FileReferenceMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesign returns the old "design" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldDesign(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *FileReferenceMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesign is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesign requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesign: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Design, nil
+	return oldValue.Version, nil
 }
 
-// ClearDesign clears the value of the "design" field.
-func (m *QRCodeMutation) ClearDesign() {
-	m.design = nil
-	m.clearedFields[qrcode.FieldDesign] = struct{}{}
+// AddVersion adds i to the "version" field.
+func (m *FileReferenceMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// DesignCleared returns if the "design" field was cleared in this mutation.
-func (m *QRCodeMutation) DesignCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldDesign]
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *FileReferenceMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVersion clears the value of the "version" field.
+func (m *FileReferenceMutation) ClearVersion() {
+	m.version = nil
+	m.addversion = nil
+	m.clearedFields[filereference.FieldVersion] = struct{}{}
+}
+
+// VersionCleared returns if the "version" field was cleared in this mutation.
+func (m *FileReferenceMutation) VersionCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldVersion]
 	return ok
 }
 
-// ResetDesign resets all changes to the "design" field.
-func (m *QRCodeMutation) ResetDesign() {
-	m.design = nil
-	delete(m.clearedFields, qrcode.FieldDesign)
+// ResetVersion resets all changes to the "version" field.
+func (m *FileReferenceMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+	delete(m.clearedFields, filereference.FieldVersion)
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *QRCodeMutation) SetGroupID(i int) {
-	m.group = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *FileReferenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *QRCodeMutation) GroupID() (r int, exists bool) {
-	v := m.group
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileReferenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the QRCode entity.
-// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeMutation) OldGroupID(ctx context.Context) (v *int, err error) {
+func (m *FileReferenceMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.GroupID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearGroupID clears the value of the "group_id" field.
-func (m *QRCodeMutation) ClearGroupID() {
-	m.group = nil
-	m.clearedFields[qrcode.FieldGroupID] = struct{}{}
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *FileReferenceMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[filereference.FieldCreatedAt] = struct{}{}
 }
 
-// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
-func (m *QRCodeMutation) GroupIDCleared() bool {
-	_, ok := m.clearedFields[qrcode.FieldGroupID]
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *FileReferenceMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldCreatedAt]
 	return ok
 }
 
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *QRCodeMutation) ResetGroupID() {
-	m.group = nil
-	delete(m.clearedFields, qrcode.FieldGroupID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileReferenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, filereference.FieldCreatedAt)
 }
 
-// AddFileRefIDs adds the "file_refs" edge to the FileReference entity by ids.
-func (m *QRCodeMutation) AddFileRefIDs(ids ...int) {
-	if m.file_refs == nil {
-		m.file_refs = make(map[int]struct{})
+// SetScanStatus sets the "scan_status" field.
+func (m *FileReferenceMutation) SetScanStatus(fs filereference.ScanStatus) {
+	m.scan_status = &fs
+}
+
+// ScanStatus returns the value of the "scan_status" field in the mutation.
+func (m *FileReferenceMutation) ScanStatus() (r filereference.ScanStatus, exists bool) {
+	v := m.scan_status
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.file_refs[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldScanStatus returns the old "scan_status" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldScanStatus(ctx context.Context) (v filereference.ScanStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanStatus: %w", err)
 	}
+	return oldValue.ScanStatus, nil
 }
 
-// ClearFileRefs clears the "file_refs" edge to the FileReference entity.
-func (m *QRCodeMutation) ClearFileRefs() {
-	m.clearedfile_refs = true
+// ResetScanStatus resets all changes to the "scan_status" field.
+func (m *FileReferenceMutation) ResetScanStatus() {
+	m.scan_status = nil
 }
 
-// FileRefsCleared reports if the "file_refs" edge to the FileReference entity was cleared.
-func (m *QRCodeMutation) FileRefsCleared() bool {
-	return m.clearedfile_refs
+// SetScanResult sets the "scan_result" field.
+func (m *FileReferenceMutation) SetScanResult(s string) {
+	m.scan_result = &s
 }
 
-// RemoveFileRefIDs removes the "file_refs" edge to the FileReference entity by IDs.
-func (m *QRCodeMutation) RemoveFileRefIDs(ids ...int) {
-	if m.removedfile_refs == nil {
-		m.removedfile_refs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.file_refs, ids[i])
-		m.removedfile_refs[ids[i]] = struct{}{}
+// ScanResult returns the value of the "scan_result" field in the mutation.
+func (m *FileReferenceMutation) ScanResult() (r string, exists bool) {
+	v := m.scan_result
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFileRefs returns the removed IDs of the "file_refs" edge to the FileReference entity.
-func (m *QRCodeMutation) RemovedFileRefsIDs() (ids []int) {
-	for id := range m.removedfile_refs {
-		ids = append(ids, id)
+// OldScanResult returns the old "scan_result" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldScanResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanResult is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanResult: %w", err)
+	}
+	return oldValue.ScanResult, nil
 }
 
-// FileRefsIDs returns the "file_refs" edge IDs in the mutation.
-func (m *QRCodeMutation) FileRefsIDs() (ids []int) {
-	for id := range m.file_refs {
-		ids = append(ids, id)
-	}
-	return
+// ClearScanResult clears the value of the "scan_result" field.
+func (m *FileReferenceMutation) ClearScanResult() {
+	m.scan_result = nil
+	m.clearedFields[filereference.FieldScanResult] = struct{}{}
 }
 
-// ResetFileRefs resets all changes to the "file_refs" edge.
-func (m *QRCodeMutation) ResetFileRefs() {
-	m.file_refs = nil
-	m.clearedfile_refs = false
-	m.removedfile_refs = nil
+// ScanResultCleared returns if the "scan_result" field was cleared in this mutation.
+func (m *FileReferenceMutation) ScanResultCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldScanResult]
+	return ok
 }
 
-// ClearGroup clears the "group" edge to the QRCodeGroup entity.
-func (m *QRCodeMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[qrcode.FieldGroupID] = struct{}{}
+// ResetScanResult resets all changes to the "scan_result" field.
+func (m *FileReferenceMutation) ResetScanResult() {
+	m.scan_result = nil
+	delete(m.clearedFields, filereference.FieldScanResult)
 }
 
-// GroupCleared reports if the "group" edge to the QRCodeGroup entity was cleared.
-func (m *QRCodeMutation) GroupCleared() bool {
-	return m.GroupIDCleared() || m.clearedgroup
+// SetScanAttempts sets the "scan_attempts" field.
+func (m *FileReferenceMutation) SetScanAttempts(i int) {
+	m.scan_attempts = &i
+	m.addscan_attempts = nil
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *QRCodeMutation) GroupIDs() (ids []int) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// ScanAttempts returns the value of the "scan_attempts" field in the mutation.
+func (m *FileReferenceMutation) ScanAttempts() (r int, exists bool) {
+	v := m.scan_attempts
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *QRCodeMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// OldScanAttempts returns the old "scan_attempts" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldScanAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanAttempts: %w", err)
+	}
+	return oldValue.ScanAttempts, nil
 }
 
-// AddAnalyticsRecordIDs adds the "analytics_records" edge to the QRCodeAnalytics entity by ids.
-func (m *QRCodeMutation) AddAnalyticsRecordIDs(ids ...int) {
-	if m.analytics_records == nil {
-		m.analytics_records = make(map[int]struct{})
+// AddScanAttempts adds i to the "scan_attempts" field.
+func (m *FileReferenceMutation) AddScanAttempts(i int) {
+	if m.addscan_attempts != nil {
+		*m.addscan_attempts += i
+	} else {
+		m.addscan_attempts = &i
 	}
-	for i := range ids {
-		m.analytics_records[ids[i]] = struct{}{}
+}
+
+// AddedScanAttempts returns the value that was added to the "scan_attempts" field in this mutation.
+func (m *FileReferenceMutation) AddedScanAttempts() (r int, exists bool) {
+	v := m.addscan_attempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAnalyticsRecords clears the "analytics_records" edge to the QRCodeAnalytics entity.
-func (m *QRCodeMutation) ClearAnalyticsRecords() {
-	m.clearedanalytics_records = true
+// ResetScanAttempts resets all changes to the "scan_attempts" field.
+func (m *FileReferenceMutation) ResetScanAttempts() {
+	m.scan_attempts = nil
+	m.addscan_attempts = nil
 }
 
-// AnalyticsRecordsCleared reports if the "analytics_records" edge to the QRCodeAnalytics entity was cleared.
-func (m *QRCodeMutation) AnalyticsRecordsCleared() bool {
-	return m.clearedanalytics_records
+// SetScannedAt sets the "scanned_at" field.
+func (m *FileReferenceMutation) SetScannedAt(t time.Time) {
+	m.scanned_at = &t
 }
 
-// RemoveAnalyticsRecordIDs removes the "analytics_records" edge to the QRCodeAnalytics entity by IDs.
-func (m *QRCodeMutation) RemoveAnalyticsRecordIDs(ids ...int) {
-	if m.removedanalytics_records == nil {
-		m.removedanalytics_records = make(map[int]struct{})
+// ScannedAt returns the value of the "scanned_at" field in the mutation.
+func (m *FileReferenceMutation) ScannedAt() (r time.Time, exists bool) {
+	v := m.scanned_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.analytics_records, ids[i])
-		m.removedanalytics_records[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldScannedAt returns the old "scanned_at" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldScannedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
+	}
+	return oldValue.ScannedAt, nil
 }
 
-// RemovedAnalyticsRecords returns the removed IDs of the "analytics_records" edge to the QRCodeAnalytics entity.
-func (m *QRCodeMutation) RemovedAnalyticsRecordsIDs() (ids []int) {
-	for id := range m.removedanalytics_records {
-		ids = append(ids, id)
+// ClearScannedAt clears the value of the "scanned_at" field.
+func (m *FileReferenceMutation) ClearScannedAt() {
+	m.scanned_at = nil
+	m.clearedFields[filereference.FieldScannedAt] = struct{}{}
+}
+
+// ScannedAtCleared returns if the "scanned_at" field was cleared in this mutation.
+func (m *FileReferenceMutation) ScannedAtCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldScannedAt]
+	return ok
+}
+
+// ResetScannedAt resets all changes to the "scanned_at" field.
+func (m *FileReferenceMutation) ResetScannedAt() {
+	m.scanned_at = nil
+	delete(m.clearedFields, filereference.FieldScannedAt)
+}
+
+// SetNextScanAt sets the "next_scan_at" field.
+func (m *FileReferenceMutation) SetNextScanAt(t time.Time) {
+	m.next_scan_at = &t
+}
+
+// NextScanAt returns the value of the "next_scan_at" field in the mutation.
+func (m *FileReferenceMutation) NextScanAt() (r time.Time, exists bool) {
+	v := m.next_scan_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextScanAt returns the old "next_scan_at" field's value of the FileReference entity.
+// If the FileReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileReferenceMutation) OldNextScanAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextScanAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextScanAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextScanAt: %w", err)
+	}
+	return oldValue.NextScanAt, nil
+}
+
+// ClearNextScanAt clears the value of the "next_scan_at" field.
+func (m *FileReferenceMutation) ClearNextScanAt() {
+	m.next_scan_at = nil
+	m.clearedFields[filereference.FieldNextScanAt] = struct{}{}
+}
+
+// NextScanAtCleared returns if the "next_scan_at" field was cleared in this mutation.
+func (m *FileReferenceMutation) NextScanAtCleared() bool {
+	_, ok := m.clearedFields[filereference.FieldNextScanAt]
+	return ok
+}
+
+// ResetNextScanAt resets all changes to the "next_scan_at" field.
+func (m *FileReferenceMutation) ResetNextScanAt() {
+	m.next_scan_at = nil
+	delete(m.clearedFields, filereference.FieldNextScanAt)
+}
+
+// SetQrCodeID sets the "qr_code" edge to the QRCode entity by id.
+func (m *FileReferenceMutation) SetQrCodeID(id int) {
+	m.qr_code = &id
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (m *FileReferenceMutation) ClearQrCode() {
+	m.clearedqr_code = true
+}
+
+// QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
+func (m *FileReferenceMutation) QrCodeCleared() bool {
+	return m.clearedqr_code
+}
+
+// QrCodeID returns the "qr_code" edge ID in the mutation.
+func (m *FileReferenceMutation) QrCodeID() (id int, exists bool) {
+	if m.qr_code != nil {
+		return *m.qr_code, true
 	}
 	return
 }
 
-// AnalyticsRecordsIDs returns the "analytics_records" edge IDs in the mutation.
-func (m *QRCodeMutation) AnalyticsRecordsIDs() (ids []int) {
-	for id := range m.analytics_records {
-		ids = append(ids, id)
+// QrCodeIDs returns the "qr_code" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QrCodeID instead. It exists only for internal usage by the builders.
+func (m *FileReferenceMutation) QrCodeIDs() (ids []int) {
+	if id := m.qr_code; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAnalyticsRecords resets all changes to the "analytics_records" edge.
-func (m *QRCodeMutation) ResetAnalyticsRecords() {
-	m.analytics_records = nil
-	m.clearedanalytics_records = false
-	m.removedanalytics_records = nil
+// ResetQrCode resets all changes to the "qr_code" edge.
+func (m *FileReferenceMutation) ResetQrCode() {
+	m.qr_code = nil
+	m.clearedqr_code = false
 }
 
-// Where appends a list predicates to the QRCodeMutation builder.
-func (m *QRCodeMutation) Where(ps ...predicate.QRCode) {
+// Where appends a list predicates to the FileReferenceMutation builder.
+func (m *FileReferenceMutation) Where(ps ...predicate.FileReference) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QRCodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FileReferenceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QRCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QRCode, len(ps))
+func (m *FileReferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileReference, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1518,66 +1627,8027 @@ func (m *QRCodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QRCodeMutation) Op() Op {
+func (m *FileReferenceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QRCodeMutation) SetOp(op Op) {
+func (m *FileReferenceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QRCode).
-func (m *QRCodeMutation) Type() string {
+// Type returns the node type of this mutation (FileReference).
+func (m *FileReferenceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QRCodeMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *FileReferenceMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.filename != nil {
+		fields = append(fields, filereference.FieldFilename)
+	}
+	if m.url != nil {
+		fields = append(fields, filereference.FieldURL)
+	}
+	if m.size != nil {
+		fields = append(fields, filereference.FieldSize)
+	}
 	if m._type != nil {
-		fields = append(fields, qrcode.FieldType)
+		fields = append(fields, filereference.FieldType)
 	}
-	if m.title != nil {
-		fields = append(fields, qrcode.FieldTitle)
+	if m.backend != nil {
+		fields = append(fields, filereference.FieldBackend)
 	}
-	if m.description != nil {
-		fields = append(fields, qrcode.FieldDescription)
+	if m.key != nil {
+		fields = append(fields, filereference.FieldKey)
 	}
-	if m.redirect_url != nil {
-		fields = append(fields, qrcode.FieldRedirectURL)
+	if m.checksum != nil {
+		fields = append(fields, filereference.FieldChecksum)
 	}
-	if m.short_url != nil {
-		fields = append(fields, qrcode.FieldShortURL)
+	if m.width != nil {
+		fields = append(fields, filereference.FieldWidth)
 	}
-	if m.content != nil {
-		fields = append(fields, qrcode.FieldContent)
+	if m.height != nil {
+		fields = append(fields, filereference.FieldHeight)
+	}
+	if m.variants != nil {
+		fields = append(fields, filereference.FieldVariants)
+	}
+	if m.variants_size != nil {
+		fields = append(fields, filereference.FieldVariantsSize)
+	}
+	if m.owner != nil {
+		fields = append(fields, filereference.FieldOwner)
+	}
+	if m.pdf != nil {
+		fields = append(fields, filereference.FieldPdf)
+	}
+	if m.version != nil {
+		fields = append(fields, filereference.FieldVersion)
 	}
 	if m.created_at != nil {
-		fields = append(fields, qrcode.FieldCreatedAt)
+		fields = append(fields, filereference.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, qrcode.FieldUpdatedAt)
+	if m.scan_status != nil {
+		fields = append(fields, filereference.FieldScanStatus)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, qrcode.FieldExpiresAt)
+	if m.scan_result != nil {
+		fields = append(fields, filereference.FieldScanResult)
 	}
-	if m.analytics != nil {
-		fields = append(fields, qrcode.FieldAnalytics)
+	if m.scan_attempts != nil {
+		fields = append(fields, filereference.FieldScanAttempts)
+	}
+	if m.scanned_at != nil {
+		fields = append(fields, filereference.FieldScannedAt)
+	}
+	if m.next_scan_at != nil {
+		fields = append(fields, filereference.FieldNextScanAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileReferenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filereference.FieldFilename:
+		return m.Filename()
+	case filereference.FieldURL:
+		return m.URL()
+	case filereference.FieldSize:
+		return m.Size()
+	case filereference.FieldType:
+		return m.GetType()
+	case filereference.FieldBackend:
+		return m.Backend()
+	case filereference.FieldKey:
+		return m.Key()
+	case filereference.FieldChecksum:
+		return m.Checksum()
+	case filereference.FieldWidth:
+		return m.Width()
+	case filereference.FieldHeight:
+		return m.Height()
+	case filereference.FieldVariants:
+		return m.Variants()
+	case filereference.FieldVariantsSize:
+		return m.VariantsSize()
+	case filereference.FieldOwner:
+		return m.Owner()
+	case filereference.FieldPdf:
+		return m.Pdf()
+	case filereference.FieldVersion:
+		return m.Version()
+	case filereference.FieldCreatedAt:
+		return m.CreatedAt()
+	case filereference.FieldScanStatus:
+		return m.ScanStatus()
+	case filereference.FieldScanResult:
+		return m.ScanResult()
+	case filereference.FieldScanAttempts:
+		return m.ScanAttempts()
+	case filereference.FieldScannedAt:
+		return m.ScannedAt()
+	case filereference.FieldNextScanAt:
+		return m.NextScanAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileReferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filereference.FieldFilename:
+		return m.OldFilename(ctx)
+	case filereference.FieldURL:
+		return m.OldURL(ctx)
+	case filereference.FieldSize:
+		return m.OldSize(ctx)
+	case filereference.FieldType:
+		return m.OldType(ctx)
+	case filereference.FieldBackend:
+		return m.OldBackend(ctx)
+	case filereference.FieldKey:
+		return m.OldKey(ctx)
+	case filereference.FieldChecksum:
+		return m.OldChecksum(ctx)
+	case filereference.FieldWidth:
+		return m.OldWidth(ctx)
+	case filereference.FieldHeight:
+		return m.OldHeight(ctx)
+	case filereference.FieldVariants:
+		return m.OldVariants(ctx)
+	case filereference.FieldVariantsSize:
+		return m.OldVariantsSize(ctx)
+	case filereference.FieldOwner:
+		return m.OldOwner(ctx)
+	case filereference.FieldPdf:
+		return m.OldPdf(ctx)
+	case filereference.FieldVersion:
+		return m.OldVersion(ctx)
+	case filereference.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case filereference.FieldScanStatus:
+		return m.OldScanStatus(ctx)
+	case filereference.FieldScanResult:
+		return m.OldScanResult(ctx)
+	case filereference.FieldScanAttempts:
+		return m.OldScanAttempts(ctx)
+	case filereference.FieldScannedAt:
+		return m.OldScannedAt(ctx)
+	case filereference.FieldNextScanAt:
+		return m.OldNextScanAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileReference field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileReferenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filereference.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case filereference.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case filereference.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case filereference.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case filereference.FieldBackend:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBackend(v)
+		return nil
+	case filereference.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case filereference.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
+	case filereference.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case filereference.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case filereference.FieldVariants:
+		v, ok := value.([]schema.ImageVariant)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariants(v)
+		return nil
+	case filereference.FieldVariantsSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantsSize(v)
+		return nil
+	case filereference.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case filereference.FieldPdf:
+		v, ok := value.(*pdfinfo.Info)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdf(v)
+		return nil
+	case filereference.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case filereference.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case filereference.FieldScanStatus:
+		v, ok := value.(filereference.ScanStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanStatus(v)
+		return nil
+	case filereference.FieldScanResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanResult(v)
+		return nil
+	case filereference.FieldScanAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanAttempts(v)
+		return nil
+	case filereference.FieldScannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannedAt(v)
+		return nil
+	case filereference.FieldNextScanAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextScanAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileReferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, filereference.FieldSize)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, filereference.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, filereference.FieldHeight)
+	}
+	if m.addvariants_size != nil {
+		fields = append(fields, filereference.FieldVariantsSize)
+	}
+	if m.addversion != nil {
+		fields = append(fields, filereference.FieldVersion)
+	}
+	if m.addscan_attempts != nil {
+		fields = append(fields, filereference.FieldScanAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileReferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filereference.FieldSize:
+		return m.AddedSize()
+	case filereference.FieldWidth:
+		return m.AddedWidth()
+	case filereference.FieldHeight:
+		return m.AddedHeight()
+	case filereference.FieldVariantsSize:
+		return m.AddedVariantsSize()
+	case filereference.FieldVersion:
+		return m.AddedVersion()
+	case filereference.FieldScanAttempts:
+		return m.AddedScanAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileReferenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case filereference.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case filereference.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case filereference.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case filereference.FieldVariantsSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVariantsSize(v)
+		return nil
+	case filereference.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case filereference.FieldScanAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScanAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileReferenceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filereference.FieldKey) {
+		fields = append(fields, filereference.FieldKey)
+	}
+	if m.FieldCleared(filereference.FieldChecksum) {
+		fields = append(fields, filereference.FieldChecksum)
+	}
+	if m.FieldCleared(filereference.FieldWidth) {
+		fields = append(fields, filereference.FieldWidth)
+	}
+	if m.FieldCleared(filereference.FieldHeight) {
+		fields = append(fields, filereference.FieldHeight)
+	}
+	if m.FieldCleared(filereference.FieldVariants) {
+		fields = append(fields, filereference.FieldVariants)
+	}
+	if m.FieldCleared(filereference.FieldPdf) {
+		fields = append(fields, filereference.FieldPdf)
+	}
+	if m.FieldCleared(filereference.FieldVersion) {
+		fields = append(fields, filereference.FieldVersion)
+	}
+	if m.FieldCleared(filereference.FieldCreatedAt) {
+		fields = append(fields, filereference.FieldCreatedAt)
+	}
+	if m.FieldCleared(filereference.FieldScanResult) {
+		fields = append(fields, filereference.FieldScanResult)
+	}
+	if m.FieldCleared(filereference.FieldScannedAt) {
+		fields = append(fields, filereference.FieldScannedAt)
+	}
+	if m.FieldCleared(filereference.FieldNextScanAt) {
+		fields = append(fields, filereference.FieldNextScanAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileReferenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileReferenceMutation) ClearField(name string) error {
+	switch name {
+	case filereference.FieldKey:
+		m.ClearKey()
+		return nil
+	case filereference.FieldChecksum:
+		m.ClearChecksum()
+		return nil
+	case filereference.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case filereference.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case filereference.FieldVariants:
+		m.ClearVariants()
+		return nil
+	case filereference.FieldPdf:
+		m.ClearPdf()
+		return nil
+	case filereference.FieldVersion:
+		m.ClearVersion()
+		return nil
+	case filereference.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case filereference.FieldScanResult:
+		m.ClearScanResult()
+		return nil
+	case filereference.FieldScannedAt:
+		m.ClearScannedAt()
+		return nil
+	case filereference.FieldNextScanAt:
+		m.ClearNextScanAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileReferenceMutation) ResetField(name string) error {
+	switch name {
+	case filereference.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case filereference.FieldURL:
+		m.ResetURL()
+		return nil
+	case filereference.FieldSize:
+		m.ResetSize()
+		return nil
+	case filereference.FieldType:
+		m.ResetType()
+		return nil
+	case filereference.FieldBackend:
+		m.ResetBackend()
+		return nil
+	case filereference.FieldKey:
+		m.ResetKey()
+		return nil
+	case filereference.FieldChecksum:
+		m.ResetChecksum()
+		return nil
+	case filereference.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case filereference.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case filereference.FieldVariants:
+		m.ResetVariants()
+		return nil
+	case filereference.FieldVariantsSize:
+		m.ResetVariantsSize()
+		return nil
+	case filereference.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case filereference.FieldPdf:
+		m.ResetPdf()
+		return nil
+	case filereference.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case filereference.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case filereference.FieldScanStatus:
+		m.ResetScanStatus()
+		return nil
+	case filereference.FieldScanResult:
+		m.ResetScanResult()
+		return nil
+	case filereference.FieldScanAttempts:
+		m.ResetScanAttempts()
+		return nil
+	case filereference.FieldScannedAt:
+		m.ResetScannedAt()
+		return nil
+	case filereference.FieldNextScanAt:
+		m.ResetNextScanAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileReferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.qr_code != nil {
+		edges = append(edges, filereference.EdgeQrCode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileReferenceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filereference.EdgeQrCode:
+		if id := m.qr_code; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileReferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileReferenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileReferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedqr_code {
+		edges = append(edges, filereference.EdgeQrCode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileReferenceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filereference.EdgeQrCode:
+		return m.clearedqr_code
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileReferenceMutation) ClearEdge(name string) error {
+	switch name {
+	case filereference.EdgeQrCode:
+		m.ClearQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileReferenceMutation) ResetEdge(name string) error {
+	switch name {
+	case filereference.EdgeQrCode:
+		m.ResetQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown FileReference edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	_type            *string
+	payload          *json.RawMessage
+	appendpayload    json.RawMessage
+	status           *job.Status
+	total            *int
+	addtotal         *int
+	processed        *int
+	addprocessed     *int
+	result           *json.RawMessage
+	appendresult     json.RawMessage
+	attempts         *int
+	addattempts      *int
+	max_attempts     *int
+	addmax_attempts  *int
+	last_error       *string
+	cancel_requested *bool
+	locked_by        *string
+	locked_until     *time.Time
+	run_at           *time.Time
+	started_at       *time.Time
+	finished_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Job, error)
+	predicates       []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id int) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *JobMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *JobMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *JobMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *JobMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *JobMutation) ClearPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	m.clearedFields[job.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *JobMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[job.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+	delete(m.clearedFields, job.FieldPayload)
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *JobMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *JobMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *JobMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *JobMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *JobMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetProcessed sets the "processed" field.
+func (m *JobMutation) SetProcessed(i int) {
+	m.processed = &i
+	m.addprocessed = nil
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *JobMutation) Processed() (r int, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// AddProcessed adds i to the "processed" field.
+func (m *JobMutation) AddProcessed(i int) {
+	if m.addprocessed != nil {
+		*m.addprocessed += i
+	} else {
+		m.addprocessed = &i
+	}
+}
+
+// AddedProcessed returns the value that was added to the "processed" field in this mutation.
+func (m *JobMutation) AddedProcessed() (r int, exists bool) {
+	v := m.addprocessed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *JobMutation) ResetProcessed() {
+	m.processed = nil
+	m.addprocessed = nil
+}
+
+// SetResult sets the "result" field.
+func (m *JobMutation) SetResult(jm json.RawMessage) {
+	m.result = &jm
+	m.appendresult = nil
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *JobMutation) Result() (r json.RawMessage, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldResult(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// AppendResult adds jm to the "result" field.
+func (m *JobMutation) AppendResult(jm json.RawMessage) {
+	m.appendresult = append(m.appendresult, jm...)
+}
+
+// AppendedResult returns the list of values that were appended to the "result" field in this mutation.
+func (m *JobMutation) AppendedResult() (json.RawMessage, bool) {
+	if len(m.appendresult) == 0 {
+		return nil, false
+	}
+	return m.appendresult, true
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *JobMutation) ClearResult() {
+	m.result = nil
+	m.appendresult = nil
+	m.clearedFields[job.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *JobMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[job.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *JobMutation) ResetResult() {
+	m.result = nil
+	m.appendresult = nil
+	delete(m.clearedFields, job.FieldResult)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *JobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *JobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *JobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *JobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *JobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *JobMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *JobMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *JobMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[job.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *JobMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *JobMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, job.FieldLastError)
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (m *JobMutation) SetCancelRequested(b bool) {
+	m.cancel_requested = &b
+}
+
+// CancelRequested returns the value of the "cancel_requested" field in the mutation.
+func (m *JobMutation) CancelRequested() (r bool, exists bool) {
+	v := m.cancel_requested
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelRequested returns the old "cancel_requested" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCancelRequested(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelRequested is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelRequested requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelRequested: %w", err)
+	}
+	return oldValue.CancelRequested, nil
+}
+
+// ResetCancelRequested resets all changes to the "cancel_requested" field.
+func (m *JobMutation) ResetCancelRequested() {
+	m.cancel_requested = nil
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *JobMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *JobMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (m *JobMutation) ClearLockedBy() {
+	m.locked_by = nil
+	m.clearedFields[job.FieldLockedBy] = struct{}{}
+}
+
+// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
+func (m *JobMutation) LockedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedBy]
+	return ok
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *JobMutation) ResetLockedBy() {
+	m.locked_by = nil
+	delete(m.clearedFields, job.FieldLockedBy)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *JobMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *JobMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *JobMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[job.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *JobMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[job.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *JobMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, job.FieldLockedUntil)
+}
+
+// SetRunAt sets the "run_at" field.
+func (m *JobMutation) SetRunAt(t time.Time) {
+	m.run_at = &t
+}
+
+// RunAt returns the value of the "run_at" field in the mutation.
+func (m *JobMutation) RunAt() (r time.Time, exists bool) {
+	v := m.run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunAt returns the old "run_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunAt: %w", err)
+	}
+	return oldValue.RunAt, nil
+}
+
+// ResetRunAt resets all changes to the "run_at" field.
+func (m *JobMutation) ResetRunAt() {
+	m.run_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *JobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *JobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *JobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[job.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *JobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *JobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, job.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[job.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, job.FieldFinishedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m._type != nil {
+		fields = append(fields, job.FieldType)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.total != nil {
+		fields = append(fields, job.FieldTotal)
+	}
+	if m.processed != nil {
+		fields = append(fields, job.FieldProcessed)
+	}
+	if m.result != nil {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.cancel_requested != nil {
+		fields = append(fields, job.FieldCancelRequested)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.run_at != nil {
+		fields = append(fields, job.FieldRunAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldType:
+		return m.GetType()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldTotal:
+		return m.Total()
+	case job.FieldProcessed:
+		return m.Processed()
+	case job.FieldResult:
+		return m.Result()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case job.FieldLastError:
+		return m.LastError()
+	case job.FieldCancelRequested:
+		return m.CancelRequested()
+	case job.FieldLockedBy:
+		return m.LockedBy()
+	case job.FieldLockedUntil:
+		return m.LockedUntil()
+	case job.FieldRunAt:
+		return m.RunAt()
+	case job.FieldStartedAt:
+		return m.StartedAt()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldType:
+		return m.OldType(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldTotal:
+		return m.OldTotal(ctx)
+	case job.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case job.FieldResult:
+		return m.OldResult(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case job.FieldLastError:
+		return m.OldLastError(ctx)
+	case job.FieldCancelRequested:
+		return m.OldCancelRequested(ctx)
+	case job.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case job.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case job.FieldRunAt:
+		return m.OldRunAt(ctx)
+	case job.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case job.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessed(v)
+		return nil
+	case job.FieldResult:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case job.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case job.FieldCancelRequested:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelRequested(v)
+		return nil
+	case job.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case job.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case job.FieldRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunAt(v)
+		return nil
+	case job.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal != nil {
+		fields = append(fields, job.FieldTotal)
+	}
+	if m.addprocessed != nil {
+		fields = append(fields, job.FieldProcessed)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, job.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldTotal:
+		return m.AddedTotal()
+	case job.FieldProcessed:
+		return m.AddedProcessed()
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case job.FieldProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessed(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldPayload) {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.FieldCleared(job.FieldResult) {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.FieldCleared(job.FieldLastError) {
+		fields = append(fields, job.FieldLastError)
+	}
+	if m.FieldCleared(job.FieldLockedBy) {
+		fields = append(fields, job.FieldLockedBy)
+	}
+	if m.FieldCleared(job.FieldLockedUntil) {
+		fields = append(fields, job.FieldLockedUntil)
+	}
+	if m.FieldCleared(job.FieldStartedAt) {
+		fields = append(fields, job.FieldStartedAt)
+	}
+	if m.FieldCleared(job.FieldFinishedAt) {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case job.FieldResult:
+		m.ClearResult()
+		return nil
+	case job.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case job.FieldLockedBy:
+		m.ClearLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case job.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldType:
+		m.ResetType()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case job.FieldProcessed:
+		m.ResetProcessed()
+		return nil
+	case job.FieldResult:
+		m.ResetResult()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case job.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case job.FieldCancelRequested:
+		m.ResetCancelRequested()
+		return nil
+	case job.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case job.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case job.FieldRunAt:
+		m.ResetRunAt()
+		return nil
+	case job.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// QRCodeMutation represents an operation that mutates the QRCode nodes in the graph.
+type QRCodeMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	_type                    *string
+	title                    *string
+	description              *string
+	redirect_url             *string
+	short_url                *string
+	content                  *map[string]interface{}
+	created_at               *time.Time
+	updated_at               *time.Time
+	expires_at               *time.Time
+	starts_at                *time.Time
+	schedule                 **schedule.Schedule
+	fallback_url             *string
+	fallback_message         *string
+	max_scans                *int
+	addmax_scans             *int
+	scans_per_visitor        *int
+	addscans_per_visitor     *int
+	visitor_key              *qrcode.VisitorKey
+	redeemed                 *int
+	addredeemed              *int
+	ended_message            *string
+	password_hash            *string
+	signing                  *qrcode.Signing
+	signed_token             *string
+	analytics                *bool
+	analytics_counts_only    *bool
+	active                   *bool
+	tags                     *[]string
+	appendtags               []string
+	design                   *map[string]interface{}
+	gs1_key                  *string
+	import_key               *string
+	clearedFields            map[string]struct{}
+	file_refs                map[int]struct{}
+	removedfile_refs         map[int]struct{}
+	clearedfile_refs         bool
+	group                    *int
+	clearedgroup             bool
+	analytics_records        map[int]struct{}
+	removedanalytics_records map[int]struct{}
+	clearedanalytics_records bool
+	daily_analytics          map[int]struct{}
+	removeddaily_analytics   map[int]struct{}
+	cleareddaily_analytics   bool
+	webhooks                 map[int]struct{}
+	removedwebhooks          map[int]struct{}
+	clearedwebhooks          bool
+	redemptions              map[int]struct{}
+	removedredemptions       map[int]struct{}
+	clearedredemptions       bool
+	done                     bool
+	oldValue                 func(context.Context) (*QRCode, error)
+	predicates               []predicate.QRCode
+}
+
+var _ ent.Mutation = (*QRCodeMutation)(nil)
+
+// qrcodeOption allows management of the mutation configuration using functional options.
+type qrcodeOption func(*QRCodeMutation)
+
+// newQRCodeMutation creates new mutation for the QRCode entity.
+func newQRCodeMutation(c config, op Op, opts ...qrcodeOption) *QRCodeMutation {
+	m := &QRCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQRCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQRCodeID sets the ID field of the mutation.
+func withQRCodeID(id int) qrcodeOption {
+	return func(m *QRCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QRCode
+		)
+		m.oldValue = func(ctx context.Context) (*QRCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QRCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQRCode sets the old QRCode of the mutation.
+func withQRCode(node *QRCode) qrcodeOption {
+	return func(m *QRCodeMutation) {
+		m.oldValue = func(context.Context) (*QRCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QRCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QRCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QRCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QRCodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QRCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *QRCodeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *QRCodeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *QRCodeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *QRCodeMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QRCodeMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QRCodeMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QRCodeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QRCodeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QRCodeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[qrcode.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QRCodeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QRCodeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, qrcode.FieldDescription)
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (m *QRCodeMutation) SetRedirectURL(s string) {
+	m.redirect_url = &s
+}
+
+// RedirectURL returns the value of the "redirect_url" field in the mutation.
+func (m *QRCodeMutation) RedirectURL() (r string, exists bool) {
+	v := m.redirect_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectURL returns the old "redirect_url" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldRedirectURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectURL: %w", err)
+	}
+	return oldValue.RedirectURL, nil
+}
+
+// ClearRedirectURL clears the value of the "redirect_url" field.
+func (m *QRCodeMutation) ClearRedirectURL() {
+	m.redirect_url = nil
+	m.clearedFields[qrcode.FieldRedirectURL] = struct{}{}
+}
+
+// RedirectURLCleared returns if the "redirect_url" field was cleared in this mutation.
+func (m *QRCodeMutation) RedirectURLCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldRedirectURL]
+	return ok
+}
+
+// ResetRedirectURL resets all changes to the "redirect_url" field.
+func (m *QRCodeMutation) ResetRedirectURL() {
+	m.redirect_url = nil
+	delete(m.clearedFields, qrcode.FieldRedirectURL)
+}
+
+// SetShortURL sets the "short_url" field.
+func (m *QRCodeMutation) SetShortURL(s string) {
+	m.short_url = &s
+}
+
+// ShortURL returns the value of the "short_url" field in the mutation.
+func (m *QRCodeMutation) ShortURL() (r string, exists bool) {
+	v := m.short_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortURL returns the old "short_url" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldShortURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortURL: %w", err)
+	}
+	return oldValue.ShortURL, nil
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (m *QRCodeMutation) ClearShortURL() {
+	m.short_url = nil
+	m.clearedFields[qrcode.FieldShortURL] = struct{}{}
+}
+
+// ShortURLCleared returns if the "short_url" field was cleared in this mutation.
+func (m *QRCodeMutation) ShortURLCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldShortURL]
+	return ok
+}
+
+// ResetShortURL resets all changes to the "short_url" field.
+func (m *QRCodeMutation) ResetShortURL() {
+	m.short_url = nil
+	delete(m.clearedFields, qrcode.FieldShortURL)
+}
+
+// SetContent sets the "content" field.
+func (m *QRCodeMutation) SetContent(value map[string]interface{}) {
+	m.content = &value
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *QRCodeMutation) Content() (r map[string]interface{}, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldContent(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *QRCodeMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QRCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QRCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QRCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QRCodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QRCodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QRCodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *QRCodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *QRCodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *QRCodeMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[qrcode.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *QRCodeMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *QRCodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, qrcode.FieldExpiresAt)
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *QRCodeMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *QRCodeMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldStartsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (m *QRCodeMutation) ClearStartsAt() {
+	m.starts_at = nil
+	m.clearedFields[qrcode.FieldStartsAt] = struct{}{}
+}
+
+// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
+func (m *QRCodeMutation) StartsAtCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldStartsAt]
+	return ok
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *QRCodeMutation) ResetStartsAt() {
+	m.starts_at = nil
+	delete(m.clearedFields, qrcode.FieldStartsAt)
+}
+
+// SetSchedule sets the "schedule" field.
+func (m *QRCodeMutation) SetSchedule(s *schedule.Schedule) {
+	m.schedule = &s
+}
+
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *QRCodeMutation) Schedule() (r *schedule.Schedule, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedule returns the old "schedule" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldSchedule(ctx context.Context) (v *schedule.Schedule, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (m *QRCodeMutation) ClearSchedule() {
+	m.schedule = nil
+	m.clearedFields[qrcode.FieldSchedule] = struct{}{}
+}
+
+// ScheduleCleared returns if the "schedule" field was cleared in this mutation.
+func (m *QRCodeMutation) ScheduleCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldSchedule]
+	return ok
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *QRCodeMutation) ResetSchedule() {
+	m.schedule = nil
+	delete(m.clearedFields, qrcode.FieldSchedule)
+}
+
+// SetFallbackURL sets the "fallback_url" field.
+func (m *QRCodeMutation) SetFallbackURL(s string) {
+	m.fallback_url = &s
+}
+
+// FallbackURL returns the value of the "fallback_url" field in the mutation.
+func (m *QRCodeMutation) FallbackURL() (r string, exists bool) {
+	v := m.fallback_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackURL returns the old "fallback_url" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldFallbackURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackURL: %w", err)
+	}
+	return oldValue.FallbackURL, nil
+}
+
+// ClearFallbackURL clears the value of the "fallback_url" field.
+func (m *QRCodeMutation) ClearFallbackURL() {
+	m.fallback_url = nil
+	m.clearedFields[qrcode.FieldFallbackURL] = struct{}{}
+}
+
+// FallbackURLCleared returns if the "fallback_url" field was cleared in this mutation.
+func (m *QRCodeMutation) FallbackURLCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldFallbackURL]
+	return ok
+}
+
+// ResetFallbackURL resets all changes to the "fallback_url" field.
+func (m *QRCodeMutation) ResetFallbackURL() {
+	m.fallback_url = nil
+	delete(m.clearedFields, qrcode.FieldFallbackURL)
+}
+
+// SetFallbackMessage sets the "fallback_message" field.
+func (m *QRCodeMutation) SetFallbackMessage(s string) {
+	m.fallback_message = &s
+}
+
+// FallbackMessage returns the value of the "fallback_message" field in the mutation.
+func (m *QRCodeMutation) FallbackMessage() (r string, exists bool) {
+	v := m.fallback_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackMessage returns the old "fallback_message" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldFallbackMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackMessage: %w", err)
+	}
+	return oldValue.FallbackMessage, nil
+}
+
+// ClearFallbackMessage clears the value of the "fallback_message" field.
+func (m *QRCodeMutation) ClearFallbackMessage() {
+	m.fallback_message = nil
+	m.clearedFields[qrcode.FieldFallbackMessage] = struct{}{}
+}
+
+// FallbackMessageCleared returns if the "fallback_message" field was cleared in this mutation.
+func (m *QRCodeMutation) FallbackMessageCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldFallbackMessage]
+	return ok
+}
+
+// ResetFallbackMessage resets all changes to the "fallback_message" field.
+func (m *QRCodeMutation) ResetFallbackMessage() {
+	m.fallback_message = nil
+	delete(m.clearedFields, qrcode.FieldFallbackMessage)
+}
+
+// SetMaxScans sets the "max_scans" field.
+func (m *QRCodeMutation) SetMaxScans(i int) {
+	m.max_scans = &i
+	m.addmax_scans = nil
+}
+
+// MaxScans returns the value of the "max_scans" field in the mutation.
+func (m *QRCodeMutation) MaxScans() (r int, exists bool) {
+	v := m.max_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxScans returns the old "max_scans" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldMaxScans(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxScans is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxScans requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxScans: %w", err)
+	}
+	return oldValue.MaxScans, nil
+}
+
+// AddMaxScans adds i to the "max_scans" field.
+func (m *QRCodeMutation) AddMaxScans(i int) {
+	if m.addmax_scans != nil {
+		*m.addmax_scans += i
+	} else {
+		m.addmax_scans = &i
+	}
+}
+
+// AddedMaxScans returns the value that was added to the "max_scans" field in this mutation.
+func (m *QRCodeMutation) AddedMaxScans() (r int, exists bool) {
+	v := m.addmax_scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxScans clears the value of the "max_scans" field.
+func (m *QRCodeMutation) ClearMaxScans() {
+	m.max_scans = nil
+	m.addmax_scans = nil
+	m.clearedFields[qrcode.FieldMaxScans] = struct{}{}
+}
+
+// MaxScansCleared returns if the "max_scans" field was cleared in this mutation.
+func (m *QRCodeMutation) MaxScansCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldMaxScans]
+	return ok
+}
+
+// ResetMaxScans resets all changes to the "max_scans" field.
+func (m *QRCodeMutation) ResetMaxScans() {
+	m.max_scans = nil
+	m.addmax_scans = nil
+	delete(m.clearedFields, qrcode.FieldMaxScans)
+}
+
+// SetScansPerVisitor sets the "scans_per_visitor" field.
+func (m *QRCodeMutation) SetScansPerVisitor(i int) {
+	m.scans_per_visitor = &i
+	m.addscans_per_visitor = nil
+}
+
+// ScansPerVisitor returns the value of the "scans_per_visitor" field in the mutation.
+func (m *QRCodeMutation) ScansPerVisitor() (r int, exists bool) {
+	v := m.scans_per_visitor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScansPerVisitor returns the old "scans_per_visitor" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldScansPerVisitor(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScansPerVisitor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScansPerVisitor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScansPerVisitor: %w", err)
+	}
+	return oldValue.ScansPerVisitor, nil
+}
+
+// AddScansPerVisitor adds i to the "scans_per_visitor" field.
+func (m *QRCodeMutation) AddScansPerVisitor(i int) {
+	if m.addscans_per_visitor != nil {
+		*m.addscans_per_visitor += i
+	} else {
+		m.addscans_per_visitor = &i
+	}
+}
+
+// AddedScansPerVisitor returns the value that was added to the "scans_per_visitor" field in this mutation.
+func (m *QRCodeMutation) AddedScansPerVisitor() (r int, exists bool) {
+	v := m.addscans_per_visitor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScansPerVisitor clears the value of the "scans_per_visitor" field.
+func (m *QRCodeMutation) ClearScansPerVisitor() {
+	m.scans_per_visitor = nil
+	m.addscans_per_visitor = nil
+	m.clearedFields[qrcode.FieldScansPerVisitor] = struct{}{}
+}
+
+// ScansPerVisitorCleared returns if the "scans_per_visitor" field was cleared in this mutation.
+func (m *QRCodeMutation) ScansPerVisitorCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldScansPerVisitor]
+	return ok
+}
+
+// ResetScansPerVisitor resets all changes to the "scans_per_visitor" field.
+func (m *QRCodeMutation) ResetScansPerVisitor() {
+	m.scans_per_visitor = nil
+	m.addscans_per_visitor = nil
+	delete(m.clearedFields, qrcode.FieldScansPerVisitor)
+}
+
+// SetVisitorKey sets the "visitor_key" field.
+func (m *QRCodeMutation) SetVisitorKey(qk qrcode.VisitorKey) {
+	m.visitor_key = &qk
+}
+
+// VisitorKey returns the value of the "visitor_key" field in the mutation.
+func (m *QRCodeMutation) VisitorKey() (r qrcode.VisitorKey, exists bool) {
+	v := m.visitor_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisitorKey returns the old "visitor_key" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldVisitorKey(ctx context.Context) (v qrcode.VisitorKey, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisitorKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisitorKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisitorKey: %w", err)
+	}
+	return oldValue.VisitorKey, nil
+}
+
+// ResetVisitorKey resets all changes to the "visitor_key" field.
+func (m *QRCodeMutation) ResetVisitorKey() {
+	m.visitor_key = nil
+}
+
+// SetRedeemed sets the "redeemed" field.
+func (m *QRCodeMutation) SetRedeemed(i int) {
+	m.redeemed = &i
+	m.addredeemed = nil
+}
+
+// Redeemed returns the value of the "redeemed" field in the mutation.
+func (m *QRCodeMutation) Redeemed() (r int, exists bool) {
+	v := m.redeemed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedeemed returns the old "redeemed" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldRedeemed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedeemed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedeemed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedeemed: %w", err)
+	}
+	return oldValue.Redeemed, nil
+}
+
+// AddRedeemed adds i to the "redeemed" field.
+func (m *QRCodeMutation) AddRedeemed(i int) {
+	if m.addredeemed != nil {
+		*m.addredeemed += i
+	} else {
+		m.addredeemed = &i
+	}
+}
+
+// AddedRedeemed returns the value that was added to the "redeemed" field in this mutation.
+func (m *QRCodeMutation) AddedRedeemed() (r int, exists bool) {
+	v := m.addredeemed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRedeemed resets all changes to the "redeemed" field.
+func (m *QRCodeMutation) ResetRedeemed() {
+	m.redeemed = nil
+	m.addredeemed = nil
+}
+
+// SetEndedMessage sets the "ended_message" field.
+func (m *QRCodeMutation) SetEndedMessage(s string) {
+	m.ended_message = &s
+}
+
+// EndedMessage returns the value of the "ended_message" field in the mutation.
+func (m *QRCodeMutation) EndedMessage() (r string, exists bool) {
+	v := m.ended_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedMessage returns the old "ended_message" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldEndedMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedMessage: %w", err)
+	}
+	return oldValue.EndedMessage, nil
+}
+
+// ClearEndedMessage clears the value of the "ended_message" field.
+func (m *QRCodeMutation) ClearEndedMessage() {
+	m.ended_message = nil
+	m.clearedFields[qrcode.FieldEndedMessage] = struct{}{}
+}
+
+// EndedMessageCleared returns if the "ended_message" field was cleared in this mutation.
+func (m *QRCodeMutation) EndedMessageCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldEndedMessage]
+	return ok
+}
+
+// ResetEndedMessage resets all changes to the "ended_message" field.
+func (m *QRCodeMutation) ResetEndedMessage() {
+	m.ended_message = nil
+	delete(m.clearedFields, qrcode.FieldEndedMessage)
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *QRCodeMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *QRCodeMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *QRCodeMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[qrcode.FieldPasswordHash] = struct{}{}
+}
+
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *QRCodeMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldPasswordHash]
+	return ok
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *QRCodeMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, qrcode.FieldPasswordHash)
+}
+
+// SetSigning sets the "signing" field.
+func (m *QRCodeMutation) SetSigning(q qrcode.Signing) {
+	m.signing = &q
+}
+
+// Signing returns the value of the "signing" field in the mutation.
+func (m *QRCodeMutation) Signing() (r qrcode.Signing, exists bool) {
+	v := m.signing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigning returns the old "signing" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldSigning(ctx context.Context) (v *qrcode.Signing, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigning is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigning requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigning: %w", err)
+	}
+	return oldValue.Signing, nil
+}
+
+// ClearSigning clears the value of the "signing" field.
+func (m *QRCodeMutation) ClearSigning() {
+	m.signing = nil
+	m.clearedFields[qrcode.FieldSigning] = struct{}{}
+}
+
+// SigningCleared returns if the "signing" field was cleared in this mutation.
+func (m *QRCodeMutation) SigningCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldSigning]
+	return ok
+}
+
+// ResetSigning resets all changes to the "signing" field.
+func (m *QRCodeMutation) ResetSigning() {
+	m.signing = nil
+	delete(m.clearedFields, qrcode.FieldSigning)
+}
+
+// SetSignedToken sets the "signed_token" field.
+func (m *QRCodeMutation) SetSignedToken(s string) {
+	m.signed_token = &s
+}
+
+// SignedToken returns the value of the "signed_token" field in the mutation.
+func (m *QRCodeMutation) SignedToken() (r string, exists bool) {
+	v := m.signed_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignedToken returns the old "signed_token" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldSignedToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignedToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignedToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignedToken: %w", err)
+	}
+	return oldValue.SignedToken, nil
+}
+
+// ClearSignedToken clears the value of the "signed_token" field.
+func (m *QRCodeMutation) ClearSignedToken() {
+	m.signed_token = nil
+	m.clearedFields[qrcode.FieldSignedToken] = struct{}{}
+}
+
+// SignedTokenCleared returns if the "signed_token" field was cleared in this mutation.
+func (m *QRCodeMutation) SignedTokenCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldSignedToken]
+	return ok
+}
+
+// ResetSignedToken resets all changes to the "signed_token" field.
+func (m *QRCodeMutation) ResetSignedToken() {
+	m.signed_token = nil
+	delete(m.clearedFields, qrcode.FieldSignedToken)
+}
+
+// SetAnalytics sets the "analytics" field.
+func (m *QRCodeMutation) SetAnalytics(b bool) {
+	m.analytics = &b
+}
+
+// Analytics returns the value of the "analytics" field in the mutation.
+func (m *QRCodeMutation) Analytics() (r bool, exists bool) {
+	v := m.analytics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnalytics returns the old "analytics" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldAnalytics(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnalytics is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnalytics requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnalytics: %w", err)
+	}
+	return oldValue.Analytics, nil
+}
+
+// ResetAnalytics resets all changes to the "analytics" field.
+func (m *QRCodeMutation) ResetAnalytics() {
+	m.analytics = nil
+}
+
+// SetAnalyticsCountsOnly sets the "analytics_counts_only" field.
+func (m *QRCodeMutation) SetAnalyticsCountsOnly(b bool) {
+	m.analytics_counts_only = &b
+}
+
+// AnalyticsCountsOnly returns the value of the "analytics_counts_only" field in the mutation.
+func (m *QRCodeMutation) AnalyticsCountsOnly() (r bool, exists bool) {
+	v := m.analytics_counts_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnalyticsCountsOnly returns the old "analytics_counts_only" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldAnalyticsCountsOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnalyticsCountsOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnalyticsCountsOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnalyticsCountsOnly: %w", err)
+	}
+	return oldValue.AnalyticsCountsOnly, nil
+}
+
+// ResetAnalyticsCountsOnly resets all changes to the "analytics_counts_only" field.
+func (m *QRCodeMutation) ResetAnalyticsCountsOnly() {
+	m.analytics_counts_only = nil
+}
+
+// SetActive sets the "active" field.
+func (m *QRCodeMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *QRCodeMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *QRCodeMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *QRCodeMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *QRCodeMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *QRCodeMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *QRCodeMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *QRCodeMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[qrcode.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *QRCodeMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *QRCodeMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, qrcode.FieldTags)
+}
+
+// SetDesign sets the "design" field.
+func (m *QRCodeMutation) SetDesign(value map[string]interface{}) {
+	m.design = &value
+}
+
+// Design returns the value of the "design" field in the mutation.
+func (m *QRCodeMutation) Design() (r map[string]interface{}, exists bool) {
+	v := m.design
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesign returns the old "design" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldDesign(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesign is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesign requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesign: %w", err)
+	}
+	return oldValue.Design, nil
+}
+
+// ClearDesign clears the value of the "design" field.
+func (m *QRCodeMutation) ClearDesign() {
+	m.design = nil
+	m.clearedFields[qrcode.FieldDesign] = struct{}{}
+}
+
+// DesignCleared returns if the "design" field was cleared in this mutation.
+func (m *QRCodeMutation) DesignCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldDesign]
+	return ok
+}
+
+// ResetDesign resets all changes to the "design" field.
+func (m *QRCodeMutation) ResetDesign() {
+	m.design = nil
+	delete(m.clearedFields, qrcode.FieldDesign)
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *QRCodeMutation) SetGroupID(i int) {
+	m.group = &i
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *QRCodeMutation) GroupID() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldGroupID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (m *QRCodeMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[qrcode.FieldGroupID] = struct{}{}
+}
+
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *QRCodeMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldGroupID]
+	return ok
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *QRCodeMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, qrcode.FieldGroupID)
+}
+
+// SetGs1Key sets the "gs1_key" field.
+func (m *QRCodeMutation) SetGs1Key(s string) {
+	m.gs1_key = &s
+}
+
+// Gs1Key returns the value of the "gs1_key" field in the mutation.
+func (m *QRCodeMutation) Gs1Key() (r string, exists bool) {
+	v := m.gs1_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGs1Key returns the old "gs1_key" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldGs1Key(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGs1Key is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGs1Key requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGs1Key: %w", err)
+	}
+	return oldValue.Gs1Key, nil
+}
+
+// ClearGs1Key clears the value of the "gs1_key" field.
+func (m *QRCodeMutation) ClearGs1Key() {
+	m.gs1_key = nil
+	m.clearedFields[qrcode.FieldGs1Key] = struct{}{}
+}
+
+// Gs1KeyCleared returns if the "gs1_key" field was cleared in this mutation.
+func (m *QRCodeMutation) Gs1KeyCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldGs1Key]
+	return ok
+}
+
+// ResetGs1Key resets all changes to the "gs1_key" field.
+func (m *QRCodeMutation) ResetGs1Key() {
+	m.gs1_key = nil
+	delete(m.clearedFields, qrcode.FieldGs1Key)
+}
+
+// SetImportKey sets the "import_key" field.
+func (m *QRCodeMutation) SetImportKey(s string) {
+	m.import_key = &s
+}
+
+// ImportKey returns the value of the "import_key" field in the mutation.
+func (m *QRCodeMutation) ImportKey() (r string, exists bool) {
+	v := m.import_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportKey returns the old "import_key" field's value of the QRCode entity.
+// If the QRCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeMutation) OldImportKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportKey: %w", err)
+	}
+	return oldValue.ImportKey, nil
+}
+
+// ClearImportKey clears the value of the "import_key" field.
+func (m *QRCodeMutation) ClearImportKey() {
+	m.import_key = nil
+	m.clearedFields[qrcode.FieldImportKey] = struct{}{}
+}
+
+// ImportKeyCleared returns if the "import_key" field was cleared in this mutation.
+func (m *QRCodeMutation) ImportKeyCleared() bool {
+	_, ok := m.clearedFields[qrcode.FieldImportKey]
+	return ok
+}
+
+// ResetImportKey resets all changes to the "import_key" field.
+func (m *QRCodeMutation) ResetImportKey() {
+	m.import_key = nil
+	delete(m.clearedFields, qrcode.FieldImportKey)
+}
+
+// AddFileRefIDs adds the "file_refs" edge to the FileReference entity by ids.
+func (m *QRCodeMutation) AddFileRefIDs(ids ...int) {
+	if m.file_refs == nil {
+		m.file_refs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.file_refs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFileRefs clears the "file_refs" edge to the FileReference entity.
+func (m *QRCodeMutation) ClearFileRefs() {
+	m.clearedfile_refs = true
+}
+
+// FileRefsCleared reports if the "file_refs" edge to the FileReference entity was cleared.
+func (m *QRCodeMutation) FileRefsCleared() bool {
+	return m.clearedfile_refs
+}
+
+// RemoveFileRefIDs removes the "file_refs" edge to the FileReference entity by IDs.
+func (m *QRCodeMutation) RemoveFileRefIDs(ids ...int) {
+	if m.removedfile_refs == nil {
+		m.removedfile_refs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.file_refs, ids[i])
+		m.removedfile_refs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFileRefs returns the removed IDs of the "file_refs" edge to the FileReference entity.
+func (m *QRCodeMutation) RemovedFileRefsIDs() (ids []int) {
+	for id := range m.removedfile_refs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FileRefsIDs returns the "file_refs" edge IDs in the mutation.
+func (m *QRCodeMutation) FileRefsIDs() (ids []int) {
+	for id := range m.file_refs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFileRefs resets all changes to the "file_refs" edge.
+func (m *QRCodeMutation) ResetFileRefs() {
+	m.file_refs = nil
+	m.clearedfile_refs = false
+	m.removedfile_refs = nil
+}
+
+// ClearGroup clears the "group" edge to the QRCodeGroup entity.
+func (m *QRCodeMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[qrcode.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the QRCodeGroup entity was cleared.
+func (m *QRCodeMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *QRCodeMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *QRCodeMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddAnalyticsRecordIDs adds the "analytics_records" edge to the QRCodeAnalytics entity by ids.
+func (m *QRCodeMutation) AddAnalyticsRecordIDs(ids ...int) {
+	if m.analytics_records == nil {
+		m.analytics_records = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.analytics_records[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnalyticsRecords clears the "analytics_records" edge to the QRCodeAnalytics entity.
+func (m *QRCodeMutation) ClearAnalyticsRecords() {
+	m.clearedanalytics_records = true
+}
+
+// AnalyticsRecordsCleared reports if the "analytics_records" edge to the QRCodeAnalytics entity was cleared.
+func (m *QRCodeMutation) AnalyticsRecordsCleared() bool {
+	return m.clearedanalytics_records
+}
+
+// RemoveAnalyticsRecordIDs removes the "analytics_records" edge to the QRCodeAnalytics entity by IDs.
+func (m *QRCodeMutation) RemoveAnalyticsRecordIDs(ids ...int) {
+	if m.removedanalytics_records == nil {
+		m.removedanalytics_records = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.analytics_records, ids[i])
+		m.removedanalytics_records[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnalyticsRecords returns the removed IDs of the "analytics_records" edge to the QRCodeAnalytics entity.
+func (m *QRCodeMutation) RemovedAnalyticsRecordsIDs() (ids []int) {
+	for id := range m.removedanalytics_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnalyticsRecordsIDs returns the "analytics_records" edge IDs in the mutation.
+func (m *QRCodeMutation) AnalyticsRecordsIDs() (ids []int) {
+	for id := range m.analytics_records {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnalyticsRecords resets all changes to the "analytics_records" edge.
+func (m *QRCodeMutation) ResetAnalyticsRecords() {
+	m.analytics_records = nil
+	m.clearedanalytics_records = false
+	m.removedanalytics_records = nil
+}
+
+// AddDailyAnalyticIDs adds the "daily_analytics" edge to the QRCodeAnalyticsDaily entity by ids.
+func (m *QRCodeMutation) AddDailyAnalyticIDs(ids ...int) {
+	if m.daily_analytics == nil {
+		m.daily_analytics = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.daily_analytics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDailyAnalytics clears the "daily_analytics" edge to the QRCodeAnalyticsDaily entity.
+func (m *QRCodeMutation) ClearDailyAnalytics() {
+	m.cleareddaily_analytics = true
+}
+
+// DailyAnalyticsCleared reports if the "daily_analytics" edge to the QRCodeAnalyticsDaily entity was cleared.
+func (m *QRCodeMutation) DailyAnalyticsCleared() bool {
+	return m.cleareddaily_analytics
+}
+
+// RemoveDailyAnalyticIDs removes the "daily_analytics" edge to the QRCodeAnalyticsDaily entity by IDs.
+func (m *QRCodeMutation) RemoveDailyAnalyticIDs(ids ...int) {
+	if m.removeddaily_analytics == nil {
+		m.removeddaily_analytics = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.daily_analytics, ids[i])
+		m.removeddaily_analytics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDailyAnalytics returns the removed IDs of the "daily_analytics" edge to the QRCodeAnalyticsDaily entity.
+func (m *QRCodeMutation) RemovedDailyAnalyticsIDs() (ids []int) {
+	for id := range m.removeddaily_analytics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DailyAnalyticsIDs returns the "daily_analytics" edge IDs in the mutation.
+func (m *QRCodeMutation) DailyAnalyticsIDs() (ids []int) {
+	for id := range m.daily_analytics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDailyAnalytics resets all changes to the "daily_analytics" edge.
+func (m *QRCodeMutation) ResetDailyAnalytics() {
+	m.daily_analytics = nil
+	m.cleareddaily_analytics = false
+	m.removeddaily_analytics = nil
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by ids.
+func (m *QRCodeMutation) AddWebhookIDs(ids ...int) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhooks clears the "webhooks" edge to the Webhook entity.
+func (m *QRCodeMutation) ClearWebhooks() {
+	m.clearedwebhooks = true
+}
+
+// WebhooksCleared reports if the "webhooks" edge to the Webhook entity was cleared.
+func (m *QRCodeMutation) WebhooksCleared() bool {
+	return m.clearedwebhooks
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to the Webhook entity by IDs.
+func (m *QRCodeMutation) RemoveWebhookIDs(ids ...int) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.webhooks, ids[i])
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed IDs of the "webhooks" edge to the Webhook entity.
+func (m *QRCodeMutation) RemovedWebhooksIDs() (ids []int) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the "webhooks" edge IDs in the mutation.
+func (m *QRCodeMutation) WebhooksIDs() (ids []int) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks resets all changes to the "webhooks" edge.
+func (m *QRCodeMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.clearedwebhooks = false
+	m.removedwebhooks = nil
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the QRCodeRedemption entity by ids.
+func (m *QRCodeMutation) AddRedemptionIDs(ids ...int) {
+	if m.redemptions == nil {
+		m.redemptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.redemptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRedemptions clears the "redemptions" edge to the QRCodeRedemption entity.
+func (m *QRCodeMutation) ClearRedemptions() {
+	m.clearedredemptions = true
+}
+
+// RedemptionsCleared reports if the "redemptions" edge to the QRCodeRedemption entity was cleared.
+func (m *QRCodeMutation) RedemptionsCleared() bool {
+	return m.clearedredemptions
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to the QRCodeRedemption entity by IDs.
+func (m *QRCodeMutation) RemoveRedemptionIDs(ids ...int) {
+	if m.removedredemptions == nil {
+		m.removedredemptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.redemptions, ids[i])
+		m.removedredemptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRedemptions returns the removed IDs of the "redemptions" edge to the QRCodeRedemption entity.
+func (m *QRCodeMutation) RemovedRedemptionsIDs() (ids []int) {
+	for id := range m.removedredemptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RedemptionsIDs returns the "redemptions" edge IDs in the mutation.
+func (m *QRCodeMutation) RedemptionsIDs() (ids []int) {
+	for id := range m.redemptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRedemptions resets all changes to the "redemptions" edge.
+func (m *QRCodeMutation) ResetRedemptions() {
+	m.redemptions = nil
+	m.clearedredemptions = false
+	m.removedredemptions = nil
+}
+
+// Where appends a list predicates to the QRCodeMutation builder.
+func (m *QRCodeMutation) Where(ps ...predicate.QRCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QRCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QRCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QRCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QRCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QRCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QRCode).
+func (m *QRCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QRCodeMutation) Fields() []string {
+	fields := make([]string, 0, 29)
+	if m._type != nil {
+		fields = append(fields, qrcode.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, qrcode.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, qrcode.FieldDescription)
+	}
+	if m.redirect_url != nil {
+		fields = append(fields, qrcode.FieldRedirectURL)
+	}
+	if m.short_url != nil {
+		fields = append(fields, qrcode.FieldShortURL)
+	}
+	if m.content != nil {
+		fields = append(fields, qrcode.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, qrcode.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, qrcode.FieldUpdatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, qrcode.FieldExpiresAt)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, qrcode.FieldStartsAt)
+	}
+	if m.schedule != nil {
+		fields = append(fields, qrcode.FieldSchedule)
+	}
+	if m.fallback_url != nil {
+		fields = append(fields, qrcode.FieldFallbackURL)
+	}
+	if m.fallback_message != nil {
+		fields = append(fields, qrcode.FieldFallbackMessage)
+	}
+	if m.max_scans != nil {
+		fields = append(fields, qrcode.FieldMaxScans)
+	}
+	if m.scans_per_visitor != nil {
+		fields = append(fields, qrcode.FieldScansPerVisitor)
+	}
+	if m.visitor_key != nil {
+		fields = append(fields, qrcode.FieldVisitorKey)
+	}
+	if m.redeemed != nil {
+		fields = append(fields, qrcode.FieldRedeemed)
+	}
+	if m.ended_message != nil {
+		fields = append(fields, qrcode.FieldEndedMessage)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, qrcode.FieldPasswordHash)
+	}
+	if m.signing != nil {
+		fields = append(fields, qrcode.FieldSigning)
+	}
+	if m.signed_token != nil {
+		fields = append(fields, qrcode.FieldSignedToken)
+	}
+	if m.analytics != nil {
+		fields = append(fields, qrcode.FieldAnalytics)
+	}
+	if m.analytics_counts_only != nil {
+		fields = append(fields, qrcode.FieldAnalyticsCountsOnly)
+	}
+	if m.active != nil {
+		fields = append(fields, qrcode.FieldActive)
+	}
+	if m.tags != nil {
+		fields = append(fields, qrcode.FieldTags)
+	}
+	if m.design != nil {
+		fields = append(fields, qrcode.FieldDesign)
+	}
+	if m.group != nil {
+		fields = append(fields, qrcode.FieldGroupID)
+	}
+	if m.gs1_key != nil {
+		fields = append(fields, qrcode.FieldGs1Key)
+	}
+	if m.import_key != nil {
+		fields = append(fields, qrcode.FieldImportKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QRCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case qrcode.FieldType:
+		return m.GetType()
+	case qrcode.FieldTitle:
+		return m.Title()
+	case qrcode.FieldDescription:
+		return m.Description()
+	case qrcode.FieldRedirectURL:
+		return m.RedirectURL()
+	case qrcode.FieldShortURL:
+		return m.ShortURL()
+	case qrcode.FieldContent:
+		return m.Content()
+	case qrcode.FieldCreatedAt:
+		return m.CreatedAt()
+	case qrcode.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case qrcode.FieldExpiresAt:
+		return m.ExpiresAt()
+	case qrcode.FieldStartsAt:
+		return m.StartsAt()
+	case qrcode.FieldSchedule:
+		return m.Schedule()
+	case qrcode.FieldFallbackURL:
+		return m.FallbackURL()
+	case qrcode.FieldFallbackMessage:
+		return m.FallbackMessage()
+	case qrcode.FieldMaxScans:
+		return m.MaxScans()
+	case qrcode.FieldScansPerVisitor:
+		return m.ScansPerVisitor()
+	case qrcode.FieldVisitorKey:
+		return m.VisitorKey()
+	case qrcode.FieldRedeemed:
+		return m.Redeemed()
+	case qrcode.FieldEndedMessage:
+		return m.EndedMessage()
+	case qrcode.FieldPasswordHash:
+		return m.PasswordHash()
+	case qrcode.FieldSigning:
+		return m.Signing()
+	case qrcode.FieldSignedToken:
+		return m.SignedToken()
+	case qrcode.FieldAnalytics:
+		return m.Analytics()
+	case qrcode.FieldAnalyticsCountsOnly:
+		return m.AnalyticsCountsOnly()
+	case qrcode.FieldActive:
+		return m.Active()
+	case qrcode.FieldTags:
+		return m.Tags()
+	case qrcode.FieldDesign:
+		return m.Design()
+	case qrcode.FieldGroupID:
+		return m.GroupID()
+	case qrcode.FieldGs1Key:
+		return m.Gs1Key()
+	case qrcode.FieldImportKey:
+		return m.ImportKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QRCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case qrcode.FieldType:
+		return m.OldType(ctx)
+	case qrcode.FieldTitle:
+		return m.OldTitle(ctx)
+	case qrcode.FieldDescription:
+		return m.OldDescription(ctx)
+	case qrcode.FieldRedirectURL:
+		return m.OldRedirectURL(ctx)
+	case qrcode.FieldShortURL:
+		return m.OldShortURL(ctx)
+	case qrcode.FieldContent:
+		return m.OldContent(ctx)
+	case qrcode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case qrcode.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case qrcode.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case qrcode.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case qrcode.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case qrcode.FieldFallbackURL:
+		return m.OldFallbackURL(ctx)
+	case qrcode.FieldFallbackMessage:
+		return m.OldFallbackMessage(ctx)
+	case qrcode.FieldMaxScans:
+		return m.OldMaxScans(ctx)
+	case qrcode.FieldScansPerVisitor:
+		return m.OldScansPerVisitor(ctx)
+	case qrcode.FieldVisitorKey:
+		return m.OldVisitorKey(ctx)
+	case qrcode.FieldRedeemed:
+		return m.OldRedeemed(ctx)
+	case qrcode.FieldEndedMessage:
+		return m.OldEndedMessage(ctx)
+	case qrcode.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case qrcode.FieldSigning:
+		return m.OldSigning(ctx)
+	case qrcode.FieldSignedToken:
+		return m.OldSignedToken(ctx)
+	case qrcode.FieldAnalytics:
+		return m.OldAnalytics(ctx)
+	case qrcode.FieldAnalyticsCountsOnly:
+		return m.OldAnalyticsCountsOnly(ctx)
+	case qrcode.FieldActive:
+		return m.OldActive(ctx)
+	case qrcode.FieldTags:
+		return m.OldTags(ctx)
+	case qrcode.FieldDesign:
+		return m.OldDesign(ctx)
+	case qrcode.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case qrcode.FieldGs1Key:
+		return m.OldGs1Key(ctx)
+	case qrcode.FieldImportKey:
+		return m.OldImportKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown QRCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case qrcode.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case qrcode.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case qrcode.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case qrcode.FieldRedirectURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectURL(v)
+		return nil
+	case qrcode.FieldShortURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortURL(v)
+		return nil
+	case qrcode.FieldContent:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case qrcode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case qrcode.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case qrcode.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case qrcode.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case qrcode.FieldSchedule:
+		v, ok := value.(*schedule.Schedule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case qrcode.FieldFallbackURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackURL(v)
+		return nil
+	case qrcode.FieldFallbackMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackMessage(v)
+		return nil
+	case qrcode.FieldMaxScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxScans(v)
+		return nil
+	case qrcode.FieldScansPerVisitor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScansPerVisitor(v)
+		return nil
+	case qrcode.FieldVisitorKey:
+		v, ok := value.(qrcode.VisitorKey)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisitorKey(v)
+		return nil
+	case qrcode.FieldRedeemed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedeemed(v)
+		return nil
+	case qrcode.FieldEndedMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedMessage(v)
+		return nil
+	case qrcode.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case qrcode.FieldSigning:
+		v, ok := value.(qrcode.Signing)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigning(v)
+		return nil
+	case qrcode.FieldSignedToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignedToken(v)
+		return nil
+	case qrcode.FieldAnalytics:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnalytics(v)
+		return nil
+	case qrcode.FieldAnalyticsCountsOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnalyticsCountsOnly(v)
+		return nil
+	case qrcode.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case qrcode.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case qrcode.FieldDesign:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesign(v)
+		return nil
+	case qrcode.FieldGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case qrcode.FieldGs1Key:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGs1Key(v)
+		return nil
+	case qrcode.FieldImportKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QRCodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_scans != nil {
+		fields = append(fields, qrcode.FieldMaxScans)
+	}
+	if m.addscans_per_visitor != nil {
+		fields = append(fields, qrcode.FieldScansPerVisitor)
+	}
+	if m.addredeemed != nil {
+		fields = append(fields, qrcode.FieldRedeemed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QRCodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case qrcode.FieldMaxScans:
+		return m.AddedMaxScans()
+	case qrcode.FieldScansPerVisitor:
+		return m.AddedScansPerVisitor()
+	case qrcode.FieldRedeemed:
+		return m.AddedRedeemed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case qrcode.FieldMaxScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxScans(v)
+		return nil
+	case qrcode.FieldScansPerVisitor:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScansPerVisitor(v)
+		return nil
+	case qrcode.FieldRedeemed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRedeemed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QRCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(qrcode.FieldDescription) {
+		fields = append(fields, qrcode.FieldDescription)
+	}
+	if m.FieldCleared(qrcode.FieldRedirectURL) {
+		fields = append(fields, qrcode.FieldRedirectURL)
+	}
+	if m.FieldCleared(qrcode.FieldShortURL) {
+		fields = append(fields, qrcode.FieldShortURL)
+	}
+	if m.FieldCleared(qrcode.FieldExpiresAt) {
+		fields = append(fields, qrcode.FieldExpiresAt)
+	}
+	if m.FieldCleared(qrcode.FieldStartsAt) {
+		fields = append(fields, qrcode.FieldStartsAt)
+	}
+	if m.FieldCleared(qrcode.FieldSchedule) {
+		fields = append(fields, qrcode.FieldSchedule)
+	}
+	if m.FieldCleared(qrcode.FieldFallbackURL) {
+		fields = append(fields, qrcode.FieldFallbackURL)
+	}
+	if m.FieldCleared(qrcode.FieldFallbackMessage) {
+		fields = append(fields, qrcode.FieldFallbackMessage)
+	}
+	if m.FieldCleared(qrcode.FieldMaxScans) {
+		fields = append(fields, qrcode.FieldMaxScans)
+	}
+	if m.FieldCleared(qrcode.FieldScansPerVisitor) {
+		fields = append(fields, qrcode.FieldScansPerVisitor)
+	}
+	if m.FieldCleared(qrcode.FieldEndedMessage) {
+		fields = append(fields, qrcode.FieldEndedMessage)
+	}
+	if m.FieldCleared(qrcode.FieldPasswordHash) {
+		fields = append(fields, qrcode.FieldPasswordHash)
+	}
+	if m.FieldCleared(qrcode.FieldSigning) {
+		fields = append(fields, qrcode.FieldSigning)
+	}
+	if m.FieldCleared(qrcode.FieldSignedToken) {
+		fields = append(fields, qrcode.FieldSignedToken)
+	}
+	if m.FieldCleared(qrcode.FieldTags) {
+		fields = append(fields, qrcode.FieldTags)
+	}
+	if m.FieldCleared(qrcode.FieldDesign) {
+		fields = append(fields, qrcode.FieldDesign)
+	}
+	if m.FieldCleared(qrcode.FieldGroupID) {
+		fields = append(fields, qrcode.FieldGroupID)
+	}
+	if m.FieldCleared(qrcode.FieldGs1Key) {
+		fields = append(fields, qrcode.FieldGs1Key)
+	}
+	if m.FieldCleared(qrcode.FieldImportKey) {
+		fields = append(fields, qrcode.FieldImportKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QRCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QRCodeMutation) ClearField(name string) error {
+	switch name {
+	case qrcode.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case qrcode.FieldRedirectURL:
+		m.ClearRedirectURL()
+		return nil
+	case qrcode.FieldShortURL:
+		m.ClearShortURL()
+		return nil
+	case qrcode.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case qrcode.FieldStartsAt:
+		m.ClearStartsAt()
+		return nil
+	case qrcode.FieldSchedule:
+		m.ClearSchedule()
+		return nil
+	case qrcode.FieldFallbackURL:
+		m.ClearFallbackURL()
+		return nil
+	case qrcode.FieldFallbackMessage:
+		m.ClearFallbackMessage()
+		return nil
+	case qrcode.FieldMaxScans:
+		m.ClearMaxScans()
+		return nil
+	case qrcode.FieldScansPerVisitor:
+		m.ClearScansPerVisitor()
+		return nil
+	case qrcode.FieldEndedMessage:
+		m.ClearEndedMessage()
+		return nil
+	case qrcode.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case qrcode.FieldSigning:
+		m.ClearSigning()
+		return nil
+	case qrcode.FieldSignedToken:
+		m.ClearSignedToken()
+		return nil
+	case qrcode.FieldTags:
+		m.ClearTags()
+		return nil
+	case qrcode.FieldDesign:
+		m.ClearDesign()
+		return nil
+	case qrcode.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	case qrcode.FieldGs1Key:
+		m.ClearGs1Key()
+		return nil
+	case qrcode.FieldImportKey:
+		m.ClearImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QRCodeMutation) ResetField(name string) error {
+	switch name {
+	case qrcode.FieldType:
+		m.ResetType()
+		return nil
+	case qrcode.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case qrcode.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case qrcode.FieldRedirectURL:
+		m.ResetRedirectURL()
+		return nil
+	case qrcode.FieldShortURL:
+		m.ResetShortURL()
+		return nil
+	case qrcode.FieldContent:
+		m.ResetContent()
+		return nil
+	case qrcode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case qrcode.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case qrcode.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case qrcode.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case qrcode.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case qrcode.FieldFallbackURL:
+		m.ResetFallbackURL()
+		return nil
+	case qrcode.FieldFallbackMessage:
+		m.ResetFallbackMessage()
+		return nil
+	case qrcode.FieldMaxScans:
+		m.ResetMaxScans()
+		return nil
+	case qrcode.FieldScansPerVisitor:
+		m.ResetScansPerVisitor()
+		return nil
+	case qrcode.FieldVisitorKey:
+		m.ResetVisitorKey()
+		return nil
+	case qrcode.FieldRedeemed:
+		m.ResetRedeemed()
+		return nil
+	case qrcode.FieldEndedMessage:
+		m.ResetEndedMessage()
+		return nil
+	case qrcode.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case qrcode.FieldSigning:
+		m.ResetSigning()
+		return nil
+	case qrcode.FieldSignedToken:
+		m.ResetSignedToken()
+		return nil
+	case qrcode.FieldAnalytics:
+		m.ResetAnalytics()
+		return nil
+	case qrcode.FieldAnalyticsCountsOnly:
+		m.ResetAnalyticsCountsOnly()
+		return nil
+	case qrcode.FieldActive:
+		m.ResetActive()
+		return nil
+	case qrcode.FieldTags:
+		m.ResetTags()
+		return nil
+	case qrcode.FieldDesign:
+		m.ResetDesign()
+		return nil
+	case qrcode.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case qrcode.FieldGs1Key:
+		m.ResetGs1Key()
+		return nil
+	case qrcode.FieldImportKey:
+		m.ResetImportKey()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QRCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.file_refs != nil {
+		edges = append(edges, qrcode.EdgeFileRefs)
+	}
+	if m.group != nil {
+		edges = append(edges, qrcode.EdgeGroup)
+	}
+	if m.analytics_records != nil {
+		edges = append(edges, qrcode.EdgeAnalyticsRecords)
+	}
+	if m.daily_analytics != nil {
+		edges = append(edges, qrcode.EdgeDailyAnalytics)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, qrcode.EdgeWebhooks)
+	}
+	if m.redemptions != nil {
+		edges = append(edges, qrcode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QRCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case qrcode.EdgeFileRefs:
+		ids := make([]ent.Value, 0, len(m.file_refs))
+		for id := range m.file_refs {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case qrcode.EdgeAnalyticsRecords:
+		ids := make([]ent.Value, 0, len(m.analytics_records))
+		for id := range m.analytics_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeDailyAnalytics:
+		ids := make([]ent.Value, 0, len(m.daily_analytics))
+		for id := range m.daily_analytics {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeRedemptions:
+		ids := make([]ent.Value, 0, len(m.redemptions))
+		for id := range m.redemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QRCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedfile_refs != nil {
+		edges = append(edges, qrcode.EdgeFileRefs)
+	}
+	if m.removedanalytics_records != nil {
+		edges = append(edges, qrcode.EdgeAnalyticsRecords)
+	}
+	if m.removeddaily_analytics != nil {
+		edges = append(edges, qrcode.EdgeDailyAnalytics)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, qrcode.EdgeWebhooks)
+	}
+	if m.removedredemptions != nil {
+		edges = append(edges, qrcode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QRCodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case qrcode.EdgeFileRefs:
+		ids := make([]ent.Value, 0, len(m.removedfile_refs))
+		for id := range m.removedfile_refs {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeAnalyticsRecords:
+		ids := make([]ent.Value, 0, len(m.removedanalytics_records))
+		for id := range m.removedanalytics_records {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeDailyAnalytics:
+		ids := make([]ent.Value, 0, len(m.removeddaily_analytics))
+		for id := range m.removeddaily_analytics {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case qrcode.EdgeRedemptions:
+		ids := make([]ent.Value, 0, len(m.removedredemptions))
+		for id := range m.removedredemptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QRCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedfile_refs {
+		edges = append(edges, qrcode.EdgeFileRefs)
+	}
+	if m.clearedgroup {
+		edges = append(edges, qrcode.EdgeGroup)
+	}
+	if m.clearedanalytics_records {
+		edges = append(edges, qrcode.EdgeAnalyticsRecords)
+	}
+	if m.cleareddaily_analytics {
+		edges = append(edges, qrcode.EdgeDailyAnalytics)
+	}
+	if m.clearedwebhooks {
+		edges = append(edges, qrcode.EdgeWebhooks)
+	}
+	if m.clearedredemptions {
+		edges = append(edges, qrcode.EdgeRedemptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QRCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case qrcode.EdgeFileRefs:
+		return m.clearedfile_refs
+	case qrcode.EdgeGroup:
+		return m.clearedgroup
+	case qrcode.EdgeAnalyticsRecords:
+		return m.clearedanalytics_records
+	case qrcode.EdgeDailyAnalytics:
+		return m.cleareddaily_analytics
+	case qrcode.EdgeWebhooks:
+		return m.clearedwebhooks
+	case qrcode.EdgeRedemptions:
+		return m.clearedredemptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QRCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case qrcode.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QRCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case qrcode.EdgeFileRefs:
+		m.ResetFileRefs()
+		return nil
+	case qrcode.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case qrcode.EdgeAnalyticsRecords:
+		m.ResetAnalyticsRecords()
+		return nil
+	case qrcode.EdgeDailyAnalytics:
+		m.ResetDailyAnalytics()
+		return nil
+	case qrcode.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	case qrcode.EdgeRedemptions:
+		m.ResetRedemptions()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCode edge %s", name)
+}
+
+// QRCodeAnalyticsMutation represents an operation that mutates the QRCodeAnalytics nodes in the graph.
+type QRCodeAnalyticsMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	ip_address     *string
+	user_agent     *string
+	location       *string
+	device         *string
+	scanned_at     *time.Time
+	rolled_up      *bool
+	clearedFields  map[string]struct{}
+	qr_code        *int
+	clearedqr_code bool
+	done           bool
+	oldValue       func(context.Context) (*QRCodeAnalytics, error)
+	predicates     []predicate.QRCodeAnalytics
+}
+
+var _ ent.Mutation = (*QRCodeAnalyticsMutation)(nil)
+
+// qrcodeanalyticsOption allows management of the mutation configuration using functional options.
+type qrcodeanalyticsOption func(*QRCodeAnalyticsMutation)
+
+// newQRCodeAnalyticsMutation creates new mutation for the QRCodeAnalytics entity.
+func newQRCodeAnalyticsMutation(c config, op Op, opts ...qrcodeanalyticsOption) *QRCodeAnalyticsMutation {
+	m := &QRCodeAnalyticsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQRCodeAnalytics,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQRCodeAnalyticsID sets the ID field of the mutation.
+func withQRCodeAnalyticsID(id int) qrcodeanalyticsOption {
+	return func(m *QRCodeAnalyticsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QRCodeAnalytics
+		)
+		m.oldValue = func(ctx context.Context) (*QRCodeAnalytics, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QRCodeAnalytics.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQRCodeAnalytics sets the old QRCodeAnalytics of the mutation.
+func withQRCodeAnalytics(node *QRCodeAnalytics) qrcodeanalyticsOption {
+	return func(m *QRCodeAnalyticsMutation) {
+		m.oldValue = func(context.Context) (*QRCodeAnalytics, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QRCodeAnalyticsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QRCodeAnalyticsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QRCodeAnalyticsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QRCodeAnalyticsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QRCodeAnalytics.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *QRCodeAnalyticsMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *QRCodeAnalyticsMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *QRCodeAnalyticsMutation) ResetIPAddress() {
+	m.ip_address = nil
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *QRCodeAnalyticsMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *QRCodeAnalyticsMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *QRCodeAnalyticsMutation) ResetUserAgent() {
+	m.user_agent = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *QRCodeAnalyticsMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *QRCodeAnalyticsMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *QRCodeAnalyticsMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[qrcodeanalytics.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *QRCodeAnalyticsMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[qrcodeanalytics.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *QRCodeAnalyticsMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, qrcodeanalytics.FieldLocation)
+}
+
+// SetDevice sets the "device" field.
+func (m *QRCodeAnalyticsMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *QRCodeAnalyticsMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldDevice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *QRCodeAnalyticsMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[qrcodeanalytics.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *QRCodeAnalyticsMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[qrcodeanalytics.FieldDevice]
+	return ok
+}
+
+// ResetDevice resets all changes to the "device" field.
+func (m *QRCodeAnalyticsMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, qrcodeanalytics.FieldDevice)
+}
+
+// SetScannedAt sets the "scanned_at" field.
+func (m *QRCodeAnalyticsMutation) SetScannedAt(t time.Time) {
+	m.scanned_at = &t
+}
+
+// ScannedAt returns the value of the "scanned_at" field in the mutation.
+func (m *QRCodeAnalyticsMutation) ScannedAt() (r time.Time, exists bool) {
+	v := m.scanned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScannedAt returns the old "scanned_at" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldScannedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScannedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
+	}
+	return oldValue.ScannedAt, nil
+}
+
+// ResetScannedAt resets all changes to the "scanned_at" field.
+func (m *QRCodeAnalyticsMutation) ResetScannedAt() {
+	m.scanned_at = nil
+}
+
+// SetRolledUp sets the "rolled_up" field.
+func (m *QRCodeAnalyticsMutation) SetRolledUp(b bool) {
+	m.rolled_up = &b
+}
+
+// RolledUp returns the value of the "rolled_up" field in the mutation.
+func (m *QRCodeAnalyticsMutation) RolledUp() (r bool, exists bool) {
+	v := m.rolled_up
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolledUp returns the old "rolled_up" field's value of the QRCodeAnalytics entity.
+// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsMutation) OldRolledUp(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolledUp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolledUp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolledUp: %w", err)
+	}
+	return oldValue.RolledUp, nil
+}
+
+// ResetRolledUp resets all changes to the "rolled_up" field.
+func (m *QRCodeAnalyticsMutation) ResetRolledUp() {
+	m.rolled_up = nil
+}
+
+// SetQrCodeID sets the "qr_code" edge to the QRCode entity by id.
+func (m *QRCodeAnalyticsMutation) SetQrCodeID(id int) {
+	m.qr_code = &id
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (m *QRCodeAnalyticsMutation) ClearQrCode() {
+	m.clearedqr_code = true
+}
+
+// QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
+func (m *QRCodeAnalyticsMutation) QrCodeCleared() bool {
+	return m.clearedqr_code
+}
+
+// QrCodeID returns the "qr_code" edge ID in the mutation.
+func (m *QRCodeAnalyticsMutation) QrCodeID() (id int, exists bool) {
+	if m.qr_code != nil {
+		return *m.qr_code, true
+	}
+	return
+}
+
+// QrCodeIDs returns the "qr_code" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QrCodeID instead. It exists only for internal usage by the builders.
+func (m *QRCodeAnalyticsMutation) QrCodeIDs() (ids []int) {
+	if id := m.qr_code; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQrCode resets all changes to the "qr_code" edge.
+func (m *QRCodeAnalyticsMutation) ResetQrCode() {
+	m.qr_code = nil
+	m.clearedqr_code = false
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsMutation builder.
+func (m *QRCodeAnalyticsMutation) Where(ps ...predicate.QRCodeAnalytics) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QRCodeAnalyticsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QRCodeAnalyticsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QRCodeAnalytics, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QRCodeAnalyticsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QRCodeAnalyticsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QRCodeAnalytics).
+func (m *QRCodeAnalyticsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QRCodeAnalyticsMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.ip_address != nil {
+		fields = append(fields, qrcodeanalytics.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, qrcodeanalytics.FieldUserAgent)
+	}
+	if m.location != nil {
+		fields = append(fields, qrcodeanalytics.FieldLocation)
+	}
+	if m.device != nil {
+		fields = append(fields, qrcodeanalytics.FieldDevice)
+	}
+	if m.scanned_at != nil {
+		fields = append(fields, qrcodeanalytics.FieldScannedAt)
+	}
+	if m.rolled_up != nil {
+		fields = append(fields, qrcodeanalytics.FieldRolledUp)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QRCodeAnalyticsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case qrcodeanalytics.FieldIPAddress:
+		return m.IPAddress()
+	case qrcodeanalytics.FieldUserAgent:
+		return m.UserAgent()
+	case qrcodeanalytics.FieldLocation:
+		return m.Location()
+	case qrcodeanalytics.FieldDevice:
+		return m.Device()
+	case qrcodeanalytics.FieldScannedAt:
+		return m.ScannedAt()
+	case qrcodeanalytics.FieldRolledUp:
+		return m.RolledUp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QRCodeAnalyticsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case qrcodeanalytics.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case qrcodeanalytics.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case qrcodeanalytics.FieldLocation:
+		return m.OldLocation(ctx)
+	case qrcodeanalytics.FieldDevice:
+		return m.OldDevice(ctx)
+	case qrcodeanalytics.FieldScannedAt:
+		return m.OldScannedAt(ctx)
+	case qrcodeanalytics.FieldRolledUp:
+		return m.OldRolledUp(ctx)
+	}
+	return nil, fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeAnalyticsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case qrcodeanalytics.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case qrcodeanalytics.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case qrcodeanalytics.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case qrcodeanalytics.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case qrcodeanalytics.FieldScannedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScannedAt(v)
+		return nil
+	case qrcodeanalytics.FieldRolledUp:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolledUp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QRCodeAnalyticsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QRCodeAnalyticsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeAnalyticsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QRCodeAnalyticsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(qrcodeanalytics.FieldLocation) {
+		fields = append(fields, qrcodeanalytics.FieldLocation)
+	}
+	if m.FieldCleared(qrcodeanalytics.FieldDevice) {
+		fields = append(fields, qrcodeanalytics.FieldDevice)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QRCodeAnalyticsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QRCodeAnalyticsMutation) ClearField(name string) error {
+	switch name {
+	case qrcodeanalytics.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case qrcodeanalytics.FieldDevice:
+		m.ClearDevice()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QRCodeAnalyticsMutation) ResetField(name string) error {
+	switch name {
+	case qrcodeanalytics.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case qrcodeanalytics.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case qrcodeanalytics.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case qrcodeanalytics.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case qrcodeanalytics.FieldScannedAt:
+		m.ResetScannedAt()
+		return nil
+	case qrcodeanalytics.FieldRolledUp:
+		m.ResetRolledUp()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QRCodeAnalyticsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.qr_code != nil {
+		edges = append(edges, qrcodeanalytics.EdgeQrCode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QRCodeAnalyticsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case qrcodeanalytics.EdgeQrCode:
+		if id := m.qr_code; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QRCodeAnalyticsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QRCodeAnalyticsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QRCodeAnalyticsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedqr_code {
+		edges = append(edges, qrcodeanalytics.EdgeQrCode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QRCodeAnalyticsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case qrcodeanalytics.EdgeQrCode:
+		return m.clearedqr_code
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QRCodeAnalyticsMutation) ClearEdge(name string) error {
+	switch name {
+	case qrcodeanalytics.EdgeQrCode:
+		m.ClearQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QRCodeAnalyticsMutation) ResetEdge(name string) error {
+	switch name {
+	case qrcodeanalytics.EdgeQrCode:
+		m.ResetQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalytics edge %s", name)
+}
+
+// QRCodeAnalyticsDailyMutation represents an operation that mutates the QRCodeAnalyticsDaily nodes in the graph.
+type QRCodeAnalyticsDailyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	day                *time.Time
+	scans              *int
+	addscans           *int
+	unique_visitors    *int
+	addunique_visitors *int
+	devices            *map[string]int
+	clearedFields      map[string]struct{}
+	qr_code            *int
+	clearedqr_code     bool
+	done               bool
+	oldValue           func(context.Context) (*QRCodeAnalyticsDaily, error)
+	predicates         []predicate.QRCodeAnalyticsDaily
+}
+
+var _ ent.Mutation = (*QRCodeAnalyticsDailyMutation)(nil)
+
+// qrcodeanalyticsdailyOption allows management of the mutation configuration using functional options.
+type qrcodeanalyticsdailyOption func(*QRCodeAnalyticsDailyMutation)
+
+// newQRCodeAnalyticsDailyMutation creates new mutation for the QRCodeAnalyticsDaily entity.
+func newQRCodeAnalyticsDailyMutation(c config, op Op, opts ...qrcodeanalyticsdailyOption) *QRCodeAnalyticsDailyMutation {
+	m := &QRCodeAnalyticsDailyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQRCodeAnalyticsDaily,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQRCodeAnalyticsDailyID sets the ID field of the mutation.
+func withQRCodeAnalyticsDailyID(id int) qrcodeanalyticsdailyOption {
+	return func(m *QRCodeAnalyticsDailyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QRCodeAnalyticsDaily
+		)
+		m.oldValue = func(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QRCodeAnalyticsDaily.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQRCodeAnalyticsDaily sets the old QRCodeAnalyticsDaily of the mutation.
+func withQRCodeAnalyticsDaily(node *QRCodeAnalyticsDaily) qrcodeanalyticsdailyOption {
+	return func(m *QRCodeAnalyticsDailyMutation) {
+		m.oldValue = func(context.Context) (*QRCodeAnalyticsDaily, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QRCodeAnalyticsDailyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QRCodeAnalyticsDailyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QRCodeAnalyticsDailyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QRCodeAnalyticsDailyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QRCodeAnalyticsDaily.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (m *QRCodeAnalyticsDailyMutation) SetQrCodeID(i int) {
+	m.qr_code = &i
+}
+
+// QrCodeID returns the value of the "qr_code_id" field in the mutation.
+func (m *QRCodeAnalyticsDailyMutation) QrCodeID() (r int, exists bool) {
+	v := m.qr_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQrCodeID returns the old "qr_code_id" field's value of the QRCodeAnalyticsDaily entity.
+// If the QRCodeAnalyticsDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsDailyMutation) OldQrCodeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQrCodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQrCodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQrCodeID: %w", err)
+	}
+	return oldValue.QrCodeID, nil
+}
+
+// ResetQrCodeID resets all changes to the "qr_code_id" field.
+func (m *QRCodeAnalyticsDailyMutation) ResetQrCodeID() {
+	m.qr_code = nil
+}
+
+// SetDay sets the "day" field.
+func (m *QRCodeAnalyticsDailyMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *QRCodeAnalyticsDailyMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the QRCodeAnalyticsDaily entity.
+// If the QRCodeAnalyticsDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsDailyMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *QRCodeAnalyticsDailyMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetScans sets the "scans" field.
+func (m *QRCodeAnalyticsDailyMutation) SetScans(i int) {
+	m.scans = &i
+	m.addscans = nil
+}
+
+// Scans returns the value of the "scans" field in the mutation.
+func (m *QRCodeAnalyticsDailyMutation) Scans() (r int, exists bool) {
+	v := m.scans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScans returns the old "scans" field's value of the QRCodeAnalyticsDaily entity.
+// If the QRCodeAnalyticsDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsDailyMutation) OldScans(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScans is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScans requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScans: %w", err)
+	}
+	return oldValue.Scans, nil
+}
+
+// AddScans adds i to the "scans" field.
+func (m *QRCodeAnalyticsDailyMutation) AddScans(i int) {
+	if m.addscans != nil {
+		*m.addscans += i
+	} else {
+		m.addscans = &i
+	}
+}
+
+// AddedScans returns the value that was added to the "scans" field in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) AddedScans() (r int, exists bool) {
+	v := m.addscans
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScans resets all changes to the "scans" field.
+func (m *QRCodeAnalyticsDailyMutation) ResetScans() {
+	m.scans = nil
+	m.addscans = nil
+}
+
+// SetUniqueVisitors sets the "unique_visitors" field.
+func (m *QRCodeAnalyticsDailyMutation) SetUniqueVisitors(i int) {
+	m.unique_visitors = &i
+	m.addunique_visitors = nil
+}
+
+// UniqueVisitors returns the value of the "unique_visitors" field in the mutation.
+func (m *QRCodeAnalyticsDailyMutation) UniqueVisitors() (r int, exists bool) {
+	v := m.unique_visitors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUniqueVisitors returns the old "unique_visitors" field's value of the QRCodeAnalyticsDaily entity.
+// If the QRCodeAnalyticsDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsDailyMutation) OldUniqueVisitors(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUniqueVisitors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUniqueVisitors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUniqueVisitors: %w", err)
+	}
+	return oldValue.UniqueVisitors, nil
+}
+
+// AddUniqueVisitors adds i to the "unique_visitors" field.
+func (m *QRCodeAnalyticsDailyMutation) AddUniqueVisitors(i int) {
+	if m.addunique_visitors != nil {
+		*m.addunique_visitors += i
+	} else {
+		m.addunique_visitors = &i
+	}
+}
+
+// AddedUniqueVisitors returns the value that was added to the "unique_visitors" field in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) AddedUniqueVisitors() (r int, exists bool) {
+	v := m.addunique_visitors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUniqueVisitors resets all changes to the "unique_visitors" field.
+func (m *QRCodeAnalyticsDailyMutation) ResetUniqueVisitors() {
+	m.unique_visitors = nil
+	m.addunique_visitors = nil
+}
+
+// SetDevices sets the "devices" field.
+func (m *QRCodeAnalyticsDailyMutation) SetDevices(value map[string]int) {
+	m.devices = &value
+}
+
+// Devices returns the value of the "devices" field in the mutation.
+func (m *QRCodeAnalyticsDailyMutation) Devices() (r map[string]int, exists bool) {
+	v := m.devices
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevices returns the old "devices" field's value of the QRCodeAnalyticsDaily entity.
+// If the QRCodeAnalyticsDaily object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeAnalyticsDailyMutation) OldDevices(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevices is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevices requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevices: %w", err)
+	}
+	return oldValue.Devices, nil
+}
+
+// ClearDevices clears the value of the "devices" field.
+func (m *QRCodeAnalyticsDailyMutation) ClearDevices() {
+	m.devices = nil
+	m.clearedFields[qrcodeanalyticsdaily.FieldDevices] = struct{}{}
+}
+
+// DevicesCleared returns if the "devices" field was cleared in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) DevicesCleared() bool {
+	_, ok := m.clearedFields[qrcodeanalyticsdaily.FieldDevices]
+	return ok
+}
+
+// ResetDevices resets all changes to the "devices" field.
+func (m *QRCodeAnalyticsDailyMutation) ResetDevices() {
+	m.devices = nil
+	delete(m.clearedFields, qrcodeanalyticsdaily.FieldDevices)
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (m *QRCodeAnalyticsDailyMutation) ClearQrCode() {
+	m.clearedqr_code = true
+	m.clearedFields[qrcodeanalyticsdaily.FieldQrCodeID] = struct{}{}
+}
+
+// QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
+func (m *QRCodeAnalyticsDailyMutation) QrCodeCleared() bool {
+	return m.clearedqr_code
+}
+
+// QrCodeIDs returns the "qr_code" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QrCodeID instead. It exists only for internal usage by the builders.
+func (m *QRCodeAnalyticsDailyMutation) QrCodeIDs() (ids []int) {
+	if id := m.qr_code; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQrCode resets all changes to the "qr_code" edge.
+func (m *QRCodeAnalyticsDailyMutation) ResetQrCode() {
+	m.qr_code = nil
+	m.clearedqr_code = false
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsDailyMutation builder.
+func (m *QRCodeAnalyticsDailyMutation) Where(ps ...predicate.QRCodeAnalyticsDaily) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QRCodeAnalyticsDailyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QRCodeAnalyticsDailyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QRCodeAnalyticsDaily, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QRCodeAnalyticsDailyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QRCodeAnalyticsDailyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QRCodeAnalyticsDaily).
+func (m *QRCodeAnalyticsDailyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QRCodeAnalyticsDailyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.qr_code != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldQrCodeID)
+	}
+	if m.day != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldDay)
+	}
+	if m.scans != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldScans)
+	}
+	if m.unique_visitors != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldUniqueVisitors)
+	}
+	if m.devices != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldDevices)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QRCodeAnalyticsDailyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case qrcodeanalyticsdaily.FieldQrCodeID:
+		return m.QrCodeID()
+	case qrcodeanalyticsdaily.FieldDay:
+		return m.Day()
+	case qrcodeanalyticsdaily.FieldScans:
+		return m.Scans()
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		return m.UniqueVisitors()
+	case qrcodeanalyticsdaily.FieldDevices:
+		return m.Devices()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QRCodeAnalyticsDailyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case qrcodeanalyticsdaily.FieldQrCodeID:
+		return m.OldQrCodeID(ctx)
+	case qrcodeanalyticsdaily.FieldDay:
+		return m.OldDay(ctx)
+	case qrcodeanalyticsdaily.FieldScans:
+		return m.OldScans(ctx)
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		return m.OldUniqueVisitors(ctx)
+	case qrcodeanalyticsdaily.FieldDevices:
+		return m.OldDevices(ctx)
+	}
+	return nil, fmt.Errorf("unknown QRCodeAnalyticsDaily field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeAnalyticsDailyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case qrcodeanalyticsdaily.FieldQrCodeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQrCodeID(v)
+		return nil
+	case qrcodeanalyticsdaily.FieldDay:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case qrcodeanalyticsdaily.FieldScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScans(v)
+		return nil
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUniqueVisitors(v)
+		return nil
+	case qrcodeanalyticsdaily.FieldDevices:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevices(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QRCodeAnalyticsDailyMutation) AddedFields() []string {
+	var fields []string
+	if m.addscans != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldScans)
+	}
+	if m.addunique_visitors != nil {
+		fields = append(fields, qrcodeanalyticsdaily.FieldUniqueVisitors)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QRCodeAnalyticsDailyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case qrcodeanalyticsdaily.FieldScans:
+		return m.AddedScans()
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		return m.AddedUniqueVisitors()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeAnalyticsDailyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case qrcodeanalyticsdaily.FieldScans:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScans(v)
+		return nil
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUniqueVisitors(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QRCodeAnalyticsDailyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(qrcodeanalyticsdaily.FieldDevices) {
+		fields = append(fields, qrcodeanalyticsdaily.FieldDevices)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QRCodeAnalyticsDailyMutation) ClearField(name string) error {
+	switch name {
+	case qrcodeanalyticsdaily.FieldDevices:
+		m.ClearDevices()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QRCodeAnalyticsDailyMutation) ResetField(name string) error {
+	switch name {
+	case qrcodeanalyticsdaily.FieldQrCodeID:
+		m.ResetQrCodeID()
+		return nil
+	case qrcodeanalyticsdaily.FieldDay:
+		m.ResetDay()
+		return nil
+	case qrcodeanalyticsdaily.FieldScans:
+		m.ResetScans()
+		return nil
+	case qrcodeanalyticsdaily.FieldUniqueVisitors:
+		m.ResetUniqueVisitors()
+		return nil
+	case qrcodeanalyticsdaily.FieldDevices:
+		m.ResetDevices()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.qr_code != nil {
+		edges = append(edges, qrcodeanalyticsdaily.EdgeQrCode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case qrcodeanalyticsdaily.EdgeQrCode:
+		if id := m.qr_code; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedqr_code {
+		edges = append(edges, qrcodeanalyticsdaily.EdgeQrCode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QRCodeAnalyticsDailyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case qrcodeanalyticsdaily.EdgeQrCode:
+		return m.clearedqr_code
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QRCodeAnalyticsDailyMutation) ClearEdge(name string) error {
+	switch name {
+	case qrcodeanalyticsdaily.EdgeQrCode:
+		m.ClearQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QRCodeAnalyticsDailyMutation) ResetEdge(name string) error {
+	switch name {
+	case qrcodeanalyticsdaily.EdgeQrCode:
+		m.ResetQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeAnalyticsDaily edge %s", name)
+}
+
+// QRCodeGroupMutation represents an operation that mutates the QRCodeGroup nodes in the graph.
+type QRCodeGroupMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	description    *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	qrcodes        map[int]struct{}
+	removedqrcodes map[int]struct{}
+	clearedqrcodes bool
+	done           bool
+	oldValue       func(context.Context) (*QRCodeGroup, error)
+	predicates     []predicate.QRCodeGroup
+}
+
+var _ ent.Mutation = (*QRCodeGroupMutation)(nil)
+
+// qrcodegroupOption allows management of the mutation configuration using functional options.
+type qrcodegroupOption func(*QRCodeGroupMutation)
+
+// newQRCodeGroupMutation creates new mutation for the QRCodeGroup entity.
+func newQRCodeGroupMutation(c config, op Op, opts ...qrcodegroupOption) *QRCodeGroupMutation {
+	m := &QRCodeGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQRCodeGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQRCodeGroupID sets the ID field of the mutation.
+func withQRCodeGroupID(id int) qrcodegroupOption {
+	return func(m *QRCodeGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QRCodeGroup
+		)
+		m.oldValue = func(ctx context.Context) (*QRCodeGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QRCodeGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQRCodeGroup sets the old QRCodeGroup of the mutation.
+func withQRCodeGroup(node *QRCodeGroup) qrcodegroupOption {
+	return func(m *QRCodeGroupMutation) {
+		m.oldValue = func(context.Context) (*QRCodeGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QRCodeGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QRCodeGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QRCodeGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QRCodeGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QRCodeGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *QRCodeGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *QRCodeGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the QRCodeGroup entity.
+// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *QRCodeGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QRCodeGroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QRCodeGroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the QRCodeGroup entity.
+// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QRCodeGroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[qrcodegroup.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QRCodeGroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[qrcodegroup.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QRCodeGroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, qrcodegroup.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QRCodeGroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QRCodeGroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QRCodeGroup entity.
+// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QRCodeGroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QRCodeGroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QRCodeGroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the QRCodeGroup entity.
+// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeGroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QRCodeGroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddQrcodeIDs adds the "qrcodes" edge to the QRCode entity by ids.
+func (m *QRCodeGroupMutation) AddQrcodeIDs(ids ...int) {
+	if m.qrcodes == nil {
+		m.qrcodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.qrcodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQrcodes clears the "qrcodes" edge to the QRCode entity.
+func (m *QRCodeGroupMutation) ClearQrcodes() {
+	m.clearedqrcodes = true
+}
+
+// QrcodesCleared reports if the "qrcodes" edge to the QRCode entity was cleared.
+func (m *QRCodeGroupMutation) QrcodesCleared() bool {
+	return m.clearedqrcodes
+}
+
+// RemoveQrcodeIDs removes the "qrcodes" edge to the QRCode entity by IDs.
+func (m *QRCodeGroupMutation) RemoveQrcodeIDs(ids ...int) {
+	if m.removedqrcodes == nil {
+		m.removedqrcodes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.qrcodes, ids[i])
+		m.removedqrcodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQrcodes returns the removed IDs of the "qrcodes" edge to the QRCode entity.
+func (m *QRCodeGroupMutation) RemovedQrcodesIDs() (ids []int) {
+	for id := range m.removedqrcodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QrcodesIDs returns the "qrcodes" edge IDs in the mutation.
+func (m *QRCodeGroupMutation) QrcodesIDs() (ids []int) {
+	for id := range m.qrcodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQrcodes resets all changes to the "qrcodes" edge.
+func (m *QRCodeGroupMutation) ResetQrcodes() {
+	m.qrcodes = nil
+	m.clearedqrcodes = false
+	m.removedqrcodes = nil
+}
+
+// Where appends a list predicates to the QRCodeGroupMutation builder.
+func (m *QRCodeGroupMutation) Where(ps ...predicate.QRCodeGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QRCodeGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QRCodeGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QRCodeGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QRCodeGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QRCodeGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QRCodeGroup).
+func (m *QRCodeGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QRCodeGroupMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, qrcodegroup.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, qrcodegroup.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, qrcodegroup.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, qrcodegroup.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QRCodeGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case qrcodegroup.FieldName:
+		return m.Name()
+	case qrcodegroup.FieldDescription:
+		return m.Description()
+	case qrcodegroup.FieldCreatedAt:
+		return m.CreatedAt()
+	case qrcodegroup.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QRCodeGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case qrcodegroup.FieldName:
+		return m.OldName(ctx)
+	case qrcodegroup.FieldDescription:
+		return m.OldDescription(ctx)
+	case qrcodegroup.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case qrcodegroup.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QRCodeGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case qrcodegroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case qrcodegroup.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case qrcodegroup.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case qrcodegroup.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QRCodeGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QRCodeGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QRCodeGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QRCodeGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(qrcodegroup.FieldDescription) {
+		fields = append(fields, qrcodegroup.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QRCodeGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QRCodeGroupMutation) ClearField(name string) error {
+	switch name {
+	case qrcodegroup.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QRCodeGroupMutation) ResetField(name string) error {
+	switch name {
+	case qrcodegroup.FieldName:
+		m.ResetName()
+		return nil
+	case qrcodegroup.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case qrcodegroup.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case qrcodegroup.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QRCodeGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.qrcodes != nil {
+		edges = append(edges, qrcodegroup.EdgeQrcodes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QRCodeGroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case qrcodegroup.EdgeQrcodes:
+		ids := make([]ent.Value, 0, len(m.qrcodes))
+		for id := range m.qrcodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QRCodeGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedqrcodes != nil {
+		edges = append(edges, qrcodegroup.EdgeQrcodes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QRCodeGroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case qrcodegroup.EdgeQrcodes:
+		ids := make([]ent.Value, 0, len(m.removedqrcodes))
+		for id := range m.removedqrcodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QRCodeGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedqrcodes {
+		edges = append(edges, qrcodegroup.EdgeQrcodes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QRCodeGroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case qrcodegroup.EdgeQrcodes:
+		return m.clearedqrcodes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QRCodeGroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QRCodeGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QRCodeGroupMutation) ResetEdge(name string) error {
+	switch name {
+	case qrcodegroup.EdgeQrcodes:
+		m.ResetQrcodes()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeGroup edge %s", name)
+}
+
+// QRCodeRedemptionMutation represents an operation that mutates the QRCodeRedemption nodes in the graph.
+type QRCodeRedemptionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	visitor        *string
+	count          *int
+	addcount       *int
+	first_at       *time.Time
+	last_at        *time.Time
+	clearedFields  map[string]struct{}
+	qr_code        *int
+	clearedqr_code bool
+	done           bool
+	oldValue       func(context.Context) (*QRCodeRedemption, error)
+	predicates     []predicate.QRCodeRedemption
+}
+
+var _ ent.Mutation = (*QRCodeRedemptionMutation)(nil)
+
+// qrcoderedemptionOption allows management of the mutation configuration using functional options.
+type qrcoderedemptionOption func(*QRCodeRedemptionMutation)
+
+// newQRCodeRedemptionMutation creates new mutation for the QRCodeRedemption entity.
+func newQRCodeRedemptionMutation(c config, op Op, opts ...qrcoderedemptionOption) *QRCodeRedemptionMutation {
+	m := &QRCodeRedemptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQRCodeRedemption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQRCodeRedemptionID sets the ID field of the mutation.
+func withQRCodeRedemptionID(id int) qrcoderedemptionOption {
+	return func(m *QRCodeRedemptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QRCodeRedemption
+		)
+		m.oldValue = func(ctx context.Context) (*QRCodeRedemption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QRCodeRedemption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQRCodeRedemption sets the old QRCodeRedemption of the mutation.
+func withQRCodeRedemption(node *QRCodeRedemption) qrcoderedemptionOption {
+	return func(m *QRCodeRedemptionMutation) {
+		m.oldValue = func(context.Context) (*QRCodeRedemption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QRCodeRedemptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QRCodeRedemptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QRCodeRedemptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QRCodeRedemptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QRCodeRedemption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (m *QRCodeRedemptionMutation) SetQrCodeID(i int) {
+	m.qr_code = &i
+}
+
+// QrCodeID returns the value of the "qr_code_id" field in the mutation.
+func (m *QRCodeRedemptionMutation) QrCodeID() (r int, exists bool) {
+	v := m.qr_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQrCodeID returns the old "qr_code_id" field's value of the QRCodeRedemption entity.
+// If the QRCodeRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeRedemptionMutation) OldQrCodeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQrCodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQrCodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQrCodeID: %w", err)
+	}
+	return oldValue.QrCodeID, nil
+}
+
+// ResetQrCodeID resets all changes to the "qr_code_id" field.
+func (m *QRCodeRedemptionMutation) ResetQrCodeID() {
+	m.qr_code = nil
+}
+
+// SetVisitor sets the "visitor" field.
+func (m *QRCodeRedemptionMutation) SetVisitor(s string) {
+	m.visitor = &s
+}
+
+// Visitor returns the value of the "visitor" field in the mutation.
+func (m *QRCodeRedemptionMutation) Visitor() (r string, exists bool) {
+	v := m.visitor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisitor returns the old "visitor" field's value of the QRCodeRedemption entity.
+// If the QRCodeRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeRedemptionMutation) OldVisitor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisitor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisitor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisitor: %w", err)
+	}
+	return oldValue.Visitor, nil
+}
+
+// ResetVisitor resets all changes to the "visitor" field.
+func (m *QRCodeRedemptionMutation) ResetVisitor() {
+	m.visitor = nil
+}
+
+// SetCount sets the "count" field.
+func (m *QRCodeRedemptionMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *QRCodeRedemptionMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the QRCodeRedemption entity.
+// If the QRCodeRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeRedemptionMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *QRCodeRedemptionMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *QRCodeRedemptionMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *QRCodeRedemptionMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetFirstAt sets the "first_at" field.
+func (m *QRCodeRedemptionMutation) SetFirstAt(t time.Time) {
+	m.first_at = &t
+}
+
+// FirstAt returns the value of the "first_at" field in the mutation.
+func (m *QRCodeRedemptionMutation) FirstAt() (r time.Time, exists bool) {
+	v := m.first_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstAt returns the old "first_at" field's value of the QRCodeRedemption entity.
+// If the QRCodeRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeRedemptionMutation) OldFirstAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstAt: %w", err)
+	}
+	return oldValue.FirstAt, nil
+}
+
+// ResetFirstAt resets all changes to the "first_at" field.
+func (m *QRCodeRedemptionMutation) ResetFirstAt() {
+	m.first_at = nil
+}
+
+// SetLastAt sets the "last_at" field.
+func (m *QRCodeRedemptionMutation) SetLastAt(t time.Time) {
+	m.last_at = &t
+}
+
+// LastAt returns the value of the "last_at" field in the mutation.
+func (m *QRCodeRedemptionMutation) LastAt() (r time.Time, exists bool) {
+	v := m.last_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAt returns the old "last_at" field's value of the QRCodeRedemption entity.
+// If the QRCodeRedemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QRCodeRedemptionMutation) OldLastAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAt: %w", err)
+	}
+	return oldValue.LastAt, nil
+}
+
+// ResetLastAt resets all changes to the "last_at" field.
+func (m *QRCodeRedemptionMutation) ResetLastAt() {
+	m.last_at = nil
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (m *QRCodeRedemptionMutation) ClearQrCode() {
+	m.clearedqr_code = true
+	m.clearedFields[qrcoderedemption.FieldQrCodeID] = struct{}{}
+}
+
+// QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
+func (m *QRCodeRedemptionMutation) QrCodeCleared() bool {
+	return m.clearedqr_code
+}
+
+// QrCodeIDs returns the "qr_code" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QrCodeID instead. It exists only for internal usage by the builders.
+func (m *QRCodeRedemptionMutation) QrCodeIDs() (ids []int) {
+	if id := m.qr_code; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQrCode resets all changes to the "qr_code" edge.
+func (m *QRCodeRedemptionMutation) ResetQrCode() {
+	m.qr_code = nil
+	m.clearedqr_code = false
+}
+
+// Where appends a list predicates to the QRCodeRedemptionMutation builder.
+func (m *QRCodeRedemptionMutation) Where(ps ...predicate.QRCodeRedemption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QRCodeRedemptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QRCodeRedemptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QRCodeRedemption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QRCodeRedemptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QRCodeRedemptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QRCodeRedemption).
+func (m *QRCodeRedemptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QRCodeRedemptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.qr_code != nil {
+		fields = append(fields, qrcoderedemption.FieldQrCodeID)
+	}
+	if m.visitor != nil {
+		fields = append(fields, qrcoderedemption.FieldVisitor)
+	}
+	if m.count != nil {
+		fields = append(fields, qrcoderedemption.FieldCount)
+	}
+	if m.first_at != nil {
+		fields = append(fields, qrcoderedemption.FieldFirstAt)
+	}
+	if m.last_at != nil {
+		fields = append(fields, qrcoderedemption.FieldLastAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QRCodeRedemptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case qrcoderedemption.FieldQrCodeID:
+		return m.QrCodeID()
+	case qrcoderedemption.FieldVisitor:
+		return m.Visitor()
+	case qrcoderedemption.FieldCount:
+		return m.Count()
+	case qrcoderedemption.FieldFirstAt:
+		return m.FirstAt()
+	case qrcoderedemption.FieldLastAt:
+		return m.LastAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QRCodeRedemptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case qrcoderedemption.FieldQrCodeID:
+		return m.OldQrCodeID(ctx)
+	case qrcoderedemption.FieldVisitor:
+		return m.OldVisitor(ctx)
+	case qrcoderedemption.FieldCount:
+		return m.OldCount(ctx)
+	case qrcoderedemption.FieldFirstAt:
+		return m.OldFirstAt(ctx)
+	case qrcoderedemption.FieldLastAt:
+		return m.OldLastAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QRCodeRedemption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeRedemptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case qrcoderedemption.FieldQrCodeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQrCodeID(v)
+		return nil
+	case qrcoderedemption.FieldVisitor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisitor(v)
+		return nil
+	case qrcoderedemption.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case qrcoderedemption.FieldFirstAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstAt(v)
+		return nil
+	case qrcoderedemption.FieldLastAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeRedemption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QRCodeRedemptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, qrcoderedemption.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QRCodeRedemptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case qrcoderedemption.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QRCodeRedemptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case qrcoderedemption.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeRedemption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QRCodeRedemptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QRCodeRedemptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QRCodeRedemptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QRCodeRedemption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QRCodeRedemptionMutation) ResetField(name string) error {
+	switch name {
+	case qrcoderedemption.FieldQrCodeID:
+		m.ResetQrCodeID()
+		return nil
+	case qrcoderedemption.FieldVisitor:
+		m.ResetVisitor()
+		return nil
+	case qrcoderedemption.FieldCount:
+		m.ResetCount()
+		return nil
+	case qrcoderedemption.FieldFirstAt:
+		m.ResetFirstAt()
+		return nil
+	case qrcoderedemption.FieldLastAt:
+		m.ResetLastAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeRedemption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QRCodeRedemptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.qr_code != nil {
+		edges = append(edges, qrcoderedemption.EdgeQrCode)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QRCodeRedemptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case qrcoderedemption.EdgeQrCode:
+		if id := m.qr_code; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QRCodeRedemptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QRCodeRedemptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QRCodeRedemptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedqr_code {
+		edges = append(edges, qrcoderedemption.EdgeQrCode)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QRCodeRedemptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case qrcoderedemption.EdgeQrCode:
+		return m.clearedqr_code
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QRCodeRedemptionMutation) ClearEdge(name string) error {
+	switch name {
+	case qrcoderedemption.EdgeQrCode:
+		m.ClearQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeRedemption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QRCodeRedemptionMutation) ResetEdge(name string) error {
+	switch name {
+	case qrcoderedemption.EdgeQrCode:
+		m.ResetQrCode()
+		return nil
+	}
+	return fmt.Errorf("unknown QRCodeRedemption edge %s", name)
+}
+
+// SigningKeyMutation represents an operation that mutates the SigningKey nodes in the graph.
+type SigningKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	kid           *string
+	public_key    *[]byte
+	private_key   *[]byte
+	status        *signingkey.Status
+	created_at    *time.Time
+	retired_at    *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SigningKey, error)
+	predicates    []predicate.SigningKey
+}
+
+var _ ent.Mutation = (*SigningKeyMutation)(nil)
+
+// signingkeyOption allows management of the mutation configuration using functional options.
+type signingkeyOption func(*SigningKeyMutation)
+
+// newSigningKeyMutation creates new mutation for the SigningKey entity.
+func newSigningKeyMutation(c config, op Op, opts ...signingkeyOption) *SigningKeyMutation {
+	m := &SigningKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSigningKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSigningKeyID sets the ID field of the mutation.
+func withSigningKeyID(id int) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SigningKey
+		)
+		m.oldValue = func(ctx context.Context) (*SigningKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SigningKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSigningKey sets the old SigningKey of the mutation.
+func withSigningKey(node *SigningKey) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		m.oldValue = func(context.Context) (*SigningKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SigningKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SigningKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SigningKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SigningKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SigningKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKid sets the "kid" field.
+func (m *SigningKeyMutation) SetKid(s string) {
+	m.kid = &s
+}
+
+// Kid returns the value of the "kid" field in the mutation.
+func (m *SigningKeyMutation) Kid() (r string, exists bool) {
+	v := m.kid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKid returns the old "kid" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldKid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKid: %w", err)
+	}
+	return oldValue.Kid, nil
+}
+
+// ResetKid resets all changes to the "kid" field.
+func (m *SigningKeyMutation) ResetKid() {
+	m.kid = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SigningKeyMutation) SetPublicKey(b []byte) {
+	m.public_key = &b
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SigningKeyMutation) PublicKey() (r []byte, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SigningKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *SigningKeyMutation) SetPrivateKey(b []byte) {
+	m.private_key = &b
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SigningKeyMutation) PrivateKey() (r []byte, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPrivateKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SigningKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SigningKeyMutation) SetStatus(s signingkey.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SigningKeyMutation) Status() (r signingkey.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldStatus(ctx context.Context) (v signingkey.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SigningKeyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SigningKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SigningKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SigningKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (m *SigningKeyMutation) SetRetiredAt(t time.Time) {
+	m.retired_at = &t
+}
+
+// RetiredAt returns the value of the "retired_at" field in the mutation.
+func (m *SigningKeyMutation) RetiredAt() (r time.Time, exists bool) {
+	v := m.retired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredAt returns the old "retired_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldRetiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredAt: %w", err)
+	}
+	return oldValue.RetiredAt, nil
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (m *SigningKeyMutation) ClearRetiredAt() {
+	m.retired_at = nil
+	m.clearedFields[signingkey.FieldRetiredAt] = struct{}{}
+}
+
+// RetiredAtCleared returns if the "retired_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) RetiredAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldRetiredAt]
+	return ok
+}
+
+// ResetRetiredAt resets all changes to the "retired_at" field.
+func (m *SigningKeyMutation) ResetRetiredAt() {
+	m.retired_at = nil
+	delete(m.clearedFields, signingkey.FieldRetiredAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SigningKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SigningKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SigningKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[signingkey.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SigningKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, signingkey.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Where(ps ...predicate.SigningKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SigningKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SigningKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SigningKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SigningKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SigningKey).
+func (m *SigningKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SigningKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.kid != nil {
+		fields = append(fields, signingkey.FieldKid)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signingkey.FieldPublicKey)
+	}
+	if m.private_key != nil {
+		fields = append(fields, signingkey.FieldPrivateKey)
 	}
-	if m.active != nil {
-		fields = append(fields, qrcode.FieldActive)
+	if m.status != nil {
+		fields = append(fields, signingkey.FieldStatus)
 	}
-	if m.tags != nil {
-		fields = append(fields, qrcode.FieldTags)
+	if m.created_at != nil {
+		fields = append(fields, signingkey.FieldCreatedAt)
 	}
-	if m.design != nil {
-		fields = append(fields, qrcode.FieldDesign)
+	if m.retired_at != nil {
+		fields = append(fields, signingkey.FieldRetiredAt)
 	}
-	if m.group != nil {
-		fields = append(fields, qrcode.FieldGroupID)
+	if m.revoked_at != nil {
+		fields = append(fields, signingkey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -1585,36 +9655,22 @@ func (m *QRCodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QRCodeMutation) Field(name string) (ent.Value, bool) {
+func (m *SigningKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case qrcode.FieldType:
-		return m.GetType()
-	case qrcode.FieldTitle:
-		return m.Title()
-	case qrcode.FieldDescription:
-		return m.Description()
-	case qrcode.FieldRedirectURL:
-		return m.RedirectURL()
-	case qrcode.FieldShortURL:
-		return m.ShortURL()
-	case qrcode.FieldContent:
-		return m.Content()
-	case qrcode.FieldCreatedAt:
+	case signingkey.FieldKid:
+		return m.Kid()
+	case signingkey.FieldPublicKey:
+		return m.PublicKey()
+	case signingkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case signingkey.FieldStatus:
+		return m.Status()
+	case signingkey.FieldCreatedAt:
 		return m.CreatedAt()
-	case qrcode.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case qrcode.FieldExpiresAt:
-		return m.ExpiresAt()
-	case qrcode.FieldAnalytics:
-		return m.Analytics()
-	case qrcode.FieldActive:
-		return m.Active()
-	case qrcode.FieldTags:
-		return m.Tags()
-	case qrcode.FieldDesign:
-		return m.Design()
-	case qrcode.FieldGroupID:
-		return m.GroupID()
+	case signingkey.FieldRetiredAt:
+		return m.RetiredAt()
+	case signingkey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -1622,444 +9678,253 @@ func (m *QRCodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QRCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case qrcode.FieldType:
-		return m.OldType(ctx)
-	case qrcode.FieldTitle:
-		return m.OldTitle(ctx)
-	case qrcode.FieldDescription:
-		return m.OldDescription(ctx)
-	case qrcode.FieldRedirectURL:
-		return m.OldRedirectURL(ctx)
-	case qrcode.FieldShortURL:
-		return m.OldShortURL(ctx)
-	case qrcode.FieldContent:
-		return m.OldContent(ctx)
-	case qrcode.FieldCreatedAt:
+	case signingkey.FieldKid:
+		return m.OldKid(ctx)
+	case signingkey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signingkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case signingkey.FieldStatus:
+		return m.OldStatus(ctx)
+	case signingkey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case qrcode.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case qrcode.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case qrcode.FieldAnalytics:
-		return m.OldAnalytics(ctx)
-	case qrcode.FieldActive:
-		return m.OldActive(ctx)
-	case qrcode.FieldTags:
-		return m.OldTags(ctx)
-	case qrcode.FieldDesign:
-		return m.OldDesign(ctx)
-	case qrcode.FieldGroupID:
-		return m.OldGroupID(ctx)
+	case signingkey.FieldRetiredAt:
+		return m.OldRetiredAt(ctx)
+	case signingkey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QRCode field %s", name)
+	return nil, fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeMutation) SetField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case qrcode.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case qrcode.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case qrcode.FieldDescription:
+	case signingkey.FieldKid:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetKid(v)
 		return nil
-	case qrcode.FieldRedirectURL:
-		v, ok := value.(string)
+	case signingkey.FieldPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRedirectURL(v)
+		m.SetPublicKey(v)
 		return nil
-	case qrcode.FieldShortURL:
-		v, ok := value.(string)
+	case signingkey.FieldPrivateKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShortURL(v)
+		m.SetPrivateKey(v)
 		return nil
-	case qrcode.FieldContent:
-		v, ok := value.(map[string]interface{})
+	case signingkey.FieldStatus:
+		v, ok := value.(signingkey.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetStatus(v)
 		return nil
-	case qrcode.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case qrcode.FieldUpdatedAt:
+	case signingkey.FieldRetiredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetRetiredAt(v)
 		return nil
-	case qrcode.FieldExpiresAt:
+	case signingkey.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
-		return nil
-	case qrcode.FieldAnalytics:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAnalytics(v)
-		return nil
-	case qrcode.FieldActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActive(v)
-		return nil
-	case qrcode.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case qrcode.FieldDesign:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDesign(v)
-		return nil
-	case qrcode.FieldGroupID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGroupID(v)
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QRCode field %s", name)
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QRCodeMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *SigningKeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QRCodeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *SigningKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeMutation) AddField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown QRCode numeric field %s", name)
+	return fmt.Errorf("unknown SigningKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QRCodeMutation) ClearedFields() []string {
+func (m *SigningKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(qrcode.FieldDescription) {
-		fields = append(fields, qrcode.FieldDescription)
-	}
-	if m.FieldCleared(qrcode.FieldRedirectURL) {
-		fields = append(fields, qrcode.FieldRedirectURL)
-	}
-	if m.FieldCleared(qrcode.FieldShortURL) {
-		fields = append(fields, qrcode.FieldShortURL)
-	}
-	if m.FieldCleared(qrcode.FieldExpiresAt) {
-		fields = append(fields, qrcode.FieldExpiresAt)
-	}
-	if m.FieldCleared(qrcode.FieldTags) {
-		fields = append(fields, qrcode.FieldTags)
-	}
-	if m.FieldCleared(qrcode.FieldDesign) {
-		fields = append(fields, qrcode.FieldDesign)
+	if m.FieldCleared(signingkey.FieldRetiredAt) {
+		fields = append(fields, signingkey.FieldRetiredAt)
 	}
-	if m.FieldCleared(qrcode.FieldGroupID) {
-		fields = append(fields, qrcode.FieldGroupID)
+	if m.FieldCleared(signingkey.FieldRevokedAt) {
+		fields = append(fields, signingkey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QRCodeMutation) FieldCleared(name string) bool {
+func (m *SigningKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QRCodeMutation) ClearField(name string) error {
+func (m *SigningKeyMutation) ClearField(name string) error {
 	switch name {
-	case qrcode.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case qrcode.FieldRedirectURL:
-		m.ClearRedirectURL()
-		return nil
-	case qrcode.FieldShortURL:
-		m.ClearShortURL()
-		return nil
-	case qrcode.FieldExpiresAt:
-		m.ClearExpiresAt()
-		return nil
-	case qrcode.FieldTags:
-		m.ClearTags()
-		return nil
-	case qrcode.FieldDesign:
-		m.ClearDesign()
+	case signingkey.FieldRetiredAt:
+		m.ClearRetiredAt()
 		return nil
-	case qrcode.FieldGroupID:
-		m.ClearGroupID()
+	case signingkey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCode nullable field %s", name)
+	return fmt.Errorf("unknown SigningKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QRCodeMutation) ResetField(name string) error {
+func (m *SigningKeyMutation) ResetField(name string) error {
 	switch name {
-	case qrcode.FieldType:
-		m.ResetType()
-		return nil
-	case qrcode.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case qrcode.FieldDescription:
-		m.ResetDescription()
+	case signingkey.FieldKid:
+		m.ResetKid()
 		return nil
-	case qrcode.FieldRedirectURL:
-		m.ResetRedirectURL()
+	case signingkey.FieldPublicKey:
+		m.ResetPublicKey()
 		return nil
-	case qrcode.FieldShortURL:
-		m.ResetShortURL()
+	case signingkey.FieldPrivateKey:
+		m.ResetPrivateKey()
 		return nil
-	case qrcode.FieldContent:
-		m.ResetContent()
+	case signingkey.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case qrcode.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case qrcode.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case qrcode.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case qrcode.FieldAnalytics:
-		m.ResetAnalytics()
-		return nil
-	case qrcode.FieldActive:
-		m.ResetActive()
-		return nil
-	case qrcode.FieldTags:
-		m.ResetTags()
-		return nil
-	case qrcode.FieldDesign:
-		m.ResetDesign()
+	case signingkey.FieldRetiredAt:
+		m.ResetRetiredAt()
 		return nil
-	case qrcode.FieldGroupID:
-		m.ResetGroupID()
+	case signingkey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCode field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QRCodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.file_refs != nil {
-		edges = append(edges, qrcode.EdgeFileRefs)
-	}
-	if m.group != nil {
-		edges = append(edges, qrcode.EdgeGroup)
-	}
-	if m.analytics_records != nil {
-		edges = append(edges, qrcode.EdgeAnalyticsRecords)
-	}
-	return edges
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *QRCodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case qrcode.EdgeFileRefs:
-		ids := make([]ent.Value, 0, len(m.file_refs))
-		for id := range m.file_refs {
-			ids = append(ids, id)
-		}
-		return ids
-	case qrcode.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case qrcode.EdgeAnalyticsRecords:
-		ids := make([]ent.Value, 0, len(m.analytics_records))
-		for id := range m.analytics_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SigningKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SigningKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QRCodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedfile_refs != nil {
-		edges = append(edges, qrcode.EdgeFileRefs)
-	}
-	if m.removedanalytics_records != nil {
-		edges = append(edges, qrcode.EdgeAnalyticsRecords)
-	}
+func (m *SigningKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QRCodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case qrcode.EdgeFileRefs:
-		ids := make([]ent.Value, 0, len(m.removedfile_refs))
-		for id := range m.removedfile_refs {
-			ids = append(ids, id)
-		}
-		return ids
-	case qrcode.EdgeAnalyticsRecords:
-		ids := make([]ent.Value, 0, len(m.removedanalytics_records))
-		for id := range m.removedanalytics_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SigningKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QRCodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedfile_refs {
-		edges = append(edges, qrcode.EdgeFileRefs)
-	}
-	if m.clearedgroup {
-		edges = append(edges, qrcode.EdgeGroup)
-	}
-	if m.clearedanalytics_records {
-		edges = append(edges, qrcode.EdgeAnalyticsRecords)
-	}
+func (m *SigningKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QRCodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case qrcode.EdgeFileRefs:
-		return m.clearedfile_refs
-	case qrcode.EdgeGroup:
-		return m.clearedgroup
-	case qrcode.EdgeAnalyticsRecords:
-		return m.clearedanalytics_records
-	}
+func (m *SigningKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QRCodeMutation) ClearEdge(name string) error {
-	switch name {
-	case qrcode.EdgeGroup:
-		m.ClearGroup()
-		return nil
-	}
-	return fmt.Errorf("unknown QRCode unique edge %s", name)
+func (m *SigningKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QRCodeMutation) ResetEdge(name string) error {
-	switch name {
-	case qrcode.EdgeFileRefs:
-		m.ResetFileRefs()
-		return nil
-	case qrcode.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case qrcode.EdgeAnalyticsRecords:
-		m.ResetAnalyticsRecords()
-		return nil
-	}
-	return fmt.Errorf("unknown QRCode edge %s", name)
+func (m *SigningKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey edge %s", name)
 }
 
-// QRCodeAnalyticsMutation represents an operation that mutates the QRCodeAnalytics nodes in the graph.
-type QRCodeAnalyticsMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	ip_address     *string
-	user_agent     *string
-	location       *string
-	device         *string
-	scanned_at     *time.Time
-	clearedFields  map[string]struct{}
-	qr_code        *int
-	clearedqr_code bool
-	done           bool
-	oldValue       func(context.Context) (*QRCodeAnalytics, error)
-	predicates     []predicate.QRCodeAnalytics
-}
-
-var _ ent.Mutation = (*QRCodeAnalyticsMutation)(nil)
-
-// qrcodeanalyticsOption allows management of the mutation configuration using functional options.
-type qrcodeanalyticsOption func(*QRCodeAnalyticsMutation)
-
-// newQRCodeAnalyticsMutation creates new mutation for the QRCodeAnalytics entity.
-func newQRCodeAnalyticsMutation(c config, op Op, opts ...qrcodeanalyticsOption) *QRCodeAnalyticsMutation {
-	m := &QRCodeAnalyticsMutation{
+	op                Op
+	typ               string
+	id                *int
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	description       *string
+	active            *bool
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	qr_code           *int
+	clearedqr_code    bool
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQRCodeAnalytics,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2068,20 +9933,20 @@ func newQRCodeAnalyticsMutation(c config, op Op, opts ...qrcodeanalyticsOption)
 	return m
 }
 
-// withQRCodeAnalyticsID sets the ID field of the mutation.
-func withQRCodeAnalyticsID(id int) qrcodeanalyticsOption {
-	return func(m *QRCodeAnalyticsMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id int) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *QRCodeAnalytics
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*QRCodeAnalytics, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().QRCodeAnalytics.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2090,10 +9955,10 @@ func withQRCodeAnalyticsID(id int) qrcodeanalyticsOption {
 	}
 }
 
-// withQRCodeAnalytics sets the old QRCodeAnalytics of the mutation.
-func withQRCodeAnalytics(node *QRCodeAnalytics) qrcodeanalyticsOption {
-	return func(m *QRCodeAnalyticsMutation) {
-		m.oldValue = func(context.Context) (*QRCodeAnalytics, error) {
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2102,7 +9967,7 @@ func withQRCodeAnalytics(node *QRCodeAnalytics) qrcodeanalyticsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QRCodeAnalyticsMutation) Client() *Client {
+func (m WebhookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2110,7 +9975,7 @@ func (m QRCodeAnalyticsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QRCodeAnalyticsMutation) Tx() (*Tx, error) {
+func (m WebhookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2121,7 +9986,7 @@ func (m QRCodeAnalyticsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QRCodeAnalyticsMutation) ID() (id int, exists bool) {
+func (m *WebhookMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2132,7 +9997,7 @@ func (m *QRCodeAnalyticsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QRCodeAnalyticsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2141,245 +10006,356 @@ func (m *QRCodeAnalyticsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QRCodeAnalytics.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *QRCodeAnalyticsMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *QRCodeAnalyticsMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the QRCodeAnalytics entity.
-// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeAnalyticsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.URL, nil
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *QRCodeAnalyticsMutation) ResetIPAddress() {
-	m.ip_address = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *QRCodeAnalyticsMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *QRCodeAnalyticsMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the QRCodeAnalytics entity.
-// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeAnalyticsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.Secret, nil
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *QRCodeAnalyticsMutation) ResetUserAgent() {
-	m.user_agent = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetLocation sets the "location" field.
-func (m *QRCodeAnalyticsMutation) SetLocation(s string) {
-	m.location = &s
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *QRCodeAnalyticsMutation) Location() (r string, exists bool) {
-	v := m.location
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the QRCodeAnalytics entity.
-// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeAnalyticsMutation) OldLocation(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *WebhookMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhook.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhook.FieldDescription)
+}
+
+// SetActive sets the "active" field.
+func (m *WebhookMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *WebhookMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *WebhookMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (m *WebhookMutation) SetQrCodeID(i int) {
+	m.qr_code = &i
+}
+
+// QrCodeID returns the value of the "qr_code_id" field in the mutation.
+func (m *WebhookMutation) QrCodeID() (r int, exists bool) {
+	v := m.qr_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQrCodeID returns the old "qr_code_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldQrCodeID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQrCodeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldQrCodeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldQrCodeID: %w", err)
 	}
-	return oldValue.Location, nil
+	return oldValue.QrCodeID, nil
 }
 
-// ClearLocation clears the value of the "location" field.
-func (m *QRCodeAnalyticsMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[qrcodeanalytics.FieldLocation] = struct{}{}
+// ClearQrCodeID clears the value of the "qr_code_id" field.
+func (m *WebhookMutation) ClearQrCodeID() {
+	m.qr_code = nil
+	m.clearedFields[webhook.FieldQrCodeID] = struct{}{}
 }
 
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *QRCodeAnalyticsMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[qrcodeanalytics.FieldLocation]
+// QrCodeIDCleared returns if the "qr_code_id" field was cleared in this mutation.
+func (m *WebhookMutation) QrCodeIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldQrCodeID]
 	return ok
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *QRCodeAnalyticsMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, qrcodeanalytics.FieldLocation)
+// ResetQrCodeID resets all changes to the "qr_code_id" field.
+func (m *WebhookMutation) ResetQrCodeID() {
+	m.qr_code = nil
+	delete(m.clearedFields, webhook.FieldQrCodeID)
 }
 
-// SetDevice sets the "device" field.
-func (m *QRCodeAnalyticsMutation) SetDevice(s string) {
-	m.device = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Device returns the value of the "device" field in the mutation.
-func (m *QRCodeAnalyticsMutation) Device() (r string, exists bool) {
-	v := m.device
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDevice returns the old "device" field's value of the QRCodeAnalytics entity.
-// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeAnalyticsMutation) OldDevice(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDevice requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Device, nil
-}
-
-// ClearDevice clears the value of the "device" field.
-func (m *QRCodeAnalyticsMutation) ClearDevice() {
-	m.device = nil
-	m.clearedFields[qrcodeanalytics.FieldDevice] = struct{}{}
-}
-
-// DeviceCleared returns if the "device" field was cleared in this mutation.
-func (m *QRCodeAnalyticsMutation) DeviceCleared() bool {
-	_, ok := m.clearedFields[qrcodeanalytics.FieldDevice]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDevice resets all changes to the "device" field.
-func (m *QRCodeAnalyticsMutation) ResetDevice() {
-	m.device = nil
-	delete(m.clearedFields, qrcodeanalytics.FieldDevice)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetScannedAt sets the "scanned_at" field.
-func (m *QRCodeAnalyticsMutation) SetScannedAt(t time.Time) {
-	m.scanned_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ScannedAt returns the value of the "scanned_at" field in the mutation.
-func (m *QRCodeAnalyticsMutation) ScannedAt() (r time.Time, exists bool) {
-	v := m.scanned_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScannedAt returns the old "scanned_at" field's value of the QRCodeAnalytics entity.
-// If the QRCodeAnalytics object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeAnalyticsMutation) OldScannedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScannedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScannedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScannedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ScannedAt, nil
-}
-
-// ResetScannedAt resets all changes to the "scanned_at" field.
-func (m *QRCodeAnalyticsMutation) ResetScannedAt() {
-	m.scanned_at = nil
+	return oldValue.UpdatedAt, nil
 }
 
-// SetQrCodeID sets the "qr_code" edge to the QRCode entity by id.
-func (m *QRCodeAnalyticsMutation) SetQrCodeID(id int) {
-	m.qr_code = &id
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // ClearQrCode clears the "qr_code" edge to the QRCode entity.
-func (m *QRCodeAnalyticsMutation) ClearQrCode() {
+func (m *WebhookMutation) ClearQrCode() {
 	m.clearedqr_code = true
+	m.clearedFields[webhook.FieldQrCodeID] = struct{}{}
 }
 
 // QrCodeCleared reports if the "qr_code" edge to the QRCode entity was cleared.
-func (m *QRCodeAnalyticsMutation) QrCodeCleared() bool {
-	return m.clearedqr_code
-}
-
-// QrCodeID returns the "qr_code" edge ID in the mutation.
-func (m *QRCodeAnalyticsMutation) QrCodeID() (id int, exists bool) {
-	if m.qr_code != nil {
-		return *m.qr_code, true
-	}
-	return
+func (m *WebhookMutation) QrCodeCleared() bool {
+	return m.QrCodeIDCleared() || m.clearedqr_code
 }
 
 // QrCodeIDs returns the "qr_code" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // QrCodeID instead. It exists only for internal usage by the builders.
-func (m *QRCodeAnalyticsMutation) QrCodeIDs() (ids []int) {
+func (m *WebhookMutation) QrCodeIDs() (ids []int) {
 	if id := m.qr_code; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2387,20 +10363,74 @@ func (m *QRCodeAnalyticsMutation) QrCodeIDs() (ids []int) {
 }
 
 // ResetQrCode resets all changes to the "qr_code" edge.
-func (m *QRCodeAnalyticsMutation) ResetQrCode() {
+func (m *WebhookMutation) ResetQrCode() {
 	m.qr_code = nil
 	m.clearedqr_code = false
 }
 
-// Where appends a list predicates to the QRCodeAnalyticsMutation builder.
-func (m *QRCodeAnalyticsMutation) Where(ps ...predicate.QRCodeAnalytics) {
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QRCodeAnalyticsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QRCodeAnalyticsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QRCodeAnalytics, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2408,39 +10438,48 @@ func (m *QRCodeAnalyticsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QRCodeAnalyticsMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QRCodeAnalyticsMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QRCodeAnalytics).
-func (m *QRCodeAnalyticsMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QRCodeAnalyticsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.ip_address != nil {
-		fields = append(fields, qrcodeanalytics.FieldIPAddress)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.user_agent != nil {
-		fields = append(fields, qrcodeanalytics.FieldUserAgent)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.location != nil {
-		fields = append(fields, qrcodeanalytics.FieldLocation)
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
 	}
-	if m.device != nil {
-		fields = append(fields, qrcodeanalytics.FieldDevice)
+	if m.description != nil {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.scanned_at != nil {
-		fields = append(fields, qrcodeanalytics.FieldScannedAt)
+	if m.active != nil {
+		fields = append(fields, webhook.FieldActive)
+	}
+	if m.qr_code != nil {
+		fields = append(fields, webhook.FieldQrCodeID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2448,18 +10487,24 @@ func (m *QRCodeAnalyticsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QRCodeAnalyticsMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case qrcodeanalytics.FieldIPAddress:
-		return m.IPAddress()
-	case qrcodeanalytics.FieldUserAgent:
-		return m.UserAgent()
-	case qrcodeanalytics.FieldLocation:
-		return m.Location()
-	case qrcodeanalytics.FieldDevice:
-		return m.Device()
-	case qrcodeanalytics.FieldScannedAt:
-		return m.ScannedAt()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldDescription:
+		return m.Description()
+	case webhook.FieldActive:
+		return m.Active()
+	case webhook.FieldQrCodeID:
+		return m.QrCodeID()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2467,249 +10512,322 @@ func (m *QRCodeAnalyticsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QRCodeAnalyticsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case qrcodeanalytics.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case qrcodeanalytics.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case qrcodeanalytics.FieldLocation:
-		return m.OldLocation(ctx)
-	case qrcodeanalytics.FieldDevice:
-		return m.OldDevice(ctx)
-	case qrcodeanalytics.FieldScannedAt:
-		return m.OldScannedAt(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldDescription:
+		return m.OldDescription(ctx)
+	case webhook.FieldActive:
+		return m.OldActive(ctx)
+	case webhook.FieldQrCodeID:
+		return m.OldQrCodeID(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeAnalyticsMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case qrcodeanalytics.FieldIPAddress:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetURL(v)
 		return nil
-	case qrcodeanalytics.FieldUserAgent:
+	case webhook.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAgent(v)
+		m.SetSecret(v)
 		return nil
-	case qrcodeanalytics.FieldLocation:
-		v, ok := value.(string)
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetEvents(v)
 		return nil
-	case qrcodeanalytics.FieldDevice:
+	case webhook.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDevice(v)
+		m.SetDescription(v)
 		return nil
-	case qrcodeanalytics.FieldScannedAt:
+	case webhook.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case webhook.FieldQrCodeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQrCodeID(v)
+		return nil
+	case webhook.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScannedAt(v)
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QRCodeAnalyticsMutation) AddedFields() []string {
-	return nil
+func (m *WebhookMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QRCodeAnalyticsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeAnalyticsMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QRCodeAnalyticsMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(qrcodeanalytics.FieldLocation) {
-		fields = append(fields, qrcodeanalytics.FieldLocation)
+	if m.FieldCleared(webhook.FieldDescription) {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.FieldCleared(qrcodeanalytics.FieldDevice) {
-		fields = append(fields, qrcodeanalytics.FieldDevice)
+	if m.FieldCleared(webhook.FieldQrCodeID) {
+		fields = append(fields, webhook.FieldQrCodeID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QRCodeAnalyticsMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QRCodeAnalyticsMutation) ClearField(name string) error {
+func (m *WebhookMutation) ClearField(name string) error {
 	switch name {
-	case qrcodeanalytics.FieldLocation:
-		m.ClearLocation()
+	case webhook.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case qrcodeanalytics.FieldDevice:
-		m.ClearDevice()
+	case webhook.FieldQrCodeID:
+		m.ClearQrCodeID()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics nullable field %s", name)
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QRCodeAnalyticsMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case qrcodeanalytics.FieldIPAddress:
-		m.ResetIPAddress()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case qrcodeanalytics.FieldUserAgent:
-		m.ResetUserAgent()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case qrcodeanalytics.FieldLocation:
-		m.ResetLocation()
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case webhook.FieldActive:
+		m.ResetActive()
 		return nil
-	case qrcodeanalytics.FieldDevice:
-		m.ResetDevice()
+	case webhook.FieldQrCodeID:
+		m.ResetQrCodeID()
 		return nil
-	case qrcodeanalytics.FieldScannedAt:
-		m.ResetScannedAt()
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QRCodeAnalyticsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.qr_code != nil {
-		edges = append(edges, qrcodeanalytics.EdgeQrCode)
+		edges = append(edges, webhook.EdgeQrCode)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QRCodeAnalyticsMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case qrcodeanalytics.EdgeQrCode:
+	case webhook.EdgeQrCode:
 		if id := m.qr_code; id != nil {
 			return []ent.Value{*id}
 		}
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QRCodeAnalyticsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QRCodeAnalyticsMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QRCodeAnalyticsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedqr_code {
-		edges = append(edges, qrcodeanalytics.EdgeQrCode)
+		edges = append(edges, webhook.EdgeQrCode)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QRCodeAnalyticsMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case qrcodeanalytics.EdgeQrCode:
+	case webhook.EdgeQrCode:
 		return m.clearedqr_code
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QRCodeAnalyticsMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case qrcodeanalytics.EdgeQrCode:
+	case webhook.EdgeQrCode:
 		m.ClearQrCode()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QRCodeAnalyticsMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case qrcodeanalytics.EdgeQrCode:
+	case webhook.EdgeQrCode:
 		m.ResetQrCode()
 		return nil
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
 	}
-	return fmt.Errorf("unknown QRCodeAnalytics edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// QRCodeGroupMutation represents an operation that mutates the QRCodeGroup nodes in the graph.
-type QRCodeGroupMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	name           *string
-	description    *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	qrcodes        map[int]struct{}
-	removedqrcodes map[int]struct{}
-	clearedqrcodes bool
-	done           bool
-	oldValue       func(context.Context) (*QRCodeGroup, error)
-	predicates     []predicate.QRCodeGroup
-}
-
-var _ ent.Mutation = (*QRCodeGroupMutation)(nil)
-
-// qrcodegroupOption allows management of the mutation configuration using functional options.
-type qrcodegroupOption func(*QRCodeGroupMutation)
-
-// newQRCodeGroupMutation creates new mutation for the QRCodeGroup entity.
-func newQRCodeGroupMutation(c config, op Op, opts ...qrcodegroupOption) *QRCodeGroupMutation {
-	m := &QRCodeGroupMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	event              *string
+	payload            *string
+	status             *webhookdelivery.Status
+	attempts           *int
+	addattempts        *int
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	next_attempt_at    *time.Time
+	delivered_at       *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *int
+	clearedwebhook     bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQRCodeGroup,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2718,20 +10836,20 @@ func newQRCodeGroupMutation(c config, op Op, opts ...qrcodegroupOption) *QRCodeG
 	return m
 }
 
-// withQRCodeGroupID sets the ID field of the mutation.
-func withQRCodeGroupID(id int) qrcodegroupOption {
-	return func(m *QRCodeGroupMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *QRCodeGroup
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*QRCodeGroup, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().QRCodeGroup.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2740,10 +10858,10 @@ func withQRCodeGroupID(id int) qrcodegroupOption {
 	}
 }
 
-// withQRCodeGroup sets the old QRCodeGroup of the mutation.
-func withQRCodeGroup(node *QRCodeGroup) qrcodegroupOption {
-	return func(m *QRCodeGroupMutation) {
-		m.oldValue = func(context.Context) (*QRCodeGroup, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2752,7 +10870,7 @@ func withQRCodeGroup(node *QRCodeGroup) qrcodegroupOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QRCodeGroupMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2760,7 +10878,7 @@ func (m QRCodeGroupMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QRCodeGroupMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2771,7 +10889,7 @@ func (m QRCodeGroupMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QRCodeGroupMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2782,7 +10900,7 @@ func (m *QRCodeGroupMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QRCodeGroupMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2791,232 +10909,488 @@ func (m *QRCodeGroupMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QRCodeGroup.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *QRCodeGroupMutation) SetName(s string) {
-	m.name = &s
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(i int) {
+	m.webhook = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *QRCodeGroupMutation) Name() (r string, exists bool) {
-	v := m.name
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r int, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the QRCodeGroup entity.
-// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeGroupMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.WebhookID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *QRCodeGroupMutation) ResetName() {
-	m.name = nil
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *QRCodeGroupMutation) SetDescription(s string) {
-	m.description = &s
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *QRCodeGroupMutation) Description() (r string, exists bool) {
-	v := m.description
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the QRCodeGroup entity.
-// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Event, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *QRCodeGroupMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[qrcodegroup.FieldDescription] = struct{}{}
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *QRCodeGroupMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[qrcodegroup.FieldDescription]
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *QRCodeGroupMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, qrcodegroup.FieldDescription)
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *QRCodeGroupMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QRCodeGroupMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the QRCodeGroup entity.
-// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QRCodeGroupMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *QRCodeGroupMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *QRCodeGroupMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the QRCodeGroup entity.
-// If the QRCodeGroup object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QRCodeGroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *QRCodeGroupMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// AddQrcodeIDs adds the "qrcodes" edge to the QRCode entity by ids.
-func (m *QRCodeGroupMutation) AddQrcodeIDs(ids ...int) {
-	if m.qrcodes == nil {
-		m.qrcodes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.qrcodes[ids[i]] = struct{}{}
-	}
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// ClearQrcodes clears the "qrcodes" edge to the QRCode entity.
-func (m *QRCodeGroupMutation) ClearQrcodes() {
-	m.clearedqrcodes = true
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// QrcodesCleared reports if the "qrcodes" edge to the QRCode entity was cleared.
-func (m *QRCodeGroupMutation) QrcodesCleared() bool {
-	return m.clearedqrcodes
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveQrcodeIDs removes the "qrcodes" edge to the QRCode entity by IDs.
-func (m *QRCodeGroupMutation) RemoveQrcodeIDs(ids ...int) {
-	if m.removedqrcodes == nil {
-		m.removedqrcodes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.qrcodes, ids[i])
-		m.removedqrcodes[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedQrcodes returns the removed IDs of the "qrcodes" edge to the QRCode entity.
-func (m *QRCodeGroupMutation) RemovedQrcodesIDs() (ids []int) {
-	for id := range m.removedqrcodes {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// QrcodesIDs returns the "qrcodes" edge IDs in the mutation.
-func (m *QRCodeGroupMutation) QrcodesIDs() (ids []int) {
-	for id := range m.qrcodes {
-		ids = append(ids, id)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdelivery.FieldWebhookID] = struct{}{}
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []int) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetQrcodes resets all changes to the "qrcodes" edge.
-func (m *QRCodeGroupMutation) ResetQrcodes() {
-	m.qrcodes = nil
-	m.clearedqrcodes = false
-	m.removedqrcodes = nil
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
-// Where appends a list predicates to the QRCodeGroupMutation builder.
-func (m *QRCodeGroupMutation) Where(ps ...predicate.QRCodeGroup) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QRCodeGroupMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QRCodeGroupMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QRCodeGroup, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3024,36 +11398,54 @@ func (m *QRCodeGroupMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QRCodeGroupMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QRCodeGroupMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QRCodeGroup).
-func (m *QRCodeGroupMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QRCodeGroupMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, qrcodegroup.FieldName)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.description != nil {
-		fields = append(fields, qrcodegroup.FieldDescription)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.created_at != nil {
-		fields = append(fields, qrcodegroup.FieldCreatedAt)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, qrcodegroup.FieldUpdatedAt)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3061,16 +11453,28 @@ func (m *QRCodeGroupMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QRCodeGroupMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case qrcodegroup.FieldName:
-		return m.Name()
-	case qrcodegroup.FieldDescription:
-		return m.Description()
-	case qrcodegroup.FieldCreatedAt:
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case qrcodegroup.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3078,207 +11482,308 @@ func (m *QRCodeGroupMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QRCodeGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case qrcodegroup.FieldName:
-		return m.OldName(ctx)
-	case qrcodegroup.FieldDescription:
-		return m.OldDescription(ctx)
-	case qrcodegroup.FieldCreatedAt:
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case qrcodegroup.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QRCodeGroup field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeGroupMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case qrcodegroup.FieldName:
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
+		return nil
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEvent(v)
 		return nil
-	case qrcodegroup.FieldDescription:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetPayload(v)
 		return nil
-	case qrcodegroup.FieldCreatedAt:
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case qrcodegroup.FieldUpdatedAt:
+	case webhookdelivery.FieldDeliveredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetDeliveredAt(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QRCodeGroupMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QRCodeGroupMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QRCodeGroupMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QRCodeGroupMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(qrcodegroup.FieldDescription) {
-		fields = append(fields, qrcodegroup.FieldDescription)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QRCodeGroupMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QRCodeGroupMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case qrcodegroup.FieldDescription:
-		m.ClearDescription()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QRCodeGroupMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case qrcodegroup.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case qrcodegroup.FieldDescription:
-		m.ResetDescription()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case qrcodegroup.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case qrcodegroup.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QRCodeGroupMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.qrcodes != nil {
-		edges = append(edges, qrcodegroup.EdgeQrcodes)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QRCodeGroupMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case qrcodegroup.EdgeQrcodes:
-		ids := make([]ent.Value, 0, len(m.qrcodes))
-		for id := range m.qrcodes {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QRCodeGroupMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedqrcodes != nil {
-		edges = append(edges, qrcodegroup.EdgeQrcodes)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QRCodeGroupMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case qrcodegroup.EdgeQrcodes:
-		ids := make([]ent.Value, 0, len(m.removedqrcodes))
-		for id := range m.removedqrcodes {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QRCodeGroupMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedqrcodes {
-		edges = append(edges, qrcodegroup.EdgeQrcodes)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QRCodeGroupMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case qrcodegroup.EdgeQrcodes:
-		return m.clearedqrcodes
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QRCodeGroupMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QRCodeGroupMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case qrcodegroup.EdgeQrcodes:
-		m.ResetQrcodes()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown QRCodeGroup edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
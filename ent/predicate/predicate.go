@@ -6,14 +6,35 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Checkpoint is the predicate function for checkpoint builders.
+type Checkpoint func(*sql.Selector)
+
 // FileReference is the predicate function for filereference builders.
 type FileReference func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
 // QRCode is the predicate function for qrcode builders.
 type QRCode func(*sql.Selector)
 
 // QRCodeAnalytics is the predicate function for qrcodeanalytics builders.
 type QRCodeAnalytics func(*sql.Selector)
 
+// QRCodeAnalyticsDaily is the predicate function for qrcodeanalyticsdaily builders.
+type QRCodeAnalyticsDaily func(*sql.Selector)
+
 // QRCodeGroup is the predicate function for qrcodegroup builders.
 type QRCodeGroup func(*sql.Selector)
+
+// QRCodeRedemption is the predicate function for qrcoderedemption builders.
+type QRCodeRedemption func(*sql.Selector)
+
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/pkg/schedule"
 	"strings"
 	"time"
 
@@ -37,8 +38,34 @@ type QRCode struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// Schedule holds the value of the "schedule" field.
+	Schedule *schedule.Schedule `json:"schedule,omitempty"`
+	// FallbackURL holds the value of the "fallback_url" field.
+	FallbackURL string `json:"fallback_url,omitempty"`
+	// FallbackMessage holds the value of the "fallback_message" field.
+	FallbackMessage string `json:"fallback_message,omitempty"`
+	// MaxScans holds the value of the "max_scans" field.
+	MaxScans *int `json:"max_scans,omitempty"`
+	// ScansPerVisitor holds the value of the "scans_per_visitor" field.
+	ScansPerVisitor *int `json:"scans_per_visitor,omitempty"`
+	// VisitorKey holds the value of the "visitor_key" field.
+	VisitorKey qrcode.VisitorKey `json:"visitor_key,omitempty"`
+	// Redeemed holds the value of the "redeemed" field.
+	Redeemed int `json:"redeemed,omitempty"`
+	// EndedMessage holds the value of the "ended_message" field.
+	EndedMessage string `json:"ended_message,omitempty"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// Signing holds the value of the "signing" field.
+	Signing *qrcode.Signing `json:"signing,omitempty"`
+	// SignedToken holds the value of the "signed_token" field.
+	SignedToken string `json:"signed_token,omitempty"`
 	// Analytics holds the value of the "analytics" field.
 	Analytics bool `json:"analytics,omitempty"`
+	// AnalyticsCountsOnly holds the value of the "analytics_counts_only" field.
+	AnalyticsCountsOnly bool `json:"analytics_counts_only,omitempty"`
 	// Active holds the value of the "active" field.
 	Active bool `json:"active,omitempty"`
 	// Tags holds the value of the "tags" field.
@@ -47,6 +74,10 @@ type QRCode struct {
 	Design map[string]interface{} `json:"design,omitempty"`
 	// GroupID holds the value of the "group_id" field.
 	GroupID *int `json:"group_id,omitempty"`
+	// Gs1Key holds the value of the "gs1_key" field.
+	Gs1Key string `json:"gs1_key,omitempty"`
+	// ImportKey holds the value of the "import_key" field.
+	ImportKey string `json:"import_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QRCodeQuery when eager-loading is set.
 	Edges        QRCodeEdges `json:"edges"`
@@ -61,9 +92,15 @@ type QRCodeEdges struct {
 	Group *QRCodeGroup `json:"group,omitempty"`
 	// AnalyticsRecords holds the value of the analytics_records edge.
 	AnalyticsRecords []*QRCodeAnalytics `json:"analytics_records,omitempty"`
+	// DailyAnalytics holds the value of the daily_analytics edge.
+	DailyAnalytics []*QRCodeAnalyticsDaily `json:"daily_analytics,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Redemptions holds the value of the redemptions edge.
+	Redemptions []*QRCodeRedemption `json:"redemptions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // FileRefsOrErr returns the FileRefs value or an error if the edge
@@ -95,20 +132,47 @@ func (e QRCodeEdges) AnalyticsRecordsOrErr() ([]*QRCodeAnalytics, error) {
 	return nil, &NotLoadedError{edge: "analytics_records"}
 }
 
+// DailyAnalyticsOrErr returns the DailyAnalytics value or an error if the edge
+// was not loaded in eager-loading.
+func (e QRCodeEdges) DailyAnalyticsOrErr() ([]*QRCodeAnalyticsDaily, error) {
+	if e.loadedTypes[3] {
+		return e.DailyAnalytics, nil
+	}
+	return nil, &NotLoadedError{edge: "daily_analytics"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e QRCodeEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[4] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// RedemptionsOrErr returns the Redemptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e QRCodeEdges) RedemptionsOrErr() ([]*QRCodeRedemption, error) {
+	if e.loadedTypes[5] {
+		return e.Redemptions, nil
+	}
+	return nil, &NotLoadedError{edge: "redemptions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*QRCode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case qrcode.FieldContent, qrcode.FieldTags, qrcode.FieldDesign:
+		case qrcode.FieldContent, qrcode.FieldSchedule, qrcode.FieldTags, qrcode.FieldDesign:
 			values[i] = new([]byte)
-		case qrcode.FieldAnalytics, qrcode.FieldActive:
+		case qrcode.FieldAnalytics, qrcode.FieldAnalyticsCountsOnly, qrcode.FieldActive:
 			values[i] = new(sql.NullBool)
-		case qrcode.FieldID, qrcode.FieldGroupID:
+		case qrcode.FieldID, qrcode.FieldMaxScans, qrcode.FieldScansPerVisitor, qrcode.FieldRedeemed, qrcode.FieldGroupID:
 			values[i] = new(sql.NullInt64)
-		case qrcode.FieldType, qrcode.FieldTitle, qrcode.FieldDescription, qrcode.FieldRedirectURL, qrcode.FieldShortURL:
+		case qrcode.FieldType, qrcode.FieldTitle, qrcode.FieldDescription, qrcode.FieldRedirectURL, qrcode.FieldShortURL, qrcode.FieldFallbackURL, qrcode.FieldFallbackMessage, qrcode.FieldVisitorKey, qrcode.FieldEndedMessage, qrcode.FieldPasswordHash, qrcode.FieldSigning, qrcode.FieldSignedToken, qrcode.FieldGs1Key, qrcode.FieldImportKey:
 			values[i] = new(sql.NullString)
-		case qrcode.FieldCreatedAt, qrcode.FieldUpdatedAt, qrcode.FieldExpiresAt:
+		case qrcode.FieldCreatedAt, qrcode.FieldUpdatedAt, qrcode.FieldExpiresAt, qrcode.FieldStartsAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -188,12 +252,96 @@ func (qc *QRCode) assignValues(columns []string, values []any) error {
 				qc.ExpiresAt = new(time.Time)
 				*qc.ExpiresAt = value.Time
 			}
+		case qrcode.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				qc.StartsAt = new(time.Time)
+				*qc.StartsAt = value.Time
+			}
+		case qrcode.FieldSchedule:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qc.Schedule); err != nil {
+					return fmt.Errorf("unmarshal field schedule: %w", err)
+				}
+			}
+		case qrcode.FieldFallbackURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_url", values[i])
+			} else if value.Valid {
+				qc.FallbackURL = value.String
+			}
+		case qrcode.FieldFallbackMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_message", values[i])
+			} else if value.Valid {
+				qc.FallbackMessage = value.String
+			}
+		case qrcode.FieldMaxScans:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_scans", values[i])
+			} else if value.Valid {
+				qc.MaxScans = new(int)
+				*qc.MaxScans = int(value.Int64)
+			}
+		case qrcode.FieldScansPerVisitor:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scans_per_visitor", values[i])
+			} else if value.Valid {
+				qc.ScansPerVisitor = new(int)
+				*qc.ScansPerVisitor = int(value.Int64)
+			}
+		case qrcode.FieldVisitorKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visitor_key", values[i])
+			} else if value.Valid {
+				qc.VisitorKey = qrcode.VisitorKey(value.String)
+			}
+		case qrcode.FieldRedeemed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed", values[i])
+			} else if value.Valid {
+				qc.Redeemed = int(value.Int64)
+			}
+		case qrcode.FieldEndedMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_message", values[i])
+			} else if value.Valid {
+				qc.EndedMessage = value.String
+			}
+		case qrcode.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				qc.PasswordHash = value.String
+			}
+		case qrcode.FieldSigning:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing", values[i])
+			} else if value.Valid {
+				qc.Signing = new(qrcode.Signing)
+				*qc.Signing = qrcode.Signing(value.String)
+			}
+		case qrcode.FieldSignedToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signed_token", values[i])
+			} else if value.Valid {
+				qc.SignedToken = value.String
+			}
 		case qrcode.FieldAnalytics:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field analytics", values[i])
 			} else if value.Valid {
 				qc.Analytics = value.Bool
 			}
+		case qrcode.FieldAnalyticsCountsOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field analytics_counts_only", values[i])
+			} else if value.Valid {
+				qc.AnalyticsCountsOnly = value.Bool
+			}
 		case qrcode.FieldActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field active", values[i])
@@ -223,6 +371,18 @@ func (qc *QRCode) assignValues(columns []string, values []any) error {
 				qc.GroupID = new(int)
 				*qc.GroupID = int(value.Int64)
 			}
+		case qrcode.FieldGs1Key:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gs1_key", values[i])
+			} else if value.Valid {
+				qc.Gs1Key = value.String
+			}
+		case qrcode.FieldImportKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_key", values[i])
+			} else if value.Valid {
+				qc.ImportKey = value.String
+			}
 		default:
 			qc.selectValues.Set(columns[i], values[i])
 		}
@@ -251,6 +411,21 @@ func (qc *QRCode) QueryAnalyticsRecords() *QRCodeAnalyticsQuery {
 	return NewQRCodeClient(qc.config).QueryAnalyticsRecords(qc)
 }
 
+// QueryDailyAnalytics queries the "daily_analytics" edge of the QRCode entity.
+func (qc *QRCode) QueryDailyAnalytics() *QRCodeAnalyticsDailyQuery {
+	return NewQRCodeClient(qc.config).QueryDailyAnalytics(qc)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the QRCode entity.
+func (qc *QRCode) QueryWebhooks() *WebhookQuery {
+	return NewQRCodeClient(qc.config).QueryWebhooks(qc)
+}
+
+// QueryRedemptions queries the "redemptions" edge of the QRCode entity.
+func (qc *QRCode) QueryRedemptions() *QRCodeRedemptionQuery {
+	return NewQRCodeClient(qc.config).QueryRedemptions(qc)
+}
+
 // Update returns a builder for updating this QRCode.
 // Note that you need to call QRCode.Unwrap() before calling this method if this QRCode
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -303,9 +478,55 @@ func (qc *QRCode) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := qc.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("schedule=")
+	builder.WriteString(fmt.Sprintf("%v", qc.Schedule))
+	builder.WriteString(", ")
+	builder.WriteString("fallback_url=")
+	builder.WriteString(qc.FallbackURL)
+	builder.WriteString(", ")
+	builder.WriteString("fallback_message=")
+	builder.WriteString(qc.FallbackMessage)
+	builder.WriteString(", ")
+	if v := qc.MaxScans; v != nil {
+		builder.WriteString("max_scans=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := qc.ScansPerVisitor; v != nil {
+		builder.WriteString("scans_per_visitor=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visitor_key=")
+	builder.WriteString(fmt.Sprintf("%v", qc.VisitorKey))
+	builder.WriteString(", ")
+	builder.WriteString("redeemed=")
+	builder.WriteString(fmt.Sprintf("%v", qc.Redeemed))
+	builder.WriteString(", ")
+	builder.WriteString("ended_message=")
+	builder.WriteString(qc.EndedMessage)
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := qc.Signing; v != nil {
+		builder.WriteString("signing=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("signed_token=")
+	builder.WriteString(qc.SignedToken)
+	builder.WriteString(", ")
 	builder.WriteString("analytics=")
 	builder.WriteString(fmt.Sprintf("%v", qc.Analytics))
 	builder.WriteString(", ")
+	builder.WriteString("analytics_counts_only=")
+	builder.WriteString(fmt.Sprintf("%v", qc.AnalyticsCountsOnly))
+	builder.WriteString(", ")
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", qc.Active))
 	builder.WriteString(", ")
@@ -319,6 +540,12 @@ func (qc *QRCode) String() string {
 		builder.WriteString("group_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("gs1_key=")
+	builder.WriteString(qc.Gs1Key)
+	builder.WriteString(", ")
+	builder.WriteString("import_key=")
+	builder.WriteString(qc.ImportKey)
 	builder.WriteByte(')')
 	return builder.String()
 }
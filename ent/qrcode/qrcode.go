@@ -3,6 +3,7 @@
 package qrcode
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -32,8 +33,34 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldSchedule holds the string denoting the schedule field in the database.
+	FieldSchedule = "schedule"
+	// FieldFallbackURL holds the string denoting the fallback_url field in the database.
+	FieldFallbackURL = "fallback_url"
+	// FieldFallbackMessage holds the string denoting the fallback_message field in the database.
+	FieldFallbackMessage = "fallback_message"
+	// FieldMaxScans holds the string denoting the max_scans field in the database.
+	FieldMaxScans = "max_scans"
+	// FieldScansPerVisitor holds the string denoting the scans_per_visitor field in the database.
+	FieldScansPerVisitor = "scans_per_visitor"
+	// FieldVisitorKey holds the string denoting the visitor_key field in the database.
+	FieldVisitorKey = "visitor_key"
+	// FieldRedeemed holds the string denoting the redeemed field in the database.
+	FieldRedeemed = "redeemed"
+	// FieldEndedMessage holds the string denoting the ended_message field in the database.
+	FieldEndedMessage = "ended_message"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldSigning holds the string denoting the signing field in the database.
+	FieldSigning = "signing"
+	// FieldSignedToken holds the string denoting the signed_token field in the database.
+	FieldSignedToken = "signed_token"
 	// FieldAnalytics holds the string denoting the analytics field in the database.
 	FieldAnalytics = "analytics"
+	// FieldAnalyticsCountsOnly holds the string denoting the analytics_counts_only field in the database.
+	FieldAnalyticsCountsOnly = "analytics_counts_only"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
 	// FieldTags holds the string denoting the tags field in the database.
@@ -42,12 +69,22 @@ const (
 	FieldDesign = "design"
 	// FieldGroupID holds the string denoting the group_id field in the database.
 	FieldGroupID = "group_id"
+	// FieldGs1Key holds the string denoting the gs1_key field in the database.
+	FieldGs1Key = "gs1_key"
+	// FieldImportKey holds the string denoting the import_key field in the database.
+	FieldImportKey = "import_key"
 	// EdgeFileRefs holds the string denoting the file_refs edge name in mutations.
 	EdgeFileRefs = "file_refs"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeAnalyticsRecords holds the string denoting the analytics_records edge name in mutations.
 	EdgeAnalyticsRecords = "analytics_records"
+	// EdgeDailyAnalytics holds the string denoting the daily_analytics edge name in mutations.
+	EdgeDailyAnalytics = "daily_analytics"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeRedemptions holds the string denoting the redemptions edge name in mutations.
+	EdgeRedemptions = "redemptions"
 	// Table holds the table name of the qrcode in the database.
 	Table = "qr_codes"
 	// FileRefsTable is the table that holds the file_refs relation/edge.
@@ -71,6 +108,27 @@ const (
 	AnalyticsRecordsInverseTable = "qr_code_analytics"
 	// AnalyticsRecordsColumn is the table column denoting the analytics_records relation/edge.
 	AnalyticsRecordsColumn = "qr_code_analytics_records"
+	// DailyAnalyticsTable is the table that holds the daily_analytics relation/edge.
+	DailyAnalyticsTable = "qr_code_analytics_dailies"
+	// DailyAnalyticsInverseTable is the table name for the QRCodeAnalyticsDaily entity.
+	// It exists in this package in order to avoid circular dependency with the "qrcodeanalyticsdaily" package.
+	DailyAnalyticsInverseTable = "qr_code_analytics_dailies"
+	// DailyAnalyticsColumn is the table column denoting the daily_analytics relation/edge.
+	DailyAnalyticsColumn = "qr_code_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "qr_code_id"
+	// RedemptionsTable is the table that holds the redemptions relation/edge.
+	RedemptionsTable = "qr_code_redemptions"
+	// RedemptionsInverseTable is the table name for the QRCodeRedemption entity.
+	// It exists in this package in order to avoid circular dependency with the "qrcoderedemption" package.
+	RedemptionsInverseTable = "qr_code_redemptions"
+	// RedemptionsColumn is the table column denoting the redemptions relation/edge.
+	RedemptionsColumn = "qr_code_id"
 )
 
 // Columns holds all SQL columns for qrcode fields.
@@ -85,11 +143,26 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldExpiresAt,
+	FieldStartsAt,
+	FieldSchedule,
+	FieldFallbackURL,
+	FieldFallbackMessage,
+	FieldMaxScans,
+	FieldScansPerVisitor,
+	FieldVisitorKey,
+	FieldRedeemed,
+	FieldEndedMessage,
+	FieldPasswordHash,
+	FieldSigning,
+	FieldSignedToken,
 	FieldAnalytics,
+	FieldAnalyticsCountsOnly,
 	FieldActive,
 	FieldTags,
 	FieldDesign,
 	FieldGroupID,
+	FieldGs1Key,
+	FieldImportKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,12 +186,65 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRedeemed holds the default value on creation for the "redeemed" field.
+	DefaultRedeemed int
 	// DefaultAnalytics holds the default value on creation for the "analytics" field.
 	DefaultAnalytics bool
+	// DefaultAnalyticsCountsOnly holds the default value on creation for the "analytics_counts_only" field.
+	DefaultAnalyticsCountsOnly bool
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
 )
 
+// VisitorKey defines the type for the "visitor_key" enum field.
+type VisitorKey string
+
+// VisitorKeyCookie is the default value of the VisitorKey enum.
+const DefaultVisitorKey = VisitorKeyCookie
+
+// VisitorKey values.
+const (
+	VisitorKeyCookie      VisitorKey = "cookie"
+	VisitorKeyFingerprint VisitorKey = "fingerprint"
+)
+
+func (vk VisitorKey) String() string {
+	return string(vk)
+}
+
+// VisitorKeyValidator is a validator for the "visitor_key" field enum values. It is called by the builders before save.
+func VisitorKeyValidator(vk VisitorKey) error {
+	switch vk {
+	case VisitorKeyCookie, VisitorKeyFingerprint:
+		return nil
+	default:
+		return fmt.Errorf("qrcode: invalid enum value for visitor_key field: %q", vk)
+	}
+}
+
+// Signing defines the type for the "signing" enum field.
+type Signing string
+
+// Signing values.
+const (
+	SigningURL      Signing = "url"
+	SigningEmbedded Signing = "embedded"
+)
+
+func (s Signing) String() string {
+	return string(s)
+}
+
+// SigningValidator is a validator for the "signing" field enum values. It is called by the builders before save.
+func SigningValidator(s Signing) error {
+	switch s {
+	case SigningURL, SigningEmbedded:
+		return nil
+	default:
+		return fmt.Errorf("qrcode: invalid enum value for signing field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the QRCode queries.
 type OrderOption func(*sql.Selector)
 
@@ -167,11 +293,71 @@ func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByFallbackURL orders the results by the fallback_url field.
+func ByFallbackURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackURL, opts...).ToFunc()
+}
+
+// ByFallbackMessage orders the results by the fallback_message field.
+func ByFallbackMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackMessage, opts...).ToFunc()
+}
+
+// ByMaxScans orders the results by the max_scans field.
+func ByMaxScans(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxScans, opts...).ToFunc()
+}
+
+// ByScansPerVisitor orders the results by the scans_per_visitor field.
+func ByScansPerVisitor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScansPerVisitor, opts...).ToFunc()
+}
+
+// ByVisitorKey orders the results by the visitor_key field.
+func ByVisitorKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisitorKey, opts...).ToFunc()
+}
+
+// ByRedeemed orders the results by the redeemed field.
+func ByRedeemed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemed, opts...).ToFunc()
+}
+
+// ByEndedMessage orders the results by the ended_message field.
+func ByEndedMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedMessage, opts...).ToFunc()
+}
+
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// BySigning orders the results by the signing field.
+func BySigning(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigning, opts...).ToFunc()
+}
+
+// BySignedToken orders the results by the signed_token field.
+func BySignedToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignedToken, opts...).ToFunc()
+}
+
 // ByAnalytics orders the results by the analytics field.
 func ByAnalytics(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnalytics, opts...).ToFunc()
 }
 
+// ByAnalyticsCountsOnly orders the results by the analytics_counts_only field.
+func ByAnalyticsCountsOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnalyticsCountsOnly, opts...).ToFunc()
+}
+
 // ByActive orders the results by the active field.
 func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
@@ -182,6 +368,16 @@ func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
 }
 
+// ByGs1Key orders the results by the gs1_key field.
+func ByGs1Key(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGs1Key, opts...).ToFunc()
+}
+
+// ByImportKey orders the results by the import_key field.
+func ByImportKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportKey, opts...).ToFunc()
+}
+
 // ByFileRefsCount orders the results by file_refs count.
 func ByFileRefsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -216,6 +412,48 @@ func ByAnalyticsRecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newAnalyticsRecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDailyAnalyticsCount orders the results by daily_analytics count.
+func ByDailyAnalyticsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDailyAnalyticsStep(), opts...)
+	}
+}
+
+// ByDailyAnalytics orders the results by daily_analytics terms.
+func ByDailyAnalytics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDailyAnalyticsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRedemptionsCount orders the results by redemptions count.
+func ByRedemptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRedemptionsStep(), opts...)
+	}
+}
+
+// ByRedemptions orders the results by redemptions terms.
+func ByRedemptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRedemptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFileRefsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -237,3 +475,24 @@ func newAnalyticsRecordsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AnalyticsRecordsTable, AnalyticsRecordsColumn),
 	)
 }
+func newDailyAnalyticsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DailyAnalyticsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DailyAnalyticsTable, DailyAnalyticsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
+func newRedemptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RedemptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+	)
+}
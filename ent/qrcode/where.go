@@ -95,11 +95,61 @@ func ExpiresAt(v time.Time) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// FallbackURL applies equality check predicate on the "fallback_url" field. It's identical to FallbackURLEQ.
+func FallbackURL(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldFallbackURL, v))
+}
+
+// FallbackMessage applies equality check predicate on the "fallback_message" field. It's identical to FallbackMessageEQ.
+func FallbackMessage(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldFallbackMessage, v))
+}
+
+// MaxScans applies equality check predicate on the "max_scans" field. It's identical to MaxScansEQ.
+func MaxScans(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldMaxScans, v))
+}
+
+// ScansPerVisitor applies equality check predicate on the "scans_per_visitor" field. It's identical to ScansPerVisitorEQ.
+func ScansPerVisitor(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldScansPerVisitor, v))
+}
+
+// Redeemed applies equality check predicate on the "redeemed" field. It's identical to RedeemedEQ.
+func Redeemed(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldRedeemed, v))
+}
+
+// EndedMessage applies equality check predicate on the "ended_message" field. It's identical to EndedMessageEQ.
+func EndedMessage(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldEndedMessage, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// SignedToken applies equality check predicate on the "signed_token" field. It's identical to SignedTokenEQ.
+func SignedToken(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldSignedToken, v))
+}
+
 // Analytics applies equality check predicate on the "analytics" field. It's identical to AnalyticsEQ.
 func Analytics(v bool) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldAnalytics, v))
 }
 
+// AnalyticsCountsOnly applies equality check predicate on the "analytics_counts_only" field. It's identical to AnalyticsCountsOnlyEQ.
+func AnalyticsCountsOnly(v bool) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldAnalyticsCountsOnly, v))
+}
+
 // Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
 func Active(v bool) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldActive, v))
@@ -110,6 +160,16 @@ func GroupID(v int) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldGroupID, v))
 }
 
+// Gs1Key applies equality check predicate on the "gs1_key" field. It's identical to Gs1KeyEQ.
+func Gs1Key(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldGs1Key, v))
+}
+
+// ImportKey applies equality check predicate on the "import_key" field. It's identical to ImportKeyEQ.
+func ImportKey(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldImportKey, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v string) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldType, v))
@@ -595,6 +655,631 @@ func ExpiresAtNotNil() predicate.QRCode {
 	return predicate.QRCode(sql.FieldNotNull(FieldExpiresAt))
 }
 
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldStartsAt))
+}
+
+// ScheduleIsNil applies the IsNil predicate on the "schedule" field.
+func ScheduleIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldSchedule))
+}
+
+// ScheduleNotNil applies the NotNil predicate on the "schedule" field.
+func ScheduleNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldSchedule))
+}
+
+// FallbackURLEQ applies the EQ predicate on the "fallback_url" field.
+func FallbackURLEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldFallbackURL, v))
+}
+
+// FallbackURLNEQ applies the NEQ predicate on the "fallback_url" field.
+func FallbackURLNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldFallbackURL, v))
+}
+
+// FallbackURLIn applies the In predicate on the "fallback_url" field.
+func FallbackURLIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldFallbackURL, vs...))
+}
+
+// FallbackURLNotIn applies the NotIn predicate on the "fallback_url" field.
+func FallbackURLNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldFallbackURL, vs...))
+}
+
+// FallbackURLGT applies the GT predicate on the "fallback_url" field.
+func FallbackURLGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldFallbackURL, v))
+}
+
+// FallbackURLGTE applies the GTE predicate on the "fallback_url" field.
+func FallbackURLGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldFallbackURL, v))
+}
+
+// FallbackURLLT applies the LT predicate on the "fallback_url" field.
+func FallbackURLLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldFallbackURL, v))
+}
+
+// FallbackURLLTE applies the LTE predicate on the "fallback_url" field.
+func FallbackURLLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldFallbackURL, v))
+}
+
+// FallbackURLContains applies the Contains predicate on the "fallback_url" field.
+func FallbackURLContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldFallbackURL, v))
+}
+
+// FallbackURLHasPrefix applies the HasPrefix predicate on the "fallback_url" field.
+func FallbackURLHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldFallbackURL, v))
+}
+
+// FallbackURLHasSuffix applies the HasSuffix predicate on the "fallback_url" field.
+func FallbackURLHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldFallbackURL, v))
+}
+
+// FallbackURLIsNil applies the IsNil predicate on the "fallback_url" field.
+func FallbackURLIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldFallbackURL))
+}
+
+// FallbackURLNotNil applies the NotNil predicate on the "fallback_url" field.
+func FallbackURLNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldFallbackURL))
+}
+
+// FallbackURLEqualFold applies the EqualFold predicate on the "fallback_url" field.
+func FallbackURLEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldFallbackURL, v))
+}
+
+// FallbackURLContainsFold applies the ContainsFold predicate on the "fallback_url" field.
+func FallbackURLContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldFallbackURL, v))
+}
+
+// FallbackMessageEQ applies the EQ predicate on the "fallback_message" field.
+func FallbackMessageEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldFallbackMessage, v))
+}
+
+// FallbackMessageNEQ applies the NEQ predicate on the "fallback_message" field.
+func FallbackMessageNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldFallbackMessage, v))
+}
+
+// FallbackMessageIn applies the In predicate on the "fallback_message" field.
+func FallbackMessageIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldFallbackMessage, vs...))
+}
+
+// FallbackMessageNotIn applies the NotIn predicate on the "fallback_message" field.
+func FallbackMessageNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldFallbackMessage, vs...))
+}
+
+// FallbackMessageGT applies the GT predicate on the "fallback_message" field.
+func FallbackMessageGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldFallbackMessage, v))
+}
+
+// FallbackMessageGTE applies the GTE predicate on the "fallback_message" field.
+func FallbackMessageGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldFallbackMessage, v))
+}
+
+// FallbackMessageLT applies the LT predicate on the "fallback_message" field.
+func FallbackMessageLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldFallbackMessage, v))
+}
+
+// FallbackMessageLTE applies the LTE predicate on the "fallback_message" field.
+func FallbackMessageLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldFallbackMessage, v))
+}
+
+// FallbackMessageContains applies the Contains predicate on the "fallback_message" field.
+func FallbackMessageContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldFallbackMessage, v))
+}
+
+// FallbackMessageHasPrefix applies the HasPrefix predicate on the "fallback_message" field.
+func FallbackMessageHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldFallbackMessage, v))
+}
+
+// FallbackMessageHasSuffix applies the HasSuffix predicate on the "fallback_message" field.
+func FallbackMessageHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldFallbackMessage, v))
+}
+
+// FallbackMessageIsNil applies the IsNil predicate on the "fallback_message" field.
+func FallbackMessageIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldFallbackMessage))
+}
+
+// FallbackMessageNotNil applies the NotNil predicate on the "fallback_message" field.
+func FallbackMessageNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldFallbackMessage))
+}
+
+// FallbackMessageEqualFold applies the EqualFold predicate on the "fallback_message" field.
+func FallbackMessageEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldFallbackMessage, v))
+}
+
+// FallbackMessageContainsFold applies the ContainsFold predicate on the "fallback_message" field.
+func FallbackMessageContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldFallbackMessage, v))
+}
+
+// MaxScansEQ applies the EQ predicate on the "max_scans" field.
+func MaxScansEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldMaxScans, v))
+}
+
+// MaxScansNEQ applies the NEQ predicate on the "max_scans" field.
+func MaxScansNEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldMaxScans, v))
+}
+
+// MaxScansIn applies the In predicate on the "max_scans" field.
+func MaxScansIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldMaxScans, vs...))
+}
+
+// MaxScansNotIn applies the NotIn predicate on the "max_scans" field.
+func MaxScansNotIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldMaxScans, vs...))
+}
+
+// MaxScansGT applies the GT predicate on the "max_scans" field.
+func MaxScansGT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldMaxScans, v))
+}
+
+// MaxScansGTE applies the GTE predicate on the "max_scans" field.
+func MaxScansGTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldMaxScans, v))
+}
+
+// MaxScansLT applies the LT predicate on the "max_scans" field.
+func MaxScansLT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldMaxScans, v))
+}
+
+// MaxScansLTE applies the LTE predicate on the "max_scans" field.
+func MaxScansLTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldMaxScans, v))
+}
+
+// MaxScansIsNil applies the IsNil predicate on the "max_scans" field.
+func MaxScansIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldMaxScans))
+}
+
+// MaxScansNotNil applies the NotNil predicate on the "max_scans" field.
+func MaxScansNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldMaxScans))
+}
+
+// ScansPerVisitorEQ applies the EQ predicate on the "scans_per_visitor" field.
+func ScansPerVisitorEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorNEQ applies the NEQ predicate on the "scans_per_visitor" field.
+func ScansPerVisitorNEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorIn applies the In predicate on the "scans_per_visitor" field.
+func ScansPerVisitorIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldScansPerVisitor, vs...))
+}
+
+// ScansPerVisitorNotIn applies the NotIn predicate on the "scans_per_visitor" field.
+func ScansPerVisitorNotIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldScansPerVisitor, vs...))
+}
+
+// ScansPerVisitorGT applies the GT predicate on the "scans_per_visitor" field.
+func ScansPerVisitorGT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorGTE applies the GTE predicate on the "scans_per_visitor" field.
+func ScansPerVisitorGTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorLT applies the LT predicate on the "scans_per_visitor" field.
+func ScansPerVisitorLT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorLTE applies the LTE predicate on the "scans_per_visitor" field.
+func ScansPerVisitorLTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldScansPerVisitor, v))
+}
+
+// ScansPerVisitorIsNil applies the IsNil predicate on the "scans_per_visitor" field.
+func ScansPerVisitorIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldScansPerVisitor))
+}
+
+// ScansPerVisitorNotNil applies the NotNil predicate on the "scans_per_visitor" field.
+func ScansPerVisitorNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldScansPerVisitor))
+}
+
+// VisitorKeyEQ applies the EQ predicate on the "visitor_key" field.
+func VisitorKeyEQ(v VisitorKey) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldVisitorKey, v))
+}
+
+// VisitorKeyNEQ applies the NEQ predicate on the "visitor_key" field.
+func VisitorKeyNEQ(v VisitorKey) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldVisitorKey, v))
+}
+
+// VisitorKeyIn applies the In predicate on the "visitor_key" field.
+func VisitorKeyIn(vs ...VisitorKey) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldVisitorKey, vs...))
+}
+
+// VisitorKeyNotIn applies the NotIn predicate on the "visitor_key" field.
+func VisitorKeyNotIn(vs ...VisitorKey) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldVisitorKey, vs...))
+}
+
+// RedeemedEQ applies the EQ predicate on the "redeemed" field.
+func RedeemedEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldRedeemed, v))
+}
+
+// RedeemedNEQ applies the NEQ predicate on the "redeemed" field.
+func RedeemedNEQ(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldRedeemed, v))
+}
+
+// RedeemedIn applies the In predicate on the "redeemed" field.
+func RedeemedIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldRedeemed, vs...))
+}
+
+// RedeemedNotIn applies the NotIn predicate on the "redeemed" field.
+func RedeemedNotIn(vs ...int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldRedeemed, vs...))
+}
+
+// RedeemedGT applies the GT predicate on the "redeemed" field.
+func RedeemedGT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldRedeemed, v))
+}
+
+// RedeemedGTE applies the GTE predicate on the "redeemed" field.
+func RedeemedGTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldRedeemed, v))
+}
+
+// RedeemedLT applies the LT predicate on the "redeemed" field.
+func RedeemedLT(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldRedeemed, v))
+}
+
+// RedeemedLTE applies the LTE predicate on the "redeemed" field.
+func RedeemedLTE(v int) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldRedeemed, v))
+}
+
+// EndedMessageEQ applies the EQ predicate on the "ended_message" field.
+func EndedMessageEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldEndedMessage, v))
+}
+
+// EndedMessageNEQ applies the NEQ predicate on the "ended_message" field.
+func EndedMessageNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldEndedMessage, v))
+}
+
+// EndedMessageIn applies the In predicate on the "ended_message" field.
+func EndedMessageIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldEndedMessage, vs...))
+}
+
+// EndedMessageNotIn applies the NotIn predicate on the "ended_message" field.
+func EndedMessageNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldEndedMessage, vs...))
+}
+
+// EndedMessageGT applies the GT predicate on the "ended_message" field.
+func EndedMessageGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldEndedMessage, v))
+}
+
+// EndedMessageGTE applies the GTE predicate on the "ended_message" field.
+func EndedMessageGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldEndedMessage, v))
+}
+
+// EndedMessageLT applies the LT predicate on the "ended_message" field.
+func EndedMessageLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldEndedMessage, v))
+}
+
+// EndedMessageLTE applies the LTE predicate on the "ended_message" field.
+func EndedMessageLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldEndedMessage, v))
+}
+
+// EndedMessageContains applies the Contains predicate on the "ended_message" field.
+func EndedMessageContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldEndedMessage, v))
+}
+
+// EndedMessageHasPrefix applies the HasPrefix predicate on the "ended_message" field.
+func EndedMessageHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldEndedMessage, v))
+}
+
+// EndedMessageHasSuffix applies the HasSuffix predicate on the "ended_message" field.
+func EndedMessageHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldEndedMessage, v))
+}
+
+// EndedMessageIsNil applies the IsNil predicate on the "ended_message" field.
+func EndedMessageIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldEndedMessage))
+}
+
+// EndedMessageNotNil applies the NotNil predicate on the "ended_message" field.
+func EndedMessageNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldEndedMessage))
+}
+
+// EndedMessageEqualFold applies the EqualFold predicate on the "ended_message" field.
+func EndedMessageEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldEndedMessage, v))
+}
+
+// EndedMessageContainsFold applies the ContainsFold predicate on the "ended_message" field.
+func EndedMessageContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldEndedMessage, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashIsNil applies the IsNil predicate on the "password_hash" field.
+func PasswordHashIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldPasswordHash))
+}
+
+// PasswordHashNotNil applies the NotNil predicate on the "password_hash" field.
+func PasswordHashNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldPasswordHash))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// SigningEQ applies the EQ predicate on the "signing" field.
+func SigningEQ(v Signing) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldSigning, v))
+}
+
+// SigningNEQ applies the NEQ predicate on the "signing" field.
+func SigningNEQ(v Signing) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldSigning, v))
+}
+
+// SigningIn applies the In predicate on the "signing" field.
+func SigningIn(vs ...Signing) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldSigning, vs...))
+}
+
+// SigningNotIn applies the NotIn predicate on the "signing" field.
+func SigningNotIn(vs ...Signing) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldSigning, vs...))
+}
+
+// SigningIsNil applies the IsNil predicate on the "signing" field.
+func SigningIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldSigning))
+}
+
+// SigningNotNil applies the NotNil predicate on the "signing" field.
+func SigningNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldSigning))
+}
+
+// SignedTokenEQ applies the EQ predicate on the "signed_token" field.
+func SignedTokenEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldSignedToken, v))
+}
+
+// SignedTokenNEQ applies the NEQ predicate on the "signed_token" field.
+func SignedTokenNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldSignedToken, v))
+}
+
+// SignedTokenIn applies the In predicate on the "signed_token" field.
+func SignedTokenIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldSignedToken, vs...))
+}
+
+// SignedTokenNotIn applies the NotIn predicate on the "signed_token" field.
+func SignedTokenNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldSignedToken, vs...))
+}
+
+// SignedTokenGT applies the GT predicate on the "signed_token" field.
+func SignedTokenGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldSignedToken, v))
+}
+
+// SignedTokenGTE applies the GTE predicate on the "signed_token" field.
+func SignedTokenGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldSignedToken, v))
+}
+
+// SignedTokenLT applies the LT predicate on the "signed_token" field.
+func SignedTokenLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldSignedToken, v))
+}
+
+// SignedTokenLTE applies the LTE predicate on the "signed_token" field.
+func SignedTokenLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldSignedToken, v))
+}
+
+// SignedTokenContains applies the Contains predicate on the "signed_token" field.
+func SignedTokenContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldSignedToken, v))
+}
+
+// SignedTokenHasPrefix applies the HasPrefix predicate on the "signed_token" field.
+func SignedTokenHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldSignedToken, v))
+}
+
+// SignedTokenHasSuffix applies the HasSuffix predicate on the "signed_token" field.
+func SignedTokenHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldSignedToken, v))
+}
+
+// SignedTokenIsNil applies the IsNil predicate on the "signed_token" field.
+func SignedTokenIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldSignedToken))
+}
+
+// SignedTokenNotNil applies the NotNil predicate on the "signed_token" field.
+func SignedTokenNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldSignedToken))
+}
+
+// SignedTokenEqualFold applies the EqualFold predicate on the "signed_token" field.
+func SignedTokenEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldSignedToken, v))
+}
+
+// SignedTokenContainsFold applies the ContainsFold predicate on the "signed_token" field.
+func SignedTokenContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldSignedToken, v))
+}
+
 // AnalyticsEQ applies the EQ predicate on the "analytics" field.
 func AnalyticsEQ(v bool) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldAnalytics, v))
@@ -605,6 +1290,16 @@ func AnalyticsNEQ(v bool) predicate.QRCode {
 	return predicate.QRCode(sql.FieldNEQ(FieldAnalytics, v))
 }
 
+// AnalyticsCountsOnlyEQ applies the EQ predicate on the "analytics_counts_only" field.
+func AnalyticsCountsOnlyEQ(v bool) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldAnalyticsCountsOnly, v))
+}
+
+// AnalyticsCountsOnlyNEQ applies the NEQ predicate on the "analytics_counts_only" field.
+func AnalyticsCountsOnlyNEQ(v bool) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldAnalyticsCountsOnly, v))
+}
+
 // ActiveEQ applies the EQ predicate on the "active" field.
 func ActiveEQ(v bool) predicate.QRCode {
 	return predicate.QRCode(sql.FieldEQ(FieldActive, v))
@@ -665,6 +1360,156 @@ func GroupIDNotNil() predicate.QRCode {
 	return predicate.QRCode(sql.FieldNotNull(FieldGroupID))
 }
 
+// Gs1KeyEQ applies the EQ predicate on the "gs1_key" field.
+func Gs1KeyEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldGs1Key, v))
+}
+
+// Gs1KeyNEQ applies the NEQ predicate on the "gs1_key" field.
+func Gs1KeyNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldGs1Key, v))
+}
+
+// Gs1KeyIn applies the In predicate on the "gs1_key" field.
+func Gs1KeyIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldGs1Key, vs...))
+}
+
+// Gs1KeyNotIn applies the NotIn predicate on the "gs1_key" field.
+func Gs1KeyNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldGs1Key, vs...))
+}
+
+// Gs1KeyGT applies the GT predicate on the "gs1_key" field.
+func Gs1KeyGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldGs1Key, v))
+}
+
+// Gs1KeyGTE applies the GTE predicate on the "gs1_key" field.
+func Gs1KeyGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldGs1Key, v))
+}
+
+// Gs1KeyLT applies the LT predicate on the "gs1_key" field.
+func Gs1KeyLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldGs1Key, v))
+}
+
+// Gs1KeyLTE applies the LTE predicate on the "gs1_key" field.
+func Gs1KeyLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldGs1Key, v))
+}
+
+// Gs1KeyContains applies the Contains predicate on the "gs1_key" field.
+func Gs1KeyContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldGs1Key, v))
+}
+
+// Gs1KeyHasPrefix applies the HasPrefix predicate on the "gs1_key" field.
+func Gs1KeyHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldGs1Key, v))
+}
+
+// Gs1KeyHasSuffix applies the HasSuffix predicate on the "gs1_key" field.
+func Gs1KeyHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldGs1Key, v))
+}
+
+// Gs1KeyIsNil applies the IsNil predicate on the "gs1_key" field.
+func Gs1KeyIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldGs1Key))
+}
+
+// Gs1KeyNotNil applies the NotNil predicate on the "gs1_key" field.
+func Gs1KeyNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldGs1Key))
+}
+
+// Gs1KeyEqualFold applies the EqualFold predicate on the "gs1_key" field.
+func Gs1KeyEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldGs1Key, v))
+}
+
+// Gs1KeyContainsFold applies the ContainsFold predicate on the "gs1_key" field.
+func Gs1KeyContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldGs1Key, v))
+}
+
+// ImportKeyEQ applies the EQ predicate on the "import_key" field.
+func ImportKeyEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEQ(FieldImportKey, v))
+}
+
+// ImportKeyNEQ applies the NEQ predicate on the "import_key" field.
+func ImportKeyNEQ(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNEQ(FieldImportKey, v))
+}
+
+// ImportKeyIn applies the In predicate on the "import_key" field.
+func ImportKeyIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldIn(FieldImportKey, vs...))
+}
+
+// ImportKeyNotIn applies the NotIn predicate on the "import_key" field.
+func ImportKeyNotIn(vs ...string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotIn(FieldImportKey, vs...))
+}
+
+// ImportKeyGT applies the GT predicate on the "import_key" field.
+func ImportKeyGT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGT(FieldImportKey, v))
+}
+
+// ImportKeyGTE applies the GTE predicate on the "import_key" field.
+func ImportKeyGTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldGTE(FieldImportKey, v))
+}
+
+// ImportKeyLT applies the LT predicate on the "import_key" field.
+func ImportKeyLT(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLT(FieldImportKey, v))
+}
+
+// ImportKeyLTE applies the LTE predicate on the "import_key" field.
+func ImportKeyLTE(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldLTE(FieldImportKey, v))
+}
+
+// ImportKeyContains applies the Contains predicate on the "import_key" field.
+func ImportKeyContains(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContains(FieldImportKey, v))
+}
+
+// ImportKeyHasPrefix applies the HasPrefix predicate on the "import_key" field.
+func ImportKeyHasPrefix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasPrefix(FieldImportKey, v))
+}
+
+// ImportKeyHasSuffix applies the HasSuffix predicate on the "import_key" field.
+func ImportKeyHasSuffix(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldHasSuffix(FieldImportKey, v))
+}
+
+// ImportKeyIsNil applies the IsNil predicate on the "import_key" field.
+func ImportKeyIsNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldIsNull(FieldImportKey))
+}
+
+// ImportKeyNotNil applies the NotNil predicate on the "import_key" field.
+func ImportKeyNotNil() predicate.QRCode {
+	return predicate.QRCode(sql.FieldNotNull(FieldImportKey))
+}
+
+// ImportKeyEqualFold applies the EqualFold predicate on the "import_key" field.
+func ImportKeyEqualFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldEqualFold(FieldImportKey, v))
+}
+
+// ImportKeyContainsFold applies the ContainsFold predicate on the "import_key" field.
+func ImportKeyContainsFold(v string) predicate.QRCode {
+	return predicate.QRCode(sql.FieldContainsFold(FieldImportKey, v))
+}
+
 // HasFileRefs applies the HasEdge predicate on the "file_refs" edge.
 func HasFileRefs() predicate.QRCode {
 	return predicate.QRCode(func(s *sql.Selector) {
@@ -734,6 +1579,75 @@ func HasAnalyticsRecordsWith(preds ...predicate.QRCodeAnalytics) predicate.QRCod
 	})
 }
 
+// HasDailyAnalytics applies the HasEdge predicate on the "daily_analytics" edge.
+func HasDailyAnalytics() predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DailyAnalyticsTable, DailyAnalyticsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDailyAnalyticsWith applies the HasEdge predicate on the "daily_analytics" edge with a given conditions (other predicates).
+func HasDailyAnalyticsWith(preds ...predicate.QRCodeAnalyticsDaily) predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := newDailyAnalyticsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRedemptions applies the HasEdge predicate on the "redemptions" edge.
+func HasRedemptions() predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRedemptionsWith applies the HasEdge predicate on the "redemptions" edge with a given conditions (other predicates).
+func HasRedemptionsWith(preds ...predicate.QRCodeRedemption) predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		step := newRedemptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.QRCode) predicate.QRCode {
 	return predicate.QRCode(sql.AndPredicates(predicates...))
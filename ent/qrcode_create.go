@@ -9,7 +9,11 @@ import (
 	"qr_backend/ent/filereference"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/webhook"
+	"qr_backend/pkg/schedule"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -125,6 +129,166 @@ func (qcc *QRCodeCreate) SetNillableExpiresAt(t *time.Time) *QRCodeCreate {
 	return qcc
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (qcc *QRCodeCreate) SetStartsAt(t time.Time) *QRCodeCreate {
+	qcc.mutation.SetStartsAt(t)
+	return qcc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableStartsAt(t *time.Time) *QRCodeCreate {
+	if t != nil {
+		qcc.SetStartsAt(*t)
+	}
+	return qcc
+}
+
+// SetSchedule sets the "schedule" field.
+func (qcc *QRCodeCreate) SetSchedule(s *schedule.Schedule) *QRCodeCreate {
+	qcc.mutation.SetSchedule(s)
+	return qcc
+}
+
+// SetFallbackURL sets the "fallback_url" field.
+func (qcc *QRCodeCreate) SetFallbackURL(s string) *QRCodeCreate {
+	qcc.mutation.SetFallbackURL(s)
+	return qcc
+}
+
+// SetNillableFallbackURL sets the "fallback_url" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableFallbackURL(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetFallbackURL(*s)
+	}
+	return qcc
+}
+
+// SetFallbackMessage sets the "fallback_message" field.
+func (qcc *QRCodeCreate) SetFallbackMessage(s string) *QRCodeCreate {
+	qcc.mutation.SetFallbackMessage(s)
+	return qcc
+}
+
+// SetNillableFallbackMessage sets the "fallback_message" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableFallbackMessage(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetFallbackMessage(*s)
+	}
+	return qcc
+}
+
+// SetMaxScans sets the "max_scans" field.
+func (qcc *QRCodeCreate) SetMaxScans(i int) *QRCodeCreate {
+	qcc.mutation.SetMaxScans(i)
+	return qcc
+}
+
+// SetNillableMaxScans sets the "max_scans" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableMaxScans(i *int) *QRCodeCreate {
+	if i != nil {
+		qcc.SetMaxScans(*i)
+	}
+	return qcc
+}
+
+// SetScansPerVisitor sets the "scans_per_visitor" field.
+func (qcc *QRCodeCreate) SetScansPerVisitor(i int) *QRCodeCreate {
+	qcc.mutation.SetScansPerVisitor(i)
+	return qcc
+}
+
+// SetNillableScansPerVisitor sets the "scans_per_visitor" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableScansPerVisitor(i *int) *QRCodeCreate {
+	if i != nil {
+		qcc.SetScansPerVisitor(*i)
+	}
+	return qcc
+}
+
+// SetVisitorKey sets the "visitor_key" field.
+func (qcc *QRCodeCreate) SetVisitorKey(qk qrcode.VisitorKey) *QRCodeCreate {
+	qcc.mutation.SetVisitorKey(qk)
+	return qcc
+}
+
+// SetNillableVisitorKey sets the "visitor_key" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableVisitorKey(qk *qrcode.VisitorKey) *QRCodeCreate {
+	if qk != nil {
+		qcc.SetVisitorKey(*qk)
+	}
+	return qcc
+}
+
+// SetRedeemed sets the "redeemed" field.
+func (qcc *QRCodeCreate) SetRedeemed(i int) *QRCodeCreate {
+	qcc.mutation.SetRedeemed(i)
+	return qcc
+}
+
+// SetNillableRedeemed sets the "redeemed" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableRedeemed(i *int) *QRCodeCreate {
+	if i != nil {
+		qcc.SetRedeemed(*i)
+	}
+	return qcc
+}
+
+// SetEndedMessage sets the "ended_message" field.
+func (qcc *QRCodeCreate) SetEndedMessage(s string) *QRCodeCreate {
+	qcc.mutation.SetEndedMessage(s)
+	return qcc
+}
+
+// SetNillableEndedMessage sets the "ended_message" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableEndedMessage(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetEndedMessage(*s)
+	}
+	return qcc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (qcc *QRCodeCreate) SetPasswordHash(s string) *QRCodeCreate {
+	qcc.mutation.SetPasswordHash(s)
+	return qcc
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillablePasswordHash(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetPasswordHash(*s)
+	}
+	return qcc
+}
+
+// SetSigning sets the "signing" field.
+func (qcc *QRCodeCreate) SetSigning(q qrcode.Signing) *QRCodeCreate {
+	qcc.mutation.SetSigning(q)
+	return qcc
+}
+
+// SetNillableSigning sets the "signing" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableSigning(q *qrcode.Signing) *QRCodeCreate {
+	if q != nil {
+		qcc.SetSigning(*q)
+	}
+	return qcc
+}
+
+// SetSignedToken sets the "signed_token" field.
+func (qcc *QRCodeCreate) SetSignedToken(s string) *QRCodeCreate {
+	qcc.mutation.SetSignedToken(s)
+	return qcc
+}
+
+// SetNillableSignedToken sets the "signed_token" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableSignedToken(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetSignedToken(*s)
+	}
+	return qcc
+}
+
 // SetAnalytics sets the "analytics" field.
 func (qcc *QRCodeCreate) SetAnalytics(b bool) *QRCodeCreate {
 	qcc.mutation.SetAnalytics(b)
@@ -139,6 +303,20 @@ func (qcc *QRCodeCreate) SetNillableAnalytics(b *bool) *QRCodeCreate {
 	return qcc
 }
 
+// SetAnalyticsCountsOnly sets the "analytics_counts_only" field.
+func (qcc *QRCodeCreate) SetAnalyticsCountsOnly(b bool) *QRCodeCreate {
+	qcc.mutation.SetAnalyticsCountsOnly(b)
+	return qcc
+}
+
+// SetNillableAnalyticsCountsOnly sets the "analytics_counts_only" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableAnalyticsCountsOnly(b *bool) *QRCodeCreate {
+	if b != nil {
+		qcc.SetAnalyticsCountsOnly(*b)
+	}
+	return qcc
+}
+
 // SetActive sets the "active" field.
 func (qcc *QRCodeCreate) SetActive(b bool) *QRCodeCreate {
 	qcc.mutation.SetActive(b)
@@ -179,6 +357,34 @@ func (qcc *QRCodeCreate) SetNillableGroupID(i *int) *QRCodeCreate {
 	return qcc
 }
 
+// SetGs1Key sets the "gs1_key" field.
+func (qcc *QRCodeCreate) SetGs1Key(s string) *QRCodeCreate {
+	qcc.mutation.SetGs1Key(s)
+	return qcc
+}
+
+// SetNillableGs1Key sets the "gs1_key" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableGs1Key(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetGs1Key(*s)
+	}
+	return qcc
+}
+
+// SetImportKey sets the "import_key" field.
+func (qcc *QRCodeCreate) SetImportKey(s string) *QRCodeCreate {
+	qcc.mutation.SetImportKey(s)
+	return qcc
+}
+
+// SetNillableImportKey sets the "import_key" field if the given value is not nil.
+func (qcc *QRCodeCreate) SetNillableImportKey(s *string) *QRCodeCreate {
+	if s != nil {
+		qcc.SetImportKey(*s)
+	}
+	return qcc
+}
+
 // AddFileRefIDs adds the "file_refs" edge to the FileReference entity by IDs.
 func (qcc *QRCodeCreate) AddFileRefIDs(ids ...int) *QRCodeCreate {
 	qcc.mutation.AddFileRefIDs(ids...)
@@ -214,6 +420,51 @@ func (qcc *QRCodeCreate) AddAnalyticsRecords(q ...*QRCodeAnalytics) *QRCodeCreat
 	return qcc.AddAnalyticsRecordIDs(ids...)
 }
 
+// AddDailyAnalyticIDs adds the "daily_analytics" edge to the QRCodeAnalyticsDaily entity by IDs.
+func (qcc *QRCodeCreate) AddDailyAnalyticIDs(ids ...int) *QRCodeCreate {
+	qcc.mutation.AddDailyAnalyticIDs(ids...)
+	return qcc
+}
+
+// AddDailyAnalytics adds the "daily_analytics" edges to the QRCodeAnalyticsDaily entity.
+func (qcc *QRCodeCreate) AddDailyAnalytics(q ...*QRCodeAnalyticsDaily) *QRCodeCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcc.AddDailyAnalyticIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (qcc *QRCodeCreate) AddWebhookIDs(ids ...int) *QRCodeCreate {
+	qcc.mutation.AddWebhookIDs(ids...)
+	return qcc
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (qcc *QRCodeCreate) AddWebhooks(w ...*Webhook) *QRCodeCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return qcc.AddWebhookIDs(ids...)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the QRCodeRedemption entity by IDs.
+func (qcc *QRCodeCreate) AddRedemptionIDs(ids ...int) *QRCodeCreate {
+	qcc.mutation.AddRedemptionIDs(ids...)
+	return qcc
+}
+
+// AddRedemptions adds the "redemptions" edges to the QRCodeRedemption entity.
+func (qcc *QRCodeCreate) AddRedemptions(q ...*QRCodeRedemption) *QRCodeCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcc.AddRedemptionIDs(ids...)
+}
+
 // Mutation returns the QRCodeMutation object of the builder.
 func (qcc *QRCodeCreate) Mutation() *QRCodeMutation {
 	return qcc.mutation
@@ -257,10 +508,22 @@ func (qcc *QRCodeCreate) defaults() {
 		v := qrcode.DefaultUpdatedAt()
 		qcc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := qcc.mutation.VisitorKey(); !ok {
+		v := qrcode.DefaultVisitorKey
+		qcc.mutation.SetVisitorKey(v)
+	}
+	if _, ok := qcc.mutation.Redeemed(); !ok {
+		v := qrcode.DefaultRedeemed
+		qcc.mutation.SetRedeemed(v)
+	}
 	if _, ok := qcc.mutation.Analytics(); !ok {
 		v := qrcode.DefaultAnalytics
 		qcc.mutation.SetAnalytics(v)
 	}
+	if _, ok := qcc.mutation.AnalyticsCountsOnly(); !ok {
+		v := qrcode.DefaultAnalyticsCountsOnly
+		qcc.mutation.SetAnalyticsCountsOnly(v)
+	}
 	if _, ok := qcc.mutation.Active(); !ok {
 		v := qrcode.DefaultActive
 		qcc.mutation.SetActive(v)
@@ -294,9 +557,33 @@ func (qcc *QRCodeCreate) check() error {
 	if _, ok := qcc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QRCode.updated_at"`)}
 	}
+	if v, ok := qcc.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "QRCode.schedule": %w`, err)}
+		}
+	}
+	if _, ok := qcc.mutation.VisitorKey(); !ok {
+		return &ValidationError{Name: "visitor_key", err: errors.New(`ent: missing required field "QRCode.visitor_key"`)}
+	}
+	if v, ok := qcc.mutation.VisitorKey(); ok {
+		if err := qrcode.VisitorKeyValidator(v); err != nil {
+			return &ValidationError{Name: "visitor_key", err: fmt.Errorf(`ent: validator failed for field "QRCode.visitor_key": %w`, err)}
+		}
+	}
+	if _, ok := qcc.mutation.Redeemed(); !ok {
+		return &ValidationError{Name: "redeemed", err: errors.New(`ent: missing required field "QRCode.redeemed"`)}
+	}
+	if v, ok := qcc.mutation.Signing(); ok {
+		if err := qrcode.SigningValidator(v); err != nil {
+			return &ValidationError{Name: "signing", err: fmt.Errorf(`ent: validator failed for field "QRCode.signing": %w`, err)}
+		}
+	}
 	if _, ok := qcc.mutation.Analytics(); !ok {
 		return &ValidationError{Name: "analytics", err: errors.New(`ent: missing required field "QRCode.analytics"`)}
 	}
+	if _, ok := qcc.mutation.AnalyticsCountsOnly(); !ok {
+		return &ValidationError{Name: "analytics_counts_only", err: errors.New(`ent: missing required field "QRCode.analytics_counts_only"`)}
+	}
 	if _, ok := qcc.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "QRCode.active"`)}
 	}
@@ -362,10 +649,62 @@ func (qcc *QRCodeCreate) createSpec() (*QRCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(qrcode.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = &value
 	}
+	if value, ok := qcc.mutation.StartsAt(); ok {
+		_spec.SetField(qrcode.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := qcc.mutation.Schedule(); ok {
+		_spec.SetField(qrcode.FieldSchedule, field.TypeJSON, value)
+		_node.Schedule = value
+	}
+	if value, ok := qcc.mutation.FallbackURL(); ok {
+		_spec.SetField(qrcode.FieldFallbackURL, field.TypeString, value)
+		_node.FallbackURL = value
+	}
+	if value, ok := qcc.mutation.FallbackMessage(); ok {
+		_spec.SetField(qrcode.FieldFallbackMessage, field.TypeString, value)
+		_node.FallbackMessage = value
+	}
+	if value, ok := qcc.mutation.MaxScans(); ok {
+		_spec.SetField(qrcode.FieldMaxScans, field.TypeInt, value)
+		_node.MaxScans = &value
+	}
+	if value, ok := qcc.mutation.ScansPerVisitor(); ok {
+		_spec.SetField(qrcode.FieldScansPerVisitor, field.TypeInt, value)
+		_node.ScansPerVisitor = &value
+	}
+	if value, ok := qcc.mutation.VisitorKey(); ok {
+		_spec.SetField(qrcode.FieldVisitorKey, field.TypeEnum, value)
+		_node.VisitorKey = value
+	}
+	if value, ok := qcc.mutation.Redeemed(); ok {
+		_spec.SetField(qrcode.FieldRedeemed, field.TypeInt, value)
+		_node.Redeemed = value
+	}
+	if value, ok := qcc.mutation.EndedMessage(); ok {
+		_spec.SetField(qrcode.FieldEndedMessage, field.TypeString, value)
+		_node.EndedMessage = value
+	}
+	if value, ok := qcc.mutation.PasswordHash(); ok {
+		_spec.SetField(qrcode.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := qcc.mutation.Signing(); ok {
+		_spec.SetField(qrcode.FieldSigning, field.TypeEnum, value)
+		_node.Signing = &value
+	}
+	if value, ok := qcc.mutation.SignedToken(); ok {
+		_spec.SetField(qrcode.FieldSignedToken, field.TypeString, value)
+		_node.SignedToken = value
+	}
 	if value, ok := qcc.mutation.Analytics(); ok {
 		_spec.SetField(qrcode.FieldAnalytics, field.TypeBool, value)
 		_node.Analytics = value
 	}
+	if value, ok := qcc.mutation.AnalyticsCountsOnly(); ok {
+		_spec.SetField(qrcode.FieldAnalyticsCountsOnly, field.TypeBool, value)
+		_node.AnalyticsCountsOnly = value
+	}
 	if value, ok := qcc.mutation.Active(); ok {
 		_spec.SetField(qrcode.FieldActive, field.TypeBool, value)
 		_node.Active = value
@@ -378,6 +717,14 @@ func (qcc *QRCodeCreate) createSpec() (*QRCode, *sqlgraph.CreateSpec) {
 		_spec.SetField(qrcode.FieldDesign, field.TypeJSON, value)
 		_node.Design = value
 	}
+	if value, ok := qcc.mutation.Gs1Key(); ok {
+		_spec.SetField(qrcode.FieldGs1Key, field.TypeString, value)
+		_node.Gs1Key = value
+	}
+	if value, ok := qcc.mutation.ImportKey(); ok {
+		_spec.SetField(qrcode.FieldImportKey, field.TypeString, value)
+		_node.ImportKey = value
+	}
 	if nodes := qcc.mutation.FileRefsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -427,6 +774,54 @@ func (qcc *QRCodeCreate) createSpec() (*QRCode, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qcc.mutation.DailyAnalyticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qcc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qcc.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,7 +11,10 @@ import (
 	"qr_backend/ent/predicate"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/webhook"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -29,6 +32,9 @@ type QRCodeQuery struct {
 	withFileRefs         *FileReferenceQuery
 	withGroup            *QRCodeGroupQuery
 	withAnalyticsRecords *QRCodeAnalyticsQuery
+	withDailyAnalytics   *QRCodeAnalyticsDailyQuery
+	withWebhooks         *WebhookQuery
+	withRedemptions      *QRCodeRedemptionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +137,72 @@ func (qcq *QRCodeQuery) QueryAnalyticsRecords() *QRCodeAnalyticsQuery {
 	return query
 }
 
+// QueryDailyAnalytics chains the current query on the "daily_analytics" edge.
+func (qcq *QRCodeQuery) QueryDailyAnalytics() *QRCodeAnalyticsDailyQuery {
+	query := (&QRCodeAnalyticsDailyClient{config: qcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, selector),
+			sqlgraph.To(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.DailyAnalyticsTable, qrcode.DailyAnalyticsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (qcq *QRCodeQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: qcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.WebhooksTable, qrcode.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRedemptions chains the current query on the "redemptions" edge.
+func (qcq *QRCodeQuery) QueryRedemptions() *QRCodeRedemptionQuery {
+	query := (&QRCodeRedemptionClient{config: qcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcode.Table, qrcode.FieldID, selector),
+			sqlgraph.To(qrcoderedemption.Table, qrcoderedemption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, qrcode.RedemptionsTable, qrcode.RedemptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first QRCode entity from the query.
 // Returns a *NotFoundError when no QRCode was found.
 func (qcq *QRCodeQuery) First(ctx context.Context) (*QRCode, error) {
@@ -326,6 +398,9 @@ func (qcq *QRCodeQuery) Clone() *QRCodeQuery {
 		withFileRefs:         qcq.withFileRefs.Clone(),
 		withGroup:            qcq.withGroup.Clone(),
 		withAnalyticsRecords: qcq.withAnalyticsRecords.Clone(),
+		withDailyAnalytics:   qcq.withDailyAnalytics.Clone(),
+		withWebhooks:         qcq.withWebhooks.Clone(),
+		withRedemptions:      qcq.withRedemptions.Clone(),
 		// clone intermediate query.
 		sql:  qcq.sql.Clone(),
 		path: qcq.path,
@@ -365,6 +440,39 @@ func (qcq *QRCodeQuery) WithAnalyticsRecords(opts ...func(*QRCodeAnalyticsQuery)
 	return qcq
 }
 
+// WithDailyAnalytics tells the query-builder to eager-load the nodes that are connected to
+// the "daily_analytics" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcq *QRCodeQuery) WithDailyAnalytics(opts ...func(*QRCodeAnalyticsDailyQuery)) *QRCodeQuery {
+	query := (&QRCodeAnalyticsDailyClient{config: qcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcq.withDailyAnalytics = query
+	return qcq
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcq *QRCodeQuery) WithWebhooks(opts ...func(*WebhookQuery)) *QRCodeQuery {
+	query := (&WebhookClient{config: qcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcq.withWebhooks = query
+	return qcq
+}
+
+// WithRedemptions tells the query-builder to eager-load the nodes that are connected to
+// the "redemptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcq *QRCodeQuery) WithRedemptions(opts ...func(*QRCodeRedemptionQuery)) *QRCodeQuery {
+	query := (&QRCodeRedemptionClient{config: qcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcq.withRedemptions = query
+	return qcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +551,13 @@ func (qcq *QRCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRCo
 	var (
 		nodes       = []*QRCode{}
 		_spec       = qcq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			qcq.withFileRefs != nil,
 			qcq.withGroup != nil,
 			qcq.withAnalyticsRecords != nil,
+			qcq.withDailyAnalytics != nil,
+			qcq.withWebhooks != nil,
+			qcq.withRedemptions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -487,6 +598,27 @@ func (qcq *QRCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRCo
 			return nil, err
 		}
 	}
+	if query := qcq.withDailyAnalytics; query != nil {
+		if err := qcq.loadDailyAnalytics(ctx, query, nodes,
+			func(n *QRCode) { n.Edges.DailyAnalytics = []*QRCodeAnalyticsDaily{} },
+			func(n *QRCode, e *QRCodeAnalyticsDaily) { n.Edges.DailyAnalytics = append(n.Edges.DailyAnalytics, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := qcq.withWebhooks; query != nil {
+		if err := qcq.loadWebhooks(ctx, query, nodes,
+			func(n *QRCode) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *QRCode, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := qcq.withRedemptions; query != nil {
+		if err := qcq.loadRedemptions(ctx, query, nodes,
+			func(n *QRCode) { n.Edges.Redemptions = []*QRCodeRedemption{} },
+			func(n *QRCode, e *QRCodeRedemption) { n.Edges.Redemptions = append(n.Edges.Redemptions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -584,6 +716,99 @@ func (qcq *QRCodeQuery) loadAnalyticsRecords(ctx context.Context, query *QRCodeA
 	}
 	return nil
 }
+func (qcq *QRCodeQuery) loadDailyAnalytics(ctx context.Context, query *QRCodeAnalyticsDailyQuery, nodes []*QRCode, init func(*QRCode), assign func(*QRCode, *QRCodeAnalyticsDaily)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*QRCode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(qrcodeanalyticsdaily.FieldQrCodeID)
+	}
+	query.Where(predicate.QRCodeAnalyticsDaily(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(qrcode.DailyAnalyticsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QrCodeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "qr_code_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (qcq *QRCodeQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*QRCode, init func(*QRCode), assign func(*QRCode, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*QRCode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldQrCodeID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(qrcode.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QrCodeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "qr_code_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "qr_code_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (qcq *QRCodeQuery) loadRedemptions(ctx context.Context, query *QRCodeRedemptionQuery, nodes []*QRCode, init func(*QRCode), assign func(*QRCode, *QRCodeRedemption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*QRCode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(qrcoderedemption.FieldQrCodeID)
+	}
+	query.Where(predicate.QRCodeRedemption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(qrcode.RedemptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.QrCodeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "qr_code_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (qcq *QRCodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qcq.querySpec()
@@ -10,7 +10,11 @@ import (
 	"qr_backend/ent/predicate"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/ent/webhook"
+	"qr_backend/pkg/schedule"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -166,6 +170,247 @@ func (qcu *QRCodeUpdate) ClearExpiresAt() *QRCodeUpdate {
 	return qcu
 }
 
+// SetStartsAt sets the "starts_at" field.
+func (qcu *QRCodeUpdate) SetStartsAt(t time.Time) *QRCodeUpdate {
+	qcu.mutation.SetStartsAt(t)
+	return qcu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableStartsAt(t *time.Time) *QRCodeUpdate {
+	if t != nil {
+		qcu.SetStartsAt(*t)
+	}
+	return qcu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (qcu *QRCodeUpdate) ClearStartsAt() *QRCodeUpdate {
+	qcu.mutation.ClearStartsAt()
+	return qcu
+}
+
+// SetSchedule sets the "schedule" field.
+func (qcu *QRCodeUpdate) SetSchedule(s *schedule.Schedule) *QRCodeUpdate {
+	qcu.mutation.SetSchedule(s)
+	return qcu
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (qcu *QRCodeUpdate) ClearSchedule() *QRCodeUpdate {
+	qcu.mutation.ClearSchedule()
+	return qcu
+}
+
+// SetFallbackURL sets the "fallback_url" field.
+func (qcu *QRCodeUpdate) SetFallbackURL(s string) *QRCodeUpdate {
+	qcu.mutation.SetFallbackURL(s)
+	return qcu
+}
+
+// SetNillableFallbackURL sets the "fallback_url" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableFallbackURL(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetFallbackURL(*s)
+	}
+	return qcu
+}
+
+// ClearFallbackURL clears the value of the "fallback_url" field.
+func (qcu *QRCodeUpdate) ClearFallbackURL() *QRCodeUpdate {
+	qcu.mutation.ClearFallbackURL()
+	return qcu
+}
+
+// SetFallbackMessage sets the "fallback_message" field.
+func (qcu *QRCodeUpdate) SetFallbackMessage(s string) *QRCodeUpdate {
+	qcu.mutation.SetFallbackMessage(s)
+	return qcu
+}
+
+// SetNillableFallbackMessage sets the "fallback_message" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableFallbackMessage(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetFallbackMessage(*s)
+	}
+	return qcu
+}
+
+// ClearFallbackMessage clears the value of the "fallback_message" field.
+func (qcu *QRCodeUpdate) ClearFallbackMessage() *QRCodeUpdate {
+	qcu.mutation.ClearFallbackMessage()
+	return qcu
+}
+
+// SetMaxScans sets the "max_scans" field.
+func (qcu *QRCodeUpdate) SetMaxScans(i int) *QRCodeUpdate {
+	qcu.mutation.ResetMaxScans()
+	qcu.mutation.SetMaxScans(i)
+	return qcu
+}
+
+// SetNillableMaxScans sets the "max_scans" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableMaxScans(i *int) *QRCodeUpdate {
+	if i != nil {
+		qcu.SetMaxScans(*i)
+	}
+	return qcu
+}
+
+// AddMaxScans adds i to the "max_scans" field.
+func (qcu *QRCodeUpdate) AddMaxScans(i int) *QRCodeUpdate {
+	qcu.mutation.AddMaxScans(i)
+	return qcu
+}
+
+// ClearMaxScans clears the value of the "max_scans" field.
+func (qcu *QRCodeUpdate) ClearMaxScans() *QRCodeUpdate {
+	qcu.mutation.ClearMaxScans()
+	return qcu
+}
+
+// SetScansPerVisitor sets the "scans_per_visitor" field.
+func (qcu *QRCodeUpdate) SetScansPerVisitor(i int) *QRCodeUpdate {
+	qcu.mutation.ResetScansPerVisitor()
+	qcu.mutation.SetScansPerVisitor(i)
+	return qcu
+}
+
+// SetNillableScansPerVisitor sets the "scans_per_visitor" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableScansPerVisitor(i *int) *QRCodeUpdate {
+	if i != nil {
+		qcu.SetScansPerVisitor(*i)
+	}
+	return qcu
+}
+
+// AddScansPerVisitor adds i to the "scans_per_visitor" field.
+func (qcu *QRCodeUpdate) AddScansPerVisitor(i int) *QRCodeUpdate {
+	qcu.mutation.AddScansPerVisitor(i)
+	return qcu
+}
+
+// ClearScansPerVisitor clears the value of the "scans_per_visitor" field.
+func (qcu *QRCodeUpdate) ClearScansPerVisitor() *QRCodeUpdate {
+	qcu.mutation.ClearScansPerVisitor()
+	return qcu
+}
+
+// SetVisitorKey sets the "visitor_key" field.
+func (qcu *QRCodeUpdate) SetVisitorKey(qk qrcode.VisitorKey) *QRCodeUpdate {
+	qcu.mutation.SetVisitorKey(qk)
+	return qcu
+}
+
+// SetNillableVisitorKey sets the "visitor_key" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableVisitorKey(qk *qrcode.VisitorKey) *QRCodeUpdate {
+	if qk != nil {
+		qcu.SetVisitorKey(*qk)
+	}
+	return qcu
+}
+
+// SetRedeemed sets the "redeemed" field.
+func (qcu *QRCodeUpdate) SetRedeemed(i int) *QRCodeUpdate {
+	qcu.mutation.ResetRedeemed()
+	qcu.mutation.SetRedeemed(i)
+	return qcu
+}
+
+// SetNillableRedeemed sets the "redeemed" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableRedeemed(i *int) *QRCodeUpdate {
+	if i != nil {
+		qcu.SetRedeemed(*i)
+	}
+	return qcu
+}
+
+// AddRedeemed adds i to the "redeemed" field.
+func (qcu *QRCodeUpdate) AddRedeemed(i int) *QRCodeUpdate {
+	qcu.mutation.AddRedeemed(i)
+	return qcu
+}
+
+// SetEndedMessage sets the "ended_message" field.
+func (qcu *QRCodeUpdate) SetEndedMessage(s string) *QRCodeUpdate {
+	qcu.mutation.SetEndedMessage(s)
+	return qcu
+}
+
+// SetNillableEndedMessage sets the "ended_message" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableEndedMessage(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetEndedMessage(*s)
+	}
+	return qcu
+}
+
+// ClearEndedMessage clears the value of the "ended_message" field.
+func (qcu *QRCodeUpdate) ClearEndedMessage() *QRCodeUpdate {
+	qcu.mutation.ClearEndedMessage()
+	return qcu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (qcu *QRCodeUpdate) SetPasswordHash(s string) *QRCodeUpdate {
+	qcu.mutation.SetPasswordHash(s)
+	return qcu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillablePasswordHash(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetPasswordHash(*s)
+	}
+	return qcu
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (qcu *QRCodeUpdate) ClearPasswordHash() *QRCodeUpdate {
+	qcu.mutation.ClearPasswordHash()
+	return qcu
+}
+
+// SetSigning sets the "signing" field.
+func (qcu *QRCodeUpdate) SetSigning(q qrcode.Signing) *QRCodeUpdate {
+	qcu.mutation.SetSigning(q)
+	return qcu
+}
+
+// SetNillableSigning sets the "signing" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableSigning(q *qrcode.Signing) *QRCodeUpdate {
+	if q != nil {
+		qcu.SetSigning(*q)
+	}
+	return qcu
+}
+
+// ClearSigning clears the value of the "signing" field.
+func (qcu *QRCodeUpdate) ClearSigning() *QRCodeUpdate {
+	qcu.mutation.ClearSigning()
+	return qcu
+}
+
+// SetSignedToken sets the "signed_token" field.
+func (qcu *QRCodeUpdate) SetSignedToken(s string) *QRCodeUpdate {
+	qcu.mutation.SetSignedToken(s)
+	return qcu
+}
+
+// SetNillableSignedToken sets the "signed_token" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableSignedToken(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetSignedToken(*s)
+	}
+	return qcu
+}
+
+// ClearSignedToken clears the value of the "signed_token" field.
+func (qcu *QRCodeUpdate) ClearSignedToken() *QRCodeUpdate {
+	qcu.mutation.ClearSignedToken()
+	return qcu
+}
+
 // SetAnalytics sets the "analytics" field.
 func (qcu *QRCodeUpdate) SetAnalytics(b bool) *QRCodeUpdate {
 	qcu.mutation.SetAnalytics(b)
@@ -180,6 +425,20 @@ func (qcu *QRCodeUpdate) SetNillableAnalytics(b *bool) *QRCodeUpdate {
 	return qcu
 }
 
+// SetAnalyticsCountsOnly sets the "analytics_counts_only" field.
+func (qcu *QRCodeUpdate) SetAnalyticsCountsOnly(b bool) *QRCodeUpdate {
+	qcu.mutation.SetAnalyticsCountsOnly(b)
+	return qcu
+}
+
+// SetNillableAnalyticsCountsOnly sets the "analytics_counts_only" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableAnalyticsCountsOnly(b *bool) *QRCodeUpdate {
+	if b != nil {
+		qcu.SetAnalyticsCountsOnly(*b)
+	}
+	return qcu
+}
+
 // SetActive sets the "active" field.
 func (qcu *QRCodeUpdate) SetActive(b bool) *QRCodeUpdate {
 	qcu.mutation.SetActive(b)
@@ -244,6 +503,26 @@ func (qcu *QRCodeUpdate) ClearGroupID() *QRCodeUpdate {
 	return qcu
 }
 
+// SetGs1Key sets the "gs1_key" field.
+func (qcu *QRCodeUpdate) SetGs1Key(s string) *QRCodeUpdate {
+	qcu.mutation.SetGs1Key(s)
+	return qcu
+}
+
+// SetNillableGs1Key sets the "gs1_key" field if the given value is not nil.
+func (qcu *QRCodeUpdate) SetNillableGs1Key(s *string) *QRCodeUpdate {
+	if s != nil {
+		qcu.SetGs1Key(*s)
+	}
+	return qcu
+}
+
+// ClearGs1Key clears the value of the "gs1_key" field.
+func (qcu *QRCodeUpdate) ClearGs1Key() *QRCodeUpdate {
+	qcu.mutation.ClearGs1Key()
+	return qcu
+}
+
 // AddFileRefIDs adds the "file_refs" edge to the FileReference entity by IDs.
 func (qcu *QRCodeUpdate) AddFileRefIDs(ids ...int) *QRCodeUpdate {
 	qcu.mutation.AddFileRefIDs(ids...)
@@ -279,6 +558,51 @@ func (qcu *QRCodeUpdate) AddAnalyticsRecords(q ...*QRCodeAnalytics) *QRCodeUpdat
 	return qcu.AddAnalyticsRecordIDs(ids...)
 }
 
+// AddDailyAnalyticIDs adds the "daily_analytics" edge to the QRCodeAnalyticsDaily entity by IDs.
+func (qcu *QRCodeUpdate) AddDailyAnalyticIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.AddDailyAnalyticIDs(ids...)
+	return qcu
+}
+
+// AddDailyAnalytics adds the "daily_analytics" edges to the QRCodeAnalyticsDaily entity.
+func (qcu *QRCodeUpdate) AddDailyAnalytics(q ...*QRCodeAnalyticsDaily) *QRCodeUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcu.AddDailyAnalyticIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (qcu *QRCodeUpdate) AddWebhookIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.AddWebhookIDs(ids...)
+	return qcu
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (qcu *QRCodeUpdate) AddWebhooks(w ...*Webhook) *QRCodeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return qcu.AddWebhookIDs(ids...)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the QRCodeRedemption entity by IDs.
+func (qcu *QRCodeUpdate) AddRedemptionIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.AddRedemptionIDs(ids...)
+	return qcu
+}
+
+// AddRedemptions adds the "redemptions" edges to the QRCodeRedemption entity.
+func (qcu *QRCodeUpdate) AddRedemptions(q ...*QRCodeRedemption) *QRCodeUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcu.AddRedemptionIDs(ids...)
+}
+
 // Mutation returns the QRCodeMutation object of the builder.
 func (qcu *QRCodeUpdate) Mutation() *QRCodeMutation {
 	return qcu.mutation
@@ -332,6 +656,69 @@ func (qcu *QRCodeUpdate) RemoveAnalyticsRecords(q ...*QRCodeAnalytics) *QRCodeUp
 	return qcu.RemoveAnalyticsRecordIDs(ids...)
 }
 
+// ClearDailyAnalytics clears all "daily_analytics" edges to the QRCodeAnalyticsDaily entity.
+func (qcu *QRCodeUpdate) ClearDailyAnalytics() *QRCodeUpdate {
+	qcu.mutation.ClearDailyAnalytics()
+	return qcu
+}
+
+// RemoveDailyAnalyticIDs removes the "daily_analytics" edge to QRCodeAnalyticsDaily entities by IDs.
+func (qcu *QRCodeUpdate) RemoveDailyAnalyticIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.RemoveDailyAnalyticIDs(ids...)
+	return qcu
+}
+
+// RemoveDailyAnalytics removes "daily_analytics" edges to QRCodeAnalyticsDaily entities.
+func (qcu *QRCodeUpdate) RemoveDailyAnalytics(q ...*QRCodeAnalyticsDaily) *QRCodeUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcu.RemoveDailyAnalyticIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (qcu *QRCodeUpdate) ClearWebhooks() *QRCodeUpdate {
+	qcu.mutation.ClearWebhooks()
+	return qcu
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (qcu *QRCodeUpdate) RemoveWebhookIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.RemoveWebhookIDs(ids...)
+	return qcu
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (qcu *QRCodeUpdate) RemoveWebhooks(w ...*Webhook) *QRCodeUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return qcu.RemoveWebhookIDs(ids...)
+}
+
+// ClearRedemptions clears all "redemptions" edges to the QRCodeRedemption entity.
+func (qcu *QRCodeUpdate) ClearRedemptions() *QRCodeUpdate {
+	qcu.mutation.ClearRedemptions()
+	return qcu
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to QRCodeRedemption entities by IDs.
+func (qcu *QRCodeUpdate) RemoveRedemptionIDs(ids ...int) *QRCodeUpdate {
+	qcu.mutation.RemoveRedemptionIDs(ids...)
+	return qcu
+}
+
+// RemoveRedemptions removes "redemptions" edges to QRCodeRedemption entities.
+func (qcu *QRCodeUpdate) RemoveRedemptions(q ...*QRCodeRedemption) *QRCodeUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcu.RemoveRedemptionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (qcu *QRCodeUpdate) Save(ctx context.Context) (int, error) {
 	qcu.defaults()
@@ -380,6 +767,21 @@ func (qcu *QRCodeUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "QRCode.title": %w`, err)}
 		}
 	}
+	if v, ok := qcu.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "QRCode.schedule": %w`, err)}
+		}
+	}
+	if v, ok := qcu.mutation.VisitorKey(); ok {
+		if err := qrcode.VisitorKeyValidator(v); err != nil {
+			return &ValidationError{Name: "visitor_key", err: fmt.Errorf(`ent: validator failed for field "QRCode.visitor_key": %w`, err)}
+		}
+	}
+	if v, ok := qcu.mutation.Signing(); ok {
+		if err := qrcode.SigningValidator(v); err != nil {
+			return &ValidationError{Name: "signing", err: fmt.Errorf(`ent: validator failed for field "QRCode.signing": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -434,9 +836,87 @@ func (qcu *QRCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if qcu.mutation.ExpiresAtCleared() {
 		_spec.ClearField(qrcode.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := qcu.mutation.StartsAt(); ok {
+		_spec.SetField(qrcode.FieldStartsAt, field.TypeTime, value)
+	}
+	if qcu.mutation.StartsAtCleared() {
+		_spec.ClearField(qrcode.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := qcu.mutation.Schedule(); ok {
+		_spec.SetField(qrcode.FieldSchedule, field.TypeJSON, value)
+	}
+	if qcu.mutation.ScheduleCleared() {
+		_spec.ClearField(qrcode.FieldSchedule, field.TypeJSON)
+	}
+	if value, ok := qcu.mutation.FallbackURL(); ok {
+		_spec.SetField(qrcode.FieldFallbackURL, field.TypeString, value)
+	}
+	if qcu.mutation.FallbackURLCleared() {
+		_spec.ClearField(qrcode.FieldFallbackURL, field.TypeString)
+	}
+	if value, ok := qcu.mutation.FallbackMessage(); ok {
+		_spec.SetField(qrcode.FieldFallbackMessage, field.TypeString, value)
+	}
+	if qcu.mutation.FallbackMessageCleared() {
+		_spec.ClearField(qrcode.FieldFallbackMessage, field.TypeString)
+	}
+	if value, ok := qcu.mutation.MaxScans(); ok {
+		_spec.SetField(qrcode.FieldMaxScans, field.TypeInt, value)
+	}
+	if value, ok := qcu.mutation.AddedMaxScans(); ok {
+		_spec.AddField(qrcode.FieldMaxScans, field.TypeInt, value)
+	}
+	if qcu.mutation.MaxScansCleared() {
+		_spec.ClearField(qrcode.FieldMaxScans, field.TypeInt)
+	}
+	if value, ok := qcu.mutation.ScansPerVisitor(); ok {
+		_spec.SetField(qrcode.FieldScansPerVisitor, field.TypeInt, value)
+	}
+	if value, ok := qcu.mutation.AddedScansPerVisitor(); ok {
+		_spec.AddField(qrcode.FieldScansPerVisitor, field.TypeInt, value)
+	}
+	if qcu.mutation.ScansPerVisitorCleared() {
+		_spec.ClearField(qrcode.FieldScansPerVisitor, field.TypeInt)
+	}
+	if value, ok := qcu.mutation.VisitorKey(); ok {
+		_spec.SetField(qrcode.FieldVisitorKey, field.TypeEnum, value)
+	}
+	if value, ok := qcu.mutation.Redeemed(); ok {
+		_spec.SetField(qrcode.FieldRedeemed, field.TypeInt, value)
+	}
+	if value, ok := qcu.mutation.AddedRedeemed(); ok {
+		_spec.AddField(qrcode.FieldRedeemed, field.TypeInt, value)
+	}
+	if value, ok := qcu.mutation.EndedMessage(); ok {
+		_spec.SetField(qrcode.FieldEndedMessage, field.TypeString, value)
+	}
+	if qcu.mutation.EndedMessageCleared() {
+		_spec.ClearField(qrcode.FieldEndedMessage, field.TypeString)
+	}
+	if value, ok := qcu.mutation.PasswordHash(); ok {
+		_spec.SetField(qrcode.FieldPasswordHash, field.TypeString, value)
+	}
+	if qcu.mutation.PasswordHashCleared() {
+		_spec.ClearField(qrcode.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := qcu.mutation.Signing(); ok {
+		_spec.SetField(qrcode.FieldSigning, field.TypeEnum, value)
+	}
+	if qcu.mutation.SigningCleared() {
+		_spec.ClearField(qrcode.FieldSigning, field.TypeEnum)
+	}
+	if value, ok := qcu.mutation.SignedToken(); ok {
+		_spec.SetField(qrcode.FieldSignedToken, field.TypeString, value)
+	}
+	if qcu.mutation.SignedTokenCleared() {
+		_spec.ClearField(qrcode.FieldSignedToken, field.TypeString)
+	}
 	if value, ok := qcu.mutation.Analytics(); ok {
 		_spec.SetField(qrcode.FieldAnalytics, field.TypeBool, value)
 	}
+	if value, ok := qcu.mutation.AnalyticsCountsOnly(); ok {
+		_spec.SetField(qrcode.FieldAnalyticsCountsOnly, field.TypeBool, value)
+	}
 	if value, ok := qcu.mutation.Active(); ok {
 		_spec.SetField(qrcode.FieldActive, field.TypeBool, value)
 	}
@@ -457,6 +937,15 @@ func (qcu *QRCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if qcu.mutation.DesignCleared() {
 		_spec.ClearField(qrcode.FieldDesign, field.TypeJSON)
 	}
+	if value, ok := qcu.mutation.Gs1Key(); ok {
+		_spec.SetField(qrcode.FieldGs1Key, field.TypeString, value)
+	}
+	if qcu.mutation.Gs1KeyCleared() {
+		_spec.ClearField(qrcode.FieldGs1Key, field.TypeString)
+	}
+	if qcu.mutation.ImportKeyCleared() {
+		_spec.ClearField(qrcode.FieldImportKey, field.TypeString)
+	}
 	if qcu.mutation.FileRefsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -576,6 +1065,141 @@ func (qcu *QRCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qcu.mutation.DailyAnalyticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.RemovedDailyAnalyticsIDs(); len(nodes) > 0 && !qcu.mutation.DailyAnalyticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.DailyAnalyticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qcu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !qcu.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qcu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !qcu.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcu.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, qcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{qrcode.Label}
@@ -644,89 +1268,330 @@ func (qcuo *QRCodeUpdateOne) ClearDescription() *QRCodeUpdateOne {
 	return qcuo
 }
 
-// SetRedirectURL sets the "redirect_url" field.
-func (qcuo *QRCodeUpdateOne) SetRedirectURL(s string) *QRCodeUpdateOne {
-	qcuo.mutation.SetRedirectURL(s)
+// SetRedirectURL sets the "redirect_url" field.
+func (qcuo *QRCodeUpdateOne) SetRedirectURL(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetRedirectURL(s)
+	return qcuo
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableRedirectURL(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetRedirectURL(*s)
+	}
+	return qcuo
+}
+
+// ClearRedirectURL clears the value of the "redirect_url" field.
+func (qcuo *QRCodeUpdateOne) ClearRedirectURL() *QRCodeUpdateOne {
+	qcuo.mutation.ClearRedirectURL()
+	return qcuo
+}
+
+// SetShortURL sets the "short_url" field.
+func (qcuo *QRCodeUpdateOne) SetShortURL(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetShortURL(s)
+	return qcuo
+}
+
+// SetNillableShortURL sets the "short_url" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableShortURL(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetShortURL(*s)
+	}
+	return qcuo
+}
+
+// ClearShortURL clears the value of the "short_url" field.
+func (qcuo *QRCodeUpdateOne) ClearShortURL() *QRCodeUpdateOne {
+	qcuo.mutation.ClearShortURL()
+	return qcuo
+}
+
+// SetContent sets the "content" field.
+func (qcuo *QRCodeUpdateOne) SetContent(m map[string]interface{}) *QRCodeUpdateOne {
+	qcuo.mutation.SetContent(m)
+	return qcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qcuo *QRCodeUpdateOne) SetCreatedAt(t time.Time) *QRCodeUpdateOne {
+	qcuo.mutation.SetCreatedAt(t)
+	return qcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableCreatedAt(t *time.Time) *QRCodeUpdateOne {
+	if t != nil {
+		qcuo.SetCreatedAt(*t)
+	}
+	return qcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qcuo *QRCodeUpdateOne) SetUpdatedAt(t time.Time) *QRCodeUpdateOne {
+	qcuo.mutation.SetUpdatedAt(t)
+	return qcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qcuo *QRCodeUpdateOne) SetExpiresAt(t time.Time) *QRCodeUpdateOne {
+	qcuo.mutation.SetExpiresAt(t)
+	return qcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *QRCodeUpdateOne {
+	if t != nil {
+		qcuo.SetExpiresAt(*t)
+	}
+	return qcuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (qcuo *QRCodeUpdateOne) ClearExpiresAt() *QRCodeUpdateOne {
+	qcuo.mutation.ClearExpiresAt()
+	return qcuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (qcuo *QRCodeUpdateOne) SetStartsAt(t time.Time) *QRCodeUpdateOne {
+	qcuo.mutation.SetStartsAt(t)
+	return qcuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableStartsAt(t *time.Time) *QRCodeUpdateOne {
+	if t != nil {
+		qcuo.SetStartsAt(*t)
+	}
+	return qcuo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (qcuo *QRCodeUpdateOne) ClearStartsAt() *QRCodeUpdateOne {
+	qcuo.mutation.ClearStartsAt()
+	return qcuo
+}
+
+// SetSchedule sets the "schedule" field.
+func (qcuo *QRCodeUpdateOne) SetSchedule(s *schedule.Schedule) *QRCodeUpdateOne {
+	qcuo.mutation.SetSchedule(s)
+	return qcuo
+}
+
+// ClearSchedule clears the value of the "schedule" field.
+func (qcuo *QRCodeUpdateOne) ClearSchedule() *QRCodeUpdateOne {
+	qcuo.mutation.ClearSchedule()
+	return qcuo
+}
+
+// SetFallbackURL sets the "fallback_url" field.
+func (qcuo *QRCodeUpdateOne) SetFallbackURL(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetFallbackURL(s)
+	return qcuo
+}
+
+// SetNillableFallbackURL sets the "fallback_url" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableFallbackURL(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetFallbackURL(*s)
+	}
+	return qcuo
+}
+
+// ClearFallbackURL clears the value of the "fallback_url" field.
+func (qcuo *QRCodeUpdateOne) ClearFallbackURL() *QRCodeUpdateOne {
+	qcuo.mutation.ClearFallbackURL()
+	return qcuo
+}
+
+// SetFallbackMessage sets the "fallback_message" field.
+func (qcuo *QRCodeUpdateOne) SetFallbackMessage(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetFallbackMessage(s)
+	return qcuo
+}
+
+// SetNillableFallbackMessage sets the "fallback_message" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableFallbackMessage(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetFallbackMessage(*s)
+	}
+	return qcuo
+}
+
+// ClearFallbackMessage clears the value of the "fallback_message" field.
+func (qcuo *QRCodeUpdateOne) ClearFallbackMessage() *QRCodeUpdateOne {
+	qcuo.mutation.ClearFallbackMessage()
+	return qcuo
+}
+
+// SetMaxScans sets the "max_scans" field.
+func (qcuo *QRCodeUpdateOne) SetMaxScans(i int) *QRCodeUpdateOne {
+	qcuo.mutation.ResetMaxScans()
+	qcuo.mutation.SetMaxScans(i)
+	return qcuo
+}
+
+// SetNillableMaxScans sets the "max_scans" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableMaxScans(i *int) *QRCodeUpdateOne {
+	if i != nil {
+		qcuo.SetMaxScans(*i)
+	}
+	return qcuo
+}
+
+// AddMaxScans adds i to the "max_scans" field.
+func (qcuo *QRCodeUpdateOne) AddMaxScans(i int) *QRCodeUpdateOne {
+	qcuo.mutation.AddMaxScans(i)
+	return qcuo
+}
+
+// ClearMaxScans clears the value of the "max_scans" field.
+func (qcuo *QRCodeUpdateOne) ClearMaxScans() *QRCodeUpdateOne {
+	qcuo.mutation.ClearMaxScans()
+	return qcuo
+}
+
+// SetScansPerVisitor sets the "scans_per_visitor" field.
+func (qcuo *QRCodeUpdateOne) SetScansPerVisitor(i int) *QRCodeUpdateOne {
+	qcuo.mutation.ResetScansPerVisitor()
+	qcuo.mutation.SetScansPerVisitor(i)
+	return qcuo
+}
+
+// SetNillableScansPerVisitor sets the "scans_per_visitor" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableScansPerVisitor(i *int) *QRCodeUpdateOne {
+	if i != nil {
+		qcuo.SetScansPerVisitor(*i)
+	}
+	return qcuo
+}
+
+// AddScansPerVisitor adds i to the "scans_per_visitor" field.
+func (qcuo *QRCodeUpdateOne) AddScansPerVisitor(i int) *QRCodeUpdateOne {
+	qcuo.mutation.AddScansPerVisitor(i)
+	return qcuo
+}
+
+// ClearScansPerVisitor clears the value of the "scans_per_visitor" field.
+func (qcuo *QRCodeUpdateOne) ClearScansPerVisitor() *QRCodeUpdateOne {
+	qcuo.mutation.ClearScansPerVisitor()
+	return qcuo
+}
+
+// SetVisitorKey sets the "visitor_key" field.
+func (qcuo *QRCodeUpdateOne) SetVisitorKey(qk qrcode.VisitorKey) *QRCodeUpdateOne {
+	qcuo.mutation.SetVisitorKey(qk)
+	return qcuo
+}
+
+// SetNillableVisitorKey sets the "visitor_key" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableVisitorKey(qk *qrcode.VisitorKey) *QRCodeUpdateOne {
+	if qk != nil {
+		qcuo.SetVisitorKey(*qk)
+	}
+	return qcuo
+}
+
+// SetRedeemed sets the "redeemed" field.
+func (qcuo *QRCodeUpdateOne) SetRedeemed(i int) *QRCodeUpdateOne {
+	qcuo.mutation.ResetRedeemed()
+	qcuo.mutation.SetRedeemed(i)
+	return qcuo
+}
+
+// SetNillableRedeemed sets the "redeemed" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableRedeemed(i *int) *QRCodeUpdateOne {
+	if i != nil {
+		qcuo.SetRedeemed(*i)
+	}
+	return qcuo
+}
+
+// AddRedeemed adds i to the "redeemed" field.
+func (qcuo *QRCodeUpdateOne) AddRedeemed(i int) *QRCodeUpdateOne {
+	qcuo.mutation.AddRedeemed(i)
+	return qcuo
+}
+
+// SetEndedMessage sets the "ended_message" field.
+func (qcuo *QRCodeUpdateOne) SetEndedMessage(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetEndedMessage(s)
 	return qcuo
 }
 
-// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
-func (qcuo *QRCodeUpdateOne) SetNillableRedirectURL(s *string) *QRCodeUpdateOne {
+// SetNillableEndedMessage sets the "ended_message" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableEndedMessage(s *string) *QRCodeUpdateOne {
 	if s != nil {
-		qcuo.SetRedirectURL(*s)
+		qcuo.SetEndedMessage(*s)
 	}
 	return qcuo
 }
 
-// ClearRedirectURL clears the value of the "redirect_url" field.
-func (qcuo *QRCodeUpdateOne) ClearRedirectURL() *QRCodeUpdateOne {
-	qcuo.mutation.ClearRedirectURL()
+// ClearEndedMessage clears the value of the "ended_message" field.
+func (qcuo *QRCodeUpdateOne) ClearEndedMessage() *QRCodeUpdateOne {
+	qcuo.mutation.ClearEndedMessage()
 	return qcuo
 }
 
-// SetShortURL sets the "short_url" field.
-func (qcuo *QRCodeUpdateOne) SetShortURL(s string) *QRCodeUpdateOne {
-	qcuo.mutation.SetShortURL(s)
+// SetPasswordHash sets the "password_hash" field.
+func (qcuo *QRCodeUpdateOne) SetPasswordHash(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetPasswordHash(s)
 	return qcuo
 }
 
-// SetNillableShortURL sets the "short_url" field if the given value is not nil.
-func (qcuo *QRCodeUpdateOne) SetNillableShortURL(s *string) *QRCodeUpdateOne {
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillablePasswordHash(s *string) *QRCodeUpdateOne {
 	if s != nil {
-		qcuo.SetShortURL(*s)
+		qcuo.SetPasswordHash(*s)
 	}
 	return qcuo
 }
 
-// ClearShortURL clears the value of the "short_url" field.
-func (qcuo *QRCodeUpdateOne) ClearShortURL() *QRCodeUpdateOne {
-	qcuo.mutation.ClearShortURL()
-	return qcuo
-}
-
-// SetContent sets the "content" field.
-func (qcuo *QRCodeUpdateOne) SetContent(m map[string]interface{}) *QRCodeUpdateOne {
-	qcuo.mutation.SetContent(m)
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (qcuo *QRCodeUpdateOne) ClearPasswordHash() *QRCodeUpdateOne {
+	qcuo.mutation.ClearPasswordHash()
 	return qcuo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (qcuo *QRCodeUpdateOne) SetCreatedAt(t time.Time) *QRCodeUpdateOne {
-	qcuo.mutation.SetCreatedAt(t)
+// SetSigning sets the "signing" field.
+func (qcuo *QRCodeUpdateOne) SetSigning(q qrcode.Signing) *QRCodeUpdateOne {
+	qcuo.mutation.SetSigning(q)
 	return qcuo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (qcuo *QRCodeUpdateOne) SetNillableCreatedAt(t *time.Time) *QRCodeUpdateOne {
-	if t != nil {
-		qcuo.SetCreatedAt(*t)
+// SetNillableSigning sets the "signing" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableSigning(q *qrcode.Signing) *QRCodeUpdateOne {
+	if q != nil {
+		qcuo.SetSigning(*q)
 	}
 	return qcuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (qcuo *QRCodeUpdateOne) SetUpdatedAt(t time.Time) *QRCodeUpdateOne {
-	qcuo.mutation.SetUpdatedAt(t)
+// ClearSigning clears the value of the "signing" field.
+func (qcuo *QRCodeUpdateOne) ClearSigning() *QRCodeUpdateOne {
+	qcuo.mutation.ClearSigning()
 	return qcuo
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (qcuo *QRCodeUpdateOne) SetExpiresAt(t time.Time) *QRCodeUpdateOne {
-	qcuo.mutation.SetExpiresAt(t)
+// SetSignedToken sets the "signed_token" field.
+func (qcuo *QRCodeUpdateOne) SetSignedToken(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetSignedToken(s)
 	return qcuo
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (qcuo *QRCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *QRCodeUpdateOne {
-	if t != nil {
-		qcuo.SetExpiresAt(*t)
+// SetNillableSignedToken sets the "signed_token" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableSignedToken(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetSignedToken(*s)
 	}
 	return qcuo
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (qcuo *QRCodeUpdateOne) ClearExpiresAt() *QRCodeUpdateOne {
-	qcuo.mutation.ClearExpiresAt()
+// ClearSignedToken clears the value of the "signed_token" field.
+func (qcuo *QRCodeUpdateOne) ClearSignedToken() *QRCodeUpdateOne {
+	qcuo.mutation.ClearSignedToken()
 	return qcuo
 }
 
@@ -744,6 +1609,20 @@ func (qcuo *QRCodeUpdateOne) SetNillableAnalytics(b *bool) *QRCodeUpdateOne {
 	return qcuo
 }
 
+// SetAnalyticsCountsOnly sets the "analytics_counts_only" field.
+func (qcuo *QRCodeUpdateOne) SetAnalyticsCountsOnly(b bool) *QRCodeUpdateOne {
+	qcuo.mutation.SetAnalyticsCountsOnly(b)
+	return qcuo
+}
+
+// SetNillableAnalyticsCountsOnly sets the "analytics_counts_only" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableAnalyticsCountsOnly(b *bool) *QRCodeUpdateOne {
+	if b != nil {
+		qcuo.SetAnalyticsCountsOnly(*b)
+	}
+	return qcuo
+}
+
 // SetActive sets the "active" field.
 func (qcuo *QRCodeUpdateOne) SetActive(b bool) *QRCodeUpdateOne {
 	qcuo.mutation.SetActive(b)
@@ -808,6 +1687,26 @@ func (qcuo *QRCodeUpdateOne) ClearGroupID() *QRCodeUpdateOne {
 	return qcuo
 }
 
+// SetGs1Key sets the "gs1_key" field.
+func (qcuo *QRCodeUpdateOne) SetGs1Key(s string) *QRCodeUpdateOne {
+	qcuo.mutation.SetGs1Key(s)
+	return qcuo
+}
+
+// SetNillableGs1Key sets the "gs1_key" field if the given value is not nil.
+func (qcuo *QRCodeUpdateOne) SetNillableGs1Key(s *string) *QRCodeUpdateOne {
+	if s != nil {
+		qcuo.SetGs1Key(*s)
+	}
+	return qcuo
+}
+
+// ClearGs1Key clears the value of the "gs1_key" field.
+func (qcuo *QRCodeUpdateOne) ClearGs1Key() *QRCodeUpdateOne {
+	qcuo.mutation.ClearGs1Key()
+	return qcuo
+}
+
 // AddFileRefIDs adds the "file_refs" edge to the FileReference entity by IDs.
 func (qcuo *QRCodeUpdateOne) AddFileRefIDs(ids ...int) *QRCodeUpdateOne {
 	qcuo.mutation.AddFileRefIDs(ids...)
@@ -843,6 +1742,51 @@ func (qcuo *QRCodeUpdateOne) AddAnalyticsRecords(q ...*QRCodeAnalytics) *QRCodeU
 	return qcuo.AddAnalyticsRecordIDs(ids...)
 }
 
+// AddDailyAnalyticIDs adds the "daily_analytics" edge to the QRCodeAnalyticsDaily entity by IDs.
+func (qcuo *QRCodeUpdateOne) AddDailyAnalyticIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.AddDailyAnalyticIDs(ids...)
+	return qcuo
+}
+
+// AddDailyAnalytics adds the "daily_analytics" edges to the QRCodeAnalyticsDaily entity.
+func (qcuo *QRCodeUpdateOne) AddDailyAnalytics(q ...*QRCodeAnalyticsDaily) *QRCodeUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcuo.AddDailyAnalyticIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (qcuo *QRCodeUpdateOne) AddWebhookIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.AddWebhookIDs(ids...)
+	return qcuo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (qcuo *QRCodeUpdateOne) AddWebhooks(w ...*Webhook) *QRCodeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return qcuo.AddWebhookIDs(ids...)
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the QRCodeRedemption entity by IDs.
+func (qcuo *QRCodeUpdateOne) AddRedemptionIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.AddRedemptionIDs(ids...)
+	return qcuo
+}
+
+// AddRedemptions adds the "redemptions" edges to the QRCodeRedemption entity.
+func (qcuo *QRCodeUpdateOne) AddRedemptions(q ...*QRCodeRedemption) *QRCodeUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcuo.AddRedemptionIDs(ids...)
+}
+
 // Mutation returns the QRCodeMutation object of the builder.
 func (qcuo *QRCodeUpdateOne) Mutation() *QRCodeMutation {
 	return qcuo.mutation
@@ -896,6 +1840,69 @@ func (qcuo *QRCodeUpdateOne) RemoveAnalyticsRecords(q ...*QRCodeAnalytics) *QRCo
 	return qcuo.RemoveAnalyticsRecordIDs(ids...)
 }
 
+// ClearDailyAnalytics clears all "daily_analytics" edges to the QRCodeAnalyticsDaily entity.
+func (qcuo *QRCodeUpdateOne) ClearDailyAnalytics() *QRCodeUpdateOne {
+	qcuo.mutation.ClearDailyAnalytics()
+	return qcuo
+}
+
+// RemoveDailyAnalyticIDs removes the "daily_analytics" edge to QRCodeAnalyticsDaily entities by IDs.
+func (qcuo *QRCodeUpdateOne) RemoveDailyAnalyticIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.RemoveDailyAnalyticIDs(ids...)
+	return qcuo
+}
+
+// RemoveDailyAnalytics removes "daily_analytics" edges to QRCodeAnalyticsDaily entities.
+func (qcuo *QRCodeUpdateOne) RemoveDailyAnalytics(q ...*QRCodeAnalyticsDaily) *QRCodeUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcuo.RemoveDailyAnalyticIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (qcuo *QRCodeUpdateOne) ClearWebhooks() *QRCodeUpdateOne {
+	qcuo.mutation.ClearWebhooks()
+	return qcuo
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (qcuo *QRCodeUpdateOne) RemoveWebhookIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.RemoveWebhookIDs(ids...)
+	return qcuo
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (qcuo *QRCodeUpdateOne) RemoveWebhooks(w ...*Webhook) *QRCodeUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return qcuo.RemoveWebhookIDs(ids...)
+}
+
+// ClearRedemptions clears all "redemptions" edges to the QRCodeRedemption entity.
+func (qcuo *QRCodeUpdateOne) ClearRedemptions() *QRCodeUpdateOne {
+	qcuo.mutation.ClearRedemptions()
+	return qcuo
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to QRCodeRedemption entities by IDs.
+func (qcuo *QRCodeUpdateOne) RemoveRedemptionIDs(ids ...int) *QRCodeUpdateOne {
+	qcuo.mutation.RemoveRedemptionIDs(ids...)
+	return qcuo
+}
+
+// RemoveRedemptions removes "redemptions" edges to QRCodeRedemption entities.
+func (qcuo *QRCodeUpdateOne) RemoveRedemptions(q ...*QRCodeRedemption) *QRCodeUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qcuo.RemoveRedemptionIDs(ids...)
+}
+
 // Where appends a list predicates to the QRCodeUpdate builder.
 func (qcuo *QRCodeUpdateOne) Where(ps ...predicate.QRCode) *QRCodeUpdateOne {
 	qcuo.mutation.Where(ps...)
@@ -957,6 +1964,21 @@ func (qcuo *QRCodeUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "QRCode.title": %w`, err)}
 		}
 	}
+	if v, ok := qcuo.mutation.Schedule(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "schedule", err: fmt.Errorf(`ent: validator failed for field "QRCode.schedule": %w`, err)}
+		}
+	}
+	if v, ok := qcuo.mutation.VisitorKey(); ok {
+		if err := qrcode.VisitorKeyValidator(v); err != nil {
+			return &ValidationError{Name: "visitor_key", err: fmt.Errorf(`ent: validator failed for field "QRCode.visitor_key": %w`, err)}
+		}
+	}
+	if v, ok := qcuo.mutation.Signing(); ok {
+		if err := qrcode.SigningValidator(v); err != nil {
+			return &ValidationError{Name: "signing", err: fmt.Errorf(`ent: validator failed for field "QRCode.signing": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1028,9 +2050,87 @@ func (qcuo *QRCodeUpdateOne) sqlSave(ctx context.Context) (_node *QRCode, err er
 	if qcuo.mutation.ExpiresAtCleared() {
 		_spec.ClearField(qrcode.FieldExpiresAt, field.TypeTime)
 	}
+	if value, ok := qcuo.mutation.StartsAt(); ok {
+		_spec.SetField(qrcode.FieldStartsAt, field.TypeTime, value)
+	}
+	if qcuo.mutation.StartsAtCleared() {
+		_spec.ClearField(qrcode.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := qcuo.mutation.Schedule(); ok {
+		_spec.SetField(qrcode.FieldSchedule, field.TypeJSON, value)
+	}
+	if qcuo.mutation.ScheduleCleared() {
+		_spec.ClearField(qrcode.FieldSchedule, field.TypeJSON)
+	}
+	if value, ok := qcuo.mutation.FallbackURL(); ok {
+		_spec.SetField(qrcode.FieldFallbackURL, field.TypeString, value)
+	}
+	if qcuo.mutation.FallbackURLCleared() {
+		_spec.ClearField(qrcode.FieldFallbackURL, field.TypeString)
+	}
+	if value, ok := qcuo.mutation.FallbackMessage(); ok {
+		_spec.SetField(qrcode.FieldFallbackMessage, field.TypeString, value)
+	}
+	if qcuo.mutation.FallbackMessageCleared() {
+		_spec.ClearField(qrcode.FieldFallbackMessage, field.TypeString)
+	}
+	if value, ok := qcuo.mutation.MaxScans(); ok {
+		_spec.SetField(qrcode.FieldMaxScans, field.TypeInt, value)
+	}
+	if value, ok := qcuo.mutation.AddedMaxScans(); ok {
+		_spec.AddField(qrcode.FieldMaxScans, field.TypeInt, value)
+	}
+	if qcuo.mutation.MaxScansCleared() {
+		_spec.ClearField(qrcode.FieldMaxScans, field.TypeInt)
+	}
+	if value, ok := qcuo.mutation.ScansPerVisitor(); ok {
+		_spec.SetField(qrcode.FieldScansPerVisitor, field.TypeInt, value)
+	}
+	if value, ok := qcuo.mutation.AddedScansPerVisitor(); ok {
+		_spec.AddField(qrcode.FieldScansPerVisitor, field.TypeInt, value)
+	}
+	if qcuo.mutation.ScansPerVisitorCleared() {
+		_spec.ClearField(qrcode.FieldScansPerVisitor, field.TypeInt)
+	}
+	if value, ok := qcuo.mutation.VisitorKey(); ok {
+		_spec.SetField(qrcode.FieldVisitorKey, field.TypeEnum, value)
+	}
+	if value, ok := qcuo.mutation.Redeemed(); ok {
+		_spec.SetField(qrcode.FieldRedeemed, field.TypeInt, value)
+	}
+	if value, ok := qcuo.mutation.AddedRedeemed(); ok {
+		_spec.AddField(qrcode.FieldRedeemed, field.TypeInt, value)
+	}
+	if value, ok := qcuo.mutation.EndedMessage(); ok {
+		_spec.SetField(qrcode.FieldEndedMessage, field.TypeString, value)
+	}
+	if qcuo.mutation.EndedMessageCleared() {
+		_spec.ClearField(qrcode.FieldEndedMessage, field.TypeString)
+	}
+	if value, ok := qcuo.mutation.PasswordHash(); ok {
+		_spec.SetField(qrcode.FieldPasswordHash, field.TypeString, value)
+	}
+	if qcuo.mutation.PasswordHashCleared() {
+		_spec.ClearField(qrcode.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := qcuo.mutation.Signing(); ok {
+		_spec.SetField(qrcode.FieldSigning, field.TypeEnum, value)
+	}
+	if qcuo.mutation.SigningCleared() {
+		_spec.ClearField(qrcode.FieldSigning, field.TypeEnum)
+	}
+	if value, ok := qcuo.mutation.SignedToken(); ok {
+		_spec.SetField(qrcode.FieldSignedToken, field.TypeString, value)
+	}
+	if qcuo.mutation.SignedTokenCleared() {
+		_spec.ClearField(qrcode.FieldSignedToken, field.TypeString)
+	}
 	if value, ok := qcuo.mutation.Analytics(); ok {
 		_spec.SetField(qrcode.FieldAnalytics, field.TypeBool, value)
 	}
+	if value, ok := qcuo.mutation.AnalyticsCountsOnly(); ok {
+		_spec.SetField(qrcode.FieldAnalyticsCountsOnly, field.TypeBool, value)
+	}
 	if value, ok := qcuo.mutation.Active(); ok {
 		_spec.SetField(qrcode.FieldActive, field.TypeBool, value)
 	}
@@ -1051,6 +2151,15 @@ func (qcuo *QRCodeUpdateOne) sqlSave(ctx context.Context) (_node *QRCode, err er
 	if qcuo.mutation.DesignCleared() {
 		_spec.ClearField(qrcode.FieldDesign, field.TypeJSON)
 	}
+	if value, ok := qcuo.mutation.Gs1Key(); ok {
+		_spec.SetField(qrcode.FieldGs1Key, field.TypeString, value)
+	}
+	if qcuo.mutation.Gs1KeyCleared() {
+		_spec.ClearField(qrcode.FieldGs1Key, field.TypeString)
+	}
+	if qcuo.mutation.ImportKeyCleared() {
+		_spec.ClearField(qrcode.FieldImportKey, field.TypeString)
+	}
 	if qcuo.mutation.FileRefsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1170,6 +2279,141 @@ func (qcuo *QRCodeUpdateOne) sqlSave(ctx context.Context) (_node *QRCode, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qcuo.mutation.DailyAnalyticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.RemovedDailyAnalyticsIDs(); len(nodes) > 0 && !qcuo.mutation.DailyAnalyticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.DailyAnalyticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.DailyAnalyticsTable,
+			Columns: []string{qrcode.DailyAnalyticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qcuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !qcuo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.WebhooksTable,
+			Columns: []string{qrcode.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qcuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !qcuo.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcuo.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   qrcode.RedemptionsTable,
+			Columns: []string{qrcode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &QRCode{config: qcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -28,6 +28,8 @@ type QRCodeAnalytics struct {
 	Device string `json:"device,omitempty"`
 	// ScannedAt holds the value of the "scanned_at" field.
 	ScannedAt time.Time `json:"scanned_at,omitempty"`
+	// RolledUp holds the value of the "rolled_up" field.
+	RolledUp bool `json:"rolled_up,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the QRCodeAnalyticsQuery when eager-loading is set.
 	Edges                     QRCodeAnalyticsEdges `json:"edges"`
@@ -60,6 +62,8 @@ func (*QRCodeAnalytics) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case qrcodeanalytics.FieldRolledUp:
+			values[i] = new(sql.NullBool)
 		case qrcodeanalytics.FieldID:
 			values[i] = new(sql.NullInt64)
 		case qrcodeanalytics.FieldIPAddress, qrcodeanalytics.FieldUserAgent, qrcodeanalytics.FieldLocation, qrcodeanalytics.FieldDevice:
@@ -119,6 +123,12 @@ func (qca *QRCodeAnalytics) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				qca.ScannedAt = value.Time
 			}
+		case qrcodeanalytics.FieldRolledUp:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_up", values[i])
+			} else if value.Valid {
+				qca.RolledUp = value.Bool
+			}
 		case qrcodeanalytics.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field qr_code_analytics_records", value)
@@ -181,6 +191,9 @@ func (qca *QRCodeAnalytics) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("scanned_at=")
 	builder.WriteString(qca.ScannedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rolled_up=")
+	builder.WriteString(fmt.Sprintf("%v", qca.RolledUp))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,8 @@ const (
 	FieldDevice = "device"
 	// FieldScannedAt holds the string denoting the scanned_at field in the database.
 	FieldScannedAt = "scanned_at"
+	// FieldRolledUp holds the string denoting the rolled_up field in the database.
+	FieldRolledUp = "rolled_up"
 	// EdgeQrCode holds the string denoting the qr_code edge name in mutations.
 	EdgeQrCode = "qr_code"
 	// Table holds the table name of the qrcodeanalytics in the database.
@@ -45,6 +47,7 @@ var Columns = []string{
 	FieldLocation,
 	FieldDevice,
 	FieldScannedAt,
+	FieldRolledUp,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "qr_code_analytics"
@@ -71,6 +74,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultScannedAt holds the default value on creation for the "scanned_at" field.
 	DefaultScannedAt func() time.Time
+	// DefaultRolledUp holds the default value on creation for the "rolled_up" field.
+	DefaultRolledUp bool
 )
 
 // OrderOption defines the ordering options for the QRCodeAnalytics queries.
@@ -106,6 +111,11 @@ func ByScannedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScannedAt, opts...).ToFunc()
 }
 
+// ByRolledUp orders the results by the rolled_up field.
+func ByRolledUp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolledUp, opts...).ToFunc()
+}
+
 // ByQrCodeField orders the results by qr_code field.
 func ByQrCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -80,6 +80,11 @@ func ScannedAt(v time.Time) predicate.QRCodeAnalytics {
 	return predicate.QRCodeAnalytics(sql.FieldEQ(FieldScannedAt, v))
 }
 
+// RolledUp applies equality check predicate on the "rolled_up" field. It's identical to RolledUpEQ.
+func RolledUp(v bool) predicate.QRCodeAnalytics {
+	return predicate.QRCodeAnalytics(sql.FieldEQ(FieldRolledUp, v))
+}
+
 // IPAddressEQ applies the EQ predicate on the "ip_address" field.
 func IPAddressEQ(v string) predicate.QRCodeAnalytics {
 	return predicate.QRCodeAnalytics(sql.FieldEQ(FieldIPAddress, v))
@@ -400,6 +405,16 @@ func ScannedAtLTE(v time.Time) predicate.QRCodeAnalytics {
 	return predicate.QRCodeAnalytics(sql.FieldLTE(FieldScannedAt, v))
 }
 
+// RolledUpEQ applies the EQ predicate on the "rolled_up" field.
+func RolledUpEQ(v bool) predicate.QRCodeAnalytics {
+	return predicate.QRCodeAnalytics(sql.FieldEQ(FieldRolledUp, v))
+}
+
+// RolledUpNEQ applies the NEQ predicate on the "rolled_up" field.
+func RolledUpNEQ(v bool) predicate.QRCodeAnalytics {
+	return predicate.QRCodeAnalytics(sql.FieldNEQ(FieldRolledUp, v))
+}
+
 // HasQrCode applies the HasEdge predicate on the "qr_code" edge.
 func HasQrCode() predicate.QRCodeAnalytics {
 	return predicate.QRCodeAnalytics(func(s *sql.Selector) {
@@ -75,6 +75,20 @@ func (qcac *QRCodeAnalyticsCreate) SetNillableScannedAt(t *time.Time) *QRCodeAna
 	return qcac
 }
 
+// SetRolledUp sets the "rolled_up" field.
+func (qcac *QRCodeAnalyticsCreate) SetRolledUp(b bool) *QRCodeAnalyticsCreate {
+	qcac.mutation.SetRolledUp(b)
+	return qcac
+}
+
+// SetNillableRolledUp sets the "rolled_up" field if the given value is not nil.
+func (qcac *QRCodeAnalyticsCreate) SetNillableRolledUp(b *bool) *QRCodeAnalyticsCreate {
+	if b != nil {
+		qcac.SetRolledUp(*b)
+	}
+	return qcac
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (qcac *QRCodeAnalyticsCreate) SetQrCodeID(id int) *QRCodeAnalyticsCreate {
 	qcac.mutation.SetQrCodeID(id)
@@ -133,6 +147,10 @@ func (qcac *QRCodeAnalyticsCreate) defaults() {
 		v := qrcodeanalytics.DefaultScannedAt()
 		qcac.mutation.SetScannedAt(v)
 	}
+	if _, ok := qcac.mutation.RolledUp(); !ok {
+		v := qrcodeanalytics.DefaultRolledUp
+		qcac.mutation.SetRolledUp(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -146,6 +164,9 @@ func (qcac *QRCodeAnalyticsCreate) check() error {
 	if _, ok := qcac.mutation.ScannedAt(); !ok {
 		return &ValidationError{Name: "scanned_at", err: errors.New(`ent: missing required field "QRCodeAnalytics.scanned_at"`)}
 	}
+	if _, ok := qcac.mutation.RolledUp(); !ok {
+		return &ValidationError{Name: "rolled_up", err: errors.New(`ent: missing required field "QRCodeAnalytics.rolled_up"`)}
+	}
 	return nil
 }
 
@@ -192,6 +213,10 @@ func (qcac *QRCodeAnalyticsCreate) createSpec() (*QRCodeAnalytics, *sqlgraph.Cre
 		_spec.SetField(qrcodeanalytics.FieldScannedAt, field.TypeTime, value)
 		_node.ScannedAt = value
 	}
+	if value, ok := qcac.mutation.RolledUp(); ok {
+		_spec.SetField(qrcodeanalytics.FieldRolledUp, field.TypeBool, value)
+		_node.RolledUp = value
+	}
 	if nodes := qcac.mutation.QrCodeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
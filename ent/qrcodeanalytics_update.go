@@ -111,6 +111,20 @@ func (qcau *QRCodeAnalyticsUpdate) SetNillableScannedAt(t *time.Time) *QRCodeAna
 	return qcau
 }
 
+// SetRolledUp sets the "rolled_up" field.
+func (qcau *QRCodeAnalyticsUpdate) SetRolledUp(b bool) *QRCodeAnalyticsUpdate {
+	qcau.mutation.SetRolledUp(b)
+	return qcau
+}
+
+// SetNillableRolledUp sets the "rolled_up" field if the given value is not nil.
+func (qcau *QRCodeAnalyticsUpdate) SetNillableRolledUp(b *bool) *QRCodeAnalyticsUpdate {
+	if b != nil {
+		qcau.SetRolledUp(*b)
+	}
+	return qcau
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (qcau *QRCodeAnalyticsUpdate) SetQrCodeID(id int) *QRCodeAnalyticsUpdate {
 	qcau.mutation.SetQrCodeID(id)
@@ -198,6 +212,9 @@ func (qcau *QRCodeAnalyticsUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if value, ok := qcau.mutation.ScannedAt(); ok {
 		_spec.SetField(qrcodeanalytics.FieldScannedAt, field.TypeTime, value)
 	}
+	if value, ok := qcau.mutation.RolledUp(); ok {
+		_spec.SetField(qrcodeanalytics.FieldRolledUp, field.TypeBool, value)
+	}
 	if qcau.mutation.QrCodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -329,6 +346,20 @@ func (qcauo *QRCodeAnalyticsUpdateOne) SetNillableScannedAt(t *time.Time) *QRCod
 	return qcauo
 }
 
+// SetRolledUp sets the "rolled_up" field.
+func (qcauo *QRCodeAnalyticsUpdateOne) SetRolledUp(b bool) *QRCodeAnalyticsUpdateOne {
+	qcauo.mutation.SetRolledUp(b)
+	return qcauo
+}
+
+// SetNillableRolledUp sets the "rolled_up" field if the given value is not nil.
+func (qcauo *QRCodeAnalyticsUpdateOne) SetNillableRolledUp(b *bool) *QRCodeAnalyticsUpdateOne {
+	if b != nil {
+		qcauo.SetRolledUp(*b)
+	}
+	return qcauo
+}
+
 // SetQrCodeID sets the "qr_code" edge to the QRCode entity by ID.
 func (qcauo *QRCodeAnalyticsUpdateOne) SetQrCodeID(id int) *QRCodeAnalyticsUpdateOne {
 	qcauo.mutation.SetQrCodeID(id)
@@ -446,6 +477,9 @@ func (qcauo *QRCodeAnalyticsUpdateOne) sqlSave(ctx context.Context) (_node *QRCo
 	if value, ok := qcauo.mutation.ScannedAt(); ok {
 		_spec.SetField(qrcodeanalytics.FieldScannedAt, field.TypeTime, value)
 	}
+	if value, ok := qcauo.mutation.RolledUp(); ok {
+		_spec.SetField(qrcodeanalytics.FieldRolledUp, field.TypeBool, value)
+	}
 	if qcauo.mutation.QrCodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// QRCodeAnalyticsDaily is the model entity for the QRCodeAnalyticsDaily schema.
+type QRCodeAnalyticsDaily struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// QrCodeID holds the value of the "qr_code_id" field.
+	QrCodeID int `json:"qr_code_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// Scans holds the value of the "scans" field.
+	Scans int `json:"scans,omitempty"`
+	// UniqueVisitors holds the value of the "unique_visitors" field.
+	UniqueVisitors int `json:"unique_visitors,omitempty"`
+	// Devices holds the value of the "devices" field.
+	Devices map[string]int `json:"devices,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QRCodeAnalyticsDailyQuery when eager-loading is set.
+	Edges        QRCodeAnalyticsDailyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QRCodeAnalyticsDailyEdges holds the relations/edges for other nodes in the graph.
+type QRCodeAnalyticsDailyEdges struct {
+	// QrCode holds the value of the qr_code edge.
+	QrCode *QRCode `json:"qr_code,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QrCodeOrErr returns the QrCode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QRCodeAnalyticsDailyEdges) QrCodeOrErr() (*QRCode, error) {
+	if e.QrCode != nil {
+		return e.QrCode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: qrcode.Label}
+	}
+	return nil, &NotLoadedError{edge: "qr_code"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QRCodeAnalyticsDaily) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case qrcodeanalyticsdaily.FieldDevices:
+			values[i] = new([]byte)
+		case qrcodeanalyticsdaily.FieldID, qrcodeanalyticsdaily.FieldQrCodeID, qrcodeanalyticsdaily.FieldScans, qrcodeanalyticsdaily.FieldUniqueVisitors:
+			values[i] = new(sql.NullInt64)
+		case qrcodeanalyticsdaily.FieldDay:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QRCodeAnalyticsDaily fields.
+func (qcad *QRCodeAnalyticsDaily) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case qrcodeanalyticsdaily.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qcad.ID = int(value.Int64)
+		case qrcodeanalyticsdaily.FieldQrCodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field qr_code_id", values[i])
+			} else if value.Valid {
+				qcad.QrCodeID = int(value.Int64)
+			}
+		case qrcodeanalyticsdaily.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				qcad.Day = value.Time
+			}
+		case qrcodeanalyticsdaily.FieldScans:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scans", values[i])
+			} else if value.Valid {
+				qcad.Scans = int(value.Int64)
+			}
+		case qrcodeanalyticsdaily.FieldUniqueVisitors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_visitors", values[i])
+			} else if value.Valid {
+				qcad.UniqueVisitors = int(value.Int64)
+			}
+		case qrcodeanalyticsdaily.FieldDevices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field devices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qcad.Devices); err != nil {
+					return fmt.Errorf("unmarshal field devices: %w", err)
+				}
+			}
+		default:
+			qcad.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QRCodeAnalyticsDaily.
+// This includes values selected through modifiers, order, etc.
+func (qcad *QRCodeAnalyticsDaily) Value(name string) (ent.Value, error) {
+	return qcad.selectValues.Get(name)
+}
+
+// QueryQrCode queries the "qr_code" edge of the QRCodeAnalyticsDaily entity.
+func (qcad *QRCodeAnalyticsDaily) QueryQrCode() *QRCodeQuery {
+	return NewQRCodeAnalyticsDailyClient(qcad.config).QueryQrCode(qcad)
+}
+
+// Update returns a builder for updating this QRCodeAnalyticsDaily.
+// Note that you need to call QRCodeAnalyticsDaily.Unwrap() before calling this method if this QRCodeAnalyticsDaily
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qcad *QRCodeAnalyticsDaily) Update() *QRCodeAnalyticsDailyUpdateOne {
+	return NewQRCodeAnalyticsDailyClient(qcad.config).UpdateOne(qcad)
+}
+
+// Unwrap unwraps the QRCodeAnalyticsDaily entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qcad *QRCodeAnalyticsDaily) Unwrap() *QRCodeAnalyticsDaily {
+	_tx, ok := qcad.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QRCodeAnalyticsDaily is not a transactional entity")
+	}
+	qcad.config.driver = _tx.drv
+	return qcad
+}
+
+// String implements the fmt.Stringer.
+func (qcad *QRCodeAnalyticsDaily) String() string {
+	var builder strings.Builder
+	builder.WriteString("QRCodeAnalyticsDaily(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qcad.ID))
+	builder.WriteString("qr_code_id=")
+	builder.WriteString(fmt.Sprintf("%v", qcad.QrCodeID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(qcad.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scans=")
+	builder.WriteString(fmt.Sprintf("%v", qcad.Scans))
+	builder.WriteString(", ")
+	builder.WriteString("unique_visitors=")
+	builder.WriteString(fmt.Sprintf("%v", qcad.UniqueVisitors))
+	builder.WriteString(", ")
+	builder.WriteString("devices=")
+	builder.WriteString(fmt.Sprintf("%v", qcad.Devices))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QRCodeAnalyticsDailies is a parsable slice of QRCodeAnalyticsDaily.
+type QRCodeAnalyticsDailies []*QRCodeAnalyticsDaily
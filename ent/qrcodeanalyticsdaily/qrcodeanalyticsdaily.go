@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qrcodeanalyticsdaily
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the qrcodeanalyticsdaily type in the database.
+	Label = "qr_code_analytics_daily"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQrCodeID holds the string denoting the qr_code_id field in the database.
+	FieldQrCodeID = "qr_code_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldScans holds the string denoting the scans field in the database.
+	FieldScans = "scans"
+	// FieldUniqueVisitors holds the string denoting the unique_visitors field in the database.
+	FieldUniqueVisitors = "unique_visitors"
+	// FieldDevices holds the string denoting the devices field in the database.
+	FieldDevices = "devices"
+	// EdgeQrCode holds the string denoting the qr_code edge name in mutations.
+	EdgeQrCode = "qr_code"
+	// Table holds the table name of the qrcodeanalyticsdaily in the database.
+	Table = "qr_code_analytics_dailies"
+	// QrCodeTable is the table that holds the qr_code relation/edge.
+	QrCodeTable = "qr_code_analytics_dailies"
+	// QrCodeInverseTable is the table name for the QRCode entity.
+	// It exists in this package in order to avoid circular dependency with the "qrcode" package.
+	QrCodeInverseTable = "qr_codes"
+	// QrCodeColumn is the table column denoting the qr_code relation/edge.
+	QrCodeColumn = "qr_code_id"
+)
+
+// Columns holds all SQL columns for qrcodeanalyticsdaily fields.
+var Columns = []string{
+	FieldID,
+	FieldQrCodeID,
+	FieldDay,
+	FieldScans,
+	FieldUniqueVisitors,
+	FieldDevices,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultScans holds the default value on creation for the "scans" field.
+	DefaultScans int
+	// DefaultUniqueVisitors holds the default value on creation for the "unique_visitors" field.
+	DefaultUniqueVisitors int
+)
+
+// OrderOption defines the ordering options for the QRCodeAnalyticsDaily queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQrCodeID orders the results by the qr_code_id field.
+func ByQrCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQrCodeID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByScans orders the results by the scans field.
+func ByScans(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScans, opts...).ToFunc()
+}
+
+// ByUniqueVisitors orders the results by the unique_visitors field.
+func ByUniqueVisitors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUniqueVisitors, opts...).ToFunc()
+}
+
+// ByQrCodeField orders the results by qr_code field.
+func ByQrCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQrCodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQrCodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QrCodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QrCodeTable, QrCodeColumn),
+	)
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qrcodeanalyticsdaily
+
+import (
+	"qr_backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLTE(FieldID, id))
+}
+
+// QrCodeID applies equality check predicate on the "qr_code_id" field. It's identical to QrCodeIDEQ.
+func QrCodeID(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldQrCodeID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// Scans applies equality check predicate on the "scans" field. It's identical to ScansEQ.
+func Scans(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldScans, v))
+}
+
+// UniqueVisitors applies equality check predicate on the "unique_visitors" field. It's identical to UniqueVisitorsEQ.
+func UniqueVisitors(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldUniqueVisitors, v))
+}
+
+// QrCodeIDEQ applies the EQ predicate on the "qr_code_id" field.
+func QrCodeIDEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldQrCodeID, v))
+}
+
+// QrCodeIDNEQ applies the NEQ predicate on the "qr_code_id" field.
+func QrCodeIDNEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNEQ(FieldQrCodeID, v))
+}
+
+// QrCodeIDIn applies the In predicate on the "qr_code_id" field.
+func QrCodeIDIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIn(FieldQrCodeID, vs...))
+}
+
+// QrCodeIDNotIn applies the NotIn predicate on the "qr_code_id" field.
+func QrCodeIDNotIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotIn(FieldQrCodeID, vs...))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLTE(FieldDay, v))
+}
+
+// ScansEQ applies the EQ predicate on the "scans" field.
+func ScansEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldScans, v))
+}
+
+// ScansNEQ applies the NEQ predicate on the "scans" field.
+func ScansNEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNEQ(FieldScans, v))
+}
+
+// ScansIn applies the In predicate on the "scans" field.
+func ScansIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIn(FieldScans, vs...))
+}
+
+// ScansNotIn applies the NotIn predicate on the "scans" field.
+func ScansNotIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotIn(FieldScans, vs...))
+}
+
+// ScansGT applies the GT predicate on the "scans" field.
+func ScansGT(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGT(FieldScans, v))
+}
+
+// ScansGTE applies the GTE predicate on the "scans" field.
+func ScansGTE(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGTE(FieldScans, v))
+}
+
+// ScansLT applies the LT predicate on the "scans" field.
+func ScansLT(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLT(FieldScans, v))
+}
+
+// ScansLTE applies the LTE predicate on the "scans" field.
+func ScansLTE(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLTE(FieldScans, v))
+}
+
+// UniqueVisitorsEQ applies the EQ predicate on the "unique_visitors" field.
+func UniqueVisitorsEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldEQ(FieldUniqueVisitors, v))
+}
+
+// UniqueVisitorsNEQ applies the NEQ predicate on the "unique_visitors" field.
+func UniqueVisitorsNEQ(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNEQ(FieldUniqueVisitors, v))
+}
+
+// UniqueVisitorsIn applies the In predicate on the "unique_visitors" field.
+func UniqueVisitorsIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIn(FieldUniqueVisitors, vs...))
+}
+
+// UniqueVisitorsNotIn applies the NotIn predicate on the "unique_visitors" field.
+func UniqueVisitorsNotIn(vs ...int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotIn(FieldUniqueVisitors, vs...))
+}
+
+// UniqueVisitorsGT applies the GT predicate on the "unique_visitors" field.
+func UniqueVisitorsGT(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGT(FieldUniqueVisitors, v))
+}
+
+// UniqueVisitorsGTE applies the GTE predicate on the "unique_visitors" field.
+func UniqueVisitorsGTE(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldGTE(FieldUniqueVisitors, v))
+}
+
+// UniqueVisitorsLT applies the LT predicate on the "unique_visitors" field.
+func UniqueVisitorsLT(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLT(FieldUniqueVisitors, v))
+}
+
+// UniqueVisitorsLTE applies the LTE predicate on the "unique_visitors" field.
+func UniqueVisitorsLTE(v int) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldLTE(FieldUniqueVisitors, v))
+}
+
+// DevicesIsNil applies the IsNil predicate on the "devices" field.
+func DevicesIsNil() predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldIsNull(FieldDevices))
+}
+
+// DevicesNotNil applies the NotNil predicate on the "devices" field.
+func DevicesNotNil() predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.FieldNotNull(FieldDevices))
+}
+
+// HasQrCode applies the HasEdge predicate on the "qr_code" edge.
+func HasQrCode() predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QrCodeTable, QrCodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQrCodeWith applies the HasEdge predicate on the "qr_code" edge with a given conditions (other predicates).
+func HasQrCodeWith(preds ...predicate.QRCode) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(func(s *sql.Selector) {
+		step := newQrCodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QRCodeAnalyticsDaily) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QRCodeAnalyticsDaily) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QRCodeAnalyticsDaily) predicate.QRCodeAnalyticsDaily {
+	return predicate.QRCodeAnalyticsDaily(sql.NotPredicates(p))
+}
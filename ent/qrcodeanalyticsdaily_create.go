@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeAnalyticsDailyCreate is the builder for creating a QRCodeAnalyticsDaily entity.
+type QRCodeAnalyticsDailyCreate struct {
+	config
+	mutation *QRCodeAnalyticsDailyMutation
+	hooks    []Hook
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetQrCodeID(i int) *QRCodeAnalyticsDailyCreate {
+	qcadc.mutation.SetQrCodeID(i)
+	return qcadc
+}
+
+// SetDay sets the "day" field.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetDay(t time.Time) *QRCodeAnalyticsDailyCreate {
+	qcadc.mutation.SetDay(t)
+	return qcadc
+}
+
+// SetScans sets the "scans" field.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetScans(i int) *QRCodeAnalyticsDailyCreate {
+	qcadc.mutation.SetScans(i)
+	return qcadc
+}
+
+// SetNillableScans sets the "scans" field if the given value is not nil.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetNillableScans(i *int) *QRCodeAnalyticsDailyCreate {
+	if i != nil {
+		qcadc.SetScans(*i)
+	}
+	return qcadc
+}
+
+// SetUniqueVisitors sets the "unique_visitors" field.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetUniqueVisitors(i int) *QRCodeAnalyticsDailyCreate {
+	qcadc.mutation.SetUniqueVisitors(i)
+	return qcadc
+}
+
+// SetNillableUniqueVisitors sets the "unique_visitors" field if the given value is not nil.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetNillableUniqueVisitors(i *int) *QRCodeAnalyticsDailyCreate {
+	if i != nil {
+		qcadc.SetUniqueVisitors(*i)
+	}
+	return qcadc
+}
+
+// SetDevices sets the "devices" field.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetDevices(m map[string]int) *QRCodeAnalyticsDailyCreate {
+	qcadc.mutation.SetDevices(m)
+	return qcadc
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcadc *QRCodeAnalyticsDailyCreate) SetQrCode(q *QRCode) *QRCodeAnalyticsDailyCreate {
+	return qcadc.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeAnalyticsDailyMutation object of the builder.
+func (qcadc *QRCodeAnalyticsDailyCreate) Mutation() *QRCodeAnalyticsDailyMutation {
+	return qcadc.mutation
+}
+
+// Save creates the QRCodeAnalyticsDaily in the database.
+func (qcadc *QRCodeAnalyticsDailyCreate) Save(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+	qcadc.defaults()
+	return withHooks(ctx, qcadc.sqlSave, qcadc.mutation, qcadc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qcadc *QRCodeAnalyticsDailyCreate) SaveX(ctx context.Context) *QRCodeAnalyticsDaily {
+	v, err := qcadc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcadc *QRCodeAnalyticsDailyCreate) Exec(ctx context.Context) error {
+	_, err := qcadc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcadc *QRCodeAnalyticsDailyCreate) ExecX(ctx context.Context) {
+	if err := qcadc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qcadc *QRCodeAnalyticsDailyCreate) defaults() {
+	if _, ok := qcadc.mutation.Scans(); !ok {
+		v := qrcodeanalyticsdaily.DefaultScans
+		qcadc.mutation.SetScans(v)
+	}
+	if _, ok := qcadc.mutation.UniqueVisitors(); !ok {
+		v := qrcodeanalyticsdaily.DefaultUniqueVisitors
+		qcadc.mutation.SetUniqueVisitors(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcadc *QRCodeAnalyticsDailyCreate) check() error {
+	if _, ok := qcadc.mutation.QrCodeID(); !ok {
+		return &ValidationError{Name: "qr_code_id", err: errors.New(`ent: missing required field "QRCodeAnalyticsDaily.qr_code_id"`)}
+	}
+	if _, ok := qcadc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "QRCodeAnalyticsDaily.day"`)}
+	}
+	if _, ok := qcadc.mutation.Scans(); !ok {
+		return &ValidationError{Name: "scans", err: errors.New(`ent: missing required field "QRCodeAnalyticsDaily.scans"`)}
+	}
+	if _, ok := qcadc.mutation.UniqueVisitors(); !ok {
+		return &ValidationError{Name: "unique_visitors", err: errors.New(`ent: missing required field "QRCodeAnalyticsDaily.unique_visitors"`)}
+	}
+	if len(qcadc.mutation.QrCodeIDs()) == 0 {
+		return &ValidationError{Name: "qr_code", err: errors.New(`ent: missing required edge "QRCodeAnalyticsDaily.qr_code"`)}
+	}
+	return nil
+}
+
+func (qcadc *QRCodeAnalyticsDailyCreate) sqlSave(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+	if err := qcadc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qcadc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qcadc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qcadc.mutation.id = &_node.ID
+	qcadc.mutation.done = true
+	return _node, nil
+}
+
+func (qcadc *QRCodeAnalyticsDailyCreate) createSpec() (*QRCodeAnalyticsDaily, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QRCodeAnalyticsDaily{config: qcadc.config}
+		_spec = sqlgraph.NewCreateSpec(qrcodeanalyticsdaily.Table, sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt))
+	)
+	if value, ok := qcadc.mutation.Day(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := qcadc.mutation.Scans(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldScans, field.TypeInt, value)
+		_node.Scans = value
+	}
+	if value, ok := qcadc.mutation.UniqueVisitors(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldUniqueVisitors, field.TypeInt, value)
+		_node.UniqueVisitors = value
+	}
+	if value, ok := qcadc.mutation.Devices(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDevices, field.TypeJSON, value)
+		_node.Devices = value
+	}
+	if nodes := qcadc.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcodeanalyticsdaily.QrCodeTable,
+			Columns: []string{qrcodeanalyticsdaily.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QrCodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QRCodeAnalyticsDailyCreateBulk is the builder for creating many QRCodeAnalyticsDaily entities in bulk.
+type QRCodeAnalyticsDailyCreateBulk struct {
+	config
+	err      error
+	builders []*QRCodeAnalyticsDailyCreate
+}
+
+// Save creates the QRCodeAnalyticsDaily entities in the database.
+func (qcadcb *QRCodeAnalyticsDailyCreateBulk) Save(ctx context.Context) ([]*QRCodeAnalyticsDaily, error) {
+	if qcadcb.err != nil {
+		return nil, qcadcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcadcb.builders))
+	nodes := make([]*QRCodeAnalyticsDaily, len(qcadcb.builders))
+	mutators := make([]Mutator, len(qcadcb.builders))
+	for i := range qcadcb.builders {
+		func(i int, root context.Context) {
+			builder := qcadcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QRCodeAnalyticsDailyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcadcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcadcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcadcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcadcb *QRCodeAnalyticsDailyCreateBulk) SaveX(ctx context.Context) []*QRCodeAnalyticsDaily {
+	v, err := qcadcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcadcb *QRCodeAnalyticsDailyCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcadcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcadcb *QRCodeAnalyticsDailyCreateBulk) ExecX(ctx context.Context) {
+	if err := qcadcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
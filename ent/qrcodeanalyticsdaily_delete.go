@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeAnalyticsDailyDelete is the builder for deleting a QRCodeAnalyticsDaily entity.
+type QRCodeAnalyticsDailyDelete struct {
+	config
+	hooks    []Hook
+	mutation *QRCodeAnalyticsDailyMutation
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsDailyDelete builder.
+func (qcadd *QRCodeAnalyticsDailyDelete) Where(ps ...predicate.QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyDelete {
+	qcadd.mutation.Where(ps...)
+	return qcadd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qcadd *QRCodeAnalyticsDailyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qcadd.sqlExec, qcadd.mutation, qcadd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcadd *QRCodeAnalyticsDailyDelete) ExecX(ctx context.Context) int {
+	n, err := qcadd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qcadd *QRCodeAnalyticsDailyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(qrcodeanalyticsdaily.Table, sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt))
+	if ps := qcadd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qcadd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qcadd.mutation.done = true
+	return affected, err
+}
+
+// QRCodeAnalyticsDailyDeleteOne is the builder for deleting a single QRCodeAnalyticsDaily entity.
+type QRCodeAnalyticsDailyDeleteOne struct {
+	qcadd *QRCodeAnalyticsDailyDelete
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsDailyDelete builder.
+func (qcaddo *QRCodeAnalyticsDailyDeleteOne) Where(ps ...predicate.QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyDeleteOne {
+	qcaddo.qcadd.mutation.Where(ps...)
+	return qcaddo
+}
+
+// Exec executes the deletion query.
+func (qcaddo *QRCodeAnalyticsDailyDeleteOne) Exec(ctx context.Context) error {
+	n, err := qcaddo.qcadd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{qrcodeanalyticsdaily.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcaddo *QRCodeAnalyticsDailyDeleteOne) ExecX(ctx context.Context) {
+	if err := qcaddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
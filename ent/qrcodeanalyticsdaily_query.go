@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeAnalyticsDailyQuery is the builder for querying QRCodeAnalyticsDaily entities.
+type QRCodeAnalyticsDailyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []qrcodeanalyticsdaily.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QRCodeAnalyticsDaily
+	withQrCode *QRCodeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QRCodeAnalyticsDailyQuery builder.
+func (qcadq *QRCodeAnalyticsDailyQuery) Where(ps ...predicate.QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyQuery {
+	qcadq.predicates = append(qcadq.predicates, ps...)
+	return qcadq
+}
+
+// Limit the number of records to be returned by this query.
+func (qcadq *QRCodeAnalyticsDailyQuery) Limit(limit int) *QRCodeAnalyticsDailyQuery {
+	qcadq.ctx.Limit = &limit
+	return qcadq
+}
+
+// Offset to start from.
+func (qcadq *QRCodeAnalyticsDailyQuery) Offset(offset int) *QRCodeAnalyticsDailyQuery {
+	qcadq.ctx.Offset = &offset
+	return qcadq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qcadq *QRCodeAnalyticsDailyQuery) Unique(unique bool) *QRCodeAnalyticsDailyQuery {
+	qcadq.ctx.Unique = &unique
+	return qcadq
+}
+
+// Order specifies how the records should be ordered.
+func (qcadq *QRCodeAnalyticsDailyQuery) Order(o ...qrcodeanalyticsdaily.OrderOption) *QRCodeAnalyticsDailyQuery {
+	qcadq.order = append(qcadq.order, o...)
+	return qcadq
+}
+
+// QueryQrCode chains the current query on the "qr_code" edge.
+func (qcadq *QRCodeAnalyticsDailyQuery) QueryQrCode() *QRCodeQuery {
+	query := (&QRCodeClient{config: qcadq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcadq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcadq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.FieldID, selector),
+			sqlgraph.To(qrcode.Table, qrcode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, qrcodeanalyticsdaily.QrCodeTable, qrcodeanalyticsdaily.QrCodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcadq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QRCodeAnalyticsDaily entity from the query.
+// Returns a *NotFoundError when no QRCodeAnalyticsDaily was found.
+func (qcadq *QRCodeAnalyticsDailyQuery) First(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+	nodes, err := qcadq.Limit(1).All(setContextOp(ctx, qcadq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{qrcodeanalyticsdaily.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) FirstX(ctx context.Context) *QRCodeAnalyticsDaily {
+	node, err := qcadq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QRCodeAnalyticsDaily ID from the query.
+// Returns a *NotFoundError when no QRCodeAnalyticsDaily ID was found.
+func (qcadq *QRCodeAnalyticsDailyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qcadq.Limit(1).IDs(setContextOp(ctx, qcadq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{qrcodeanalyticsdaily.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) FirstIDX(ctx context.Context) int {
+	id, err := qcadq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QRCodeAnalyticsDaily entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QRCodeAnalyticsDaily entity is found.
+// Returns a *NotFoundError when no QRCodeAnalyticsDaily entities are found.
+func (qcadq *QRCodeAnalyticsDailyQuery) Only(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+	nodes, err := qcadq.Limit(2).All(setContextOp(ctx, qcadq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{qrcodeanalyticsdaily.Label}
+	default:
+		return nil, &NotSingularError{qrcodeanalyticsdaily.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) OnlyX(ctx context.Context) *QRCodeAnalyticsDaily {
+	node, err := qcadq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QRCodeAnalyticsDaily ID in the query.
+// Returns a *NotSingularError when more than one QRCodeAnalyticsDaily ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qcadq *QRCodeAnalyticsDailyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qcadq.Limit(2).IDs(setContextOp(ctx, qcadq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{qrcodeanalyticsdaily.Label}
+	default:
+		err = &NotSingularError{qrcodeanalyticsdaily.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qcadq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QRCodeAnalyticsDailies.
+func (qcadq *QRCodeAnalyticsDailyQuery) All(ctx context.Context) ([]*QRCodeAnalyticsDaily, error) {
+	ctx = setContextOp(ctx, qcadq.ctx, ent.OpQueryAll)
+	if err := qcadq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QRCodeAnalyticsDaily, *QRCodeAnalyticsDailyQuery]()
+	return withInterceptors[[]*QRCodeAnalyticsDaily](ctx, qcadq, qr, qcadq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) AllX(ctx context.Context) []*QRCodeAnalyticsDaily {
+	nodes, err := qcadq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QRCodeAnalyticsDaily IDs.
+func (qcadq *QRCodeAnalyticsDailyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if qcadq.ctx.Unique == nil && qcadq.path != nil {
+		qcadq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qcadq.ctx, ent.OpQueryIDs)
+	if err = qcadq.Select(qrcodeanalyticsdaily.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) IDsX(ctx context.Context) []int {
+	ids, err := qcadq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qcadq *QRCodeAnalyticsDailyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qcadq.ctx, ent.OpQueryCount)
+	if err := qcadq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qcadq, querierCount[*QRCodeAnalyticsDailyQuery](), qcadq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) CountX(ctx context.Context) int {
+	count, err := qcadq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qcadq *QRCodeAnalyticsDailyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qcadq.ctx, ent.OpQueryExist)
+	switch _, err := qcadq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qcadq *QRCodeAnalyticsDailyQuery) ExistX(ctx context.Context) bool {
+	exist, err := qcadq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QRCodeAnalyticsDailyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qcadq *QRCodeAnalyticsDailyQuery) Clone() *QRCodeAnalyticsDailyQuery {
+	if qcadq == nil {
+		return nil
+	}
+	return &QRCodeAnalyticsDailyQuery{
+		config:     qcadq.config,
+		ctx:        qcadq.ctx.Clone(),
+		order:      append([]qrcodeanalyticsdaily.OrderOption{}, qcadq.order...),
+		inters:     append([]Interceptor{}, qcadq.inters...),
+		predicates: append([]predicate.QRCodeAnalyticsDaily{}, qcadq.predicates...),
+		withQrCode: qcadq.withQrCode.Clone(),
+		// clone intermediate query.
+		sql:  qcadq.sql.Clone(),
+		path: qcadq.path,
+	}
+}
+
+// WithQrCode tells the query-builder to eager-load the nodes that are connected to
+// the "qr_code" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcadq *QRCodeAnalyticsDailyQuery) WithQrCode(opts ...func(*QRCodeQuery)) *QRCodeAnalyticsDailyQuery {
+	query := (&QRCodeClient{config: qcadq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcadq.withQrCode = query
+	return qcadq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		QrCodeID int `json:"qr_code_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QRCodeAnalyticsDaily.Query().
+//		GroupBy(qrcodeanalyticsdaily.FieldQrCodeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qcadq *QRCodeAnalyticsDailyQuery) GroupBy(field string, fields ...string) *QRCodeAnalyticsDailyGroupBy {
+	qcadq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QRCodeAnalyticsDailyGroupBy{build: qcadq}
+	grbuild.flds = &qcadq.ctx.Fields
+	grbuild.label = qrcodeanalyticsdaily.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		QrCodeID int `json:"qr_code_id,omitempty"`
+//	}
+//
+//	client.QRCodeAnalyticsDaily.Query().
+//		Select(qrcodeanalyticsdaily.FieldQrCodeID).
+//		Scan(ctx, &v)
+func (qcadq *QRCodeAnalyticsDailyQuery) Select(fields ...string) *QRCodeAnalyticsDailySelect {
+	qcadq.ctx.Fields = append(qcadq.ctx.Fields, fields...)
+	sbuild := &QRCodeAnalyticsDailySelect{QRCodeAnalyticsDailyQuery: qcadq}
+	sbuild.label = qrcodeanalyticsdaily.Label
+	sbuild.flds, sbuild.scan = &qcadq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QRCodeAnalyticsDailySelect configured with the given aggregations.
+func (qcadq *QRCodeAnalyticsDailyQuery) Aggregate(fns ...AggregateFunc) *QRCodeAnalyticsDailySelect {
+	return qcadq.Select().Aggregate(fns...)
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qcadq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qcadq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qcadq.ctx.Fields {
+		if !qrcodeanalyticsdaily.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qcadq.path != nil {
+		prev, err := qcadq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qcadq.sql = prev
+	}
+	return nil
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRCodeAnalyticsDaily, error) {
+	var (
+		nodes       = []*QRCodeAnalyticsDaily{}
+		_spec       = qcadq.querySpec()
+		loadedTypes = [1]bool{
+			qcadq.withQrCode != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QRCodeAnalyticsDaily).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QRCodeAnalyticsDaily{config: qcadq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qcadq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qcadq.withQrCode; query != nil {
+		if err := qcadq.loadQrCode(ctx, query, nodes, nil,
+			func(n *QRCodeAnalyticsDaily, e *QRCode) { n.Edges.QrCode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) loadQrCode(ctx context.Context, query *QRCodeQuery, nodes []*QRCodeAnalyticsDaily, init func(*QRCodeAnalyticsDaily), assign func(*QRCodeAnalyticsDaily, *QRCode)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*QRCodeAnalyticsDaily)
+	for i := range nodes {
+		fk := nodes[i].QrCodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(qrcode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "qr_code_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qcadq.querySpec()
+	_spec.Node.Columns = qcadq.ctx.Fields
+	if len(qcadq.ctx.Fields) > 0 {
+		_spec.Unique = qcadq.ctx.Unique != nil && *qcadq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qcadq.driver, _spec)
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.Columns, sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt))
+	_spec.From = qcadq.sql
+	if unique := qcadq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qcadq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qcadq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrcodeanalyticsdaily.FieldID)
+		for i := range fields {
+			if fields[i] != qrcodeanalyticsdaily.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if qcadq.withQrCode != nil {
+			_spec.Node.AddColumnOnce(qrcodeanalyticsdaily.FieldQrCodeID)
+		}
+	}
+	if ps := qcadq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qcadq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qcadq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qcadq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qcadq *QRCodeAnalyticsDailyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qcadq.driver.Dialect())
+	t1 := builder.Table(qrcodeanalyticsdaily.Table)
+	columns := qcadq.ctx.Fields
+	if len(columns) == 0 {
+		columns = qrcodeanalyticsdaily.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qcadq.sql != nil {
+		selector = qcadq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qcadq.ctx.Unique != nil && *qcadq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range qcadq.predicates {
+		p(selector)
+	}
+	for _, p := range qcadq.order {
+		p(selector)
+	}
+	if offset := qcadq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qcadq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QRCodeAnalyticsDailyGroupBy is the group-by builder for QRCodeAnalyticsDaily entities.
+type QRCodeAnalyticsDailyGroupBy struct {
+	selector
+	build *QRCodeAnalyticsDailyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qcadgb *QRCodeAnalyticsDailyGroupBy) Aggregate(fns ...AggregateFunc) *QRCodeAnalyticsDailyGroupBy {
+	qcadgb.fns = append(qcadgb.fns, fns...)
+	return qcadgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qcadgb *QRCodeAnalyticsDailyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qcadgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qcadgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRCodeAnalyticsDailyQuery, *QRCodeAnalyticsDailyGroupBy](ctx, qcadgb.build, qcadgb, qcadgb.build.inters, v)
+}
+
+func (qcadgb *QRCodeAnalyticsDailyGroupBy) sqlScan(ctx context.Context, root *QRCodeAnalyticsDailyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qcadgb.fns))
+	for _, fn := range qcadgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qcadgb.flds)+len(qcadgb.fns))
+		for _, f := range *qcadgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qcadgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qcadgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QRCodeAnalyticsDailySelect is the builder for selecting fields of QRCodeAnalyticsDaily entities.
+type QRCodeAnalyticsDailySelect struct {
+	*QRCodeAnalyticsDailyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qcads *QRCodeAnalyticsDailySelect) Aggregate(fns ...AggregateFunc) *QRCodeAnalyticsDailySelect {
+	qcads.fns = append(qcads.fns, fns...)
+	return qcads
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qcads *QRCodeAnalyticsDailySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qcads.ctx, ent.OpQuerySelect)
+	if err := qcads.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRCodeAnalyticsDailyQuery, *QRCodeAnalyticsDailySelect](ctx, qcads.QRCodeAnalyticsDailyQuery, qcads, qcads.inters, v)
+}
+
+func (qcads *QRCodeAnalyticsDailySelect) sqlScan(ctx context.Context, root *QRCodeAnalyticsDailyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qcads.fns))
+	for _, fn := range qcads.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qcads.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qcads.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeAnalyticsDailyUpdate is the builder for updating QRCodeAnalyticsDaily entities.
+type QRCodeAnalyticsDailyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QRCodeAnalyticsDailyMutation
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsDailyUpdate builder.
+func (qcadu *QRCodeAnalyticsDailyUpdate) Where(ps ...predicate.QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.Where(ps...)
+	return qcadu
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetQrCodeID(i int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.SetQrCodeID(i)
+	return qcadu
+}
+
+// SetNillableQrCodeID sets the "qr_code_id" field if the given value is not nil.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetNillableQrCodeID(i *int) *QRCodeAnalyticsDailyUpdate {
+	if i != nil {
+		qcadu.SetQrCodeID(*i)
+	}
+	return qcadu
+}
+
+// SetDay sets the "day" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetDay(t time.Time) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.SetDay(t)
+	return qcadu
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetNillableDay(t *time.Time) *QRCodeAnalyticsDailyUpdate {
+	if t != nil {
+		qcadu.SetDay(*t)
+	}
+	return qcadu
+}
+
+// SetScans sets the "scans" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetScans(i int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.ResetScans()
+	qcadu.mutation.SetScans(i)
+	return qcadu
+}
+
+// SetNillableScans sets the "scans" field if the given value is not nil.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetNillableScans(i *int) *QRCodeAnalyticsDailyUpdate {
+	if i != nil {
+		qcadu.SetScans(*i)
+	}
+	return qcadu
+}
+
+// AddScans adds i to the "scans" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) AddScans(i int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.AddScans(i)
+	return qcadu
+}
+
+// SetUniqueVisitors sets the "unique_visitors" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetUniqueVisitors(i int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.ResetUniqueVisitors()
+	qcadu.mutation.SetUniqueVisitors(i)
+	return qcadu
+}
+
+// SetNillableUniqueVisitors sets the "unique_visitors" field if the given value is not nil.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetNillableUniqueVisitors(i *int) *QRCodeAnalyticsDailyUpdate {
+	if i != nil {
+		qcadu.SetUniqueVisitors(*i)
+	}
+	return qcadu
+}
+
+// AddUniqueVisitors adds i to the "unique_visitors" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) AddUniqueVisitors(i int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.AddUniqueVisitors(i)
+	return qcadu
+}
+
+// SetDevices sets the "devices" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetDevices(m map[string]int) *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.SetDevices(m)
+	return qcadu
+}
+
+// ClearDevices clears the value of the "devices" field.
+func (qcadu *QRCodeAnalyticsDailyUpdate) ClearDevices() *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.ClearDevices()
+	return qcadu
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SetQrCode(q *QRCode) *QRCodeAnalyticsDailyUpdate {
+	return qcadu.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeAnalyticsDailyMutation object of the builder.
+func (qcadu *QRCodeAnalyticsDailyUpdate) Mutation() *QRCodeAnalyticsDailyMutation {
+	return qcadu.mutation
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (qcadu *QRCodeAnalyticsDailyUpdate) ClearQrCode() *QRCodeAnalyticsDailyUpdate {
+	qcadu.mutation.ClearQrCode()
+	return qcadu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qcadu *QRCodeAnalyticsDailyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qcadu.sqlSave, qcadu.mutation, qcadu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcadu *QRCodeAnalyticsDailyUpdate) SaveX(ctx context.Context) int {
+	affected, err := qcadu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qcadu *QRCodeAnalyticsDailyUpdate) Exec(ctx context.Context) error {
+	_, err := qcadu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcadu *QRCodeAnalyticsDailyUpdate) ExecX(ctx context.Context) {
+	if err := qcadu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcadu *QRCodeAnalyticsDailyUpdate) check() error {
+	if qcadu.mutation.QrCodeCleared() && len(qcadu.mutation.QrCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QRCodeAnalyticsDaily.qr_code"`)
+	}
+	return nil
+}
+
+func (qcadu *QRCodeAnalyticsDailyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qcadu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.Columns, sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt))
+	if ps := qcadu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qcadu.mutation.Day(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := qcadu.mutation.Scans(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldScans, field.TypeInt, value)
+	}
+	if value, ok := qcadu.mutation.AddedScans(); ok {
+		_spec.AddField(qrcodeanalyticsdaily.FieldScans, field.TypeInt, value)
+	}
+	if value, ok := qcadu.mutation.UniqueVisitors(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldUniqueVisitors, field.TypeInt, value)
+	}
+	if value, ok := qcadu.mutation.AddedUniqueVisitors(); ok {
+		_spec.AddField(qrcodeanalyticsdaily.FieldUniqueVisitors, field.TypeInt, value)
+	}
+	if value, ok := qcadu.mutation.Devices(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDevices, field.TypeJSON, value)
+	}
+	if qcadu.mutation.DevicesCleared() {
+		_spec.ClearField(qrcodeanalyticsdaily.FieldDevices, field.TypeJSON)
+	}
+	if qcadu.mutation.QrCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcodeanalyticsdaily.QrCodeTable,
+			Columns: []string{qrcodeanalyticsdaily.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcadu.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcodeanalyticsdaily.QrCodeTable,
+			Columns: []string{qrcodeanalyticsdaily.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qcadu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrcodeanalyticsdaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qcadu.mutation.done = true
+	return n, nil
+}
+
+// QRCodeAnalyticsDailyUpdateOne is the builder for updating a single QRCodeAnalyticsDaily entity.
+type QRCodeAnalyticsDailyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QRCodeAnalyticsDailyMutation
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetQrCodeID(i int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.SetQrCodeID(i)
+	return qcaduo
+}
+
+// SetNillableQrCodeID sets the "qr_code_id" field if the given value is not nil.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetNillableQrCodeID(i *int) *QRCodeAnalyticsDailyUpdateOne {
+	if i != nil {
+		qcaduo.SetQrCodeID(*i)
+	}
+	return qcaduo
+}
+
+// SetDay sets the "day" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetDay(t time.Time) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.SetDay(t)
+	return qcaduo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetNillableDay(t *time.Time) *QRCodeAnalyticsDailyUpdateOne {
+	if t != nil {
+		qcaduo.SetDay(*t)
+	}
+	return qcaduo
+}
+
+// SetScans sets the "scans" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetScans(i int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.ResetScans()
+	qcaduo.mutation.SetScans(i)
+	return qcaduo
+}
+
+// SetNillableScans sets the "scans" field if the given value is not nil.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetNillableScans(i *int) *QRCodeAnalyticsDailyUpdateOne {
+	if i != nil {
+		qcaduo.SetScans(*i)
+	}
+	return qcaduo
+}
+
+// AddScans adds i to the "scans" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) AddScans(i int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.AddScans(i)
+	return qcaduo
+}
+
+// SetUniqueVisitors sets the "unique_visitors" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetUniqueVisitors(i int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.ResetUniqueVisitors()
+	qcaduo.mutation.SetUniqueVisitors(i)
+	return qcaduo
+}
+
+// SetNillableUniqueVisitors sets the "unique_visitors" field if the given value is not nil.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetNillableUniqueVisitors(i *int) *QRCodeAnalyticsDailyUpdateOne {
+	if i != nil {
+		qcaduo.SetUniqueVisitors(*i)
+	}
+	return qcaduo
+}
+
+// AddUniqueVisitors adds i to the "unique_visitors" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) AddUniqueVisitors(i int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.AddUniqueVisitors(i)
+	return qcaduo
+}
+
+// SetDevices sets the "devices" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetDevices(m map[string]int) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.SetDevices(m)
+	return qcaduo
+}
+
+// ClearDevices clears the value of the "devices" field.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) ClearDevices() *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.ClearDevices()
+	return qcaduo
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SetQrCode(q *QRCode) *QRCodeAnalyticsDailyUpdateOne {
+	return qcaduo.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeAnalyticsDailyMutation object of the builder.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) Mutation() *QRCodeAnalyticsDailyMutation {
+	return qcaduo.mutation
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) ClearQrCode() *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.ClearQrCode()
+	return qcaduo
+}
+
+// Where appends a list predicates to the QRCodeAnalyticsDailyUpdate builder.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) Where(ps ...predicate.QRCodeAnalyticsDaily) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.mutation.Where(ps...)
+	return qcaduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) Select(field string, fields ...string) *QRCodeAnalyticsDailyUpdateOne {
+	qcaduo.fields = append([]string{field}, fields...)
+	return qcaduo
+}
+
+// Save executes the query and returns the updated QRCodeAnalyticsDaily entity.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) Save(ctx context.Context) (*QRCodeAnalyticsDaily, error) {
+	return withHooks(ctx, qcaduo.sqlSave, qcaduo.mutation, qcaduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) SaveX(ctx context.Context) *QRCodeAnalyticsDaily {
+	node, err := qcaduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) Exec(ctx context.Context) error {
+	_, err := qcaduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) ExecX(ctx context.Context) {
+	if err := qcaduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) check() error {
+	if qcaduo.mutation.QrCodeCleared() && len(qcaduo.mutation.QrCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QRCodeAnalyticsDaily.qr_code"`)
+	}
+	return nil
+}
+
+func (qcaduo *QRCodeAnalyticsDailyUpdateOne) sqlSave(ctx context.Context) (_node *QRCodeAnalyticsDaily, err error) {
+	if err := qcaduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrcodeanalyticsdaily.Table, qrcodeanalyticsdaily.Columns, sqlgraph.NewFieldSpec(qrcodeanalyticsdaily.FieldID, field.TypeInt))
+	id, ok := qcaduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QRCodeAnalyticsDaily.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qcaduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrcodeanalyticsdaily.FieldID)
+		for _, f := range fields {
+			if !qrcodeanalyticsdaily.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != qrcodeanalyticsdaily.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qcaduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qcaduo.mutation.Day(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := qcaduo.mutation.Scans(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldScans, field.TypeInt, value)
+	}
+	if value, ok := qcaduo.mutation.AddedScans(); ok {
+		_spec.AddField(qrcodeanalyticsdaily.FieldScans, field.TypeInt, value)
+	}
+	if value, ok := qcaduo.mutation.UniqueVisitors(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldUniqueVisitors, field.TypeInt, value)
+	}
+	if value, ok := qcaduo.mutation.AddedUniqueVisitors(); ok {
+		_spec.AddField(qrcodeanalyticsdaily.FieldUniqueVisitors, field.TypeInt, value)
+	}
+	if value, ok := qcaduo.mutation.Devices(); ok {
+		_spec.SetField(qrcodeanalyticsdaily.FieldDevices, field.TypeJSON, value)
+	}
+	if qcaduo.mutation.DevicesCleared() {
+		_spec.ClearField(qrcodeanalyticsdaily.FieldDevices, field.TypeJSON)
+	}
+	if qcaduo.mutation.QrCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcodeanalyticsdaily.QrCodeTable,
+			Columns: []string{qrcodeanalyticsdaily.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcaduo.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcodeanalyticsdaily.QrCodeTable,
+			Columns: []string{qrcodeanalyticsdaily.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QRCodeAnalyticsDaily{config: qcaduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qcaduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrcodeanalyticsdaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qcaduo.mutation.done = true
+	return _node, nil
+}
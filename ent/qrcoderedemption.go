@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// QRCodeRedemption is the model entity for the QRCodeRedemption schema.
+type QRCodeRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// QrCodeID holds the value of the "qr_code_id" field.
+	QrCodeID int `json:"qr_code_id,omitempty"`
+	// Visitor holds the value of the "visitor" field.
+	Visitor string `json:"visitor,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// FirstAt holds the value of the "first_at" field.
+	FirstAt time.Time `json:"first_at,omitempty"`
+	// LastAt holds the value of the "last_at" field.
+	LastAt time.Time `json:"last_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QRCodeRedemptionQuery when eager-loading is set.
+	Edges        QRCodeRedemptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QRCodeRedemptionEdges holds the relations/edges for other nodes in the graph.
+type QRCodeRedemptionEdges struct {
+	// QrCode holds the value of the qr_code edge.
+	QrCode *QRCode `json:"qr_code,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QrCodeOrErr returns the QrCode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QRCodeRedemptionEdges) QrCodeOrErr() (*QRCode, error) {
+	if e.QrCode != nil {
+		return e.QrCode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: qrcode.Label}
+	}
+	return nil, &NotLoadedError{edge: "qr_code"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QRCodeRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case qrcoderedemption.FieldID, qrcoderedemption.FieldQrCodeID, qrcoderedemption.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case qrcoderedemption.FieldVisitor:
+			values[i] = new(sql.NullString)
+		case qrcoderedemption.FieldFirstAt, qrcoderedemption.FieldLastAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QRCodeRedemption fields.
+func (qcr *QRCodeRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case qrcoderedemption.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qcr.ID = int(value.Int64)
+		case qrcoderedemption.FieldQrCodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field qr_code_id", values[i])
+			} else if value.Valid {
+				qcr.QrCodeID = int(value.Int64)
+			}
+		case qrcoderedemption.FieldVisitor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visitor", values[i])
+			} else if value.Valid {
+				qcr.Visitor = value.String
+			}
+		case qrcoderedemption.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				qcr.Count = int(value.Int64)
+			}
+		case qrcoderedemption.FieldFirstAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_at", values[i])
+			} else if value.Valid {
+				qcr.FirstAt = value.Time
+			}
+		case qrcoderedemption.FieldLastAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_at", values[i])
+			} else if value.Valid {
+				qcr.LastAt = value.Time
+			}
+		default:
+			qcr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QRCodeRedemption.
+// This includes values selected through modifiers, order, etc.
+func (qcr *QRCodeRedemption) Value(name string) (ent.Value, error) {
+	return qcr.selectValues.Get(name)
+}
+
+// QueryQrCode queries the "qr_code" edge of the QRCodeRedemption entity.
+func (qcr *QRCodeRedemption) QueryQrCode() *QRCodeQuery {
+	return NewQRCodeRedemptionClient(qcr.config).QueryQrCode(qcr)
+}
+
+// Update returns a builder for updating this QRCodeRedemption.
+// Note that you need to call QRCodeRedemption.Unwrap() before calling this method if this QRCodeRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qcr *QRCodeRedemption) Update() *QRCodeRedemptionUpdateOne {
+	return NewQRCodeRedemptionClient(qcr.config).UpdateOne(qcr)
+}
+
+// Unwrap unwraps the QRCodeRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qcr *QRCodeRedemption) Unwrap() *QRCodeRedemption {
+	_tx, ok := qcr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QRCodeRedemption is not a transactional entity")
+	}
+	qcr.config.driver = _tx.drv
+	return qcr
+}
+
+// String implements the fmt.Stringer.
+func (qcr *QRCodeRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("QRCodeRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qcr.ID))
+	builder.WriteString("qr_code_id=")
+	builder.WriteString(fmt.Sprintf("%v", qcr.QrCodeID))
+	builder.WriteString(", ")
+	builder.WriteString("visitor=")
+	builder.WriteString(qcr.Visitor)
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", qcr.Count))
+	builder.WriteString(", ")
+	builder.WriteString("first_at=")
+	builder.WriteString(qcr.FirstAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_at=")
+	builder.WriteString(qcr.LastAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QRCodeRedemptions is a parsable slice of QRCodeRedemption.
+type QRCodeRedemptions []*QRCodeRedemption
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qrcoderedemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the qrcoderedemption type in the database.
+	Label = "qr_code_redemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQrCodeID holds the string denoting the qr_code_id field in the database.
+	FieldQrCodeID = "qr_code_id"
+	// FieldVisitor holds the string denoting the visitor field in the database.
+	FieldVisitor = "visitor"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldFirstAt holds the string denoting the first_at field in the database.
+	FieldFirstAt = "first_at"
+	// FieldLastAt holds the string denoting the last_at field in the database.
+	FieldLastAt = "last_at"
+	// EdgeQrCode holds the string denoting the qr_code edge name in mutations.
+	EdgeQrCode = "qr_code"
+	// Table holds the table name of the qrcoderedemption in the database.
+	Table = "qr_code_redemptions"
+	// QrCodeTable is the table that holds the qr_code relation/edge.
+	QrCodeTable = "qr_code_redemptions"
+	// QrCodeInverseTable is the table name for the QRCode entity.
+	// It exists in this package in order to avoid circular dependency with the "qrcode" package.
+	QrCodeInverseTable = "qr_codes"
+	// QrCodeColumn is the table column denoting the qr_code relation/edge.
+	QrCodeColumn = "qr_code_id"
+)
+
+// Columns holds all SQL columns for qrcoderedemption fields.
+var Columns = []string{
+	FieldID,
+	FieldQrCodeID,
+	FieldVisitor,
+	FieldCount,
+	FieldFirstAt,
+	FieldLastAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// VisitorValidator is a validator for the "visitor" field. It is called by the builders before save.
+	VisitorValidator func(string) error
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int
+	// DefaultFirstAt holds the default value on creation for the "first_at" field.
+	DefaultFirstAt func() time.Time
+	// DefaultLastAt holds the default value on creation for the "last_at" field.
+	DefaultLastAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the QRCodeRedemption queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQrCodeID orders the results by the qr_code_id field.
+func ByQrCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQrCodeID, opts...).ToFunc()
+}
+
+// ByVisitor orders the results by the visitor field.
+func ByVisitor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisitor, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByFirstAt orders the results by the first_at field.
+func ByFirstAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstAt, opts...).ToFunc()
+}
+
+// ByLastAt orders the results by the last_at field.
+func ByLastAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAt, opts...).ToFunc()
+}
+
+// ByQrCodeField orders the results by qr_code field.
+func ByQrCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQrCodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQrCodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QrCodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QrCodeTable, QrCodeColumn),
+	)
+}
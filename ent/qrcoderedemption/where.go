@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package qrcoderedemption
+
+import (
+	"qr_backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// QrCodeID applies equality check predicate on the "qr_code_id" field. It's identical to QrCodeIDEQ.
+func QrCodeID(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldQrCodeID, v))
+}
+
+// Visitor applies equality check predicate on the "visitor" field. It's identical to VisitorEQ.
+func Visitor(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldVisitor, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldCount, v))
+}
+
+// FirstAt applies equality check predicate on the "first_at" field. It's identical to FirstAtEQ.
+func FirstAt(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldFirstAt, v))
+}
+
+// LastAt applies equality check predicate on the "last_at" field. It's identical to LastAtEQ.
+func LastAt(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldLastAt, v))
+}
+
+// QrCodeIDEQ applies the EQ predicate on the "qr_code_id" field.
+func QrCodeIDEQ(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldQrCodeID, v))
+}
+
+// QrCodeIDNEQ applies the NEQ predicate on the "qr_code_id" field.
+func QrCodeIDNEQ(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldQrCodeID, v))
+}
+
+// QrCodeIDIn applies the In predicate on the "qr_code_id" field.
+func QrCodeIDIn(vs ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldQrCodeID, vs...))
+}
+
+// QrCodeIDNotIn applies the NotIn predicate on the "qr_code_id" field.
+func QrCodeIDNotIn(vs ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldQrCodeID, vs...))
+}
+
+// VisitorEQ applies the EQ predicate on the "visitor" field.
+func VisitorEQ(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldVisitor, v))
+}
+
+// VisitorNEQ applies the NEQ predicate on the "visitor" field.
+func VisitorNEQ(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldVisitor, v))
+}
+
+// VisitorIn applies the In predicate on the "visitor" field.
+func VisitorIn(vs ...string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldVisitor, vs...))
+}
+
+// VisitorNotIn applies the NotIn predicate on the "visitor" field.
+func VisitorNotIn(vs ...string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldVisitor, vs...))
+}
+
+// VisitorGT applies the GT predicate on the "visitor" field.
+func VisitorGT(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGT(FieldVisitor, v))
+}
+
+// VisitorGTE applies the GTE predicate on the "visitor" field.
+func VisitorGTE(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGTE(FieldVisitor, v))
+}
+
+// VisitorLT applies the LT predicate on the "visitor" field.
+func VisitorLT(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLT(FieldVisitor, v))
+}
+
+// VisitorLTE applies the LTE predicate on the "visitor" field.
+func VisitorLTE(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLTE(FieldVisitor, v))
+}
+
+// VisitorContains applies the Contains predicate on the "visitor" field.
+func VisitorContains(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldContains(FieldVisitor, v))
+}
+
+// VisitorHasPrefix applies the HasPrefix predicate on the "visitor" field.
+func VisitorHasPrefix(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldHasPrefix(FieldVisitor, v))
+}
+
+// VisitorHasSuffix applies the HasSuffix predicate on the "visitor" field.
+func VisitorHasSuffix(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldHasSuffix(FieldVisitor, v))
+}
+
+// VisitorEqualFold applies the EqualFold predicate on the "visitor" field.
+func VisitorEqualFold(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEqualFold(FieldVisitor, v))
+}
+
+// VisitorContainsFold applies the ContainsFold predicate on the "visitor" field.
+func VisitorContainsFold(v string) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldContainsFold(FieldVisitor, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLTE(FieldCount, v))
+}
+
+// FirstAtEQ applies the EQ predicate on the "first_at" field.
+func FirstAtEQ(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldFirstAt, v))
+}
+
+// FirstAtNEQ applies the NEQ predicate on the "first_at" field.
+func FirstAtNEQ(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldFirstAt, v))
+}
+
+// FirstAtIn applies the In predicate on the "first_at" field.
+func FirstAtIn(vs ...time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldFirstAt, vs...))
+}
+
+// FirstAtNotIn applies the NotIn predicate on the "first_at" field.
+func FirstAtNotIn(vs ...time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldFirstAt, vs...))
+}
+
+// FirstAtGT applies the GT predicate on the "first_at" field.
+func FirstAtGT(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGT(FieldFirstAt, v))
+}
+
+// FirstAtGTE applies the GTE predicate on the "first_at" field.
+func FirstAtGTE(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGTE(FieldFirstAt, v))
+}
+
+// FirstAtLT applies the LT predicate on the "first_at" field.
+func FirstAtLT(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLT(FieldFirstAt, v))
+}
+
+// FirstAtLTE applies the LTE predicate on the "first_at" field.
+func FirstAtLTE(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLTE(FieldFirstAt, v))
+}
+
+// LastAtEQ applies the EQ predicate on the "last_at" field.
+func LastAtEQ(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldEQ(FieldLastAt, v))
+}
+
+// LastAtNEQ applies the NEQ predicate on the "last_at" field.
+func LastAtNEQ(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNEQ(FieldLastAt, v))
+}
+
+// LastAtIn applies the In predicate on the "last_at" field.
+func LastAtIn(vs ...time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldIn(FieldLastAt, vs...))
+}
+
+// LastAtNotIn applies the NotIn predicate on the "last_at" field.
+func LastAtNotIn(vs ...time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldNotIn(FieldLastAt, vs...))
+}
+
+// LastAtGT applies the GT predicate on the "last_at" field.
+func LastAtGT(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGT(FieldLastAt, v))
+}
+
+// LastAtGTE applies the GTE predicate on the "last_at" field.
+func LastAtGTE(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldGTE(FieldLastAt, v))
+}
+
+// LastAtLT applies the LT predicate on the "last_at" field.
+func LastAtLT(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLT(FieldLastAt, v))
+}
+
+// LastAtLTE applies the LTE predicate on the "last_at" field.
+func LastAtLTE(v time.Time) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.FieldLTE(FieldLastAt, v))
+}
+
+// HasQrCode applies the HasEdge predicate on the "qr_code" edge.
+func HasQrCode() predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QrCodeTable, QrCodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQrCodeWith applies the HasEdge predicate on the "qr_code" edge with a given conditions (other predicates).
+func HasQrCodeWith(preds ...predicate.QRCode) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(func(s *sql.Selector) {
+		step := newQrCodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QRCodeRedemption) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QRCodeRedemption) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QRCodeRedemption) predicate.QRCodeRedemption {
+	return predicate.QRCodeRedemption(sql.NotPredicates(p))
+}
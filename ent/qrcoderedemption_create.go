@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeRedemptionCreate is the builder for creating a QRCodeRedemption entity.
+type QRCodeRedemptionCreate struct {
+	config
+	mutation *QRCodeRedemptionMutation
+	hooks    []Hook
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcrc *QRCodeRedemptionCreate) SetQrCodeID(i int) *QRCodeRedemptionCreate {
+	qcrc.mutation.SetQrCodeID(i)
+	return qcrc
+}
+
+// SetVisitor sets the "visitor" field.
+func (qcrc *QRCodeRedemptionCreate) SetVisitor(s string) *QRCodeRedemptionCreate {
+	qcrc.mutation.SetVisitor(s)
+	return qcrc
+}
+
+// SetCount sets the "count" field.
+func (qcrc *QRCodeRedemptionCreate) SetCount(i int) *QRCodeRedemptionCreate {
+	qcrc.mutation.SetCount(i)
+	return qcrc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (qcrc *QRCodeRedemptionCreate) SetNillableCount(i *int) *QRCodeRedemptionCreate {
+	if i != nil {
+		qcrc.SetCount(*i)
+	}
+	return qcrc
+}
+
+// SetFirstAt sets the "first_at" field.
+func (qcrc *QRCodeRedemptionCreate) SetFirstAt(t time.Time) *QRCodeRedemptionCreate {
+	qcrc.mutation.SetFirstAt(t)
+	return qcrc
+}
+
+// SetNillableFirstAt sets the "first_at" field if the given value is not nil.
+func (qcrc *QRCodeRedemptionCreate) SetNillableFirstAt(t *time.Time) *QRCodeRedemptionCreate {
+	if t != nil {
+		qcrc.SetFirstAt(*t)
+	}
+	return qcrc
+}
+
+// SetLastAt sets the "last_at" field.
+func (qcrc *QRCodeRedemptionCreate) SetLastAt(t time.Time) *QRCodeRedemptionCreate {
+	qcrc.mutation.SetLastAt(t)
+	return qcrc
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (qcrc *QRCodeRedemptionCreate) SetNillableLastAt(t *time.Time) *QRCodeRedemptionCreate {
+	if t != nil {
+		qcrc.SetLastAt(*t)
+	}
+	return qcrc
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcrc *QRCodeRedemptionCreate) SetQrCode(q *QRCode) *QRCodeRedemptionCreate {
+	return qcrc.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeRedemptionMutation object of the builder.
+func (qcrc *QRCodeRedemptionCreate) Mutation() *QRCodeRedemptionMutation {
+	return qcrc.mutation
+}
+
+// Save creates the QRCodeRedemption in the database.
+func (qcrc *QRCodeRedemptionCreate) Save(ctx context.Context) (*QRCodeRedemption, error) {
+	qcrc.defaults()
+	return withHooks(ctx, qcrc.sqlSave, qcrc.mutation, qcrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qcrc *QRCodeRedemptionCreate) SaveX(ctx context.Context) *QRCodeRedemption {
+	v, err := qcrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcrc *QRCodeRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := qcrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcrc *QRCodeRedemptionCreate) ExecX(ctx context.Context) {
+	if err := qcrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qcrc *QRCodeRedemptionCreate) defaults() {
+	if _, ok := qcrc.mutation.Count(); !ok {
+		v := qrcoderedemption.DefaultCount
+		qcrc.mutation.SetCount(v)
+	}
+	if _, ok := qcrc.mutation.FirstAt(); !ok {
+		v := qrcoderedemption.DefaultFirstAt()
+		qcrc.mutation.SetFirstAt(v)
+	}
+	if _, ok := qcrc.mutation.LastAt(); !ok {
+		v := qrcoderedemption.DefaultLastAt()
+		qcrc.mutation.SetLastAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcrc *QRCodeRedemptionCreate) check() error {
+	if _, ok := qcrc.mutation.QrCodeID(); !ok {
+		return &ValidationError{Name: "qr_code_id", err: errors.New(`ent: missing required field "QRCodeRedemption.qr_code_id"`)}
+	}
+	if _, ok := qcrc.mutation.Visitor(); !ok {
+		return &ValidationError{Name: "visitor", err: errors.New(`ent: missing required field "QRCodeRedemption.visitor"`)}
+	}
+	if v, ok := qcrc.mutation.Visitor(); ok {
+		if err := qrcoderedemption.VisitorValidator(v); err != nil {
+			return &ValidationError{Name: "visitor", err: fmt.Errorf(`ent: validator failed for field "QRCodeRedemption.visitor": %w`, err)}
+		}
+	}
+	if _, ok := qcrc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "QRCodeRedemption.count"`)}
+	}
+	if _, ok := qcrc.mutation.FirstAt(); !ok {
+		return &ValidationError{Name: "first_at", err: errors.New(`ent: missing required field "QRCodeRedemption.first_at"`)}
+	}
+	if _, ok := qcrc.mutation.LastAt(); !ok {
+		return &ValidationError{Name: "last_at", err: errors.New(`ent: missing required field "QRCodeRedemption.last_at"`)}
+	}
+	if len(qcrc.mutation.QrCodeIDs()) == 0 {
+		return &ValidationError{Name: "qr_code", err: errors.New(`ent: missing required edge "QRCodeRedemption.qr_code"`)}
+	}
+	return nil
+}
+
+func (qcrc *QRCodeRedemptionCreate) sqlSave(ctx context.Context) (*QRCodeRedemption, error) {
+	if err := qcrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qcrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qcrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qcrc.mutation.id = &_node.ID
+	qcrc.mutation.done = true
+	return _node, nil
+}
+
+func (qcrc *QRCodeRedemptionCreate) createSpec() (*QRCodeRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QRCodeRedemption{config: qcrc.config}
+		_spec = sqlgraph.NewCreateSpec(qrcoderedemption.Table, sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt))
+	)
+	if value, ok := qcrc.mutation.Visitor(); ok {
+		_spec.SetField(qrcoderedemption.FieldVisitor, field.TypeString, value)
+		_node.Visitor = value
+	}
+	if value, ok := qcrc.mutation.Count(); ok {
+		_spec.SetField(qrcoderedemption.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := qcrc.mutation.FirstAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldFirstAt, field.TypeTime, value)
+		_node.FirstAt = value
+	}
+	if value, ok := qcrc.mutation.LastAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldLastAt, field.TypeTime, value)
+		_node.LastAt = value
+	}
+	if nodes := qcrc.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcoderedemption.QrCodeTable,
+			Columns: []string{qrcoderedemption.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QrCodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QRCodeRedemptionCreateBulk is the builder for creating many QRCodeRedemption entities in bulk.
+type QRCodeRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*QRCodeRedemptionCreate
+}
+
+// Save creates the QRCodeRedemption entities in the database.
+func (qcrcb *QRCodeRedemptionCreateBulk) Save(ctx context.Context) ([]*QRCodeRedemption, error) {
+	if qcrcb.err != nil {
+		return nil, qcrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcrcb.builders))
+	nodes := make([]*QRCodeRedemption, len(qcrcb.builders))
+	mutators := make([]Mutator, len(qcrcb.builders))
+	for i := range qcrcb.builders {
+		func(i int, root context.Context) {
+			builder := qcrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QRCodeRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcrcb *QRCodeRedemptionCreateBulk) SaveX(ctx context.Context) []*QRCodeRedemption {
+	v, err := qcrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcrcb *QRCodeRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcrcb *QRCodeRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := qcrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
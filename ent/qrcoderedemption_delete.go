@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcoderedemption"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeRedemptionDelete is the builder for deleting a QRCodeRedemption entity.
+type QRCodeRedemptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *QRCodeRedemptionMutation
+}
+
+// Where appends a list predicates to the QRCodeRedemptionDelete builder.
+func (qcrd *QRCodeRedemptionDelete) Where(ps ...predicate.QRCodeRedemption) *QRCodeRedemptionDelete {
+	qcrd.mutation.Where(ps...)
+	return qcrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qcrd *QRCodeRedemptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qcrd.sqlExec, qcrd.mutation, qcrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcrd *QRCodeRedemptionDelete) ExecX(ctx context.Context) int {
+	n, err := qcrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qcrd *QRCodeRedemptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(qrcoderedemption.Table, sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt))
+	if ps := qcrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qcrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qcrd.mutation.done = true
+	return affected, err
+}
+
+// QRCodeRedemptionDeleteOne is the builder for deleting a single QRCodeRedemption entity.
+type QRCodeRedemptionDeleteOne struct {
+	qcrd *QRCodeRedemptionDelete
+}
+
+// Where appends a list predicates to the QRCodeRedemptionDelete builder.
+func (qcrdo *QRCodeRedemptionDeleteOne) Where(ps ...predicate.QRCodeRedemption) *QRCodeRedemptionDeleteOne {
+	qcrdo.qcrd.mutation.Where(ps...)
+	return qcrdo
+}
+
+// Exec executes the deletion query.
+func (qcrdo *QRCodeRedemptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := qcrdo.qcrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{qrcoderedemption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcrdo *QRCodeRedemptionDeleteOne) ExecX(ctx context.Context) {
+	if err := qcrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeRedemptionQuery is the builder for querying QRCodeRedemption entities.
+type QRCodeRedemptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []qrcoderedemption.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QRCodeRedemption
+	withQrCode *QRCodeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QRCodeRedemptionQuery builder.
+func (qcrq *QRCodeRedemptionQuery) Where(ps ...predicate.QRCodeRedemption) *QRCodeRedemptionQuery {
+	qcrq.predicates = append(qcrq.predicates, ps...)
+	return qcrq
+}
+
+// Limit the number of records to be returned by this query.
+func (qcrq *QRCodeRedemptionQuery) Limit(limit int) *QRCodeRedemptionQuery {
+	qcrq.ctx.Limit = &limit
+	return qcrq
+}
+
+// Offset to start from.
+func (qcrq *QRCodeRedemptionQuery) Offset(offset int) *QRCodeRedemptionQuery {
+	qcrq.ctx.Offset = &offset
+	return qcrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qcrq *QRCodeRedemptionQuery) Unique(unique bool) *QRCodeRedemptionQuery {
+	qcrq.ctx.Unique = &unique
+	return qcrq
+}
+
+// Order specifies how the records should be ordered.
+func (qcrq *QRCodeRedemptionQuery) Order(o ...qrcoderedemption.OrderOption) *QRCodeRedemptionQuery {
+	qcrq.order = append(qcrq.order, o...)
+	return qcrq
+}
+
+// QueryQrCode chains the current query on the "qr_code" edge.
+func (qcrq *QRCodeRedemptionQuery) QueryQrCode() *QRCodeQuery {
+	query := (&QRCodeClient{config: qcrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qcrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qcrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(qrcoderedemption.Table, qrcoderedemption.FieldID, selector),
+			sqlgraph.To(qrcode.Table, qrcode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, qrcoderedemption.QrCodeTable, qrcoderedemption.QrCodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qcrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QRCodeRedemption entity from the query.
+// Returns a *NotFoundError when no QRCodeRedemption was found.
+func (qcrq *QRCodeRedemptionQuery) First(ctx context.Context) (*QRCodeRedemption, error) {
+	nodes, err := qcrq.Limit(1).All(setContextOp(ctx, qcrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{qrcoderedemption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) FirstX(ctx context.Context) *QRCodeRedemption {
+	node, err := qcrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QRCodeRedemption ID from the query.
+// Returns a *NotFoundError when no QRCodeRedemption ID was found.
+func (qcrq *QRCodeRedemptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qcrq.Limit(1).IDs(setContextOp(ctx, qcrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{qrcoderedemption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := qcrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QRCodeRedemption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QRCodeRedemption entity is found.
+// Returns a *NotFoundError when no QRCodeRedemption entities are found.
+func (qcrq *QRCodeRedemptionQuery) Only(ctx context.Context) (*QRCodeRedemption, error) {
+	nodes, err := qcrq.Limit(2).All(setContextOp(ctx, qcrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{qrcoderedemption.Label}
+	default:
+		return nil, &NotSingularError{qrcoderedemption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) OnlyX(ctx context.Context) *QRCodeRedemption {
+	node, err := qcrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QRCodeRedemption ID in the query.
+// Returns a *NotSingularError when more than one QRCodeRedemption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qcrq *QRCodeRedemptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qcrq.Limit(2).IDs(setContextOp(ctx, qcrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{qrcoderedemption.Label}
+	default:
+		err = &NotSingularError{qrcoderedemption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qcrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QRCodeRedemptions.
+func (qcrq *QRCodeRedemptionQuery) All(ctx context.Context) ([]*QRCodeRedemption, error) {
+	ctx = setContextOp(ctx, qcrq.ctx, ent.OpQueryAll)
+	if err := qcrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QRCodeRedemption, *QRCodeRedemptionQuery]()
+	return withInterceptors[[]*QRCodeRedemption](ctx, qcrq, qr, qcrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) AllX(ctx context.Context) []*QRCodeRedemption {
+	nodes, err := qcrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QRCodeRedemption IDs.
+func (qcrq *QRCodeRedemptionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if qcrq.ctx.Unique == nil && qcrq.path != nil {
+		qcrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qcrq.ctx, ent.OpQueryIDs)
+	if err = qcrq.Select(qrcoderedemption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := qcrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qcrq *QRCodeRedemptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qcrq.ctx, ent.OpQueryCount)
+	if err := qcrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qcrq, querierCount[*QRCodeRedemptionQuery](), qcrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) CountX(ctx context.Context) int {
+	count, err := qcrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qcrq *QRCodeRedemptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qcrq.ctx, ent.OpQueryExist)
+	switch _, err := qcrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qcrq *QRCodeRedemptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := qcrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QRCodeRedemptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qcrq *QRCodeRedemptionQuery) Clone() *QRCodeRedemptionQuery {
+	if qcrq == nil {
+		return nil
+	}
+	return &QRCodeRedemptionQuery{
+		config:     qcrq.config,
+		ctx:        qcrq.ctx.Clone(),
+		order:      append([]qrcoderedemption.OrderOption{}, qcrq.order...),
+		inters:     append([]Interceptor{}, qcrq.inters...),
+		predicates: append([]predicate.QRCodeRedemption{}, qcrq.predicates...),
+		withQrCode: qcrq.withQrCode.Clone(),
+		// clone intermediate query.
+		sql:  qcrq.sql.Clone(),
+		path: qcrq.path,
+	}
+}
+
+// WithQrCode tells the query-builder to eager-load the nodes that are connected to
+// the "qr_code" edge. The optional arguments are used to configure the query builder of the edge.
+func (qcrq *QRCodeRedemptionQuery) WithQrCode(opts ...func(*QRCodeQuery)) *QRCodeRedemptionQuery {
+	query := (&QRCodeClient{config: qcrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qcrq.withQrCode = query
+	return qcrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		QrCodeID int `json:"qr_code_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QRCodeRedemption.Query().
+//		GroupBy(qrcoderedemption.FieldQrCodeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qcrq *QRCodeRedemptionQuery) GroupBy(field string, fields ...string) *QRCodeRedemptionGroupBy {
+	qcrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QRCodeRedemptionGroupBy{build: qcrq}
+	grbuild.flds = &qcrq.ctx.Fields
+	grbuild.label = qrcoderedemption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		QrCodeID int `json:"qr_code_id,omitempty"`
+//	}
+//
+//	client.QRCodeRedemption.Query().
+//		Select(qrcoderedemption.FieldQrCodeID).
+//		Scan(ctx, &v)
+func (qcrq *QRCodeRedemptionQuery) Select(fields ...string) *QRCodeRedemptionSelect {
+	qcrq.ctx.Fields = append(qcrq.ctx.Fields, fields...)
+	sbuild := &QRCodeRedemptionSelect{QRCodeRedemptionQuery: qcrq}
+	sbuild.label = qrcoderedemption.Label
+	sbuild.flds, sbuild.scan = &qcrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QRCodeRedemptionSelect configured with the given aggregations.
+func (qcrq *QRCodeRedemptionQuery) Aggregate(fns ...AggregateFunc) *QRCodeRedemptionSelect {
+	return qcrq.Select().Aggregate(fns...)
+}
+
+func (qcrq *QRCodeRedemptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qcrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qcrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qcrq.ctx.Fields {
+		if !qrcoderedemption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qcrq.path != nil {
+		prev, err := qcrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qcrq.sql = prev
+	}
+	return nil
+}
+
+func (qcrq *QRCodeRedemptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QRCodeRedemption, error) {
+	var (
+		nodes       = []*QRCodeRedemption{}
+		_spec       = qcrq.querySpec()
+		loadedTypes = [1]bool{
+			qcrq.withQrCode != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QRCodeRedemption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QRCodeRedemption{config: qcrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qcrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := qcrq.withQrCode; query != nil {
+		if err := qcrq.loadQrCode(ctx, query, nodes, nil,
+			func(n *QRCodeRedemption, e *QRCode) { n.Edges.QrCode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (qcrq *QRCodeRedemptionQuery) loadQrCode(ctx context.Context, query *QRCodeQuery, nodes []*QRCodeRedemption, init func(*QRCodeRedemption), assign func(*QRCodeRedemption, *QRCode)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*QRCodeRedemption)
+	for i := range nodes {
+		fk := nodes[i].QrCodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(qrcode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "qr_code_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (qcrq *QRCodeRedemptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qcrq.querySpec()
+	_spec.Node.Columns = qcrq.ctx.Fields
+	if len(qcrq.ctx.Fields) > 0 {
+		_spec.Unique = qcrq.ctx.Unique != nil && *qcrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qcrq.driver, _spec)
+}
+
+func (qcrq *QRCodeRedemptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(qrcoderedemption.Table, qrcoderedemption.Columns, sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt))
+	_spec.From = qcrq.sql
+	if unique := qcrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qcrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qcrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrcoderedemption.FieldID)
+		for i := range fields {
+			if fields[i] != qrcoderedemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if qcrq.withQrCode != nil {
+			_spec.Node.AddColumnOnce(qrcoderedemption.FieldQrCodeID)
+		}
+	}
+	if ps := qcrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qcrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qcrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qcrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qcrq *QRCodeRedemptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qcrq.driver.Dialect())
+	t1 := builder.Table(qrcoderedemption.Table)
+	columns := qcrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = qrcoderedemption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qcrq.sql != nil {
+		selector = qcrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qcrq.ctx.Unique != nil && *qcrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range qcrq.predicates {
+		p(selector)
+	}
+	for _, p := range qcrq.order {
+		p(selector)
+	}
+	if offset := qcrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qcrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QRCodeRedemptionGroupBy is the group-by builder for QRCodeRedemption entities.
+type QRCodeRedemptionGroupBy struct {
+	selector
+	build *QRCodeRedemptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qcrgb *QRCodeRedemptionGroupBy) Aggregate(fns ...AggregateFunc) *QRCodeRedemptionGroupBy {
+	qcrgb.fns = append(qcrgb.fns, fns...)
+	return qcrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qcrgb *QRCodeRedemptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qcrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qcrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRCodeRedemptionQuery, *QRCodeRedemptionGroupBy](ctx, qcrgb.build, qcrgb, qcrgb.build.inters, v)
+}
+
+func (qcrgb *QRCodeRedemptionGroupBy) sqlScan(ctx context.Context, root *QRCodeRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qcrgb.fns))
+	for _, fn := range qcrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qcrgb.flds)+len(qcrgb.fns))
+		for _, f := range *qcrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qcrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qcrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QRCodeRedemptionSelect is the builder for selecting fields of QRCodeRedemption entities.
+type QRCodeRedemptionSelect struct {
+	*QRCodeRedemptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qcrs *QRCodeRedemptionSelect) Aggregate(fns ...AggregateFunc) *QRCodeRedemptionSelect {
+	qcrs.fns = append(qcrs.fns, fns...)
+	return qcrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qcrs *QRCodeRedemptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qcrs.ctx, ent.OpQuerySelect)
+	if err := qcrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QRCodeRedemptionQuery, *QRCodeRedemptionSelect](ctx, qcrs.QRCodeRedemptionQuery, qcrs, qcrs.inters, v)
+}
+
+func (qcrs *QRCodeRedemptionSelect) sqlScan(ctx context.Context, root *QRCodeRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qcrs.fns))
+	for _, fn := range qcrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qcrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qcrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QRCodeRedemptionUpdate is the builder for updating QRCodeRedemption entities.
+type QRCodeRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QRCodeRedemptionMutation
+}
+
+// Where appends a list predicates to the QRCodeRedemptionUpdate builder.
+func (qcru *QRCodeRedemptionUpdate) Where(ps ...predicate.QRCodeRedemption) *QRCodeRedemptionUpdate {
+	qcru.mutation.Where(ps...)
+	return qcru
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcru *QRCodeRedemptionUpdate) SetQrCodeID(i int) *QRCodeRedemptionUpdate {
+	qcru.mutation.SetQrCodeID(i)
+	return qcru
+}
+
+// SetNillableQrCodeID sets the "qr_code_id" field if the given value is not nil.
+func (qcru *QRCodeRedemptionUpdate) SetNillableQrCodeID(i *int) *QRCodeRedemptionUpdate {
+	if i != nil {
+		qcru.SetQrCodeID(*i)
+	}
+	return qcru
+}
+
+// SetVisitor sets the "visitor" field.
+func (qcru *QRCodeRedemptionUpdate) SetVisitor(s string) *QRCodeRedemptionUpdate {
+	qcru.mutation.SetVisitor(s)
+	return qcru
+}
+
+// SetNillableVisitor sets the "visitor" field if the given value is not nil.
+func (qcru *QRCodeRedemptionUpdate) SetNillableVisitor(s *string) *QRCodeRedemptionUpdate {
+	if s != nil {
+		qcru.SetVisitor(*s)
+	}
+	return qcru
+}
+
+// SetCount sets the "count" field.
+func (qcru *QRCodeRedemptionUpdate) SetCount(i int) *QRCodeRedemptionUpdate {
+	qcru.mutation.ResetCount()
+	qcru.mutation.SetCount(i)
+	return qcru
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (qcru *QRCodeRedemptionUpdate) SetNillableCount(i *int) *QRCodeRedemptionUpdate {
+	if i != nil {
+		qcru.SetCount(*i)
+	}
+	return qcru
+}
+
+// AddCount adds i to the "count" field.
+func (qcru *QRCodeRedemptionUpdate) AddCount(i int) *QRCodeRedemptionUpdate {
+	qcru.mutation.AddCount(i)
+	return qcru
+}
+
+// SetFirstAt sets the "first_at" field.
+func (qcru *QRCodeRedemptionUpdate) SetFirstAt(t time.Time) *QRCodeRedemptionUpdate {
+	qcru.mutation.SetFirstAt(t)
+	return qcru
+}
+
+// SetNillableFirstAt sets the "first_at" field if the given value is not nil.
+func (qcru *QRCodeRedemptionUpdate) SetNillableFirstAt(t *time.Time) *QRCodeRedemptionUpdate {
+	if t != nil {
+		qcru.SetFirstAt(*t)
+	}
+	return qcru
+}
+
+// SetLastAt sets the "last_at" field.
+func (qcru *QRCodeRedemptionUpdate) SetLastAt(t time.Time) *QRCodeRedemptionUpdate {
+	qcru.mutation.SetLastAt(t)
+	return qcru
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (qcru *QRCodeRedemptionUpdate) SetNillableLastAt(t *time.Time) *QRCodeRedemptionUpdate {
+	if t != nil {
+		qcru.SetLastAt(*t)
+	}
+	return qcru
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcru *QRCodeRedemptionUpdate) SetQrCode(q *QRCode) *QRCodeRedemptionUpdate {
+	return qcru.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeRedemptionMutation object of the builder.
+func (qcru *QRCodeRedemptionUpdate) Mutation() *QRCodeRedemptionMutation {
+	return qcru.mutation
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (qcru *QRCodeRedemptionUpdate) ClearQrCode() *QRCodeRedemptionUpdate {
+	qcru.mutation.ClearQrCode()
+	return qcru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qcru *QRCodeRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qcru.sqlSave, qcru.mutation, qcru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcru *QRCodeRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := qcru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qcru *QRCodeRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := qcru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcru *QRCodeRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := qcru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcru *QRCodeRedemptionUpdate) check() error {
+	if v, ok := qcru.mutation.Visitor(); ok {
+		if err := qrcoderedemption.VisitorValidator(v); err != nil {
+			return &ValidationError{Name: "visitor", err: fmt.Errorf(`ent: validator failed for field "QRCodeRedemption.visitor": %w`, err)}
+		}
+	}
+	if qcru.mutation.QrCodeCleared() && len(qcru.mutation.QrCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QRCodeRedemption.qr_code"`)
+	}
+	return nil
+}
+
+func (qcru *QRCodeRedemptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qcru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrcoderedemption.Table, qrcoderedemption.Columns, sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt))
+	if ps := qcru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qcru.mutation.Visitor(); ok {
+		_spec.SetField(qrcoderedemption.FieldVisitor, field.TypeString, value)
+	}
+	if value, ok := qcru.mutation.Count(); ok {
+		_spec.SetField(qrcoderedemption.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := qcru.mutation.AddedCount(); ok {
+		_spec.AddField(qrcoderedemption.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := qcru.mutation.FirstAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldFirstAt, field.TypeTime, value)
+	}
+	if value, ok := qcru.mutation.LastAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldLastAt, field.TypeTime, value)
+	}
+	if qcru.mutation.QrCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcoderedemption.QrCodeTable,
+			Columns: []string{qrcoderedemption.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcru.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcoderedemption.QrCodeTable,
+			Columns: []string{qrcoderedemption.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qcru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrcoderedemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qcru.mutation.done = true
+	return n, nil
+}
+
+// QRCodeRedemptionUpdateOne is the builder for updating a single QRCodeRedemption entity.
+type QRCodeRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QRCodeRedemptionMutation
+}
+
+// SetQrCodeID sets the "qr_code_id" field.
+func (qcruo *QRCodeRedemptionUpdateOne) SetQrCodeID(i int) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.SetQrCodeID(i)
+	return qcruo
+}
+
+// SetNillableQrCodeID sets the "qr_code_id" field if the given value is not nil.
+func (qcruo *QRCodeRedemptionUpdateOne) SetNillableQrCodeID(i *int) *QRCodeRedemptionUpdateOne {
+	if i != nil {
+		qcruo.SetQrCodeID(*i)
+	}
+	return qcruo
+}
+
+// SetVisitor sets the "visitor" field.
+func (qcruo *QRCodeRedemptionUpdateOne) SetVisitor(s string) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.SetVisitor(s)
+	return qcruo
+}
+
+// SetNillableVisitor sets the "visitor" field if the given value is not nil.
+func (qcruo *QRCodeRedemptionUpdateOne) SetNillableVisitor(s *string) *QRCodeRedemptionUpdateOne {
+	if s != nil {
+		qcruo.SetVisitor(*s)
+	}
+	return qcruo
+}
+
+// SetCount sets the "count" field.
+func (qcruo *QRCodeRedemptionUpdateOne) SetCount(i int) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.ResetCount()
+	qcruo.mutation.SetCount(i)
+	return qcruo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (qcruo *QRCodeRedemptionUpdateOne) SetNillableCount(i *int) *QRCodeRedemptionUpdateOne {
+	if i != nil {
+		qcruo.SetCount(*i)
+	}
+	return qcruo
+}
+
+// AddCount adds i to the "count" field.
+func (qcruo *QRCodeRedemptionUpdateOne) AddCount(i int) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.AddCount(i)
+	return qcruo
+}
+
+// SetFirstAt sets the "first_at" field.
+func (qcruo *QRCodeRedemptionUpdateOne) SetFirstAt(t time.Time) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.SetFirstAt(t)
+	return qcruo
+}
+
+// SetNillableFirstAt sets the "first_at" field if the given value is not nil.
+func (qcruo *QRCodeRedemptionUpdateOne) SetNillableFirstAt(t *time.Time) *QRCodeRedemptionUpdateOne {
+	if t != nil {
+		qcruo.SetFirstAt(*t)
+	}
+	return qcruo
+}
+
+// SetLastAt sets the "last_at" field.
+func (qcruo *QRCodeRedemptionUpdateOne) SetLastAt(t time.Time) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.SetLastAt(t)
+	return qcruo
+}
+
+// SetNillableLastAt sets the "last_at" field if the given value is not nil.
+func (qcruo *QRCodeRedemptionUpdateOne) SetNillableLastAt(t *time.Time) *QRCodeRedemptionUpdateOne {
+	if t != nil {
+		qcruo.SetLastAt(*t)
+	}
+	return qcruo
+}
+
+// SetQrCode sets the "qr_code" edge to the QRCode entity.
+func (qcruo *QRCodeRedemptionUpdateOne) SetQrCode(q *QRCode) *QRCodeRedemptionUpdateOne {
+	return qcruo.SetQrCodeID(q.ID)
+}
+
+// Mutation returns the QRCodeRedemptionMutation object of the builder.
+func (qcruo *QRCodeRedemptionUpdateOne) Mutation() *QRCodeRedemptionMutation {
+	return qcruo.mutation
+}
+
+// ClearQrCode clears the "qr_code" edge to the QRCode entity.
+func (qcruo *QRCodeRedemptionUpdateOne) ClearQrCode() *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.ClearQrCode()
+	return qcruo
+}
+
+// Where appends a list predicates to the QRCodeRedemptionUpdate builder.
+func (qcruo *QRCodeRedemptionUpdateOne) Where(ps ...predicate.QRCodeRedemption) *QRCodeRedemptionUpdateOne {
+	qcruo.mutation.Where(ps...)
+	return qcruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qcruo *QRCodeRedemptionUpdateOne) Select(field string, fields ...string) *QRCodeRedemptionUpdateOne {
+	qcruo.fields = append([]string{field}, fields...)
+	return qcruo
+}
+
+// Save executes the query and returns the updated QRCodeRedemption entity.
+func (qcruo *QRCodeRedemptionUpdateOne) Save(ctx context.Context) (*QRCodeRedemption, error) {
+	return withHooks(ctx, qcruo.sqlSave, qcruo.mutation, qcruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcruo *QRCodeRedemptionUpdateOne) SaveX(ctx context.Context) *QRCodeRedemption {
+	node, err := qcruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qcruo *QRCodeRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := qcruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcruo *QRCodeRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := qcruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qcruo *QRCodeRedemptionUpdateOne) check() error {
+	if v, ok := qcruo.mutation.Visitor(); ok {
+		if err := qrcoderedemption.VisitorValidator(v); err != nil {
+			return &ValidationError{Name: "visitor", err: fmt.Errorf(`ent: validator failed for field "QRCodeRedemption.visitor": %w`, err)}
+		}
+	}
+	if qcruo.mutation.QrCodeCleared() && len(qcruo.mutation.QrCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QRCodeRedemption.qr_code"`)
+	}
+	return nil
+}
+
+func (qcruo *QRCodeRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *QRCodeRedemption, err error) {
+	if err := qcruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(qrcoderedemption.Table, qrcoderedemption.Columns, sqlgraph.NewFieldSpec(qrcoderedemption.FieldID, field.TypeInt))
+	id, ok := qcruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QRCodeRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qcruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, qrcoderedemption.FieldID)
+		for _, f := range fields {
+			if !qrcoderedemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != qrcoderedemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qcruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qcruo.mutation.Visitor(); ok {
+		_spec.SetField(qrcoderedemption.FieldVisitor, field.TypeString, value)
+	}
+	if value, ok := qcruo.mutation.Count(); ok {
+		_spec.SetField(qrcoderedemption.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := qcruo.mutation.AddedCount(); ok {
+		_spec.AddField(qrcoderedemption.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := qcruo.mutation.FirstAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldFirstAt, field.TypeTime, value)
+	}
+	if value, ok := qcruo.mutation.LastAt(); ok {
+		_spec.SetField(qrcoderedemption.FieldLastAt, field.TypeTime, value)
+	}
+	if qcruo.mutation.QrCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcoderedemption.QrCodeTable,
+			Columns: []string{qrcoderedemption.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qcruo.mutation.QrCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   qrcoderedemption.QrCodeTable,
+			Columns: []string{qrcoderedemption.QrCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(qrcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QRCodeRedemption{config: qcruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qcruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{qrcoderedemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qcruo.mutation.done = true
+	return _node, nil
+}
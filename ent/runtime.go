@@ -3,11 +3,18 @@
 package ent
 
 import (
+	"qr_backend/ent/checkpoint"
 	"qr_backend/ent/filereference"
+	"qr_backend/ent/job"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
 	"qr_backend/ent/qrcodegroup"
+	"qr_backend/ent/qrcoderedemption"
 	"qr_backend/ent/schema"
+	"qr_backend/ent/signingkey"
+	"qr_backend/ent/webhook"
+	"qr_backend/ent/webhookdelivery"
 	"time"
 )
 
@@ -15,6 +22,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	checkpointFields := schema.Checkpoint{}.Fields()
+	_ = checkpointFields
+	// checkpointDescName is the schema descriptor for name field.
+	checkpointDescName := checkpointFields[0].Descriptor()
+	// checkpoint.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	checkpoint.NameValidator = checkpointDescName.Validators[0].(func(string) error)
 	filereferenceFields := schema.FileReference{}.Fields()
 	_ = filereferenceFields
 	// filereferenceDescFilename is the schema descriptor for filename field.
@@ -25,6 +38,66 @@ func init() {
 	filereferenceDescURL := filereferenceFields[1].Descriptor()
 	// filereference.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	filereference.URLValidator = filereferenceDescURL.Validators[0].(func(string) error)
+	// filereferenceDescBackend is the schema descriptor for backend field.
+	filereferenceDescBackend := filereferenceFields[4].Descriptor()
+	// filereference.DefaultBackend holds the default value on creation for the backend field.
+	filereference.DefaultBackend = filereferenceDescBackend.Default.(string)
+	// filereferenceDescVariantsSize is the schema descriptor for variants_size field.
+	filereferenceDescVariantsSize := filereferenceFields[10].Descriptor()
+	// filereference.DefaultVariantsSize holds the default value on creation for the variants_size field.
+	filereference.DefaultVariantsSize = filereferenceDescVariantsSize.Default.(int64)
+	// filereferenceDescOwner is the schema descriptor for owner field.
+	filereferenceDescOwner := filereferenceFields[11].Descriptor()
+	// filereference.DefaultOwner holds the default value on creation for the owner field.
+	filereference.DefaultOwner = filereferenceDescOwner.Default.(string)
+	// filereferenceDescCreatedAt is the schema descriptor for created_at field.
+	filereferenceDescCreatedAt := filereferenceFields[14].Descriptor()
+	// filereference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	filereference.DefaultCreatedAt = filereferenceDescCreatedAt.Default.(func() time.Time)
+	// filereferenceDescScanAttempts is the schema descriptor for scan_attempts field.
+	filereferenceDescScanAttempts := filereferenceFields[17].Descriptor()
+	// filereference.DefaultScanAttempts holds the default value on creation for the scan_attempts field.
+	filereference.DefaultScanAttempts = filereferenceDescScanAttempts.Default.(int)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescType is the schema descriptor for type field.
+	jobDescType := jobFields[0].Descriptor()
+	// job.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	job.TypeValidator = jobDescType.Validators[0].(func(string) error)
+	// jobDescTotal is the schema descriptor for total field.
+	jobDescTotal := jobFields[3].Descriptor()
+	// job.DefaultTotal holds the default value on creation for the total field.
+	job.DefaultTotal = jobDescTotal.Default.(int)
+	// jobDescProcessed is the schema descriptor for processed field.
+	jobDescProcessed := jobFields[4].Descriptor()
+	// job.DefaultProcessed holds the default value on creation for the processed field.
+	job.DefaultProcessed = jobDescProcessed.Default.(int)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[6].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[7].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// jobDescCancelRequested is the schema descriptor for cancel_requested field.
+	jobDescCancelRequested := jobFields[9].Descriptor()
+	// job.DefaultCancelRequested holds the default value on creation for the cancel_requested field.
+	job.DefaultCancelRequested = jobDescCancelRequested.Default.(bool)
+	// jobDescRunAt is the schema descriptor for run_at field.
+	jobDescRunAt := jobFields[12].Descriptor()
+	// job.DefaultRunAt holds the default value on creation for the run_at field.
+	job.DefaultRunAt = jobDescRunAt.Default.(func() time.Time)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[15].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[16].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
 	qrcodeFields := schema.QRCode{}.Fields()
 	_ = qrcodeFields
 	// qrcodeDescType is the schema descriptor for type field.
@@ -45,12 +118,20 @@ func init() {
 	qrcode.DefaultUpdatedAt = qrcodeDescUpdatedAt.Default.(func() time.Time)
 	// qrcode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	qrcode.UpdateDefaultUpdatedAt = qrcodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// qrcodeDescRedeemed is the schema descriptor for redeemed field.
+	qrcodeDescRedeemed := qrcodeFields[16].Descriptor()
+	// qrcode.DefaultRedeemed holds the default value on creation for the redeemed field.
+	qrcode.DefaultRedeemed = qrcodeDescRedeemed.Default.(int)
 	// qrcodeDescAnalytics is the schema descriptor for analytics field.
-	qrcodeDescAnalytics := qrcodeFields[9].Descriptor()
+	qrcodeDescAnalytics := qrcodeFields[21].Descriptor()
 	// qrcode.DefaultAnalytics holds the default value on creation for the analytics field.
 	qrcode.DefaultAnalytics = qrcodeDescAnalytics.Default.(bool)
+	// qrcodeDescAnalyticsCountsOnly is the schema descriptor for analytics_counts_only field.
+	qrcodeDescAnalyticsCountsOnly := qrcodeFields[22].Descriptor()
+	// qrcode.DefaultAnalyticsCountsOnly holds the default value on creation for the analytics_counts_only field.
+	qrcode.DefaultAnalyticsCountsOnly = qrcodeDescAnalyticsCountsOnly.Default.(bool)
 	// qrcodeDescActive is the schema descriptor for active field.
-	qrcodeDescActive := qrcodeFields[10].Descriptor()
+	qrcodeDescActive := qrcodeFields[23].Descriptor()
 	// qrcode.DefaultActive holds the default value on creation for the active field.
 	qrcode.DefaultActive = qrcodeDescActive.Default.(bool)
 	qrcodeanalyticsFields := schema.QRCodeAnalytics{}.Fields()
@@ -59,6 +140,20 @@ func init() {
 	qrcodeanalyticsDescScannedAt := qrcodeanalyticsFields[4].Descriptor()
 	// qrcodeanalytics.DefaultScannedAt holds the default value on creation for the scanned_at field.
 	qrcodeanalytics.DefaultScannedAt = qrcodeanalyticsDescScannedAt.Default.(func() time.Time)
+	// qrcodeanalyticsDescRolledUp is the schema descriptor for rolled_up field.
+	qrcodeanalyticsDescRolledUp := qrcodeanalyticsFields[5].Descriptor()
+	// qrcodeanalytics.DefaultRolledUp holds the default value on creation for the rolled_up field.
+	qrcodeanalytics.DefaultRolledUp = qrcodeanalyticsDescRolledUp.Default.(bool)
+	qrcodeanalyticsdailyFields := schema.QRCodeAnalyticsDaily{}.Fields()
+	_ = qrcodeanalyticsdailyFields
+	// qrcodeanalyticsdailyDescScans is the schema descriptor for scans field.
+	qrcodeanalyticsdailyDescScans := qrcodeanalyticsdailyFields[2].Descriptor()
+	// qrcodeanalyticsdaily.DefaultScans holds the default value on creation for the scans field.
+	qrcodeanalyticsdaily.DefaultScans = qrcodeanalyticsdailyDescScans.Default.(int)
+	// qrcodeanalyticsdailyDescUniqueVisitors is the schema descriptor for unique_visitors field.
+	qrcodeanalyticsdailyDescUniqueVisitors := qrcodeanalyticsdailyFields[3].Descriptor()
+	// qrcodeanalyticsdaily.DefaultUniqueVisitors holds the default value on creation for the unique_visitors field.
+	qrcodeanalyticsdaily.DefaultUniqueVisitors = qrcodeanalyticsdailyDescUniqueVisitors.Default.(int)
 	qrcodegroupFields := schema.QRCodeGroup{}.Fields()
 	_ = qrcodegroupFields
 	// qrcodegroupDescName is the schema descriptor for name field.
@@ -75,4 +170,74 @@ func init() {
 	qrcodegroup.DefaultUpdatedAt = qrcodegroupDescUpdatedAt.Default.(func() time.Time)
 	// qrcodegroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	qrcodegroup.UpdateDefaultUpdatedAt = qrcodegroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	qrcoderedemptionFields := schema.QRCodeRedemption{}.Fields()
+	_ = qrcoderedemptionFields
+	// qrcoderedemptionDescVisitor is the schema descriptor for visitor field.
+	qrcoderedemptionDescVisitor := qrcoderedemptionFields[1].Descriptor()
+	// qrcoderedemption.VisitorValidator is a validator for the "visitor" field. It is called by the builders before save.
+	qrcoderedemption.VisitorValidator = qrcoderedemptionDescVisitor.Validators[0].(func(string) error)
+	// qrcoderedemptionDescCount is the schema descriptor for count field.
+	qrcoderedemptionDescCount := qrcoderedemptionFields[2].Descriptor()
+	// qrcoderedemption.DefaultCount holds the default value on creation for the count field.
+	qrcoderedemption.DefaultCount = qrcoderedemptionDescCount.Default.(int)
+	// qrcoderedemptionDescFirstAt is the schema descriptor for first_at field.
+	qrcoderedemptionDescFirstAt := qrcoderedemptionFields[3].Descriptor()
+	// qrcoderedemption.DefaultFirstAt holds the default value on creation for the first_at field.
+	qrcoderedemption.DefaultFirstAt = qrcoderedemptionDescFirstAt.Default.(func() time.Time)
+	// qrcoderedemptionDescLastAt is the schema descriptor for last_at field.
+	qrcoderedemptionDescLastAt := qrcoderedemptionFields[4].Descriptor()
+	// qrcoderedemption.DefaultLastAt holds the default value on creation for the last_at field.
+	qrcoderedemption.DefaultLastAt = qrcoderedemptionDescLastAt.Default.(func() time.Time)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescKid is the schema descriptor for kid field.
+	signingkeyDescKid := signingkeyFields[0].Descriptor()
+	// signingkey.KidValidator is a validator for the "kid" field. It is called by the builders before save.
+	signingkey.KidValidator = signingkeyDescKid.Validators[0].(func(string) error)
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[4].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[0].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[1].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[4].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
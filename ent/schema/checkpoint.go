@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Checkpoint holds the schema definition for the Checkpoint entity: how far
+// a background watcher has got, so that it resumes there after a restart
+type Checkpoint struct {
+	ent.Schema
+}
+
+// Fields of the Checkpoint.
+func (Checkpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Unique().Immutable(),
+		field.Time("at"),
+	}
+}
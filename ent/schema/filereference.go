@@ -1,11 +1,28 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"qr_backend/pkg/pdfinfo"
 )
 
+// ImageVariant is a stored rendition of an uploaded image
+type ImageVariant struct {
+	Name   string `json:"name"`   // "original", "thumbnail", "medium", ...
+	Format string `json:"format"` // File extension, e.g. "jpg" or "webp"
+	Type   string `json:"type"`   // MIME type
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Size   int64  `json:"size"`
+	Key    string `json:"key"`
+	URL    string `json:"url"`
+}
+
 // FileReference holds the schema definition for the FileReference entity.
 type FileReference struct {
 	ent.Schema
@@ -18,6 +35,25 @@ func (FileReference) Fields() []ent.Field {
 		field.String("url").NotEmpty(),
 		field.Int64("size"),
 		field.String("type"),
+		field.String("backend").Default("local"),
+		field.String("key").Optional(),
+		field.String("checksum").Optional(),
+		field.Int("width").Optional(),
+		field.Int("height").Optional(),
+		field.JSON("variants", []ImageVariant{}).Optional(),
+		field.Int64("variants_size").Default(0), // Bytes stored for the variants, counted towards the storage quota
+		field.String("owner").Default(""),       // Whose storage quota the file counts towards; empty for the whole workspace
+		field.JSON("pdf", &pdfinfo.Info{}).Optional(),
+		field.Int("version").Optional(), // Position in the file history of a PDF or image QR code
+		// Empty for files stored before it was recorded, which count as old
+		field.Time("created_at").Optional().Nillable().Default(time.Now).Immutable(),
+		// Malware scan; files are only served once clean, or when scanning was
+		// disabled at upload ("skipped")
+		field.Enum("scan_status").Values("skipped", "pending", "clean", "infected", "failed").Default("skipped"),
+		field.String("scan_result").Optional(), // Signature found or last scan error
+		field.Int("scan_attempts").Default(0),
+		field.Time("scanned_at").Optional().Nillable(),
+		field.Time("next_scan_at").Optional().Nillable(),
 	}
 }
 
@@ -27,3 +63,13 @@ func (FileReference) Edges() []ent.Edge {
 		edge.From("qr_code", QRCode.Type).Ref("file_refs").Unique(),
 	}
 }
+
+// Indexes of the FileReference.
+func (FileReference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key"),
+		index.Fields("created_at"),
+		index.Fields("owner"),
+		index.Fields("scan_status", "next_scan_at"),
+	}
+}
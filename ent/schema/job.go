@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Job holds the schema definition for the Job entity: a background
+// operation run by the worker pool. Workers claim queued jobs by switching
+// their status, and hold them with a lease they renew while running so jobs
+// of a crashed worker are picked up again.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type").NotEmpty(),
+		field.JSON("payload", json.RawMessage{}).Optional(),
+		field.Enum("status").Values("queued", "running", "succeeded", "failed", "cancelled").Default("queued"),
+		field.Int("total").Default(0),
+		field.Int("processed").Default(0),
+		field.JSON("result", json.RawMessage{}).Optional(),
+		field.Int("attempts").Default(0),
+		field.Int("max_attempts").Default(3),
+		field.String("last_error").Optional(),
+		field.Bool("cancel_requested").Default(false),
+		field.String("locked_by").Optional(),
+		field.Time("locked_until").Optional().Nillable(),
+		field.Time("run_at").Default(time.Now),
+		field.Time("started_at").Optional().Nillable(),
+		field.Time("finished_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+		index.Fields("type"),
+	}
+}
@@ -3,9 +3,13 @@ package schema
 import (
 	"time"
 
+	"qr_backend/pkg/schedule"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // QRCode holds the schema definition for the QRCode entity.
@@ -25,11 +29,47 @@ func (QRCode) Fields() []ent.Field {
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("expires_at").Optional().Nillable(),
+		field.Time("starts_at").Optional().Nillable(),
+		// Weekly opening hours; the code is closed outside them
+		field.JSON("schedule", &schedule.Schedule{}).Optional(),
+		// Where scans go before starts_at, after expires_at and outside the
+		// schedule: a redirect, or else a page showing the message
+		field.String("fallback_url").Optional(),
+		field.String("fallback_message").Optional(),
+		// Scan limits for coupons and tickets: scans stop working after
+		// max_scans successful scans, or after scans_per_visitor scans by the
+		// same visitor, told apart by a cookie or a fingerprint
+		field.Int("max_scans").Optional().Nillable(),
+		field.Int("scans_per_visitor").Optional().Nillable(),
+		field.Enum("visitor_key").Values("cookie", "fingerprint").Default("cookie"),
+		field.Int("redeemed").Default(0),
+		// Shown once max_scans is reached
+		field.String("ended_message").Optional(),
+		// Hash of the password or PIN that unlocks the landing page, if any
+		field.String("password_hash").Optional().Sensitive(),
+		// Signed payload encoded instead of the content, as a verification
+		// URL or embedded as is
+		field.Enum("signing").Values("url", "embedded").Optional().Nillable(),
+		field.String("signed_token").Optional(),
 		field.Bool("analytics").Default(false),
+		field.Bool("analytics_counts_only").Default(false),
 		field.Bool("active").Default(true),
 		field.JSON("tags", []string{}).Optional(),
 		field.JSON("design", map[string]interface{}{}).Optional(),
 		field.Int("group_id").Optional().Nillable(),
+		// Digital Link path of GS1 product codes, such as
+		// "/01/09501101530003/10/AB12", which the resolver looks codes up by
+		field.String("gs1_key").Optional(),
+		// "<job>:<line>" of codes created by a background import, so a
+		// retried job finds the rows an earlier attempt created
+		field.String("import_key").Optional().Unique().Immutable(),
+	}
+}
+
+// Indexes of the QRCode.
+func (QRCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("gs1_key"),
 	}
 }
 
@@ -39,5 +79,11 @@ func (QRCode) Edges() []ent.Edge {
 		edge.To("file_refs", FileReference.Type),
 		edge.From("group", QRCodeGroup.Type).Ref("qrcodes").Unique().Field("group_id"),
 		edge.To("analytics_records", QRCodeAnalytics.Type),
+		edge.To("daily_analytics", QRCodeAnalyticsDaily.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("redemptions", QRCodeRedemption.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // QRCodeAnalytics holds the schema definition for the QRCodeAnalytics entity.
@@ -21,6 +22,14 @@ func (QRCodeAnalytics) Fields() []ent.Field {
 		field.String("location").Optional(),
 		field.String("device").Optional(),
 		field.Time("scanned_at").Default(time.Now),
+		field.Bool("rolled_up").Default(false),
+	}
+}
+
+// Indexes of the QRCodeAnalytics.
+func (QRCodeAnalytics) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("rolled_up", "scanned_at"),
 	}
 }
 
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QRCodeAnalyticsDaily holds the schema definition for the QRCodeAnalyticsDaily entity.
+// Each row is a per-day rollup of raw QRCodeAnalytics records, kept after the
+// raw records have been purged by the retention job.
+type QRCodeAnalyticsDaily struct {
+	ent.Schema
+}
+
+// Fields of the QRCodeAnalyticsDaily.
+func (QRCodeAnalyticsDaily) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("qr_code_id"),
+		field.Time("day"),
+		field.Int("scans").Default(0),
+		field.Int("unique_visitors").Default(0),
+		field.JSON("devices", map[string]int{}).Optional(),
+	}
+}
+
+// Edges of the QRCodeAnalyticsDaily.
+func (QRCodeAnalyticsDaily) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("qr_code", QRCode.Type).Ref("daily_analytics").Unique().Required().Field("qr_code_id"),
+	}
+}
+
+// Indexes of the QRCodeAnalyticsDaily.
+func (QRCodeAnalyticsDaily) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("qr_code_id", "day").Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QRCodeRedemption holds the schema definition for the QRCodeRedemption
+// entity: how often one visitor has scanned a code with a per-visitor limit.
+type QRCodeRedemption struct {
+	ent.Schema
+}
+
+// Fields of the QRCodeRedemption.
+func (QRCodeRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("qr_code_id"),
+		// Hash of the visitor cookie or fingerprint
+		field.String("visitor").NotEmpty(),
+		field.Int("count").Default(0),
+		field.Time("first_at").Default(time.Now),
+		field.Time("last_at").Default(time.Now),
+	}
+}
+
+// Edges of the QRCodeRedemption.
+func (QRCodeRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("qr_code", QRCode.Type).Ref("redemptions").Unique().Required().Field("qr_code_id"),
+	}
+}
+
+// Indexes of the QRCodeRedemption.
+func (QRCodeRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("qr_code_id", "visitor").Unique(),
+	}
+}
@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity: an
+// Ed25519 key pair that signs QR code payloads. One key is active at a
+// time; retired keys still verify what they signed, revoked keys do not.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kid").NotEmpty().Unique().Immutable(),
+		field.Bytes("public_key").Immutable(),
+		// Encrypted with SIGNING_KEY_ENCRYPTION_KEY when it is set
+		field.Bytes("private_key").Sensitive(),
+		field.Enum("status").Values("active", "retired", "revoked").Default("active"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("retired_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Deliveries that exhaust their attempts end up with status "dead" and form
+// the dead-letter list.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id"),
+		field.String("event").NotEmpty(),
+		field.Text("payload"),
+		field.Enum("status").Values("pending", "succeeded", "dead").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Int("response_status").Optional(),
+		field.String("last_error").Optional(),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).Ref("deliveries").Unique().Required().Field("webhook_id"),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/signingkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+}
+
+// SetKid sets the "kid" field.
+func (skc *SigningKeyCreate) SetKid(s string) *SigningKeyCreate {
+	skc.mutation.SetKid(s)
+	return skc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skc *SigningKeyCreate) SetPublicKey(b []byte) *SigningKeyCreate {
+	skc.mutation.SetPublicKey(b)
+	return skc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skc *SigningKeyCreate) SetPrivateKey(b []byte) *SigningKeyCreate {
+	skc.mutation.SetPrivateKey(b)
+	return skc
+}
+
+// SetStatus sets the "status" field.
+func (skc *SigningKeyCreate) SetStatus(s signingkey.Status) *SigningKeyCreate {
+	skc.mutation.SetStatus(s)
+	return skc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableStatus(s *signingkey.Status) *SigningKeyCreate {
+	if s != nil {
+		skc.SetStatus(*s)
+	}
+	return skc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *SigningKeyCreate) SetCreatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableCreatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (skc *SigningKeyCreate) SetRetiredAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetRetiredAt(t)
+	return skc
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableRetiredAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetRetiredAt(*t)
+	}
+	return skc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (skc *SigningKeyCreate) SetRevokedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetRevokedAt(t)
+	return skc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableRevokedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetRevokedAt(*t)
+	}
+	return skc
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skc *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (skc *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	skc.defaults()
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SigningKeyCreate) defaults() {
+	if _, ok := skc.mutation.Status(); !ok {
+		v := signingkey.DefaultStatus
+		skc.mutation.SetStatus(v)
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := signingkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SigningKeyCreate) check() error {
+	if _, ok := skc.mutation.Kid(); !ok {
+		return &ValidationError{Name: "kid", err: errors.New(`ent: missing required field "SigningKey.kid"`)}
+	}
+	if v, ok := skc.mutation.Kid(); ok {
+		if err := signingkey.KidValidator(v); err != nil {
+			return &ValidationError{Name: "kid", err: fmt.Errorf(`ent: validator failed for field "SigningKey.kid": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SigningKey.public_key"`)}
+	}
+	if _, ok := skc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "SigningKey.private_key"`)}
+	}
+	if _, ok := skc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SigningKey.status"`)}
+	}
+	if v, ok := skc.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SigningKey.created_at"`)}
+	}
+	return nil
+}
+
+func (skc *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(signingkey.Table, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt))
+	)
+	if value, ok := skc.mutation.Kid(); ok {
+		_spec.SetField(signingkey.FieldKid, field.TypeString, value)
+		_node.Kid = value
+	}
+	if value, ok := skc.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := skc.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := skc.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(signingkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+		_node.RetiredAt = &value
+	}
+	if value, ok := skc.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SigningKeyCreate
+}
+
+// Save creates the SigningKey entities in the database.
+func (skcb *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SigningKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
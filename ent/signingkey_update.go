@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/signingkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (sku *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (sku *SigningKeyUpdate) SetPrivateKey(b []byte) *SigningKeyUpdate {
+	sku.mutation.SetPrivateKey(b)
+	return sku
+}
+
+// SetStatus sets the "status" field.
+func (sku *SigningKeyUpdate) SetStatus(s signingkey.Status) *SigningKeyUpdate {
+	sku.mutation.SetStatus(s)
+	return sku
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableStatus(s *signingkey.Status) *SigningKeyUpdate {
+	if s != nil {
+		sku.SetStatus(*s)
+	}
+	return sku
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (sku *SigningKeyUpdate) SetRetiredAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetRetiredAt(t)
+	return sku
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableRetiredAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetRetiredAt(*t)
+	}
+	return sku
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (sku *SigningKeyUpdate) ClearRetiredAt() *SigningKeyUpdate {
+	sku.mutation.ClearRetiredAt()
+	return sku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sku *SigningKeyUpdate) SetRevokedAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetRevokedAt(t)
+	return sku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableRevokedAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetRevokedAt(*t)
+	}
+	return sku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sku *SigningKeyUpdate) ClearRevokedAt() *SigningKeyUpdate {
+	sku.mutation.ClearRevokedAt()
+	return sku
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (sku *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return sku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *SigningKeyUpdate) check() error {
+	if v, ok := sku.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sku *SigningKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+	}
+	if value, ok := sku.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sku.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if sku.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sku.mutation.RevokedAtCleared() {
+		_spec.ClearField(signingkey.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skuo *SigningKeyUpdateOne) SetPrivateKey(b []byte) *SigningKeyUpdateOne {
+	skuo.mutation.SetPrivateKey(b)
+	return skuo
+}
+
+// SetStatus sets the "status" field.
+func (skuo *SigningKeyUpdateOne) SetStatus(s signingkey.Status) *SigningKeyUpdateOne {
+	skuo.mutation.SetStatus(s)
+	return skuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableStatus(s *signingkey.Status) *SigningKeyUpdateOne {
+	if s != nil {
+		skuo.SetStatus(*s)
+	}
+	return skuo
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (skuo *SigningKeyUpdateOne) SetRetiredAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetRetiredAt(t)
+	return skuo
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableRetiredAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetRetiredAt(*t)
+	}
+	return skuo
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (skuo *SigningKeyUpdateOne) ClearRetiredAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearRetiredAt()
+	return skuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (skuo *SigningKeyUpdateOne) SetRevokedAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetRevokedAt(t)
+	return skuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableRevokedAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetRevokedAt(*t)
+	}
+	return skuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (skuo *SigningKeyUpdateOne) ClearRevokedAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearRevokedAt()
+	return skuo
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skuo *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return skuo.mutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (skuo *SigningKeyUpdateOne) Where(ps ...predicate.SigningKey) *SigningKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (skuo *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *SigningKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Status(); ok {
+		if err := signingkey.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SigningKey.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (skuo *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	if err := skuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeBytes, value)
+	}
+	if value, ok := skuo.mutation.Status(); ok {
+		_spec.SetField(signingkey.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := skuo.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if skuo.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.RevokedAt(); ok {
+		_spec.SetField(signingkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(signingkey.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &SigningKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
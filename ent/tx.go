@@ -12,14 +12,28 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Checkpoint is the client for interacting with the Checkpoint builders.
+	Checkpoint *CheckpointClient
 	// FileReference is the client for interacting with the FileReference builders.
 	FileReference *FileReferenceClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
 	// QRCode is the client for interacting with the QRCode builders.
 	QRCode *QRCodeClient
 	// QRCodeAnalytics is the client for interacting with the QRCodeAnalytics builders.
 	QRCodeAnalytics *QRCodeAnalyticsClient
+	// QRCodeAnalyticsDaily is the client for interacting with the QRCodeAnalyticsDaily builders.
+	QRCodeAnalyticsDaily *QRCodeAnalyticsDailyClient
 	// QRCodeGroup is the client for interacting with the QRCodeGroup builders.
 	QRCodeGroup *QRCodeGroupClient
+	// QRCodeRedemption is the client for interacting with the QRCodeRedemption builders.
+	QRCodeRedemption *QRCodeRedemptionClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +165,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Checkpoint = NewCheckpointClient(tx.config)
 	tx.FileReference = NewFileReferenceClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
 	tx.QRCode = NewQRCodeClient(tx.config)
 	tx.QRCodeAnalytics = NewQRCodeAnalyticsClient(tx.config)
+	tx.QRCodeAnalyticsDaily = NewQRCodeAnalyticsDailyClient(tx.config)
 	tx.QRCodeGroup = NewQRCodeGroupClient(tx.config)
+	tx.QRCodeRedemption = NewQRCodeRedemptionClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +185,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: FileReference.QueryXXX(), the query will be executed
+// applies a query, for example: Checkpoint.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
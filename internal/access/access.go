@@ -0,0 +1,151 @@
+// Package access protects QR code landing pages with a password or PIN.
+// Passwords are stored as salted PBKDF2 hashes. A visitor who enters the
+// right one gets a short-lived signed cookie for the code, and visitors
+// who keep guessing wrong are locked out for a while.
+package access
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"qr_backend/internal/config"
+)
+
+const (
+	// hashIterations is the PBKDF2-SHA256 work factor recommended by OWASP
+	hashIterations = 600000
+	hashScheme     = "pbkdf2-sha256"
+	saltSize       = 16
+	keySize        = 32
+)
+
+// Lengths a password or PIN may have
+const (
+	MinPasswordLength = 4
+	MaxPasswordLength = 128
+)
+
+var settings = config.AccessConfig{
+	CookieTTL:   30 * time.Minute,
+	MaxAttempts: 5,
+	Lockout:     15 * time.Minute,
+}
+
+// secret signs access cookies
+var secret []byte
+
+// Init applies the configuration. Without a configured secret a random one
+// is used, so cookies only last until a restart and only work on this
+// instance.
+func Init(cfg config.AccessConfig) error {
+	if cfg.CookieTTL > 0 {
+		settings.CookieTTL = cfg.CookieTTL
+	}
+	if cfg.MaxAttempts > 0 {
+		settings.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.Lockout > 0 {
+		settings.Lockout = cfg.Lockout
+	}
+	if cfg.CookieSecret != "" {
+		secret = []byte(cfg.CookieSecret)
+		return nil
+	}
+	secret = make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return fmt.Errorf("failed to generate access cookie secret: %w", err)
+	}
+	log.Printf("ACCESS_COOKIE_SECRET is not set; unlocked QR codes lock again on restart")
+	return nil
+}
+
+// ValidatePassword checks the length of a new password or PIN
+func ValidatePassword(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < MinPasswordLength || n > MaxPasswordLength {
+		return fmt.Errorf("password must be %d to %d characters long", MinPasswordLength, MaxPasswordLength)
+	}
+	return nil
+}
+
+// HashPassword returns a salted hash of a password, encoded as
+// pbkdf2-sha256$<iterations>$<salt>$<key>
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := pbkdf2.Key(sha256.New, password, salt, hashIterations, keySize)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawStdEncoding
+	return fmt.Sprintf("%s$%d$%s$%s", hashScheme, hashIterations, enc.EncodeToString(salt), enc.EncodeToString(key)), nil
+}
+
+// CheckPassword reports whether password matches a hash from HashPassword
+func CheckPassword(hash, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != hashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return false
+	}
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	want, err := enc.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+	got, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(want))
+	return err == nil && subtle.ConstantTimeCompare(got, want) == 1
+}
+
+// CookieName returns the name of the access cookie of a code
+func CookieName(qrID int) string {
+	return "qr_access_" + strconv.Itoa(qrID)
+}
+
+// IssueCookie returns the value of an access cookie for a code and when it
+// expires. It is tied to the password hash, so changing the password locks
+// the code again.
+func IssueCookie(qrID int, hash string) (string, time.Time) {
+	expires := time.Now().Add(settings.CookieTTL)
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	return exp + "." + sign(qrID, hash, exp), expires
+}
+
+// VerifyCookie reports whether an access cookie unlocks a code
+func VerifyCookie(qrID int, hash, value string) bool {
+	exp, sig, ok := strings.Cut(value, ".")
+	if !ok {
+		return false
+	}
+	unix, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || time.Now().Unix() >= unix {
+		return false
+	}
+	return hmac.Equal([]byte(sig), []byte(sign(qrID, hash, exp)))
+}
+
+func sign(qrID int, hash, exp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.Itoa(qrID) + "." + exp + "." + hash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,67 @@
+package access
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// attempts are the recent password attempts of one client on one code
+type attempts struct {
+	count       int
+	since       time.Time
+	lockedUntil time.Time
+}
+
+var limiter = struct {
+	sync.Mutex
+	clients map[string]*attempts
+	swept   time.Time
+}{clients: map[string]*attempts{}}
+
+// Attempt records a password attempt by a client on a code before the
+// password is checked, so that parallel guesses are counted too. It
+// returns the attempts left after this one, or how long the client has to
+// wait while locked out, in which case the password must not be checked.
+// Attempts are counted per process.
+func Attempt(qrID int, client string) (int, time.Duration) {
+	limiter.Lock()
+	defer limiter.Unlock()
+
+	now := time.Now()
+	sweep(now)
+	key := strconv.Itoa(qrID) + "|" + client
+	a := limiter.clients[key]
+	if a != nil && now.Before(a.lockedUntil) {
+		return 0, a.lockedUntil.Sub(now)
+	}
+	if a == nil || now.Sub(a.since) > settings.Lockout {
+		a = &attempts{since: now}
+		limiter.clients[key] = a
+	}
+	a.count++
+	if a.count >= settings.MaxAttempts {
+		a.lockedUntil = now.Add(settings.Lockout)
+	}
+	return settings.MaxAttempts - a.count, 0
+}
+
+// Reset forgets the attempts of a client who entered the right password
+func Reset(qrID int, client string) {
+	limiter.Lock()
+	defer limiter.Unlock()
+	delete(limiter.clients, strconv.Itoa(qrID)+"|"+client)
+}
+
+// sweep drops attempts that no longer count, at most once per lockout period
+func sweep(now time.Time) {
+	if now.Sub(limiter.swept) < settings.Lockout {
+		return
+	}
+	limiter.swept = now
+	for key, a := range limiter.clients {
+		if now.Sub(a.since) > settings.Lockout && now.After(a.lockedUntil) {
+			delete(limiter.clients, key)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package analytics
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/internal/database"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// Export formats
+const (
+	ExportCSV     = "csv"
+	ExportNDJSON  = "ndjson"
+	ExportParquet = "parquet"
+)
+
+// exportPageSize is the number of records loaded per query while exporting
+const exportPageSize = 1000
+
+// parquetRowGroupSize is the number of rows buffered before a Parquet row group is written
+const parquetRowGroupSize = 10000
+
+// ExportRecord is a raw scan record with its enrichment fields as exported to analysts
+type ExportRecord struct {
+	ID        int       `json:"id" parquet:"id"`
+	QRCodeID  int       `json:"qr_code_id" parquet:"qr_code_id"`
+	ScannedAt time.Time `json:"scanned_at" parquet:"scanned_at,timestamp(millisecond)"`
+	IPAddress string    `json:"ip_address" parquet:"ip_address"`
+	UserAgent string    `json:"user_agent" parquet:"user_agent"`
+	Device    string    `json:"device" parquet:"device"`
+	Location  string    `json:"location" parquet:"location"`
+}
+
+// ExportFilter selects the records to export. Zero values are not applied.
+type ExportFilter struct {
+	QRCodeID int
+	GroupID  int
+	From     time.Time // Inclusive
+	To       time.Time // Exclusive
+}
+
+// ExportContentType returns the MIME type and file extension of an export format
+func ExportContentType(format string) (string, string, bool) {
+	switch format {
+	case ExportCSV:
+		return "text/csv; charset=utf-8", "csv", true
+	case ExportNDJSON:
+		return "application/x-ndjson", "ndjson", true
+	case ExportParquet:
+		return "application/vnd.apache.parquet", "parquet", true
+	}
+	return "", "", false
+}
+
+// recordWriter encodes export records in one format
+type recordWriter interface {
+	Write(ExportRecord) error
+	Close() error
+}
+
+// Export streams the raw scan records matching filter to w, one page at a
+// time, so exports never hold more than a page (or one Parquet row group) in memory.
+func Export(ctx context.Context, w io.Writer, format string, filter ExportFilter) error {
+	var out recordWriter
+	switch format {
+	case ExportCSV:
+		out = newCSVWriter(w)
+	case ExportNDJSON:
+		out = &ndjsonWriter{enc: json.NewEncoder(w)}
+	case ExportParquet:
+		out = &parquetWriter{w: parquet.NewGenericWriter[ExportRecord](w)}
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	predicates := []predicate.QRCodeAnalytics{}
+	if filter.QRCodeID != 0 {
+		predicates = append(predicates, qrcodeanalytics.HasQrCodeWith(qrcode.IDEQ(filter.QRCodeID)))
+	}
+	if filter.GroupID != 0 {
+		predicates = append(predicates, qrcodeanalytics.HasQrCodeWith(qrcode.GroupIDEQ(filter.GroupID)))
+	}
+	if !filter.From.IsZero() {
+		predicates = append(predicates, qrcodeanalytics.ScannedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		predicates = append(predicates, qrcodeanalytics.ScannedAtLT(filter.To))
+	}
+
+	lastID := 0
+	for {
+		records, err := database.DB.QRCodeAnalytics.
+			Query().
+			Where(append(predicates, qrcodeanalytics.IDGT(lastID))...).
+			WithQrCode(func(q *ent.QRCodeQuery) {
+				q.Select(qrcode.FieldID)
+			}).
+			Order(ent.Asc(qrcodeanalytics.FieldID)).
+			Limit(exportPageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load analytics records: %w", err)
+		}
+
+		for _, record := range records {
+			lastID = record.ID
+			row := ExportRecord{
+				ID:        record.ID,
+				ScannedAt: record.ScannedAt,
+				IPAddress: record.IPAddress,
+				UserAgent: record.UserAgent,
+				Device:    record.Device,
+				Location:  record.Location,
+			}
+			if record.Edges.QrCode != nil {
+				row.QRCodeID = record.Edges.QrCode.ID
+			}
+			if err := out.Write(row); err != nil {
+				return fmt.Errorf("failed to write export record: %w", err)
+			}
+		}
+
+		if len(records) < exportPageSize {
+			break
+		}
+	}
+
+	return out.Close()
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	cw.w.Write([]string{"id", "qr_code_id", "scanned_at", "ip_address", "user_agent", "device", "location"})
+	return cw
+}
+
+func (cw *csvWriter) Write(r ExportRecord) error {
+	return cw.w.Write([]string{
+		strconv.Itoa(r.ID),
+		strconv.Itoa(r.QRCodeID),
+		r.ScannedAt.UTC().Format(time.RFC3339),
+		r.IPAddress,
+		r.UserAgent,
+		r.Device,
+		r.Location,
+	})
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonWriter) Write(r ExportRecord) error {
+	return nw.enc.Encode(r)
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nil
+}
+
+type parquetWriter struct {
+	w    *parquet.GenericWriter[ExportRecord]
+	rows int
+}
+
+func (pw *parquetWriter) Write(r ExportRecord) error {
+	if _, err := pw.w.Write([]ExportRecord{r}); err != nil {
+		return err
+	}
+	pw.rows++
+	if pw.rows%parquetRowGroupSize == 0 {
+		return pw.w.Flush()
+	}
+	return nil
+}
+
+func (pw *parquetWriter) Close() error {
+	return pw.w.Close()
+}
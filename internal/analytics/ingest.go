@@ -0,0 +1,410 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// event is a scan ready to be stored: privacy rules have already been applied
+type event struct {
+	QRCodeID   int       `json:"qr_code_id"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	Device     string    `json:"device,omitempty"`
+	CountsOnly bool      `json:"counts_only,omitempty"`
+	ScannedAt  time.Time `json:"scanned_at"`
+}
+
+// queued is an event waiting in the queue together with its WAL segment
+type queued struct {
+	event   event
+	segment int // WAL segment holding the event, or -1 if it was not logged
+}
+
+// IngestStats reports the state of the scan ingestion queue
+type IngestStats struct {
+	QueueDepth    int       `json:"queue_depth"`
+	QueueCapacity int       `json:"queue_capacity"`
+	Enqueued      uint64    `json:"enqueued"`
+	Dropped       uint64    `json:"dropped"`
+	Written       uint64    `json:"written"`
+	Failed        uint64    `json:"failed"`
+	Retries       uint64    `json:"retries"`
+	Batches       uint64    `json:"batches"`
+	Replayed      uint64    `json:"replayed"`
+	WALEnabled    bool      `json:"wal_enabled"`
+	LastError     string    `json:"last_error,omitempty"`
+	LastFlushAt   time.Time `json:"last_flush_at,omitempty"`
+}
+
+// ingestor buffers scans in a bounded queue and writes them in batches
+type ingestor struct {
+	cfg   config.AnalyticsConfig
+	queue chan queued
+	wal   *wal
+	done  chan struct{}
+
+	// unwritten holds replayed events the database did not take, for run
+	unwritten []queued
+
+	// mu guards closed; senders hold the read lock so the queue is never
+	// closed while a send is in progress
+	mu     sync.RWMutex
+	closed bool
+
+	enqueued atomic.Uint64
+	dropped  atomic.Uint64
+	written  atomic.Uint64
+	failed   atomic.Uint64
+	retries  atomic.Uint64
+	batches  atomic.Uint64
+	replayed atomic.Uint64
+
+	statusMu    sync.Mutex
+	lastError   string
+	lastFlushAt time.Time
+}
+
+// newIngestor creates the ingestor, replays the WAL left by a previous run
+// and starts the writer goroutine
+func newIngestor(cfg config.AnalyticsConfig) (*ingestor, error) {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 10000
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 200
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+
+	in := &ingestor{
+		cfg:   cfg,
+		queue: make(chan queued, cfg.QueueSize),
+		done:  make(chan struct{}),
+	}
+
+	if cfg.WALPath != "" {
+		w, entries, err := openWAL(cfg.WALPath)
+		if err != nil {
+			return nil, err
+		}
+		in.wal = w
+		if len(entries) > 0 {
+			log.Printf("Replaying %d scan(s) from the analytics WAL", len(entries))
+			in.replay(entries)
+		}
+	}
+
+	go in.run()
+	return in, nil
+}
+
+// replay writes the events recovered from the WAL before new scans are
+// accepted. Events the database does not take are left to run.
+func (in *ingestor) replay(entries []walEntry) {
+	batch := make([]queued, 0, len(entries))
+	for _, entry := range entries {
+		batch = append(batch, queued{event: entry.event, segment: entry.segment})
+	}
+	in.unwritten = in.flushAll(batch)
+	in.replayed.Add(uint64(len(entries)))
+}
+
+// enqueue adds an event to the queue. When the queue is full it waits up to
+// EnqueueTimeout for space and then drops the event, so a burst of scans
+// never blocks the scan handler for long.
+func (in *ingestor) enqueue(ev event) {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	if in.closed {
+		in.dropped.Add(1)
+		return
+	}
+
+	item := queued{event: ev, segment: -1}
+	if in.wal != nil {
+		segment, err := in.wal.append(ev)
+		if err != nil {
+			in.setError(err)
+		} else {
+			item.segment = segment
+		}
+	}
+
+	select {
+	case in.queue <- item:
+		in.enqueued.Add(1)
+		return
+	default:
+	}
+
+	timer := time.NewTimer(in.cfg.EnqueueTimeout)
+	defer timer.Stop()
+	select {
+	case in.queue <- item:
+		in.enqueued.Add(1)
+	case <-timer.C:
+		in.dropped.Add(1)
+		in.ack([]queued{item})
+	}
+}
+
+// run collects queued events into batches and flushes them when a batch is
+// full or FlushInterval has passed. Events the database could not take are
+// flushed again with the next batch; while a full batch of them is waiting,
+// new scans stay in the queue. It returns once the queue is closed and
+// drained, or once it is closed while the database is unavailable, leaving
+// the unwritten events in the WAL for the next start.
+func (in *ingestor) run() {
+	defer close(in.done)
+
+	ticker := time.NewTicker(in.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := in.unwritten
+	in.unwritten = nil
+	for {
+		queue := in.queue
+		if len(batch) >= in.cfg.BatchSize {
+			queue = nil
+		}
+		select {
+		case item, ok := <-queue:
+			if !ok {
+				in.flushAll(batch)
+				return
+			}
+			batch = append(batch, item)
+			if len(batch) >= in.cfg.BatchSize {
+				batch = in.flushAll(batch)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				batch = in.flushAll(batch)
+				if len(batch) > 0 && in.isClosed() {
+					return
+				}
+			}
+			if in.wal != nil {
+				if err := in.wal.sync(); err != nil {
+					in.setError(err)
+				}
+			}
+		}
+	}
+}
+
+// flushAll flushes events in batches of BatchSize and returns the ones that
+// could not be written, oldest first
+func (in *ingestor) flushAll(events []queued) []queued {
+	for len(events) > 0 {
+		n := min(len(events), in.cfg.BatchSize)
+		if left := in.flush(events[:n]); len(left) > 0 {
+			return append(left, events[n:]...)
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+// flush writes a batch, retrying with exponential backoff. If the batch keeps
+// failing, events are written one by one so a single bad event (for example
+// one of a deleted QR code) does not take the whole batch down with it. Only
+// written events and events the database rejects are acknowledged; the
+// events from the first one that fails otherwise, such as during a database
+// outage, are returned and stay in the WAL.
+func (in *ingestor) flush(batch []queued) []queued {
+	if len(batch) == 0 {
+		return nil
+	}
+	in.batches.Add(1)
+
+	events := make([]event, len(batch))
+	for i, item := range batch {
+		events[i] = item.event
+	}
+
+	backoff := 100 * time.Millisecond
+	var err error
+	for attempt := 0; attempt <= in.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			in.retries.Add(1)
+			time.Sleep(backoff)
+			if backoff < 5*time.Second {
+				backoff *= 2
+			}
+		}
+		if err = writeEvents(events); err == nil {
+			in.written.Add(uint64(len(events)))
+			in.flushed()
+			in.ack(batch)
+			return nil
+		}
+	}
+	in.setError(err)
+
+	for i, item := range batch {
+		if err := writeEvents([]event{item.event}); err != nil {
+			in.setError(err)
+			if !ent.IsConstraintError(err) {
+				in.ack(batch[:i])
+				return append([]queued(nil), batch[i:]...)
+			}
+			in.failed.Add(1)
+			continue
+		}
+		in.written.Add(1)
+	}
+	in.flushed()
+	in.ack(batch)
+	return nil
+}
+
+// ack acknowledges logged events so their WAL segments can be removed
+func (in *ingestor) ack(batch []queued) {
+	if in.wal == nil {
+		return
+	}
+	perSegment := make(map[int]int)
+	for _, item := range batch {
+		if item.segment >= 0 {
+			perSegment[item.segment]++
+		}
+	}
+	for segment, n := range perSegment {
+		in.wal.ack(segment, n)
+	}
+}
+
+// close stops accepting events and waits until the queue has been drained
+func (in *ingestor) close(ctx context.Context) error {
+	in.mu.Lock()
+	if !in.closed {
+		in.closed = true
+		close(in.queue)
+	}
+	in.mu.Unlock()
+
+	select {
+	case <-in.done:
+	case <-ctx.Done():
+		return fmt.Errorf("analytics queue not drained: %w", ctx.Err())
+	}
+
+	if in.wal != nil {
+		return in.wal.close()
+	}
+	return nil
+}
+
+// isClosed reports whether close has been called
+func (in *ingestor) isClosed() bool {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+	return in.closed
+}
+
+func (in *ingestor) stats() IngestStats {
+	in.statusMu.Lock()
+	defer in.statusMu.Unlock()
+
+	return IngestStats{
+		QueueDepth:    len(in.queue),
+		QueueCapacity: cap(in.queue),
+		Enqueued:      in.enqueued.Load(),
+		Dropped:       in.dropped.Load(),
+		Written:       in.written.Load(),
+		Failed:        in.failed.Load(),
+		Retries:       in.retries.Load(),
+		Batches:       in.batches.Load(),
+		Replayed:      in.replayed.Load(),
+		WALEnabled:    in.wal != nil,
+		LastError:     in.lastError,
+		LastFlushAt:   in.lastFlushAt,
+	}
+}
+
+func (in *ingestor) setError(err error) {
+	log.Printf("Analytics ingestion error: %v", err)
+	in.statusMu.Lock()
+	in.lastError = err.Error()
+	in.statusMu.Unlock()
+}
+
+func (in *ingestor) flushed() {
+	in.statusMu.Lock()
+	in.lastFlushAt = time.Now()
+	in.statusMu.Unlock()
+}
+
+// writeEvents stores a batch in a single transaction. Full events become raw
+// records, counts-only events are added to the daily aggregates. A
+// concurrent insert of the same day's aggregate is retried as an update.
+func writeEvents(events []event) error {
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		if err = writeEventsOnce(events); err == nil || !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func writeEventsOnce(events []event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	rollback := func(err error) error {
+		tx.Rollback()
+		return err
+	}
+
+	type countKey struct {
+		qrID int
+		day  time.Time
+	}
+	counts := make(map[countKey]int)
+	builders := make([]*ent.QRCodeAnalyticsCreate, 0, len(events))
+	for _, ev := range events {
+		if ev.CountsOnly {
+			counts[countKey{ev.QRCodeID, startOfDay(ev.ScannedAt)}]++
+			continue
+		}
+		builders = append(builders, tx.QRCodeAnalytics.Create().
+			SetIPAddress(ev.IP).
+			SetUserAgent(ev.UserAgent).
+			SetDevice(ev.Device).
+			SetScannedAt(ev.ScannedAt).
+			SetQrCodeID(ev.QRCodeID))
+	}
+
+	if len(builders) > 0 {
+		if err := tx.QRCodeAnalytics.CreateBulk(builders...).Exec(ctx); err != nil {
+			return rollback(fmt.Errorf("failed to insert scans: %w", err))
+		}
+	}
+	for key, n := range counts {
+		if err := addDaily(ctx, tx, key.qrID, key.day, n, 0, nil); err != nil {
+			return rollback(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scans: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package analytics
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IP address storage modes
+const (
+	IPModeFull     = "full"
+	IPModeTruncate = "truncate"
+	IPModeHash     = "hash"
+)
+
+// Device classes derived from the User-Agent header
+const (
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceDesktop = "desktop"
+	DeviceBot     = "bot"
+	DeviceUnknown = "unknown"
+)
+
+// dailySalt is the random salt used to hash visitors. It is kept in memory
+// only and replaced every UTC day, so hashes cannot be linked across days.
+var dailySalt struct {
+	sync.Mutex
+	day  string
+	salt []byte
+}
+
+// AnonymizeIP returns the IP address as it should be stored for the given mode.
+// userAgent is only used by the hash mode to tell apart visitors behind the same address.
+func AnonymizeIP(ip, userAgent, mode string) string {
+	switch mode {
+	case IPModeTruncate:
+		return TruncateIP(ip)
+	case IPModeHash:
+		return HashVisitor(ip, userAgent)
+	default:
+		return ip
+	}
+}
+
+// TruncateIP zeroes the host part of an address, keeping the /24 network for
+// IPv4 and the /48 network for IPv6. Unparseable input is dropped.
+func TruncateIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// HashVisitor returns a salted hash of the visitor that is stable for the
+// current UTC day only, which is enough to count unique visitors per day.
+func HashVisitor(ip, userAgent string) string {
+	h := sha256.New()
+	h.Write(currentSalt())
+	h.Write([]byte(ip))
+	h.Write([]byte{0})
+	h.Write([]byte(userAgent))
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// currentSalt returns the salt of the current UTC day, rotating it when the day changes
+func currentSalt() []byte {
+	day := time.Now().UTC().Format("2006-01-02")
+
+	dailySalt.Lock()
+	defer dailySalt.Unlock()
+
+	if dailySalt.day != day {
+		salt := make([]byte, 32)
+		if _, err := rand.Read(salt); err != nil {
+			// crypto/rand does not fail on supported platforms
+			panic(err)
+		}
+		dailySalt.day = day
+		dailySalt.salt = salt
+	}
+	return dailySalt.salt
+}
+
+// OptedOut reports whether the visitor asked not to be tracked through the
+// DNT or Sec-GPC request headers.
+func OptedOut(dnt, gpc string) bool {
+	return strings.TrimSpace(dnt) == "1" || strings.TrimSpace(gpc) == "1"
+}
+
+// DeviceClass derives a coarse device class from a User-Agent header
+func DeviceClass(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case ua == "":
+		return DeviceUnknown
+	case strings.Contains(ua, "bot") || strings.Contains(ua, "crawler") || strings.Contains(ua, "spider"):
+		return DeviceBot
+	case strings.Contains(ua, "ipad") || strings.Contains(ua, "tablet") ||
+		(strings.Contains(ua, "android") && !strings.Contains(ua, "mobile")):
+		return DeviceTablet
+	case strings.Contains(ua, "mobile") || strings.Contains(ua, "iphone") || strings.Contains(ua, "ipod"):
+		return DeviceMobile
+	default:
+		return DeviceDesktop
+	}
+}
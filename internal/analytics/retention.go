@@ -0,0 +1,275 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// rollupBatchSize limits how many raw records are loaded at once while rolling up a day
+const rollupBatchSize = 1000
+
+// dailyAggregate accumulates the raw records of one QR code for one day
+type dailyAggregate struct {
+	scans    int
+	visitors map[string]struct{}
+	devices  map[string]int
+}
+
+// StartRetention runs the rollup and retention job in the background every
+// cfg.RetentionInterval until ctx is cancelled.
+func StartRetention(ctx context.Context, cfg config.AnalyticsConfig) {
+	interval := cfg.RetentionInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := RunRetention(ctx, cfg); err != nil && ctx.Err() == nil {
+				log.Printf("Analytics retention failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunRetention rolls up raw scan records older than cfg.RollupAfterDays into
+// daily aggregates and deletes raw records older than cfg.RetentionDays.
+// Raw records are always rolled up before they are deleted, so the daily
+// aggregates keep the full scan history.
+func RunRetention(ctx context.Context, cfg config.AnalyticsConfig) error {
+	now := time.Now()
+	rollupCutoff := startOfDay(now.AddDate(0, 0, -cfg.RollupAfterDays))
+
+	var retentionCutoff time.Time
+	if cfg.RetentionDays > 0 {
+		retentionCutoff = startOfDay(now.AddDate(0, 0, -cfg.RetentionDays))
+		if retentionCutoff.After(rollupCutoff) {
+			rollupCutoff = retentionCutoff
+		}
+	}
+
+	days, err := RollupBefore(ctx, rollupCutoff)
+	if err != nil {
+		return err
+	}
+
+	var purged int
+	if cfg.RetentionDays > 0 {
+		purged, err = PurgeBefore(ctx, retentionCutoff)
+		if err != nil {
+			return err
+		}
+	}
+
+	if days > 0 || purged > 0 {
+		log.Printf("Analytics retention: rolled up %d day(s), purged %d raw record(s)", days, purged)
+	}
+	return nil
+}
+
+// RollupBefore aggregates every raw record scanned before cutoff that has not
+// been rolled up yet, one day at a time. It returns the number of days rolled up.
+func RollupBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	days := 0
+	for {
+		oldest, err := database.DB.QRCodeAnalytics.
+			Query().
+			Where(
+				qrcodeanalytics.RolledUp(false),
+				qrcodeanalytics.ScannedAtLT(cutoff),
+			).
+			Order(ent.Asc(qrcodeanalytics.FieldScannedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return days, nil
+			}
+			return days, fmt.Errorf("failed to find records to roll up: %w", err)
+		}
+
+		if err := rollupDay(ctx, startOfDay(oldest.ScannedAt)); err != nil {
+			return days, err
+		}
+		days++
+	}
+}
+
+// PurgeBefore deletes raw records scanned before cutoff that have already been
+// rolled up. It returns the number of deleted records.
+func PurgeBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := database.DB.QRCodeAnalytics.
+		Delete().
+		Where(
+			qrcodeanalytics.RolledUp(true),
+			qrcodeanalytics.ScannedAtLT(cutoff),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge analytics records: %w", err)
+	}
+	return n, nil
+}
+
+// rollupDay merges the pending raw records of a single day into the daily
+// aggregates and marks them as rolled up, all within one transaction.
+func rollupDay(ctx context.Context, day time.Time) error {
+	next := day.AddDate(0, 0, 1)
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start rollup transaction: %w", err)
+	}
+	rollback := func(err error) error {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+
+	aggregates := make(map[int]*dailyAggregate)
+	lastID := 0
+	for {
+		records, err := tx.QRCodeAnalytics.
+			Query().
+			Where(
+				qrcodeanalytics.RolledUp(false),
+				qrcodeanalytics.ScannedAtGTE(day),
+				qrcodeanalytics.ScannedAtLT(next),
+				qrcodeanalytics.IDGT(lastID),
+			).
+			WithQrCode(func(q *ent.QRCodeQuery) {
+				q.Select(qrcode.FieldID)
+			}).
+			Order(ent.Asc(qrcodeanalytics.FieldID)).
+			Limit(rollupBatchSize).
+			All(ctx)
+		if err != nil {
+			return rollback(fmt.Errorf("failed to load records for %s: %w", day.Format("2006-01-02"), err))
+		}
+
+		for _, record := range records {
+			lastID = record.ID
+			// Records of deleted QR codes are only marked as rolled up
+			if record.Edges.QrCode == nil {
+				continue
+			}
+
+			agg, ok := aggregates[record.Edges.QrCode.ID]
+			if !ok {
+				agg = &dailyAggregate{
+					visitors: make(map[string]struct{}),
+					devices:  make(map[string]int),
+				}
+				aggregates[record.Edges.QrCode.ID] = agg
+			}
+			agg.scans++
+			if record.IPAddress != "" {
+				agg.visitors[record.IPAddress] = struct{}{}
+			}
+			if record.Device != "" {
+				agg.devices[record.Device]++
+			}
+		}
+
+		if len(records) < rollupBatchSize {
+			break
+		}
+	}
+
+	for qrID, agg := range aggregates {
+		if err := addDaily(ctx, tx, qrID, day, agg.scans, len(agg.visitors), agg.devices); err != nil {
+			return rollback(err)
+		}
+	}
+
+	_, err = tx.QRCodeAnalytics.
+		Update().
+		Where(
+			qrcodeanalytics.RolledUp(false),
+			qrcodeanalytics.ScannedAtGTE(day),
+			qrcodeanalytics.ScannedAtLT(next),
+			qrcodeanalytics.IDLTE(lastID),
+		).
+		SetRolledUp(true).
+		Save(ctx)
+	if err != nil {
+		return rollback(fmt.Errorf("failed to mark records as rolled up: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit rollup: %w", err)
+	}
+	return nil
+}
+
+// addDaily adds the given counts to the daily aggregate of a QR code,
+// creating the aggregate row if it does not exist yet.
+func addDaily(ctx context.Context, tx *ent.Tx, qrID int, day time.Time, scans, visitors int, devices map[string]int) error {
+	existing, err := tx.QRCodeAnalyticsDaily.
+		Query().
+		Where(
+			qrcodeanalyticsdaily.QrCodeID(qrID),
+			qrcodeanalyticsdaily.Day(day),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to load daily aggregate: %w", err)
+	}
+
+	if existing == nil {
+		_, err = tx.QRCodeAnalyticsDaily.
+			Create().
+			SetQrCodeID(qrID).
+			SetDay(day).
+			SetScans(scans).
+			SetUniqueVisitors(visitors).
+			SetDevices(devices).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create daily aggregate: %w", err)
+		}
+		return nil
+	}
+
+	merged := make(map[string]int, len(existing.Devices)+len(devices))
+	for device, count := range existing.Devices {
+		merged[device] += count
+	}
+	for device, count := range devices {
+		merged[device] += count
+	}
+
+	_, err = existing.Update().
+		AddScans(scans).
+		AddUniqueVisitors(visitors).
+		SetDevices(merged).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update daily aggregate: %w", err)
+	}
+	return nil
+}
+
+// startOfDay truncates t to midnight UTC
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
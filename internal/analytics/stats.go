@@ -0,0 +1,147 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodeanalytics"
+	"qr_backend/ent/qrcodeanalyticsdaily"
+	"qr_backend/internal/database"
+)
+
+// DayStat holds the scan counts of a QR code for a single day
+type DayStat struct {
+	Day            string         `json:"day"`
+	Scans          int            `json:"scans"`
+	UniqueVisitors int            `json:"unique_visitors"`
+	Devices        map[string]int `json:"devices,omitempty"`
+}
+
+// Summary holds the all-time scan counts of a QR code
+type Summary struct {
+	TotalScans     int `json:"total_scans"`
+	UniqueVisitors int `json:"unique_visitors"`
+}
+
+// GetSummary combines the daily aggregates with the raw records that have not
+// been rolled up yet. Unique visitors are counted per day for rolled up
+// history, so a visitor returning on several days is counted once per day.
+func GetSummary(ctx context.Context, qrID int) (Summary, error) {
+	var summary Summary
+
+	dailies, err := database.DB.QRCodeAnalyticsDaily.
+		Query().
+		Where(qrcodeanalyticsdaily.QrCodeID(qrID)).
+		All(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("failed to load daily aggregates: %w", err)
+	}
+	for _, daily := range dailies {
+		summary.TotalScans += daily.Scans
+		summary.UniqueVisitors += daily.UniqueVisitors
+	}
+
+	records, err := database.DB.QRCodeAnalytics.
+		Query().
+		Where(
+			qrcodeanalytics.HasQrCodeWith(qrcode.IDEQ(qrID)),
+			qrcodeanalytics.RolledUp(false),
+		).
+		Select(qrcodeanalytics.FieldIPAddress).
+		All(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("failed to load analytics records: %w", err)
+	}
+	visitors := make(map[string]struct{})
+	for _, record := range records {
+		if record.IPAddress != "" {
+			visitors[record.IPAddress] = struct{}{}
+		}
+	}
+	summary.TotalScans += len(records)
+	summary.UniqueVisitors += len(visitors)
+
+	return summary, nil
+}
+
+// GetDailySeries returns one entry per day with scans between from and to
+// (inclusive), built from the daily aggregates and the raw records that have
+// not been rolled up yet.
+func GetDailySeries(ctx context.Context, qrID int, from, to time.Time) ([]DayStat, error) {
+	from = startOfDay(from)
+	until := startOfDay(to).AddDate(0, 0, 1)
+
+	days := make(map[string]*DayStat)
+	dayStat := func(day time.Time) *DayStat {
+		key := day.Format("2006-01-02")
+		stat, ok := days[key]
+		if !ok {
+			stat = &DayStat{Day: key, Devices: make(map[string]int)}
+			days[key] = stat
+		}
+		return stat
+	}
+
+	dailies, err := database.DB.QRCodeAnalyticsDaily.
+		Query().
+		Where(
+			qrcodeanalyticsdaily.QrCodeID(qrID),
+			qrcodeanalyticsdaily.DayGTE(from),
+			qrcodeanalyticsdaily.DayLT(until),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load daily aggregates: %w", err)
+	}
+	for _, daily := range dailies {
+		stat := dayStat(daily.Day)
+		stat.Scans += daily.Scans
+		stat.UniqueVisitors += daily.UniqueVisitors
+		for device, count := range daily.Devices {
+			stat.Devices[device] += count
+		}
+	}
+
+	records, err := database.DB.QRCodeAnalytics.
+		Query().
+		Where(
+			qrcodeanalytics.HasQrCodeWith(qrcode.IDEQ(qrID)),
+			qrcodeanalytics.RolledUp(false),
+			qrcodeanalytics.ScannedAtGTE(from),
+			qrcodeanalytics.ScannedAtLT(until),
+		).
+		Order(ent.Asc(qrcodeanalytics.FieldScannedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load analytics records: %w", err)
+	}
+	visitors := make(map[string]map[string]struct{})
+	for _, record := range records {
+		stat := dayStat(startOfDay(record.ScannedAt))
+		stat.Scans++
+		if record.Device != "" {
+			stat.Devices[record.Device]++
+		}
+		if record.IPAddress == "" {
+			continue
+		}
+		if visitors[stat.Day] == nil {
+			visitors[stat.Day] = make(map[string]struct{})
+		}
+		visitors[stat.Day][record.IPAddress] = struct{}{}
+	}
+	for day, ips := range visitors {
+		days[day].UniqueVisitors += len(ips)
+	}
+
+	series := make([]DayStat, 0, len(days))
+	for _, stat := range days {
+		series = append(series, *stat)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Day < series[j].Day })
+	return series, nil
+}
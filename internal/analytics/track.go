@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"qr_backend/internal/config"
+)
+
+// settings holds the analytics configuration used when recording scans
+var settings = config.AnalyticsConfig{
+	Enabled:        true,
+	IPMode:         IPModeFull,
+	StoreUserAgent: true,
+	HonorDNT:       true,
+}
+
+// ingest is the queue scans are written through, created by Init
+var ingest *ingestor
+
+// Scan describes a single scan of a QR code as seen by the scan handler
+type Scan struct {
+	QRCodeID   int
+	IP         string
+	UserAgent  string
+	DNT        string // Value of the DNT request header
+	GPC        string // Value of the Sec-GPC request header
+	CountsOnly bool   // The QR code only allows counting scans
+	ScannedAt  time.Time
+}
+
+// Init sets the analytics configuration used by Track and starts the
+// ingestion queue, replaying scans left in the write-ahead log by a crash.
+// The database must be connected.
+func Init(cfg config.AnalyticsConfig) error {
+	settings = cfg
+
+	in, err := newIngestor(cfg)
+	if err != nil {
+		return err
+	}
+	ingest = in
+	return nil
+}
+
+// Shutdown stops accepting scans and flushes the queue to the database
+func Shutdown(ctx context.Context) error {
+	if ingest == nil {
+		return nil
+	}
+	return ingest.close(ctx)
+}
+
+// Stats returns the ingestion queue metrics
+func Stats() IngestStats {
+	if ingest == nil {
+		return IngestStats{}
+	}
+	return ingest.stats()
+}
+
+// Track queues a scan for storage. Depending on the configuration and the
+// visitor's privacy signals it stores an anonymized record or only
+// increments the daily scan count. Privacy rules are applied before the scan
+// is queued, so raw visitor data never reaches the write-ahead log.
+func Track(scan Scan) {
+	if !settings.Enabled || ingest == nil {
+		return
+	}
+	if scan.ScannedAt.IsZero() {
+		scan.ScannedAt = time.Now()
+	}
+
+	ev := event{
+		QRCodeID:  scan.QRCodeID,
+		ScannedAt: scan.ScannedAt,
+	}
+	if scan.CountsOnly || (settings.HonorDNT && OptedOut(scan.DNT, scan.GPC)) {
+		ev.CountsOnly = true
+	} else {
+		ev.IP = AnonymizeIP(scan.IP, scan.UserAgent, settings.IPMode)
+		ev.Device = DeviceClass(scan.UserAgent)
+		if settings.StoreUserAgent {
+			ev.UserAgent = scan.UserAgent
+		}
+	}
+
+	ingest.enqueue(ev)
+}
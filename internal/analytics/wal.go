@@ -0,0 +1,181 @@
+package analytics
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// walSegmentEvents is the number of events after which a new segment is started
+const walSegmentEvents = 10000
+
+// wal is an append-only log of queued scan events split into segments.
+// A segment file is removed once every event it holds has been written to the
+// database, so after a crash only unwritten (or not yet acknowledged) events
+// are replayed. Replay is at-least-once: a crash between a database commit and
+// the acknowledgement can insert a scan twice.
+type wal struct {
+	mu        sync.Mutex
+	dir       string
+	cur       *os.File
+	curID     int
+	curEvents int
+	pending   map[int]int // Unacknowledged events per segment
+}
+
+// walEntry is an event read back from a segment during replay
+type walEntry struct {
+	segment int
+	event   event
+}
+
+// openWAL opens the log in dir and returns the events left by a previous run
+func openWAL(dir string) (*wal, []walEntry, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "scans-*.wal"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(paths)
+
+	w := &wal{dir: dir, pending: make(map[int]int)}
+	var entries []walEntry
+	for _, path := range paths {
+		var id int
+		if _, err := fmt.Sscanf(filepath.Base(path), "scans-%d.wal", &id); err != nil {
+			continue
+		}
+		events, err := readSegment(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, ev := range events {
+			entries = append(entries, walEntry{segment: id, event: ev})
+		}
+		w.pending[id] = len(events)
+		if id >= w.curID {
+			w.curID = id
+		}
+		if len(events) == 0 {
+			os.Remove(path)
+			delete(w.pending, id)
+		}
+	}
+
+	if err := w.startSegment(w.curID + 1); err != nil {
+		return nil, nil, err
+	}
+	return w, entries, nil
+}
+
+// readSegment reads all complete events of a segment. A partially written
+// last line, left by a crash during append, is ignored.
+func readSegment(path string) ([]event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open WAL segment: %w", err)
+	}
+	defer f.Close()
+
+	var events []event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue
+		}
+		events = append(events, ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read WAL segment: %w", err)
+	}
+	return events, nil
+}
+
+// startSegment closes the current segment and opens segment id for appending.
+// Must be called with mu held (or before the log is shared).
+func (w *wal) startSegment(id int) error {
+	if w.cur != nil {
+		w.cur.Close()
+		if w.pending[w.curID] == 0 {
+			os.Remove(w.segmentPath(w.curID))
+			delete(w.pending, w.curID)
+		}
+	}
+
+	f, err := os.OpenFile(w.segmentPath(id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open WAL segment: %w", err)
+	}
+	w.cur = f
+	w.curID = id
+	w.curEvents = 0
+	return nil
+}
+
+func (w *wal) segmentPath(id int) string {
+	return filepath.Join(w.dir, fmt.Sprintf("scans-%08d.wal", id))
+}
+
+// append writes an event to the current segment and returns the segment ID
+func (w *wal) append(ev event) (int, error) {
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return 0, err
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.curEvents >= walSegmentEvents {
+		if err := w.startSegment(w.curID + 1); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := w.cur.Write(line); err != nil {
+		return 0, fmt.Errorf("failed to append to WAL: %w", err)
+	}
+	w.curEvents++
+	w.pending[w.curID]++
+	return w.curID, nil
+}
+
+// ack marks n events of a segment as written and removes finished segments
+func (w *wal) ack(segment, n int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending[segment] -= n
+	if w.pending[segment] <= 0 && segment != w.curID {
+		os.Remove(w.segmentPath(segment))
+		delete(w.pending, segment)
+	}
+}
+
+// sync flushes the current segment to stable storage
+func (w *wal) sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.cur.Sync()
+}
+
+// close closes the current segment, removing it if it holds no pending events
+func (w *wal) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	err := w.cur.Close()
+	if w.pending[w.curID] <= 0 {
+		os.Remove(w.segmentPath(w.curID))
+		delete(w.pending, w.curID)
+	}
+	return err
+}
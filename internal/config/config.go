@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -14,17 +15,26 @@ type Config struct {
 	Database  DatabaseConfig
 	JWT       JWTConfig
 	Upload    UploadConfig
+	Storage   StorageConfig
+	Image     ImageConfig
+	Malware   MalwareConfig
 	QRCode    QRCodeConfig
 	Analytics AnalyticsConfig
+	Webhook   WebhookConfig
+	Jobs      JobsConfig
+	Access    AccessConfig
+	Signing   SigningConfig
 	Redis     RedisConfig
 	External  ExternalConfig
 	Logging   LoggingConfig
 }
 
 type ServerConfig struct {
-	Port        string
-	Host        string
-	Environment string
+	Port           string
+	Host           string
+	Environment    string
+	ProxyHeader    string   // Header carrying the client IP set by a reverse proxy, e.g. X-Real-IP; empty uses the connection address
+	TrustedProxies []string // Addresses or CIDR ranges of the proxies whose ProxyHeader is believed
 }
 
 type DatabaseConfig struct {
@@ -47,6 +57,47 @@ type UploadConfig struct {
 	MaxSize      int64
 	Path         string
 	AllowedTypes []string
+	MaxPDFPages  int // Reject PDFs with more pages; 0 means no limit
+}
+
+type StorageConfig struct {
+	Backend      string        // "local" or "s3"; where new uploads are written
+	DownloadMode string        // "proxy" streams files through the server, "redirect" sends presigned URLs
+	URLExpiry    time.Duration // Lifetime of presigned download URLs
+	Quota        int64         // Maximum bytes stored by each owner; 0 means unlimited
+	OwnerHeader  string        // Request header naming the owner of uploads, set by a trusted authenticating proxy; empty means one owner for the workspace
+	GCInterval   time.Duration // How often orphaned files and file references are collected; 0 disables it
+	GCGrace      time.Duration // Minimum age of an orphan before it is removed
+	S3           S3Config
+}
+
+type S3Config struct {
+	Endpoint  string // e.g. http://localhost:9000 for MinIO; defaults to AWS
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool   // Address objects as endpoint/bucket/key instead of bucket.endpoint/key
+	Prefix    string // Optional key prefix inside the bucket
+}
+
+type ImageConfig struct {
+	Process       bool // Resize, rotate upright and strip metadata from uploaded images
+	ThumbnailSize int  // Width of the thumbnail variant
+	MediumSize    int  // Width of the medium variant
+	MaxDimension  int  // Longest side of the stored original; 0 keeps the full size
+	MaxPixels     int  // Refuse images with more pixels than this
+	Quality       int  // Encoding quality (1-100)
+	WebP          bool // Also store WebP variants (requires cwebp)
+	AVIF          bool // Also store AVIF variants (requires avifenc)
+}
+
+type MalwareConfig struct {
+	Scanner      string        // "clamd", or empty to serve uploads without scanning
+	ClamdAddress string        // tcp://host:port or unix:///path/to/clamd.sock
+	Timeout      time.Duration // Limit of a single scan
+	PollInterval time.Duration // How often pending files are looked up; also the first retry delay
+	MaxAttempts  int           // Scan attempts before a file is marked failed
 }
 
 type QRCodeConfig struct {
@@ -56,8 +107,51 @@ type QRCodeConfig struct {
 }
 
 type AnalyticsConfig struct {
-	Enabled       bool
-	RetentionDays int
+	Enabled           bool
+	RetentionDays     int           // Raw scan records older than this are deleted
+	RollupAfterDays   int           // Raw scan records older than this are rolled up into daily aggregates
+	RetentionInterval time.Duration // How often the rollup/retention job runs
+	IPMode            string        // "full", "truncate" (/24 IPv4, /48 IPv6) or "hash" (daily rotating salted hash)
+	StoreUserAgent    bool          // Store the raw User-Agent header; the device class is always derived
+	HonorDNT          bool          // Store only counts for visitors sending DNT: 1 or Sec-GPC: 1
+	QueueSize         int           // Capacity of the in-process scan queue
+	BatchSize         int           // Maximum number of scans inserted per batch
+	FlushInterval     time.Duration // Maximum time a scan waits in the queue before being written
+	EnqueueTimeout    time.Duration // How long a scan waits for queue space before it is dropped
+	MaxRetries        int           // Retries of a failed batch insert before falling back to single inserts
+	WALPath           string        // Directory of the optional write-ahead log; empty disables it
+}
+
+type WebhookConfig struct {
+	MaxAttempts  int           // Delivery attempts before a delivery is moved to the dead-letter list
+	Timeout      time.Duration // HTTP timeout of a single delivery attempt
+	PollInterval time.Duration // How often due deliveries are looked up
+	BaseBackoff  time.Duration // Delay before the first retry, doubled on every further retry
+	MaxBackoff   time.Duration // Upper bound of the retry delay
+	QueueSize    int           // Emitted events waiting to be matched against webhooks; further events are dropped
+	Dispatchers  int           // Goroutines storing the deliveries of emitted events
+	AllowPrivate bool          // Deliver to loopback, private and link-local addresses; only for trusted internal endpoints
+}
+
+type JobsConfig struct {
+	Workers      int           // Jobs run at the same time by this process
+	PollInterval time.Duration // How often queued jobs are looked up
+	Lease        time.Duration // How long a running job stays claimed without a heartbeat before another worker may take it
+	MaxAttempts  int           // Default attempts of a job before it is marked failed
+	BaseBackoff  time.Duration // Delay before the first retry, doubled on every further retry
+	MaxBackoff   time.Duration // Upper bound of the retry delay
+	Retention    time.Duration // Finished jobs older than this are deleted
+}
+
+type SigningConfig struct {
+	KeyEncryptionKey string // Secret encrypting the private signing keys stored in the database; stored unencrypted if empty
+}
+
+type AccessConfig struct {
+	CookieSecret string        // Key signing the cookies that unlock password-protected codes; random per process if empty
+	CookieTTL    time.Duration // How long a correct password unlocks a code
+	MaxAttempts  int           // Wrong passwords a visitor may try on a code before being locked out
+	Lockout      time.Duration // How long wrong passwords are counted, and a locked out visitor waits
 }
 
 type RedisConfig struct {
@@ -84,9 +178,11 @@ func LoadConfig() (*Config, error) {
 
 	config := &Config{
 		Server: ServerConfig{
-			Port:        getEnv("SERVER_PORT", "3000"),
-			Host:        getEnv("SERVER_HOST", "localhost"),
-			Environment: getEnv("ENVIRONMENT", "development"),
+			Port:           getEnv("SERVER_PORT", "3000"),
+			Host:           getEnv("SERVER_HOST", "localhost"),
+			Environment:    getEnv("ENVIRONMENT", "development"),
+			ProxyHeader:    getEnv("SERVER_PROXY_HEADER", ""),
+			TrustedProxies: getEnvSlice("SERVER_TRUSTED_PROXIES", []string{"127.0.0.1", "::1"}),
 		},
 		Database: DatabaseConfig{
 			Type:     getEnv("DB_TYPE", "sqlite"),
@@ -105,7 +201,43 @@ func LoadConfig() (*Config, error) {
 		Upload: UploadConfig{
 			MaxSize:      getEnvInt64("UPLOAD_MAX_SIZE", 10485760), // 10MB
 			Path:         getEnv("UPLOAD_PATH", "./uploads"),
-			AllowedTypes: getEnvSlice("ALLOWED_FILE_TYPES", []string{"pdf", "jpg", "jpeg", "png", "gif", "svg"}),
+			AllowedTypes: getEnvSlice("ALLOWED_FILE_TYPES", []string{"pdf", "jpg", "jpeg", "png", "gif", "svg", "txt"}),
+			MaxPDFPages:  getEnvInt("PDF_MAX_PAGES", 500),
+		},
+		Storage: StorageConfig{
+			Backend:      getEnv("STORAGE_BACKEND", "local"),
+			DownloadMode: getEnv("STORAGE_DOWNLOAD_MODE", "proxy"),
+			URLExpiry:    getEnvDuration("STORAGE_URL_EXPIRY", 15*time.Minute),
+			Quota:        getEnvInt64("STORAGE_QUOTA", 0),
+			OwnerHeader:  getEnv("STORAGE_OWNER_HEADER", ""),
+			GCInterval:   getEnvDuration("STORAGE_GC_INTERVAL", 6*time.Hour),
+			GCGrace:      getEnvDuration("STORAGE_GC_GRACE", 24*time.Hour),
+			S3: S3Config{
+				Endpoint:  getEnv("S3_ENDPOINT", ""),
+				Region:    getEnv("S3_REGION", "us-east-1"),
+				Bucket:    getEnv("S3_BUCKET", ""),
+				AccessKey: getEnv("S3_ACCESS_KEY", ""),
+				SecretKey: getEnv("S3_SECRET_KEY", ""),
+				PathStyle: getEnvBool("S3_PATH_STYLE", true),
+				Prefix:    getEnv("S3_PREFIX", ""),
+			},
+		},
+		Malware: MalwareConfig{
+			Scanner:      getEnv("MALWARE_SCANNER", ""),
+			ClamdAddress: getEnv("CLAMD_ADDRESS", "tcp://127.0.0.1:3310"),
+			Timeout:      getEnvDuration("MALWARE_SCAN_TIMEOUT", 2*time.Minute),
+			PollInterval: getEnvDuration("MALWARE_POLL_INTERVAL", 10*time.Second),
+			MaxAttempts:  getEnvInt("MALWARE_MAX_ATTEMPTS", 5),
+		},
+		Image: ImageConfig{
+			Process:       getEnvBool("IMAGE_PROCESS", true),
+			ThumbnailSize: getEnvInt("IMAGE_THUMBNAIL_SIZE", 320),
+			MediumSize:    getEnvInt("IMAGE_MEDIUM_SIZE", 1024),
+			MaxDimension:  getEnvInt("IMAGE_MAX_DIMENSION", 4096),
+			MaxPixels:     getEnvInt("IMAGE_MAX_PIXELS", 50000000),
+			Quality:       getEnvInt("IMAGE_QUALITY", 85),
+			WebP:          getEnvBool("IMAGE_WEBP", false),
+			AVIF:          getEnvBool("IMAGE_AVIF", false),
 		},
 		QRCode: QRCodeConfig{
 			Size:   getEnvInt("QR_CODE_SIZE", 256),
@@ -113,8 +245,47 @@ func LoadConfig() (*Config, error) {
 			Margin: getEnvInt("QR_CODE_MARGIN", 1),
 		},
 		Analytics: AnalyticsConfig{
-			Enabled:       getEnvBool("ANALYTICS_ENABLED", true),
-			RetentionDays: getEnvInt("ANALYTICS_RETENTION_DAYS", 365),
+			Enabled:           getEnvBool("ANALYTICS_ENABLED", true),
+			RetentionDays:     getEnvInt("ANALYTICS_RETENTION_DAYS", 365),
+			RollupAfterDays:   getEnvInt("ANALYTICS_ROLLUP_AFTER_DAYS", 30),
+			RetentionInterval: getEnvDuration("ANALYTICS_RETENTION_INTERVAL", time.Hour),
+			IPMode:            getEnv("ANALYTICS_IP_MODE", "full"),
+			StoreUserAgent:    getEnvBool("ANALYTICS_STORE_USER_AGENT", true),
+			HonorDNT:          getEnvBool("ANALYTICS_HONOR_DNT", true),
+			QueueSize:         getEnvInt("ANALYTICS_QUEUE_SIZE", 10000),
+			BatchSize:         getEnvInt("ANALYTICS_BATCH_SIZE", 200),
+			FlushInterval:     getEnvDuration("ANALYTICS_FLUSH_INTERVAL", time.Second),
+			EnqueueTimeout:    getEnvDuration("ANALYTICS_ENQUEUE_TIMEOUT", 50*time.Millisecond),
+			MaxRetries:        getEnvInt("ANALYTICS_MAX_RETRIES", 5),
+			WALPath:           getEnv("ANALYTICS_WAL_PATH", ""),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:  getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			Timeout:      getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			PollInterval: getEnvDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BaseBackoff:  getEnvDuration("WEBHOOK_BASE_BACKOFF", 30*time.Second),
+			MaxBackoff:   getEnvDuration("WEBHOOK_MAX_BACKOFF", 6*time.Hour),
+			QueueSize:    getEnvInt("WEBHOOK_QUEUE_SIZE", 1000),
+			Dispatchers:  getEnvInt("WEBHOOK_DISPATCHERS", 2),
+			AllowPrivate: getEnvBool("WEBHOOK_ALLOW_PRIVATE", false),
+		},
+		Jobs: JobsConfig{
+			Workers:      getEnvInt("JOBS_WORKERS", 2),
+			PollInterval: getEnvDuration("JOBS_POLL_INTERVAL", 2*time.Second),
+			Lease:        getEnvDuration("JOBS_LEASE", time.Minute),
+			MaxAttempts:  getEnvInt("JOBS_MAX_ATTEMPTS", 3),
+			BaseBackoff:  getEnvDuration("JOBS_BASE_BACKOFF", 10*time.Second),
+			MaxBackoff:   getEnvDuration("JOBS_MAX_BACKOFF", 10*time.Minute),
+			Retention:    getEnvDuration("JOBS_RETENTION", 7*24*time.Hour),
+		},
+		Access: AccessConfig{
+			CookieSecret: getEnv("ACCESS_COOKIE_SECRET", ""),
+			CookieTTL:    getEnvDuration("ACCESS_COOKIE_TTL", 30*time.Minute),
+			MaxAttempts:  getEnvInt("ACCESS_MAX_ATTEMPTS", 5),
+			Lockout:      getEnvDuration("ACCESS_LOCKOUT", 15*time.Minute),
+		},
+		Signing: SigningConfig{
+			KeyEncryptionKey: getEnv("SIGNING_KEY_ENCRYPTION_KEY", ""),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -181,8 +352,16 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 
 func getEnvSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
-		// Simple comma-separated values
-		return []string{value} // You might want to split by comma here
+		// Comma-separated values, e.g. "pdf, png,jpg"
+		var values []string
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			return values
+		}
 	}
 	return defaultValue
 }
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -12,7 +13,7 @@ import (
 	"qr_backend/internal/config"
 
 	_ "github.com/lib/pq"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 )
 
 // DB represents the Ent database client
@@ -79,3 +80,23 @@ func Close() error {
 	}
 	return nil
 }
+
+// RetryLocked repeats a database write while SQLite reports the database
+// locked by another connection. With a shared cache SQLite fails at once
+// instead of waiting, so writes that must not be lost, or that run in a
+// transaction with other writes, are retried for a short while.
+func RetryLocked(ctx context.Context, write func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := write()
+		var sqliteErr sqlite3.Error
+		if err == nil || attempt == 20 || !errors.As(err, &sqliteErr) ||
+			(sqliteErr.Code != sqlite3.ErrLocked && sqliteErr.Code != sqlite3.ErrBusy) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * 10 * time.Millisecond):
+		}
+	}
+}
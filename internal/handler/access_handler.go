@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/access"
+	"qr_backend/internal/database"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// passwordRequest holds the password field of a QR code request. On
+// update, a missing password keeps the current one and "" removes it.
+type passwordRequest struct {
+	Password *string `json:"password,omitempty"`
+}
+
+// validate checks the length of a new password
+func (r *passwordRequest) validate() error {
+	if r.Password == nil || *r.Password == "" {
+		return nil
+	}
+	return access.ValidatePassword(*r.Password)
+}
+
+// passwordHash hashes a new password; it returns "" when there is none
+func (r *passwordRequest) passwordHash() (string, error) {
+	if r.Password == nil || *r.Password == "" {
+		return "", nil
+	}
+	return access.HashPassword(*r.Password)
+}
+
+// unlocked reports whether a visitor may see a QR code's content: the code
+// has no password, or the visitor has a valid access cookie for it
+func unlocked(c *fiber.Ctx, qr *ent.QRCode) bool {
+	return qr.PasswordHash == "" || access.VerifyCookie(qr.ID, qr.PasswordHash, c.Cookies(access.CookieName(qr.ID)))
+}
+
+// passwordChallenge renders the page asking for a QR code's password. The
+// form posts back to the page's own URL.
+func passwordChallenge(c *fiber.Ctx, qr *ent.QRCode, status int, msg string) error {
+	c.Set("Cache-Control", "no-store")
+	return c.Status(status).Render("password", fiber.Map{
+		"Title": qr.Title,
+		"Error": msg,
+	})
+}
+
+// UnlockScan checks the password posted from the challenge page of a
+// scanned QR code
+func UnlockScan(c *fiber.Ctx) error {
+	qr, err := database.DB.QRCode.Query().
+		Where(qrcode.ShortURLEQ(c.Params("shortcode"))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
+	}
+	return unlock(c, qr)
+}
+
+// UnlockStaticQRContent checks the password posted from the challenge page
+// of a static QR code's content
+func UnlockStaticQRContent(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+	qr, err := database.DB.QRCode.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
+	}
+	return unlock(c, qr)
+}
+
+// unlock issues an access cookie when the posted password is right, and
+// sends the visitor back to the page they came from. Wrong passwords are
+// counted per visitor IP, which is locked out after too many.
+func unlock(c *fiber.Ctx, qr *ent.QRCode) error {
+	// Inactive and closed codes, and codes without a password, are handled
+	// by the page itself
+	if qr.PasswordHash == "" || !qr.Active || qrClosure(qr, time.Now()) != nil {
+		return c.Redirect(c.OriginalURL(), fiber.StatusSeeOther)
+	}
+
+	left, wait := access.Attempt(qr.ID, c.IP())
+	if wait > 0 {
+		c.Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		return passwordChallenge(c, qr, fiber.StatusTooManyRequests,
+			fmt.Sprintf("Too many wrong attempts. Try again in %d minutes.", int(math.Ceil(wait.Minutes()))))
+	}
+	if !access.CheckPassword(qr.PasswordHash, c.FormValue("password")) {
+		msg := "Wrong password. Please try again."
+		if left <= 0 {
+			msg = "Wrong password. Too many wrong attempts; please try again later."
+		}
+		return passwordChallenge(c, qr, fiber.StatusUnauthorized, msg)
+	}
+
+	access.Reset(qr.ID, c.IP())
+	value, expires := access.IssueCookie(qr.ID, qr.PasswordHash)
+	c.Cookie(&fiber.Cookie{
+		Name:     access.CookieName(qr.ID),
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		Secure:   c.Protocol() == "https",
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+	return c.Redirect(c.OriginalURL(), fiber.StatusSeeOther)
+}
+
+// fileAccess reports whether a stored file belongs to password-protected QR
+// codes, and whether the visitor is locked out of it. Files are stored once
+// per content, so every code using the same content counts: the file is
+// served when any of them has no password or has been unlocked.
+func fileAccess(c *fiber.Ctx, ref *ent.FileReference) (protected, locked bool, err error) {
+	owned := filereference.IDEQ(ref.ID)
+	if ref.Checksum != "" {
+		owned = filereference.Or(owned, filereference.Checksum(ref.Checksum))
+	}
+	owners, err := database.DB.QRCode.Query().
+		Where(qrcode.HasFileRefsWith(owned)).
+		All(context.Background())
+	if err != nil {
+		return false, false, err
+	}
+	for _, qr := range owners {
+		if qr.PasswordHash != "" {
+			protected = true
+		}
+	}
+	if !protected {
+		return false, false, nil
+	}
+	for _, qr := range owners {
+		if unlocked(c, qr) {
+			return true, false, nil
+		}
+	}
+	return true, true, nil
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"qr_backend/ent/qrcode"
+	qranalytics "qr_backend/internal/analytics"
+	"qr_backend/internal/database"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseDateRange reads the optional from/to query parameters (YYYY-MM-DD, both inclusive)
+func parseDateRange(c *fiber.Ctx) (from, to time.Time, err error) {
+	if v := c.Query("from"); v != "" {
+		from, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid from date, expected YYYY-MM-DD")
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		to, err = time.Parse("2006-01-02", v)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid to date, expected YYYY-MM-DD")
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, fmt.Errorf("from must not be after to")
+	}
+	return from, to, nil
+}
+
+// GetQRCodeAnalyticsDaily returns the per-day scan series of a QR code for charts.
+// The series is served from the daily rollups, so it covers history whose raw
+// records have already been purged.
+func GetQRCodeAnalyticsDaily(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+
+	from, to, err := parseDateRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	// Default to the last 30 days
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -29)
+	}
+	if to.Before(from) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "from must not be after to"})
+	}
+
+	series, err := qranalytics.GetDailySeries(context.Background(), id, from, to)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve analytics"})
+	}
+
+	return c.JSON(fiber.Map{
+		"from": from.Format("2006-01-02"),
+		"to":   to.Format("2006-01-02"),
+		"days": series,
+	})
+}
+
+// GetAnalyticsIngestStats returns the scan ingestion queue metrics
+func GetAnalyticsIngestStats(c *fiber.Ctx) error {
+	return c.JSON(qranalytics.Stats())
+}
+
+// ExportQRCodeAnalytics streams the raw scan records of one QR code
+func ExportQRCodeAnalytics(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+
+	exists, err := database.DB.QRCode.Query().Where(qrcode.IDEQ(id)).Exist(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
+	}
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+	}
+
+	return exportAnalytics(c, qranalytics.ExportFilter{QRCodeID: id}, fmt.Sprintf("qr_%d_analytics", id))
+}
+
+// ExportAnalytics streams the raw scan records of every QR code in the
+// workspace, optionally restricted to one group with ?group_id=
+func ExportAnalytics(c *fiber.Ctx) error {
+	filter := qranalytics.ExportFilter{GroupID: c.QueryInt("group_id", 0)}
+	return exportAnalytics(c, filter, "analytics")
+}
+
+// exportAnalytics validates the format and date range and streams the export
+func exportAnalytics(c *fiber.Ctx, filter qranalytics.ExportFilter, basename string) error {
+	format := c.Query("format", qranalytics.ExportCSV)
+	contentType, ext, ok := qranalytics.ExportContentType(format)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid format, expected csv, ndjson or parquet"})
+	}
+
+	from, to, err := parseDateRange(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	filter.From = from
+	if !to.IsZero() {
+		filter.To = to.AddDate(0, 0, 1)
+	}
+
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.%s\"", basename, ext))
+
+	// Records are written while the response is sent, so errors past this
+	// point can only be logged
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := qranalytics.Export(context.Background(), w, format, filter); err != nil {
+			log.Printf("Analytics export failed: %v", err)
+		}
+		w.Flush()
+	})
+	return nil
+}
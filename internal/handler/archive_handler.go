@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"qr_backend/ent"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/database"
+
+	"github.com/gofiber/fiber/v2"
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// archivePageSize is the number of QR codes loaded per query while a ZIP
+// download is written
+const archivePageSize = 100
+
+// archiveOptions are the rendering options of a ZIP download
+type archiveOptions struct {
+	format  string
+	size    int
+	level   goqrcode.RecoveryLevel
+	baseURL string
+	barcode map[string]string // Barcode render options from the query
+}
+
+// DownloadQRCodesZip streams a ZIP of the images of every QR code matching
+// the list filters, with a manifest.csv mapping each file to its code.
+// Query: format (png or svg), size, level and the barcode download options.
+func DownloadQRCodesZip(c *fiber.Ctx) error {
+	filters, err := qrCodeFilters(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	options := archiveOptions{
+		format:  strings.ToLower(c.Query("format", "png")),
+		size:    c.QueryInt("size", 256),
+		baseURL: c.BaseURL(),
+	}
+	if options.format != "png" && options.format != "svg" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be png or svg"})
+	}
+	if options.size < 64 || options.size > 1024 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "size must be 64 to 1024"})
+	}
+	level, ok := parseRecoveryLevel(c.Query("level", "medium"))
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "level must be low, medium, high or highest"})
+	}
+	options.level = level
+
+	// Barcode options are checked now, while errors can still be returned
+	options.barcode = barcodeQuery(c)
+	if _, err := barcodeDownloadOptions(options.barcode, nil); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	count, err := database.DB.QRCode.Query().Where(filters...).Count(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to count QR codes"})
+	}
+	if count == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "No QR codes match the filters"})
+	}
+
+	c.Set("Content-Type", "application/zip")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"qr_codes_%s.zip\"", time.Now().UTC().Format("20060102")))
+
+	// Images are rendered while the response is sent, so errors past this
+	// point can only be logged
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := writeQRCodesZip(context.Background(), w, filters, options); err != nil {
+			log.Printf("QR code ZIP download failed: %v", err)
+		}
+		w.Flush()
+	})
+	return nil
+}
+
+// writeQRCodesZip writes the ZIP of the QR codes matching filters, one page
+// at a time. Codes that cannot be downloaded are listed in the manifest with
+// the reason instead of a file.
+func writeQRCodesZip(ctx context.Context, w *bufio.Writer, filters []predicate.QRCode, options archiveOptions) error {
+	zw := zip.NewWriter(w)
+	var manifest strings.Builder
+	mw := csv.NewWriter(&manifest)
+	mw.Write([]string{"filename", "id", "title", "short_url", "payload", "error"})
+
+	for offset := 0; ; offset += archivePageSize {
+		codes, err := database.DB.QRCode.Query().
+			Where(filters...).
+			Order(ent.Asc(qrcode.FieldID)).
+			Limit(archivePageSize).
+			Offset(offset).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, qr := range codes {
+			shortURL := ""
+			if qr.ShortURL != "" {
+				shortURL = fmt.Sprintf("%s/scan/%s", options.baseURL, qr.ShortURL)
+			}
+			filename, payload, err := writeArchiveImage(zw, qr, options)
+			if err != nil {
+				mw.Write([]string{"", strconv.Itoa(qr.ID), qr.Title, shortURL, payload, err.Error()})
+				continue
+			}
+			mw.Write([]string{filename, strconv.Itoa(qr.ID), qr.Title, shortURL, payload, ""})
+		}
+		// The response streams through w, so it is flushed after every page
+		if err := zw.Flush(); err != nil {
+			return err
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if len(codes) < archivePageSize {
+			break
+		}
+	}
+
+	mw.Flush()
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: "manifest.csv", Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write([]byte(manifest.String())); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// writeArchiveImage renders one QR code into the ZIP and returns its
+// filename and the payload it encodes. Barcodes are always PNG.
+func writeArchiveImage(zw *zip.Writer, qr *ent.QRCode, options archiveOptions) (string, string, error) {
+	symbology, payload, stored := barcodeContent(qr.Content)
+	ext := "png"
+	if !isBarcode(qr) {
+		payload, ext = qrData(qr, options.baseURL), options.format
+	}
+	if !qr.Active {
+		return "", payload, errors.New("QR code is inactive")
+	}
+	if cl := qrClosure(qr, time.Now()); cl != nil {
+		return "", payload, errors.New(cl.Message)
+	}
+
+	var imageData []byte
+	var err error
+	if isBarcode(qr) {
+		var barcodeOptions map[string]interface{}
+		if barcodeOptions, err = barcodeDownloadOptions(options.barcode, stored); err == nil {
+			imageData, _, err = generateBarcode(symbology, payload, barcodeOptions)
+		}
+	} else {
+		imageData, _, err = qrImage(payload, options.format, options.level, options.size)
+	}
+	if err != nil {
+		return "", payload, err
+	}
+
+	filename := fmt.Sprintf("%d_%s.%s", qr.ID, archiveSlug(qr.Title), ext)
+	// PNGs are already compressed
+	method := zip.Deflate
+	if ext == "png" {
+		method = zip.Store
+	}
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: filename, Method: method, Modified: qr.UpdatedAt})
+	if err != nil {
+		return "", payload, err
+	}
+	_, err = f.Write(imageData)
+	return filename, payload, err
+}
+
+// archiveSlug turns a title into a filename part of letters, digits and
+// dashes
+func archiveSlug(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+		if b.Len() >= 60 {
+			break
+		}
+	}
+	slug := strings.TrimRight(b.String(), "-")
+	if slug == "" {
+		return "qr"
+	}
+	return slug
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/pkg/schedule"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// closure is why a QR code cannot be used at some time
+type closure struct {
+	Message string
+	OpensAt *time.Time // When the code opens again, if it will
+}
+
+// qrClosure returns why a QR code cannot be used at now: it has not started
+// yet, has expired or is outside its opening hours. It returns nil when the
+// code is open. Inactive codes are checked separately, as a deactivated code
+// has no fallback.
+func qrClosure(qr *ent.QRCode, now time.Time) *closure {
+	if qr.ExpiresAt != nil && qr.ExpiresAt.Before(now) {
+		return &closure{Message: "QR code has expired"}
+	}
+
+	var opens time.Time
+	msg := ""
+	switch {
+	case qr.StartsAt != nil && qr.StartsAt.After(now):
+		msg, opens = "QR code is not active yet", *qr.StartsAt
+		if qr.Schedule != nil && !qr.Schedule.Open(opens) {
+			opens, _ = qr.Schedule.Next(opens)
+		}
+	case qr.Schedule != nil && !qr.Schedule.Open(now):
+		msg = "QR code is outside its opening hours"
+		opens, _ = qr.Schedule.Next(now)
+	default:
+		return nil
+	}
+
+	c := &closure{Message: msg}
+	if !opens.IsZero() && (qr.ExpiresAt == nil || opens.Before(*qr.ExpiresAt)) {
+		c.OpensAt = &opens
+	}
+	return c
+}
+
+// closedError responds to a request for a closed QR code with an error
+func closedError(c *fiber.Ctx, cl *closure) error {
+	resp := fiber.Map{"error": cl.Message}
+	if cl.OpensAt != nil {
+		resp["opens_at"] = cl.OpensAt
+	}
+	return c.Status(fiber.StatusForbidden).JSON(resp)
+}
+
+// closedScan sends a scan of a closed QR code to its fallback URL, or shows
+// its fallback message. Codes without a fallback respond with an error.
+// Scans sent to a fallback are not counted.
+func closedScan(c *fiber.Ctx, qr *ent.QRCode, cl *closure) error {
+	if qr.FallbackURL != "" {
+		return c.Redirect(qr.FallbackURL, fiber.StatusFound)
+	}
+	if qr.FallbackMessage == "" {
+		return closedError(c, cl)
+	}
+	opensAt := ""
+	if cl.OpensAt != nil {
+		loc := time.UTC
+		if qr.Schedule != nil {
+			if l, err := qr.Schedule.Location(); err == nil {
+				loc = l
+			}
+		}
+		opensAt = cl.OpensAt.In(loc).Format("Mon 2 Jan 15:04 MST")
+	}
+	return c.Status(fiber.StatusForbidden).Render("closed", fiber.Map{
+		"Title":   qr.Title,
+		"Message": qr.FallbackMessage,
+		"OpensAt": opensAt,
+	})
+}
+
+// availabilityRequest holds the request fields that control when a QR code
+// can be used
+type availabilityRequest struct {
+	StartsAt        *time.Time         `json:"starts_at,omitempty"`
+	ExpiresAt       *time.Time         `json:"expires_at,omitempty"`
+	Schedule        *schedule.Schedule `json:"schedule,omitempty"`
+	FallbackURL     string             `json:"fallback_url,omitempty"`
+	FallbackMessage string             `json:"fallback_message,omitempty"`
+}
+
+// validate checks the time range, schedule and fallback URL
+func (r *availabilityRequest) validate() error {
+	if r.StartsAt != nil && r.ExpiresAt != nil && !r.StartsAt.Before(*r.ExpiresAt) {
+		return errors.New("starts_at must be before expires_at")
+	}
+	if r.Schedule != nil {
+		if err := r.Schedule.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.FallbackURL != "" {
+		u, err := url.Parse(r.FallbackURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("fallback_url must be an absolute http or https URL")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/ent/schema"
+	"qr_backend/internal/database"
+	"qr_backend/internal/malware"
+	"qr_backend/internal/storage"
+	"qr_backend/internal/upload"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// createFileReference records a stored upload in the database
+func createFileReference(ctx context.Context, stored *upload.File) (*ent.FileReference, error) {
+	return saveFileReference(ctx, stored, newFileReference(stored))
+}
+
+// saveFileReference saves the prepared file reference of a stored upload,
+// which moves its bytes from its quota reservation to the recorded files,
+// and hands quarantined files to the malware scanner
+func saveFileReference(ctx context.Context, stored *upload.File, create *ent.FileReferenceCreate) (*ent.FileReference, error) {
+	var ref *ent.FileReference
+	err := stored.Commit(func() (err error) {
+		ref, err = create.Save(ctx)
+		return err
+	})
+	if err == nil && ref.ScanStatus == filereference.ScanStatusPending {
+		malware.Wake()
+	}
+	return ref, err
+}
+
+// newFileReference prepares the database record of a stored upload. With a
+// malware scanner configured, the file is quarantined until it is scanned.
+func newFileReference(stored *upload.File) *ent.FileReferenceCreate {
+	create := database.DB.FileReference.Create().
+		SetFilename(stored.Name).
+		SetURL(stored.URL).
+		SetSize(stored.Size).
+		SetType(stored.ContentType).
+		SetBackend(stored.Backend).
+		SetKey(stored.Key).
+		SetChecksum(stored.Checksum).
+		SetOwner(stored.Owner)
+	if stored.Width > 0 {
+		create.SetWidth(stored.Width).SetHeight(stored.Height)
+	}
+	if len(stored.Variants) > 0 {
+		create.SetVariants(stored.Variants).SetVariantsSize(stored.VariantsSize())
+	}
+	if stored.PDF != nil {
+		create.SetPdf(stored.PDF)
+	}
+	if malware.Enabled() {
+		create.SetScanStatus(filereference.ScanStatusPending)
+	}
+	return create
+}
+
+// findStoredFile resolves an object key to its file reference, content type
+// and size. Image variant keys ("<checksum>-<name>.<format>") resolve
+// through the file reference of their original.
+func findStoredFile(ctx context.Context, key string) (*ent.FileReference, string, int64, error) {
+	ref, err := database.DB.FileReference.Query().
+		Where(filereference.Key(key)).
+		Order(ent.Desc(filereference.FieldID)).
+		First(ctx)
+	if err == nil {
+		return ref, ref.Type, ref.Size, nil
+	}
+	checksum, _, isVariant := strings.Cut(key, "-")
+	if !ent.IsNotFound(err) || !isVariant {
+		return nil, "", 0, err
+	}
+
+	ref, err = database.DB.FileReference.Query().
+		Where(filereference.Checksum(checksum)).
+		Order(ent.Desc(filereference.FieldID)).
+		First(ctx)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	for _, v := range ref.Variants {
+		if v.Key == key {
+			return ref, v.Type, v.Size, nil
+		}
+	}
+	return nil, "", 0, &ent.NotFoundError{}
+}
+
+// quarantined returns the status and message refusing a file that has not
+// passed the malware scan, or 0 when the file may be served
+func quarantined(ref *ent.FileReference) (int, string) {
+	switch ref.ScanStatus {
+	case filereference.ScanStatusPending:
+		return fiber.StatusServiceUnavailable, "This file is still being checked for malware. Please try again shortly."
+	case filereference.ScanStatusInfected:
+		return fiber.StatusForbidden, "This file was blocked because it failed the malware scan."
+	case filereference.ScanStatusFailed:
+		return fiber.StatusServiceUnavailable, "This file could not be checked for malware and is unavailable."
+	}
+	return 0, ""
+}
+
+// ServeFile downloads a stored file by key, either by redirecting to a
+// presigned backend URL or by streaming it through the server
+func ServeFile(c *fiber.Ctx) error {
+	key := c.Params("key")
+
+	ref, contentType, size, err := findStoredFile(context.Background(), key)
+	if ent.IsNotFound(err) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "File not found"})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to fetch file"})
+	}
+	if status, msg := quarantined(ref); status != 0 {
+		if status == fiber.StatusServiceUnavailable {
+			c.Set(fiber.HeaderRetryAfter, "30")
+		}
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	protected, locked, err := fileAccess(c, ref)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to fetch file"})
+	}
+	if locked {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "This file is password protected"})
+	}
+
+	backend, err := storage.Backend(ref.Backend)
+	if err != nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if storage.Redirect() {
+		signed, err := backend.SignedURL(c.Context(), key, storage.URLExpiry())
+		if err == nil {
+			return c.Redirect(signed, fiber.StatusFound)
+		}
+		if !errors.Is(err, storage.ErrNotSupported) {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to sign file URL"})
+		}
+	}
+
+	body, err := backend.Get(context.Background(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "File not found"})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": "Failed to read file"})
+	}
+
+	// Content is immutable under its hash key
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": ref.Filename}))
+	if contentType == upload.TypeSVG {
+		sandboxSVG(c, ref.Filename)
+	}
+	if protected {
+		c.Set(fiber.HeaderCacheControl, "private, no-store")
+	} else {
+		c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	}
+	c.Set(fiber.HeaderETag, fmt.Sprintf("%q", key))
+	c.Set("X-Content-Type-Options", "nosniff")
+	return c.SendStream(body, int(size))
+}
+
+// GuardUploads keeps quarantined files from being downloaded through the
+// static /uploads route, which serves the local storage directory directly
+func GuardUploads(c *fiber.Ctx) error {
+	name := path.Base(c.Path())
+	ref, _, _, err := findStoredFile(context.Background(), name)
+	if ent.IsNotFound(err) {
+		ref, err = database.DB.FileReference.Query().
+			Where(filereference.URL("/uploads/" + name)).
+			First(context.Background())
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to fetch file"})
+	}
+	if ref != nil {
+		if status, msg := quarantined(ref); status != 0 {
+			return c.Status(status).JSON(fiber.Map{"error": msg})
+		}
+		_, locked, err := fileAccess(c, ref)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to fetch file"})
+		}
+		if locked {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "This file is password protected"})
+		}
+	}
+	if (ref != nil && ref.Type == upload.TypeSVG) || strings.EqualFold(path.Ext(name), ".svg") {
+		filename := name
+		if ref != nil {
+			filename = ref.Filename
+		}
+		sandboxSVG(c, filename)
+	}
+	return c.Next()
+}
+
+// sandboxSVG keeps an SVG served from the app's origin from running script:
+// the browser downloads it instead of rendering it, and renders it in a
+// sandbox without script or requests if it is opened anyway
+func sandboxSVG(c *fiber.Ctx, filename string) {
+	c.Set(fiber.HeaderContentSecurityPolicy, "sandbox; default-src 'none'; style-src 'unsafe-inline'")
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	c.Set("X-Content-Type-Options", "nosniff")
+}
+
+// fileUnavailable renders the landing page shown instead of a quarantined file
+func fileUnavailable(c *fiber.Ctx, status int, msg string) error {
+	return c.Status(status).Render("file_unavailable", fiber.Map{
+		"Title":   "File Unavailable",
+		"Message": msg,
+	})
+}
+
+// imageSource is one <source> of a responsive <picture>
+type imageSource struct {
+	Type   string
+	Srcset string
+}
+
+// contentFileRef returns the file reference linked from a QR code's content
+func contentFileRef(qr *ent.QRCode) *ent.FileReference {
+	id, ok := qr.Content["file_ref_id"].(float64)
+	if !ok {
+		return nil
+	}
+	ref, err := database.DB.FileReference.Get(context.Background(), int(id))
+	if err != nil {
+		return nil
+	}
+	return ref
+}
+
+// imageSources groups image variants into one srcset per format, modern
+// formats first so browsers pick the smallest file they support. It also
+// returns the URL of the medium (or largest stored) variant in the source
+// format, for browsers without srcset support.
+func imageSources(variants []schema.ImageVariant) (string, []imageSource) {
+	order := []string{"image/avif", "image/webp"}
+	sets := map[string][]string{}
+	var fallback, preview string
+	for _, v := range variants {
+		sets[v.Type] = append(sets[v.Type], fmt.Sprintf("%s %dw", v.URL, v.Width))
+		if v.Format == variants[0].Format {
+			if fallback == "" {
+				fallback = v.Type
+			}
+			if preview == "" || v.Name == "medium" {
+				preview = v.URL
+			}
+		}
+	}
+
+	var sources []imageSource
+	for _, t := range append(order, fallback) {
+		if set, ok := sets[t]; ok {
+			sources = append(sources, imageSource{Type: t, Srcset: strings.Join(set, ", ")})
+		}
+	}
+	return preview, sources
+}
+
+// pdfContent builds the content of a PDF QR code for a stored file
+func pdfContent(ref *ent.FileReference) map[string]interface{} {
+	content := map[string]interface{}{
+		"type":        "pdf",
+		"url":         ref.URL,
+		"filename":    ref.Filename,
+		"file_size":   ref.Size,
+		"file_ref_id": ref.ID,
+	}
+	if ref.Version > 0 {
+		content["file_version"] = ref.Version
+	}
+	if ref.Pdf != nil {
+		content["page_count"] = ref.Pdf.Pages
+		if ref.Pdf.Title != "" {
+			content["document_title"] = ref.Pdf.Title
+		}
+		if ref.Pdf.Author != "" {
+			content["author"] = ref.Pdf.Author
+		}
+	}
+	if len(ref.Variants) > 0 {
+		content["thumbnail_url"] = ref.Variants[0].URL
+	}
+	return content
+}
+
+// imageContent builds the content of an image QR code for a stored file
+func imageContent(ref *ent.FileReference) map[string]interface{} {
+	content := map[string]interface{}{
+		"type":        "image",
+		"url":         ref.URL,
+		"filename":    ref.Filename,
+		"file_ref_id": ref.ID,
+	}
+	if ref.Version > 0 {
+		content["file_version"] = ref.Version
+	}
+	return content
+}
+
+// formatSize formats a byte count for display
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.0f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d bytes", size)
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/internal/storage"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestQuarantinedFilesAreRefused checks that /files and /uploads refuse a
+// file until the malware scan marks it clean
+func TestQuarantinedFilesAreRefused(t *testing.T) {
+	dir := t.TempDir()
+	uploads := filepath.Join(dir, "uploads")
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(dir, "test.db")},
+		Upload:   config.UploadConfig{Path: uploads},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+	if err := storage.Init(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	const key, content = "0123abcd.txt", "file content"
+	if err := os.MkdirAll(uploads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(uploads, key), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ref, err := database.DB.FileReference.Create().
+		SetFilename("notes.txt").
+		SetURL("/uploads/" + key).
+		SetSize(int64(len(content))).
+		SetType("text/plain").
+		SetKey(key).
+		SetScanStatus(filereference.ScanStatusPending).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Get("/files/:key", ServeFile)
+	app.Use("/uploads", GuardUploads)
+	app.Static("/uploads", uploads)
+
+	tests := []struct {
+		status filereference.ScanStatus
+		want   int
+	}{
+		{filereference.ScanStatusPending, fiber.StatusServiceUnavailable},
+		{filereference.ScanStatusInfected, fiber.StatusForbidden},
+		{filereference.ScanStatusFailed, fiber.StatusServiceUnavailable},
+		{filereference.ScanStatusClean, fiber.StatusOK},
+	}
+	for _, tt := range tests {
+		if err := ref.Update().SetScanStatus(tt.status).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+		for _, url := range []string{"/files/" + key, "/uploads/" + key} {
+			resp, err := app.Test(httptest.NewRequest("GET", url, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != tt.want {
+				t.Errorf("%s while %s: status %d, want %d", url, tt.status, resp.StatusCode, tt.want)
+			}
+			if tt.want == fiber.StatusOK && string(body) != content {
+				t.Errorf("%s while %s: body %q, want %q", url, tt.status, body, content)
+			}
+			if tt.want != fiber.StatusOK && string(body) == content {
+				t.Errorf("%s while %s: served the file content", url, tt.status)
+			}
+		}
+	}
+}
+
+// TestSVGIsServedSandboxed checks that SVGs are downloaded rather than
+// rendered from the app's origin, and sandboxed if rendered anyway
+func TestSVGIsServedSandboxed(t *testing.T) {
+	dir := t.TempDir()
+	uploads := filepath.Join(dir, "uploads")
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(dir, "test.db")},
+		Upload:   config.UploadConfig{Path: uploads},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+	if err := storage.Init(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	const key, legacy, content = "0123abcd.svg", "logo.svg", `<svg xmlns="http://www.w3.org/2000/svg"/>`
+	if err := os.MkdirAll(uploads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{key, legacy} {
+		if err := os.WriteFile(filepath.Join(uploads, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := database.DB.FileReference.Create().
+		SetFilename("drawing.svg").
+		SetURL("/uploads/" + key).
+		SetSize(int64(len(content))).
+		SetType("image/svg+xml").
+		SetKey(key).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Get("/files/:key", ServeFile)
+	app.Use("/uploads", GuardUploads)
+	app.Static("/uploads", uploads)
+
+	for _, url := range []string{"/files/" + key, "/uploads/" + key, "/uploads/" + legacy} {
+		resp, err := app.Test(httptest.NewRequest("GET", url, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != fiber.StatusOK {
+			t.Fatalf("%s: status %d, want 200", url, resp.StatusCode)
+		}
+		if csp := resp.Header.Get(fiber.HeaderContentSecurityPolicy); csp != "sandbox; default-src 'none'; style-src 'unsafe-inline'" {
+			t.Errorf("%s: Content-Security-Policy %q", url, csp)
+		}
+		if disposition := resp.Header.Get(fiber.HeaderContentDisposition); !strings.HasPrefix(disposition, "attachment") {
+			t.Errorf("%s: Content-Disposition %q, want attachment", url, disposition)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"sync"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/database"
+	"qr_backend/internal/upload"
+	"qr_backend/internal/webhook"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// fileVersionMu serialises file replacements so version numbers stay unique
+var fileVersionMu sync.Mutex
+
+// findFileQRCode loads a PDF or image QR code whose file can be replaced.
+// Codes without a short URL encode the file URL itself, so a new file
+// would not reach already printed codes.
+func findFileQRCode(c *fiber.Ctx) (*ent.QRCode, int, string) {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return nil, fiber.StatusBadRequest, "Invalid QR code ID"
+	}
+	qr, err := database.DB.QRCode.Get(context.Background(), id)
+	if ent.IsNotFound(err) {
+		return nil, fiber.StatusNotFound, "QR code not found"
+	}
+	if err != nil {
+		return nil, fiber.StatusInternalServerError, "Failed to retrieve QR code"
+	}
+	if kind := qr.Content["type"]; kind != "pdf" && kind != "image" {
+		return nil, fiber.StatusBadRequest, "Only PDF and image QR codes have a replaceable file"
+	}
+	if qr.ShortURL == "" {
+		return nil, fiber.StatusBadRequest, "QR code has no short URL; its printed code points at the file directly"
+	}
+	return qr, 0, ""
+}
+
+// fileVersions returns the file history of a QR code, newest first
+func fileVersions(ctx context.Context, qr *ent.QRCode) ([]*ent.FileReference, error) {
+	return qr.QueryFileRefs().
+		Where(filereference.VersionGT(0)).
+		Order(ent.Desc(filereference.FieldVersion)).
+		All(ctx)
+}
+
+// setCurrentFile points a QR code's content, and its redirect URL if it has
+// one, at the given file version
+func setCurrentFile(ctx context.Context, qr *ent.QRCode, ref *ent.FileReference) (*ent.QRCode, error) {
+	content := imageContent(ref)
+	if qr.Content["type"] == "pdf" {
+		content = pdfContent(ref)
+	}
+	update := qr.Update().SetContent(content)
+	if qr.RedirectURL != "" {
+		update.SetRedirectURL(ref.URL)
+	}
+	return update.Save(ctx)
+}
+
+// currentFileVersion returns the version the QR code currently serves
+func currentFileVersion(qr *ent.QRCode) int {
+	version, _ := qr.Content["file_version"].(float64)
+	return int(version)
+}
+
+// ReplaceQRCodeFile uploads a new version of the file behind a PDF or image
+// QR code. Earlier versions are kept for rollback and the short URL stays
+// the same, so printed codes open the new file.
+func ReplaceQRCodeFile(c *fiber.Ctx) error {
+	ctx := context.Background()
+	qr, status, msg := findFileQRCode(c)
+	if qr == nil {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "No file uploaded"})
+	}
+	types := upload.ImageTypes
+	if qr.Content["type"] == "pdf" {
+		types = []string{upload.TypePDF}
+	}
+	stored, err := upload.Save(file, storageOwner(c), types...)
+	if err != nil {
+		return uploadError(c, err)
+	}
+	defer stored.Release()
+
+	fileVersionMu.Lock()
+	defer fileVersionMu.Unlock()
+
+	// Codes created before file versioning have an unnumbered first file
+	if current := contentFileRef(qr); current != nil && current.Version == 0 {
+		if _, err := current.Update().SetVersion(1).Save(ctx); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update file history"})
+		}
+	}
+	versions, err := fileVersions(ctx, qr)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load file history"})
+	}
+	next := 1
+	if len(versions) > 0 {
+		next = versions[0].Version + 1
+	}
+
+	fileRef, err := saveFileReference(ctx, stored, newFileReference(stored).
+		SetVersion(next).
+		SetQrCode(qr))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file reference"})
+	}
+
+	qr, err = setCurrentFile(ctx, qr, fileRef)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update QR code"})
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+
+	return c.JSON(fiber.Map{
+		"qr_code":        qr,
+		"file_reference": fileRef,
+		"version":        fileRef.Version,
+		"message":        "File replaced successfully",
+	})
+}
+
+// ListQRCodeFileVersions returns the file history of a PDF or image QR code
+func ListQRCodeFileVersions(c *fiber.Ctx) error {
+	ctx := context.Background()
+	qr, status, msg := findFileQRCode(c)
+	if qr == nil {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	versions, err := fileVersions(ctx, qr)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load file history"})
+	}
+	return c.JSON(fiber.Map{
+		"current_version": currentFileVersion(qr),
+		"versions":        versions,
+	})
+}
+
+// RollbackQRCodeFile points a PDF or image QR code back at an earlier file
+// version. Without a version in the body, the version before the current
+// one is restored.
+func RollbackQRCodeFile(c *fiber.Ctx) error {
+	var req struct {
+		Version int `json:"version"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+		}
+	}
+
+	ctx := context.Background()
+	qr, status, msg := findFileQRCode(c)
+	if qr == nil {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	fileVersionMu.Lock()
+	defer fileVersionMu.Unlock()
+
+	versions, err := fileVersions(ctx, qr)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to load file history"})
+	}
+	current := currentFileVersion(qr)
+
+	var target *ent.FileReference
+	for _, v := range versions {
+		if req.Version > 0 && v.Version == req.Version {
+			target = v
+			break
+		}
+		if req.Version == 0 && v.Version < current {
+			target = v
+			break
+		}
+	}
+	if target == nil {
+		if req.Version == 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "No earlier file version to roll back to"})
+		}
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "File version not found"})
+	}
+	if target.Version == current {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "File version is already current"})
+	}
+
+	qr, err = setCurrentFile(ctx, qr, target)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update QR code"})
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+
+	return c.JSON(fiber.Map{
+		"qr_code":        qr,
+		"file_reference": target,
+		"version":        target.Version,
+		"message":        "File rolled back successfully",
+	})
+}
@@ -0,0 +1,476 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"strings"
+	"sync"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/database"
+	"qr_backend/internal/model"
+	"qr_backend/internal/upload"
+	"qr_backend/internal/webhook"
+	"qr_backend/pkg/shorturl"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxGalleryImages caps the number of images in one gallery
+const maxGalleryImages = 50
+
+// maxCaptionLength caps the length of an image caption in characters
+const maxCaptionLength = 500
+
+// galleryLocks serializes edits to the content of each gallery, which is
+// read, modified and written back as a whole. Edits to different galleries
+// run side by side.
+var galleryLocks = struct {
+	sync.Mutex
+	byID map[int]*galleryLock
+}{byID: map[int]*galleryLock{}}
+
+// galleryLock is the lock of one gallery, shared by the edits waiting on it
+type galleryLock struct {
+	sync.Mutex
+	waiters int
+}
+
+// lockGallery locks the gallery named by the :id parameter and returns the
+// function unlocking it. An invalid ID locks nothing; findGallery rejects it.
+func lockGallery(c *fiber.Ctx) func() {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return func() {}
+	}
+
+	galleryLocks.Lock()
+	lock := galleryLocks.byID[id]
+	if lock == nil {
+		lock = &galleryLock{}
+		galleryLocks.byID[id] = lock
+	}
+	lock.waiters++
+	galleryLocks.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		galleryLocks.Lock()
+		if lock.waiters--; lock.waiters == 0 {
+			delete(galleryLocks.byID, id)
+		}
+		galleryLocks.Unlock()
+	}
+}
+
+// CreateGalleryQRCode creates an image gallery QR code from one or more
+// uploaded images. Files are sent as repeated "files" fields; optional
+// "captions" fields are matched to the files by position.
+func CreateGalleryQRCode(c *fiber.Ctx) error {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Failed to parse multipart form"})
+	}
+
+	files := form.File["files"]
+	if len(files) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "At least one image is required"})
+	}
+	if len(files) > maxGalleryImages {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": fmt.Sprintf("A gallery can hold at most %d images", maxGalleryImages)})
+	}
+
+	images, fileRefs, status, msg := saveGalleryImages(files, form.Value["captions"], storageOwner(c))
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	title := c.FormValue("title")
+	if title == "" {
+		title = "Image Gallery"
+	}
+	description := c.FormValue("description")
+
+	shortURL, err := shorturl.Generate()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate short URL"})
+	}
+
+	qrBuilder := database.DB.QRCode.Create().
+		SetType(string(model.QRTypeImages)).
+		SetTitle(title).
+		SetContent(galleryContent(shortURL, images)).
+		SetShortURL(shortURL).
+		SetAnalytics(true).
+		SetActive(true).
+		AddFileRefs(fileRefs...)
+	if description != "" {
+		qrBuilder.SetDescription(description)
+	}
+
+	qr, err := qrBuilder.Save(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create gallery QR code"})
+	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"qr_code":   qr,
+		"images":    images,
+		"short_url": shortURL,
+		"scan_url":  fmt.Sprintf("http://localhost:3000/scan/%s", shortURL),
+		"message":   "Gallery QR code created successfully",
+	})
+}
+
+// AddGalleryImages appends uploaded images to a gallery, or inserts them
+// before the zero-based "position" form value
+func AddGalleryImages(c *fiber.Ctx) error {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Failed to parse multipart form"})
+	}
+	files := form.File["files"]
+	if len(files) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "At least one image is required"})
+	}
+
+	// The images are checked against the gallery before and after they are
+	// stored, so the gallery is only locked while its content is rewritten
+	_, images, status, msg := findGallery(c)
+	if status == 0 {
+		status, msg = checkGalleryAdd(c, images, len(files))
+	}
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	added, fileRefs, status, msg := saveGalleryImages(files, form.Value["captions"], storageOwner(c))
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	defer lockGallery(c)()
+
+	qr, images, status, msg := findGallery(c)
+	if status == 0 {
+		status, msg = checkGalleryAdd(c, images, len(files))
+	}
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	position := len(images)
+	if p := c.FormValue("position"); p != "" {
+		fmt.Sscan(p, &position)
+	}
+
+	updated := append(append(append([]model.GalleryImage{}, images[:position]...), added...), images[position:]...)
+	qr, err = qr.Update().
+		SetContent(galleryContent(qr.ShortURL, updated)).
+		AddFileRefs(fileRefs...).
+		Save(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update gallery"})
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"qr_code": qr, "images": updated})
+}
+
+// checkGalleryAdd checks that count more images fit in a gallery holding
+// images, at the "position" form value if one is given; on failure it
+// returns an HTTP status and message
+func checkGalleryAdd(c *fiber.Ctx, images []model.GalleryImage, count int) (int, string) {
+	if len(images)+count > maxGalleryImages {
+		return fiber.StatusBadRequest, fmt.Sprintf("A gallery can hold at most %d images", maxGalleryImages)
+	}
+	if p := c.FormValue("position"); p != "" {
+		var position int
+		if _, err := fmt.Sscan(p, &position); err != nil || position < 0 || position > len(images) {
+			return fiber.StatusBadRequest, "Invalid position"
+		}
+	}
+	return 0, ""
+}
+
+// ReorderGalleryImages sets the display order of a gallery's images. The
+// body lists every image's file reference ID in the new order.
+func ReorderGalleryImages(c *fiber.Ctx) error {
+	var req struct {
+		Order []int `json:"order"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+
+	defer lockGallery(c)()
+
+	qr, images, status, msg := findGallery(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	byID := make(map[int]model.GalleryImage, len(images))
+	for _, img := range images {
+		byID[img.FileRefID] = img
+	}
+	if len(req.Order) != len(images) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Order must list every image of the gallery exactly once"})
+	}
+	reordered := make([]model.GalleryImage, 0, len(images))
+	for _, id := range req.Order {
+		img, ok := byID[id]
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Order must list every image of the gallery exactly once"})
+		}
+		delete(byID, id)
+		reordered = append(reordered, img)
+	}
+
+	return saveGallery(c, qr, reordered)
+}
+
+// UpdateGalleryImage changes the caption of a gallery image
+func UpdateGalleryImage(c *fiber.Ctx) error {
+	var req struct {
+		Caption string `json:"caption"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+	caption, ok := cleanCaption(req.Caption)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": fmt.Sprintf("Caption must be at most %d characters", maxCaptionLength)})
+	}
+
+	defer lockGallery(c)()
+
+	qr, images, status, msg := findGallery(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	i := galleryIndex(c, images)
+	if i < 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Image not found in gallery"})
+	}
+	images[i].Caption = caption
+
+	return saveGallery(c, qr, images)
+}
+
+// DeleteGalleryImage removes an image from a gallery. The file reference is
+// detached from the QR code but kept, so older links to the file keep working.
+func DeleteGalleryImage(c *fiber.Ctx) error {
+	defer lockGallery(c)()
+
+	qr, images, status, msg := findGallery(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	i := galleryIndex(c, images)
+	if i < 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Image not found in gallery"})
+	}
+	fileRefID := images[i].FileRefID
+	images = append(images[:i], images[i+1:]...)
+
+	qr, err := qr.Update().
+		SetContent(galleryContent(qr.ShortURL, images)).
+		RemoveFileRefIDs(fileRefID).
+		Save(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update gallery"})
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+
+	return c.JSON(fiber.Map{"qr_code": qr, "images": images})
+}
+
+// saveGalleryImages stores uploaded images and creates their file
+// references; on failure it returns an HTTP status and message
+func saveGalleryImages(files []*multipart.FileHeader, captions []string, owner string) ([]model.GalleryImage, []*ent.FileReference, int, string) {
+	images := make([]model.GalleryImage, 0, len(files))
+	fileRefs := make([]*ent.FileReference, 0, len(files))
+
+	for i, file := range files {
+		var caption string
+		if i < len(captions) {
+			var ok bool
+			if caption, ok = cleanCaption(captions[i]); !ok {
+				return nil, nil, fiber.StatusBadRequest, fmt.Sprintf("Caption must be at most %d characters", maxCaptionLength)
+			}
+		}
+
+		stored, err := upload.Save(file, owner, upload.ImageTypes...)
+		if err != nil {
+			status, msg := uploadErrorStatus(err)
+			return nil, nil, status, msg
+		}
+		defer stored.Release()
+		fileRef, err := createFileReference(context.Background(), stored)
+		if err != nil {
+			return nil, nil, fiber.StatusInternalServerError, "Failed to save file reference"
+		}
+
+		image := model.GalleryImage{
+			FileRefID: fileRef.ID,
+			URL:       stored.URL,
+			Filename:  stored.Name,
+			Caption:   caption,
+			Width:     stored.Width,
+			Height:    stored.Height,
+		}
+		for _, v := range stored.Variants {
+			if v.Name == "thumbnail" && v.Format == stored.Variants[0].Format {
+				image.ThumbnailURL = v.URL
+			}
+		}
+		images = append(images, image)
+		fileRefs = append(fileRefs, fileRef)
+	}
+	return images, fileRefs, 0, ""
+}
+
+// findGallery loads the gallery QR code named by the :id parameter; on
+// failure it returns an HTTP status and message
+func findGallery(c *fiber.Ctx) (*ent.QRCode, []model.GalleryImage, int, string) {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return nil, nil, fiber.StatusBadRequest, "Invalid QR code ID"
+	}
+
+	qr, err := database.DB.QRCode.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fiber.StatusNotFound, "QR code not found"
+		}
+		return nil, nil, fiber.StatusInternalServerError, "Failed to retrieve QR code"
+	}
+	if qr.Type != string(model.QRTypeImages) {
+		return nil, nil, fiber.StatusBadRequest, "QR code is not an image gallery"
+	}
+
+	return qr, galleryImages(qr), 0, ""
+}
+
+// galleryImages decodes the ordered images stored in a gallery's content
+func galleryImages(qr *ent.QRCode) []model.GalleryImage {
+	var content model.ImagesContent
+	raw, err := json.Marshal(qr.Content)
+	if err != nil || json.Unmarshal(raw, &content) != nil {
+		return nil
+	}
+	return content.Images
+}
+
+// galleryContent builds gallery content, keeping image_urls in sync with
+// the ordered images
+func galleryContent(shortURL string, images []model.GalleryImage) map[string]interface{} {
+	urls := make([]string, len(images))
+	for i, img := range images {
+		urls[i] = img.URL
+	}
+	return map[string]interface{}{
+		"type":        string(model.QRTypeImages),
+		"gallery_url": "/scan/" + shortURL,
+		"image_urls":  urls,
+		"images":      images,
+	}
+}
+
+// saveGallery writes reordered or edited images back to the QR code
+func saveGallery(c *fiber.Ctx, qr *ent.QRCode, images []model.GalleryImage) error {
+	qr, err := qr.Update().
+		SetContent(galleryContent(qr.ShortURL, images)).
+		Save(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update gallery"})
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+
+	return c.JSON(fiber.Map{"qr_code": qr, "images": images})
+}
+
+// galleryIndex returns the position of the image named by the :fileId
+// parameter, or -1
+func galleryIndex(c *fiber.Ctx, images []model.GalleryImage) int {
+	fileID, err := c.ParamsInt("fileId")
+	if err != nil {
+		return -1
+	}
+	for i, img := range images {
+		if img.FileRefID == fileID {
+			return i
+		}
+	}
+	return -1
+}
+
+// cleanCaption trims a caption and checks its length
+func cleanCaption(caption string) (string, bool) {
+	caption = strings.TrimSpace(caption)
+	return caption, len([]rune(caption)) <= maxCaptionLength
+}
+
+// gallerySlide is one image of the gallery landing page
+type gallerySlide struct {
+	Number     int // One-based position, for labels
+	URL        string
+	PreviewURL string
+	Sources    []imageSource
+	Caption    string
+	Filename   string
+	Width      int
+	Height     int
+}
+
+// galleryPage builds the template data of the gallery landing page
+func galleryPage(qr *ent.QRCode) fiber.Map {
+	images := galleryImages(qr)
+
+	ids := make([]int, len(images))
+	for i, img := range images {
+		ids[i] = img.FileRefID
+	}
+	refs, _ := database.DB.FileReference.Query().
+		Where(filereference.IDIn(ids...)).
+		All(context.Background())
+	byID := make(map[int]*ent.FileReference, len(refs))
+	for _, ref := range refs {
+		byID[ref.ID] = ref
+	}
+
+	// Images that have not passed the malware scan are left out
+	slides := make([]gallerySlide, 0, len(images))
+	for _, img := range images {
+		ref := byID[img.FileRefID]
+		if ref != nil {
+			if status, _ := quarantined(ref); status != 0 {
+				continue
+			}
+		}
+		slide := gallerySlide{
+			Number:     len(slides) + 1,
+			URL:        img.URL,
+			PreviewURL: img.URL,
+			Caption:    img.Caption,
+			Filename:   img.Filename,
+			Width:      img.Width,
+			Height:     img.Height,
+		}
+		if ref != nil && len(ref.Variants) > 0 {
+			slide.PreviewURL, slide.Sources = imageSources(ref.Variants)
+		}
+		slides = append(slides, slide)
+	}
+
+	return fiber.Map{
+		"Title":       qr.Title,
+		"Description": qr.Description,
+		"Slides":      slides,
+	}
+}
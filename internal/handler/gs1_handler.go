@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	qranalytics "qr_backend/internal/analytics"
+	"qr_backend/internal/database"
+	"qr_backend/internal/model"
+	"qr_backend/internal/scanlimit"
+	"qr_backend/internal/webhook"
+	"qr_backend/pkg/barcode"
+	"qr_backend/pkg/gs1"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GS1 product code formats
+const (
+	gs1FormatQR         = "qr"         // GS1 Digital Link URI in a QR code
+	gs1FormatDataMatrix = "datamatrix" // Element string in a GS1 DataMatrix
+)
+
+// CreateGS1QRCode creates a product code from GS1 application identifiers,
+// given as an element string or as GTIN, batch, expiry and serial. Digital
+// Link URIs point at this server unless base_url names another resolver.
+func CreateGS1QRCode(c *fiber.Ctx) error {
+	var req struct {
+		Title         string           `json:"title"`
+		Description   string           `json:"description,omitempty"`
+		ElementString string           `json:"element_string,omitempty"` // e.g. (01)09501101530003(10)AB12
+		GTIN          string           `json:"gtin,omitempty"`           // GTIN-8, -12, -13 or -14
+		Batch         string           `json:"batch,omitempty"`          // AI (10)
+		Expiry        string           `json:"expiry,omitempty"`         // AI (17), YYMMDD or YYYY-MM-DD
+		Serial        string           `json:"serial,omitempty"`         // AI (21)
+		Format        string           `json:"format,omitempty"`         // qr (default) or datamatrix
+		BaseURL       string           `json:"base_url,omitempty"`       // Digital Link domain
+		Product       model.GS1Product `json:"product"`
+		Analytics     bool             `json:"analytics"`
+
+		// GS1 DataMatrix rendering
+		ModuleSize int     `json:"module_size,omitempty"`
+		WidthMM    float64 `json:"width_mm,omitempty"`
+		QuietZone  int     `json:"quiet_zone,omitempty"`
+		Size       string  `json:"size,omitempty"`
+		DPI        int     `json:"dpi,omitempty"`
+	}
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+
+	elements, err := gs1Elements(req.ElementString, req.GTIN, req.Batch, req.Expiry, req.Serial)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	req.Format = strings.ToLower(strings.TrimSpace(req.Format))
+	if req.Format == "" {
+		req.Format = gs1FormatQR
+	}
+	if req.Format != gs1FormatQR && req.Format != gs1FormatDataMatrix {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be qr or datamatrix"})
+	}
+
+	base := strings.TrimRight(req.BaseURL, "/")
+	if base == "" {
+		base = c.BaseURL()
+	} else if u, err := url.Parse(base); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "base_url must be an http or https URL"})
+	}
+	for _, u := range []string{req.Product.ImageURL, req.Product.URL} {
+		if parsed, err := url.Parse(u); u != "" && (err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https")) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Product image_url and url must be http or https URLs"})
+		}
+	}
+	link, err := gs1.DigitalLink(base, elements)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	// The resolver finds codes by their Digital Link path, which must be
+	// unambiguous
+	key := gs1.Path(elements)
+	exists, err := database.DB.QRCode.Query().Where(qrcode.Gs1KeyEQ(key)).Exist(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to check existing GS1 codes"})
+	}
+	if exists {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": "A product code for " + key + " already exists"})
+	}
+
+	content := map[string]interface{}{
+		"type":           string(model.QRTypeGS1),
+		"format":         req.Format,
+		"element_string": elements.String(),
+		"elements":       elements,
+		"digital_link":   link,
+		"product":        req.Product,
+	}
+	if gtin := elements.Get("01"); gtin != "" {
+		content["gtin"] = gtin
+	}
+
+	// GS1 DataMatrix codes are rendered now to reject options the data does
+	// not fit, and regenerated on download
+	if req.Format == gs1FormatDataMatrix {
+		options := barcode.MatrixOptions{
+			ModuleSize: req.ModuleSize,
+			WidthMM:    req.WidthMM,
+			QuietZone:  req.QuietZone,
+			Size:       strings.ToLower(strings.TrimSpace(req.Size)),
+			DPI:        req.DPI,
+			GS1:        true,
+		}
+		_, size, err := generateBarcode(barcode.DataMatrix, elements.String(), options)
+		if errors.Is(err, barcode.ErrInvalidData) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate barcode: " + err.Error()})
+		}
+		content["options"] = options
+		content["size"] = size
+	}
+
+	if req.Title == "" {
+		req.Title = req.Product.Name
+	}
+	if req.Title == "" {
+		req.Title = "GS1 " + elements[0].AI + " " + elements[0].Value
+	}
+
+	qrBuilder := database.DB.QRCode.Create().
+		SetType(string(model.QRTypeGS1)).
+		SetTitle(req.Title).
+		SetContent(content).
+		SetGs1Key(key).
+		SetAnalytics(req.Analytics).
+		SetActive(true)
+	if req.Description != "" {
+		qrBuilder.SetDescription(req.Description)
+	}
+
+	qr, err := qrBuilder.Save(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create GS1 QR code"})
+	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"qr_code":        qr,
+		"element_string": elements.String(),
+		"digital_link":   link,
+		"download_url":   fmt.Sprintf("/api/qr/%d/download", qr.ID),
+		"message":        "GS1 QR code created successfully",
+	})
+}
+
+// gs1Elements builds an element string from its human readable form or from
+// the common product AIs, which are appended to it when both are given
+func gs1Elements(elementString, gtin, batch, expiry, serial string) (gs1.ElementString, error) {
+	var elements gs1.ElementString
+	if elementString != "" {
+		parsed, err := gs1.Parse(elementString)
+		if err != nil {
+			return nil, err
+		}
+		elements = parsed
+	}
+	if gtin != "" {
+		normalized, err := gs1.NormalizeGTIN(gtin)
+		if err != nil {
+			return nil, err
+		}
+		elements.Set("01", normalized)
+	}
+	if batch != "" {
+		elements.Set("10", batch)
+	}
+	if expiry != "" {
+		if t, err := time.Parse("2006-01-02", expiry); err == nil {
+			expiry = t.Format("060102")
+		}
+		elements.Set("17", expiry)
+	}
+	if serial != "" {
+		elements.Set("21", serial)
+	}
+	if len(elements) == 0 {
+		return nil, errors.New("element_string or gtin is required")
+	}
+	return elements, elements.Validate()
+}
+
+// ResolveDigitalLink resolves a GS1 Digital Link URI path to the product
+// page of the most specific matching code: a code for a GTIN and batch
+// wins over one for the GTIN alone. AIs in the path and query that the code
+// was not created with, such as a serial number, are shown on the page.
+func ResolveDigitalLink(c *fiber.Ctx) error {
+	qr, elements, status, msg := findDigitalLinkCode(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	if !qr.Active {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code is inactive"})
+	}
+	if cl := qrClosure(qr, time.Now()); cl != nil {
+		return closedScan(c, qr, cl)
+	}
+	if !unlocked(c, qr) {
+		return passwordChallenge(c, qr, fiber.StatusOK, "")
+	}
+	if scanlimit.Limited(qr) {
+		if ok, err := redeemScan(c, qr); !ok {
+			return err
+		}
+	}
+
+	webhook.Emit(webhook.EventScan, qr, map[string]interface{}{
+		"device":     qranalytics.DeviceClass(c.Get("User-Agent")),
+		"scanned_at": time.Now().UTC(),
+	})
+	if qr.Analytics {
+		qranalytics.Track(qranalytics.Scan{
+			QRCodeID:   qr.ID,
+			IP:         c.IP(),
+			UserAgent:  c.Get("User-Agent"),
+			DNT:        c.Get("DNT"),
+			GPC:        c.Get("Sec-GPC"),
+			CountsOnly: qr.AnalyticsCountsOnly,
+		})
+	}
+
+	return c.Render("product", productPage(qr, elements))
+}
+
+// UnlockDigitalLink checks the password posted from the challenge page of a
+// GS1 Digital Link URI
+func UnlockDigitalLink(c *fiber.Ctx) error {
+	qr, _, status, msg := findDigitalLinkCode(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	return unlock(c, qr)
+}
+
+// findDigitalLinkCode returns the most specific code for the Digital Link
+// URI of the request and the elements it carries; on failure it returns an
+// HTTP status and message
+func findDigitalLinkCode(c *fiber.Ctx) (*ent.QRCode, gs1.ElementString, int, string) {
+	elements, err := gs1.ParseDigitalLink(c.OriginalURL())
+	if err != nil {
+		return nil, nil, fiber.StatusBadRequest, err.Error()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	keys := gs1.Keys(elements)
+	codes, err := database.DB.QRCode.Query().Where(qrcode.Gs1KeyIn(keys...)).All(ctx)
+	if err != nil {
+		return nil, nil, fiber.StatusInternalServerError, "Failed to retrieve QR code"
+	}
+	for _, key := range keys {
+		for _, code := range codes {
+			if code.Gs1Key == key {
+				return code, elements, 0, ""
+			}
+		}
+	}
+	return nil, nil, fiber.StatusNotFound, "No product found for " + keys[len(keys)-1]
+}
+
+// gs1Attribute is an element shown on the product page
+type gs1Attribute struct {
+	AI    string
+	Title string
+	Value string
+}
+
+// productPage returns the product page data of a GS1 code scanned with
+// the given elements
+func productPage(qr *ent.QRCode, elements gs1.ElementString) fiber.Map {
+	product, _ := qr.Content["product"].(map[string]interface{})
+	name, _ := product["name"].(string)
+	if name == "" {
+		name = qr.Title
+	}
+
+	var attributes []gs1Attribute
+	expired := false
+	for _, el := range elements {
+		ai, _ := gs1.Lookup(el.AI)
+		value := el.Value
+		if ai.Date {
+			if t, err := gs1.ParseDate(el.Value); err == nil {
+				value = t.Format("2006-01-02")
+				if el.AI == "17" && t.AddDate(0, 0, 1).Before(time.Now()) {
+					expired = true
+				}
+			}
+		}
+		attributes = append(attributes, gs1Attribute{AI: el.AI, Title: ai.Title, Value: value})
+	}
+
+	return fiber.Map{
+		"Title":       name,
+		"Brand":       product["brand"],
+		"Description": product["description"],
+		"ImageURL":    product["image_url"],
+		"ProductURL":  product["url"],
+		"Attributes":  attributes,
+		"Expired":     expired,
+	}
+}
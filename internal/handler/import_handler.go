@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"qr_backend/internal/importer"
+	"qr_backend/internal/upload"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// importSyncRows is the largest import created within the request; larger
+// ones run in the background
+const importSyncRows = 100
+
+// ImportQRCodes creates QR codes from a CSV or NDJSON file, uploaded as
+// "file" or sent as the request body. Every row is validated first and
+// nothing is created unless all rows are valid. Form or query fields:
+// format (csv or ndjson, by default from the file name or content type),
+// mapping (a JSON object of column to field), dry_run and async.
+func ImportQRCodes(c *fiber.Ctx) error {
+	var r io.Reader
+	var name string
+	if file, err := c.FormFile("file"); err == nil {
+		if file.Size > upload.MaxSize() {
+			return uploadError(c, upload.ErrTooLarge)
+		}
+		f, err := file.Open()
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Failed to read uploaded file"})
+		}
+		defer f.Close()
+		r, name = f, file.Filename
+	} else if len(c.Body()) > 0 && !strings.HasPrefix(c.Get("Content-Type"), fiber.MIMEMultipartForm) {
+		if int64(len(c.Body())) > upload.MaxSize() {
+			return uploadError(c, upload.ErrTooLarge)
+		}
+		r = bytes.NewReader(c.Body())
+	} else {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "An import file is required"})
+	}
+
+	format := strings.ToLower(importParam(c, "format"))
+	if format == "" {
+		format = importFormat(name, c.Get("Content-Type"))
+	}
+
+	var mapping importer.Mapping
+	if m := importParam(c, "mapping"); m != "" {
+		if err := json.Unmarshal([]byte(m), &mapping); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "mapping must be a JSON object of column names to fields"})
+		}
+	}
+	if err := mapping.Validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	records, err := importer.Read(r, format)
+	if errors.Is(err, importer.ErrInvalidFile) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to read import file"})
+	}
+
+	plan, err := importer.Validate(context.Background(), records, mapping)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to validate import"})
+	}
+
+	if importParam(c, "dry_run") == "true" {
+		preview := append([]importer.Spec{}, plan.Specs...)
+		if len(preview) > 10 {
+			preview = preview[:10]
+		}
+		return c.JSON(fiber.Map{"dry_run": true, "plan": plan, "preview": preview})
+	}
+	if len(plan.Errors) > 0 {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": "Some rows are invalid; no QR codes were created",
+			"plan":  plan,
+		})
+	}
+
+	if len(plan.Specs) > importSyncRows || importParam(c, "async") == "true" {
+		j, err := importer.Enqueue(context.Background(), plan)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to queue import"})
+		}
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"job":        jobView(j, false),
+			"status_url": fmt.Sprintf("/api/jobs/%d", j.ID),
+		})
+	}
+
+	var results []importer.Result
+	created, failed := 0, 0
+	err = importer.Create(context.Background(), plan, func(result importer.Result) {
+		results = append(results, result)
+		if result.Error != "" {
+			failed++
+		} else {
+			created++
+		}
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error(), "results": results})
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"created":    created,
+		"failed":     failed,
+		"results":    results,
+		"new_groups": plan.NewGroups,
+	})
+}
+
+// importParam reads an import option from the form or the query
+func importParam(c *fiber.Ctx, name string) string {
+	if v := c.FormValue(name); v != "" {
+		return v
+	}
+	return c.Query(name)
+}
+
+// importFormat guesses the format of an import file from its name or
+// content type, defaulting to CSV
+func importFormat(name, contentType string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".ndjson", ".jsonl":
+		return importer.FormatNDJSON
+	case ".csv":
+		return importer.FormatCSV
+	}
+	if strings.Contains(contentType, "ndjson") || strings.Contains(contentType, "jsonl") {
+		return importer.FormatNDJSON
+	}
+	return importer.FormatCSV
+}
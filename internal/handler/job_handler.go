@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"qr_backend/ent"
+	"qr_backend/ent/job"
+	"qr_backend/ent/predicate"
+	"qr_backend/internal/database"
+	"qr_backend/internal/jobs"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListJobs lists background jobs, newest first, optionally filtered by
+// ?status= and ?type=
+func ListJobs(c *fiber.Ctx) error {
+	limit := c.QueryInt("limit", 50)
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	var filters []predicate.Job
+	if status := c.Query("status"); status != "" {
+		if err := job.StatusValidator(job.Status(status)); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "status must be queued, running, succeeded, failed or cancelled"})
+		}
+		filters = append(filters, job.StatusEQ(job.Status(status)))
+	}
+	if t := c.Query("type"); t != "" {
+		filters = append(filters, job.TypeEQ(t))
+	}
+
+	list, err := database.DB.Job.Query().
+		Where(filters...).
+		Order(ent.Desc(job.FieldID)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve jobs"})
+	}
+
+	data := make([]fiber.Map, len(list))
+	for i, j := range list {
+		data[i] = jobView(j, false)
+	}
+	return c.JSON(fiber.Map{"data": data})
+}
+
+// GetJob returns the status, progress and result of a background job
+func GetJob(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid job ID"})
+	}
+	j, err := database.DB.Job.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Job not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve job"})
+	}
+	return c.JSON(jobView(j, true))
+}
+
+// CancelJob cancels a queued job, or asks the worker running it to stop
+func CancelJob(c *fiber.Ctx) error {
+	return changeJob(c, jobs.Cancel, jobs.ErrNotCancellable)
+}
+
+// RetryJob queues a failed or cancelled job again
+func RetryJob(c *fiber.Ctx) error {
+	return changeJob(c, jobs.Retry, jobs.ErrNotRetryable)
+}
+
+// changeJob applies a status change and reports a conflict when the job is
+// not in a state that allows it
+func changeJob(c *fiber.Ctx, change func(context.Context, int) (*ent.Job, error), conflict error) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid job ID"})
+	}
+	j, err := change(context.Background(), id)
+	if errors.Is(err, conflict) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error(), "job": jobView(j, false)})
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Job not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update job"})
+	}
+	return c.JSON(jobView(j, false))
+}
+
+// jobView is the public form of a job, without its payload and lease. The
+// result, which can be large, is only included when asked for.
+func jobView(j *ent.Job, withResult bool) fiber.Map {
+	view := fiber.Map{
+		"id":               j.ID,
+		"type":             j.Type,
+		"status":           j.Status,
+		"total":            j.Total,
+		"processed":        j.Processed,
+		"progress":         jobs.Progress(j),
+		"attempts":         j.Attempts,
+		"max_attempts":     j.MaxAttempts,
+		"cancel_requested": j.CancelRequested,
+		"run_at":           j.RunAt,
+		"started_at":       j.StartedAt,
+		"finished_at":      j.FinishedAt,
+		"created_at":       j.CreatedAt,
+	}
+	if j.LastError != "" {
+		view["last_error"] = j.LastError
+	}
+	if withResult && len(j.Result) > 0 {
+		view["result"] = j.Result
+	}
+	return view
+}
@@ -2,24 +2,33 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"html/template"
 
 	"fmt"
 	"net/url"
-	"os"
-	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"qr_backend/ent"
+	"qr_backend/ent/predicate"
 	"qr_backend/ent/qrcode"
 	"qr_backend/ent/qrcodeanalytics"
+	qranalytics "qr_backend/internal/analytics"
 	"qr_backend/internal/database"
 	"qr_backend/internal/model"
+	"qr_backend/internal/scanlimit"
+	"qr_backend/internal/upload"
+	"qr_backend/internal/webhook"
 	"qr_backend/pkg/barcode"
 	qrgen "qr_backend/pkg/qrcode"
+	"qr_backend/pkg/qrsign"
 	"qr_backend/pkg/shorturl"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/gofiber/fiber/v2"
 	goqrcode "github.com/skip2/go-qrcode"
 )
@@ -33,18 +42,38 @@ func CreateQRCode(c *fiber.Ctx) error {
 		RedirectURL string                 `json:"redirect_url,omitempty"`
 		ShortURL    string                 `json:"short_url,omitempty"`
 		Content     map[string]interface{} `json:"content"`
-		ExpiresAt   *time.Time             `json:"expires_at,omitempty"`
 		Analytics   bool                   `json:"analytics"`
+		CountsOnly  bool                   `json:"analytics_counts_only"`
 		Active      bool                   `json:"active"`
 		Tags        []string               `json:"tags,omitempty"`
 		Design      map[string]interface{} `json:"design,omitempty"`
 		GroupID     *int                   `json:"group_id,omitempty"`
 		IsDynamic   bool                   `json:"is_dynamic"`
+		availabilityRequest
+		scanLimitRequest
+		passwordRequest
+		signingRequest
 	}
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
 	}
+	if err := req.availabilityRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := req.scanLimitRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := req.passwordRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := req.signingRequest.validate(req.Password != nil && *req.Password != ""); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	passwordHash, err := req.passwordHash()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to hash password"})
+	}
 
 	// Handle dynamic vs static QR code type
 	if req.IsDynamic {
@@ -72,7 +101,9 @@ func CreateQRCode(c *fiber.Ctx) error {
 		SetTitle(req.Title).
 		SetContent(req.Content).
 		SetAnalytics(req.Analytics).
-		SetActive(req.Active)
+		SetAnalyticsCountsOnly(req.CountsOnly).
+		SetActive(req.Active).
+		SetVisitorKey(qrcode.VisitorKey(req.VisitorKey))
 
 	// Set short URL if available
 	if req.ShortURL != "" {
@@ -89,6 +120,33 @@ func CreateQRCode(c *fiber.Ctx) error {
 	if req.ExpiresAt != nil {
 		qrBuilder.SetExpiresAt(*req.ExpiresAt)
 	}
+	if req.StartsAt != nil {
+		qrBuilder.SetStartsAt(*req.StartsAt)
+	}
+	if req.Schedule != nil {
+		qrBuilder.SetSchedule(req.Schedule)
+	}
+	if req.FallbackURL != "" {
+		qrBuilder.SetFallbackURL(req.FallbackURL)
+	}
+	if req.FallbackMessage != "" {
+		qrBuilder.SetFallbackMessage(req.FallbackMessage)
+	}
+	if req.MaxScans != nil {
+		qrBuilder.SetMaxScans(*req.MaxScans)
+	}
+	if req.ScansPerVisitor != nil {
+		qrBuilder.SetScansPerVisitor(*req.ScansPerVisitor)
+	}
+	if req.EndedMessage != "" {
+		qrBuilder.SetEndedMessage(req.EndedMessage)
+	}
+	if passwordHash != "" {
+		qrBuilder.SetPasswordHash(passwordHash)
+	}
+	if req.Sign != "" {
+		qrBuilder.SetSigning(qrcode.Signing(req.Sign))
+	}
 	if len(req.Tags) > 0 {
 		qrBuilder.SetTags(req.Tags)
 	}
@@ -103,6 +161,12 @@ func CreateQRCode(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create QR code"})
 	}
+	if qr.Signing != nil {
+		if qr, err = signNewQRCode(context.Background(), qr); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to sign QR code"})
+		}
+	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
 
 	return c.Status(fiber.StatusCreated).JSON(qr)
 }
@@ -146,17 +210,34 @@ func UpdateQRCode(c *fiber.Ctx) error {
 		RedirectURL string                 `json:"redirect_url,omitempty"`
 		ShortURL    string                 `json:"short_url,omitempty"`
 		Content     map[string]interface{} `json:"content"`
-		ExpiresAt   *time.Time             `json:"expires_at,omitempty"`
 		Analytics   bool                   `json:"analytics"`
+		CountsOnly  bool                   `json:"analytics_counts_only"`
 		Active      bool                   `json:"active"`
 		Tags        []string               `json:"tags,omitempty"`
 		Design      map[string]interface{} `json:"design,omitempty"`
 		GroupID     *int                   `json:"group_id,omitempty"`
+		availabilityRequest
+		scanLimitRequest
+		passwordRequest
+		signingRequest
 	}
 
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
 	}
+	if err := req.availabilityRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := req.scanLimitRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err := req.passwordRequest.validate(); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	passwordHash, err := req.passwordHash()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to hash password"})
+	}
 
 	// Fetch the existing QR code to preserve short_url if not provided
 	existingQR, err := database.DB.QRCode.Get(context.Background(), id)
@@ -166,13 +247,19 @@ func UpdateQRCode(c *fiber.Ctx) error {
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
 	}
+	protected := passwordHash != "" || (req.Password == nil && existingQR.PasswordHash != "")
+	if err := req.signingRequest.validate(protected); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
 
 	updateBuilder := database.DB.QRCode.UpdateOneID(id).
 		SetType(req.Type).
 		SetTitle(req.Title).
 		SetContent(req.Content).
 		SetAnalytics(req.Analytics).
+		SetAnalyticsCountsOnly(req.CountsOnly).
 		SetActive(req.Active).
+		SetVisitorKey(qrcode.VisitorKey(req.VisitorKey)).
 		SetUpdatedAt(time.Now())
 
 	// Always set short_url to the existing value if not provided
@@ -198,6 +285,53 @@ func UpdateQRCode(c *fiber.Ctx) error {
 	} else {
 		updateBuilder.ClearExpiresAt()
 	}
+	if req.StartsAt != nil {
+		updateBuilder.SetStartsAt(*req.StartsAt)
+	} else {
+		updateBuilder.ClearStartsAt()
+	}
+	if req.Schedule != nil {
+		updateBuilder.SetSchedule(req.Schedule)
+	} else {
+		updateBuilder.ClearSchedule()
+	}
+	if req.FallbackURL != "" {
+		updateBuilder.SetFallbackURL(req.FallbackURL)
+	} else {
+		updateBuilder.ClearFallbackURL()
+	}
+	if req.FallbackMessage != "" {
+		updateBuilder.SetFallbackMessage(req.FallbackMessage)
+	} else {
+		updateBuilder.ClearFallbackMessage()
+	}
+	if req.MaxScans != nil {
+		updateBuilder.SetMaxScans(*req.MaxScans)
+	} else {
+		updateBuilder.ClearMaxScans()
+	}
+	if req.ScansPerVisitor != nil {
+		updateBuilder.SetScansPerVisitor(*req.ScansPerVisitor)
+	} else {
+		updateBuilder.ClearScansPerVisitor()
+	}
+	if req.EndedMessage != "" {
+		updateBuilder.SetEndedMessage(req.EndedMessage)
+	} else {
+		updateBuilder.ClearEndedMessage()
+	}
+	// The password is kept unless a new one, or "" to remove it, is given
+	if passwordHash != "" {
+		updateBuilder.SetPasswordHash(passwordHash)
+	} else if req.Password != nil {
+		updateBuilder.ClearPasswordHash()
+	}
+	// Signed codes are signed again with their new content below
+	if req.Sign != "" {
+		updateBuilder.SetSigning(qrcode.Signing(req.Sign))
+	} else {
+		updateBuilder.ClearSigning().ClearSignedToken()
+	}
 	if len(req.Tags) > 0 {
 		updateBuilder.SetTags(req.Tags)
 	} else {
@@ -221,19 +355,31 @@ func UpdateQRCode(c *fiber.Ctx) error {
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update QR code"})
 	}
+	if qr.Signing != nil {
+		if qr, err = signQRCode(context.Background(), qr); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to sign QR code"})
+		}
+	}
+	webhook.Emit(webhook.EventUpdated, qr, nil)
+	if existingQR.Active && !qr.Active {
+		webhook.Emit(webhook.EventDeactivated, qr, nil)
+	}
 
 	// Always include short_url in the response
 	resp := map[string]interface{}{
-		"id":         qr.ID,
-		"type":       qr.Type,
-		"title":      qr.Title,
-		"short_url":  qr.ShortURL,
-		"content":    qr.Content,
-		"created_at": qr.CreatedAt,
-		"updated_at": qr.UpdatedAt,
-		"analytics":  qr.Analytics,
-		"active":     qr.Active,
-		"edges":      qr.Edges,
+		"id":                    qr.ID,
+		"type":                  qr.Type,
+		"title":                 qr.Title,
+		"short_url":             qr.ShortURL,
+		"content":               qr.Content,
+		"created_at":            qr.CreatedAt,
+		"updated_at":            qr.UpdatedAt,
+		"analytics":             qr.Analytics,
+		"analytics_counts_only": qr.AnalyticsCountsOnly,
+		"active":                qr.Active,
+		"signing":               qr.Signing,
+		"signed_token":          qr.SignedToken,
+		"edges":                 qr.Edges,
 	}
 	return c.JSON(resp)
 }
@@ -279,8 +425,14 @@ func ListQRCodes(c *fiber.Ctx) error {
 
 	offset := (page - 1) * limit
 
+	filters, err := qrCodeFilters(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
 	qrs, err := database.DB.QRCode.
 		Query().
+		Where(filters...).
 		WithGroup().
 		WithFileRefs().
 		Limit(limit).
@@ -293,7 +445,7 @@ func ListQRCodes(c *fiber.Ctx) error {
 	}
 
 	// Get total count for pagination
-	total, err := database.DB.QRCode.Query().Count(context.Background())
+	total, err := database.DB.QRCode.Query().Where(filters...).Count(context.Background())
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to count QR codes"})
 	}
@@ -308,6 +460,38 @@ func ListQRCodes(c *fiber.Ctx) error {
 	})
 }
 
+// qrCodeFilters reads the QR code list filters from the query: type,
+// group_id, active, tag and a title search q
+func qrCodeFilters(c *fiber.Ctx) ([]predicate.QRCode, error) {
+	var filters []predicate.QRCode
+	if t := c.Query("type"); t != "" {
+		filters = append(filters, qrcode.TypeEQ(t))
+	}
+	if c.Query("group_id") != "" {
+		groupID, err := strconv.Atoi(c.Query("group_id"))
+		if err != nil {
+			return nil, errors.New("group_id must be a number")
+		}
+		filters = append(filters, qrcode.GroupIDEQ(groupID))
+	}
+	if c.Query("active") != "" {
+		active, err := strconv.ParseBool(c.Query("active"))
+		if err != nil {
+			return nil, errors.New("active must be true or false")
+		}
+		filters = append(filters, qrcode.ActiveEQ(active))
+	}
+	if tag := c.Query("tag"); tag != "" {
+		filters = append(filters, predicate.QRCode(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(qrcode.FieldTags, tag))
+		}))
+	}
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		filters = append(filters, qrcode.TitleContainsFold(q))
+	}
+	return filters, nil
+}
+
 // DownloadQRCode generates and downloads QR code image
 func DownloadQRCode(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -324,12 +508,32 @@ func DownloadQRCode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
 	}
 
-	// Check if QR code is active and not expired
+	// Check if QR code is active and open
 	if !qr.Active {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code is inactive"})
 	}
-	if qr.ExpiresAt != nil && qr.ExpiresAt.Before(time.Now()) {
-		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code has expired"})
+	if cl := qrClosure(qr, time.Now()); cl != nil {
+		return closedError(c, cl)
+	}
+
+	// Barcodes are regenerated from their stored symbology and options,
+	// which the query can override to download at another resolution
+	if isBarcode(qr) {
+		symbology, data, stored := barcodeContent(qr.Content)
+		options, err := barcodeDownloadOptions(barcodeQuery(c), stored)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		imageData, _, err := generateBarcode(symbology, data, options)
+		if errors.Is(err, barcode.ErrInvalidData) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate barcode: " + err.Error()})
+		}
+		c.Set("Content-Type", "image/png")
+		c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.png\"", qr.Title))
+		return c.Send(imageData)
 	}
 
 	// Get query parameters for customization
@@ -341,25 +545,69 @@ func DownloadQRCode(c *fiber.Ctx) error {
 	if size < 64 || size > 1024 {
 		size = 256
 	}
+	recoveryLevel, ok := parseRecoveryLevel(level)
+	if !ok {
+		recoveryLevel = goqrcode.Medium
+	}
+
+	// Determine what data to encode
+	dataToEncode := qrData(qr, c.BaseURL())
+
+	// Generate QR code
+	imageData, contentType, err := qrImage(dataToEncode, format, recoveryLevel, size)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate QR code"})
+	}
 
-	// Convert level string to goqrcode.RecoveryLevel
-	var recoveryLevel goqrcode.RecoveryLevel
+	// Set appropriate headers
+	filename := fmt.Sprintf("%s.%s", qr.Title, format)
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+
+	return c.Send(imageData)
+}
+
+// parseRecoveryLevel converts a level name to a QR error correction level
+func parseRecoveryLevel(level string) (goqrcode.RecoveryLevel, bool) {
 	switch strings.ToLower(level) {
 	case "low":
-		recoveryLevel = goqrcode.Low
+		return goqrcode.Low, true
 	case "medium":
-		recoveryLevel = goqrcode.Medium
+		return goqrcode.Medium, true
 	case "high":
-		recoveryLevel = goqrcode.High
+		return goqrcode.High, true
 	case "highest":
-		recoveryLevel = goqrcode.Highest
-	default:
-		recoveryLevel = goqrcode.Medium
+		return goqrcode.Highest, true
+	}
+	return goqrcode.Medium, false
+}
+
+// qrImage renders data as a QR code image size pixels wide, as SVG or
+// otherwise PNG, and returns it with its content type
+func qrImage(data, format string, level goqrcode.RecoveryLevel, size int) ([]byte, string, error) {
+	if format == "svg" {
+		imageData, err := qrgen.GenerateSVG(data, level, size)
+		return imageData, "image/svg+xml", err
 	}
+	imageData, err := qrgen.GenerateWithLevel(data, level, size)
+	return imageData, "image/png", err
+}
 
-	// Determine what data to encode
+// qrData returns the data a QR code image encodes: the content itself for
+// static codes, otherwise the URL scans go through
+func qrData(qr *ent.QRCode, baseURL string) string {
 	var dataToEncode string
-	if qr.Type == "static" {
+	if qr.SignedToken != "" && qr.Signing != nil {
+		// Signed codes encode their signed payload, or a URL to verify it
+		if *qr.Signing == qrcode.SigningEmbedded {
+			return qr.SignedToken
+		}
+		return qrsign.VerificationURL(baseURL, qr.SignedToken)
+	}
+	if link, ok := qr.Content["digital_link"].(string); ok && qr.Type == string(model.QRTypeGS1) {
+		// GS1 product codes encode their Digital Link URI
+		dataToEncode = link
+	} else if qr.Type == "static" {
 		// Encode the info directly for static QR codes
 		if text, ok := qr.Content["text"].(string); ok {
 			// Plain text content for static text QR codes
@@ -456,43 +704,24 @@ func DownloadQRCode(c *fiber.Ctx) error {
 			if urlStr, ok := qr.Content["url"].(string); ok {
 				dataToEncode = urlStr
 			} else {
-				dataToEncode = fmt.Sprintf("%s/qr/%d", c.BaseURL(), qr.ID)
+				dataToEncode = fmt.Sprintf("%s/qr/%d", baseURL, qr.ID)
 			}
 		}
 	} else if (qr.Type == "dynamic" || qr.Type == "app" || qr.Type == "business") && qr.ShortURL != "" {
 		// For dynamic QR codes (including app and business), encode only the short URL
-		dataToEncode = fmt.Sprintf("%s/scan/%s", c.BaseURL(), qr.ShortURL)
+		dataToEncode = fmt.Sprintf("%s/scan/%s", baseURL, qr.ShortURL)
 	} else if qr.RedirectURL != "" {
 		dataToEncode = qr.RedirectURL
 	} else if qr.ShortURL != "" {
-		dataToEncode = fmt.Sprintf("%s/scan/%s", c.BaseURL(), qr.ShortURL)
+		dataToEncode = fmt.Sprintf("%s/scan/%s", baseURL, qr.ShortURL)
 	} else {
 		if urlStr, ok := qr.Content["url"].(string); ok {
 			dataToEncode = urlStr
 		} else {
-			dataToEncode = fmt.Sprintf("%s/qr/%d", c.BaseURL(), qr.ID)
+			dataToEncode = fmt.Sprintf("%s/qr/%d", baseURL, qr.ID)
 		}
 	}
-
-	// Generate QR code
-	var imageData []byte
-	if format == "svg" {
-		// For SVG, we'll use a simple approach (you might want to use a different library)
-		imageData, err = qrgen.GenerateWithLevel(dataToEncode, recoveryLevel, size)
-	} else {
-		imageData, err = qrgen.GenerateWithLevel(dataToEncode, recoveryLevel, size)
-	}
-
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate QR code"})
-	}
-
-	// Set appropriate headers
-	filename := fmt.Sprintf("%s.%s", qr.Title, format)
-	c.Set("Content-Type", fmt.Sprintf("image/%s", format))
-	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
-
-	return c.Send(imageData)
+	return dataToEncode
 }
 
 // ScanQRCode handles QR code scanning and redirection or static content display
@@ -520,27 +749,38 @@ func ScanQRCode(c *fiber.Ctx) error {
 	if !qr.Active {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code is inactive"})
 	}
-	if qr.ExpiresAt != nil && qr.ExpiresAt.Before(time.Now()) {
-		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code has expired"})
+	// Before starts_at, after expires_at and outside its opening hours a
+	// code sends scans to its fallback
+	if cl := qrClosure(qr, time.Now()); cl != nil {
+		return closedScan(c, qr, cl)
+	}
+	// Password-protected codes ask for their password until unlocked
+	if !unlocked(c, qr) {
+		return passwordChallenge(c, qr, fiber.StatusOK, "")
+	}
+	// Coupons and tickets stop working once their scans are used up
+	if scanlimit.Limited(qr) {
+		if ok, err := redeemScan(c, qr); !ok {
+			return err
+		}
 	}
 
+	// Notify webhooks of every scan, whether or not analytics are enabled
+	webhook.Emit(webhook.EventScan, qr, map[string]interface{}{
+		"device":     qranalytics.DeviceClass(c.Get("User-Agent")),
+		"scanned_at": time.Now().UTC(),
+	})
+
 	// Track analytics for all QR codes that have analytics enabled
 	if qr.Analytics {
-		ipAddress := c.IP()
-		userAgent := c.Get("User-Agent")
-		go func(id int, ip, ua string) {
-			goCtx, goCancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer goCancel()
-			qr, err := database.DB.QRCode.Get(goCtx, id)
-			if err == nil {
-				_, _ = database.DB.QRCodeAnalytics.Create().
-					SetIPAddress(ip).
-					SetUserAgent(ua).
-					SetScannedAt(time.Now()).
-					SetQrCode(qr).
-					Save(goCtx)
-			}
-		}(qr.ID, ipAddress, userAgent)
+		qranalytics.Track(qranalytics.Scan{
+			QRCodeID:   qr.ID,
+			IP:         c.IP(),
+			UserAgent:  c.Get("User-Agent"),
+			DNT:        c.Get("DNT"),
+			GPC:        c.Get("Sec-GPC"),
+			CountsOnly: qr.AnalyticsCountsOnly,
+		})
 	}
 
 	// App QR code landing page
@@ -551,7 +791,7 @@ func ScanQRCode(c *fiber.Ctx) error {
 		if appName == "" {
 			appName = "Mobile App"
 		}
-		
+
 		data := fiber.Map{
 			"AppName":     appName,
 			"AppStoreURL": appStoreURL,
@@ -570,11 +810,11 @@ func ScanQRCode(c *fiber.Ctx) error {
 		logoURL, _ := qr.Content["logo_url"].(string)
 		contactInfo, _ := qr.Content["contact_info"].(map[string]interface{})
 		socialLinks, _ := qr.Content["social_links"].(map[string]interface{})
-		
+
 		if businessName == "" {
 			businessName = "Business"
 		}
-		
+
 		data := fiber.Map{
 			"BusinessName": businessName,
 			"Tagline":      tagline,
@@ -741,6 +981,48 @@ func ScanQRCode(c *fiber.Ctx) error {
 		return c.Render("event", data)
 	}
 
+	// Data Matrix and linear barcode landing page; checked before the image
+	// page since the barcode is stored as a PNG
+	if qr.Content["type"] == "barcode_2d" || qr.Content["type"] == "barcode_1d" || qr.Content["data"] != nil {
+		textData, _ := qr.Content["data"].(string)
+		if textData == "" {
+			textData = "No data available"
+		}
+
+		symbology, _, _ := barcodeContent(qr.Content)
+
+		// Get the file URL from content
+		fileURL, _ := qr.Content["url"].(string)
+		filename := symbology + "_barcode.png"
+		if storedFilename, ok := qr.Content["filename"].(string); ok && storedFilename != "" {
+			filename = storedFilename
+		}
+
+		size := "200x200"
+		if s, ok := qr.Content["size"].(map[string]interface{}); ok {
+			width, _ := s["width"].(float64)
+			height, _ := s["height"].(float64)
+			size = fmt.Sprintf("%.0fx%.0f", width, height)
+			if mm, _ := s["width_mm"].(float64); mm > 0 {
+				heightMM, _ := s["height_mm"].(float64)
+				dpi, _ := s["dpi"].(float64)
+				size += fmt.Sprintf(" (%.1f × %.1f mm at %.0f dpi)", mm, heightMM, dpi)
+			}
+		}
+
+		name := barcode.SymbologyName(symbology)
+		data := fiber.Map{
+			"TextData":    textData,
+			"BarcodeType": name,
+			"Size":        size,
+			"FileURL":     fileURL,
+			"DownloadURL": fmt.Sprintf("/api/qr/%d/download", qr.ID),
+			"Filename":    filename,
+			"Title":       name + " Barcode",
+		}
+		return c.Render("barcode", data)
+	}
+
 	// PDF QR code landing page
 	if qr.Content["type"] == "pdf" || (qr.Content["url"] != nil && strings.HasSuffix(strings.ToLower(qr.Content["url"].(string)), ".pdf")) {
 		fileURL, _ := qr.Content["url"].(string)
@@ -755,9 +1037,28 @@ func ScanQRCode(c *fiber.Ctx) error {
 			"Filename": filename,
 			"Title":    "PDF Document",
 		}
+		if ref := contentFileRef(qr); ref != nil {
+			if status, msg := quarantined(ref); status != 0 {
+				return fileUnavailable(c, status, msg)
+			}
+			data["FileSize"] = formatSize(ref.Size)
+			if ref.Pdf != nil {
+				data["Pages"] = ref.Pdf.Pages
+				data["DocumentTitle"] = ref.Pdf.Title
+				data["Author"] = ref.Pdf.Author
+			}
+			if len(ref.Variants) > 0 {
+				data["ThumbnailURL"], data["ThumbnailSources"] = imageSources(ref.Variants)
+			}
+		}
 		return c.Render("pdf", data)
 	}
 
+	// Image gallery QR code landing page
+	if qr.Type == string(model.QRTypeImages) {
+		return c.Render("gallery", galleryPage(qr))
+	}
+
 	// Image QR code landing page
 	if qr.Content["type"] == "image" || (qr.Content["url"] != nil && (strings.HasSuffix(strings.ToLower(qr.Content["url"].(string)), ".jpg") || strings.HasSuffix(strings.ToLower(qr.Content["url"].(string)), ".jpeg") || strings.HasSuffix(strings.ToLower(qr.Content["url"].(string)), ".png") || strings.HasSuffix(strings.ToLower(qr.Content["url"].(string)), ".gif"))) {
 		fileURL, _ := qr.Content["url"].(string)
@@ -766,36 +1067,21 @@ func ScanQRCode(c *fiber.Ctx) error {
 			filename = storedFilename
 		}
 		data := fiber.Map{
-			"FileURL":  fileURL,
-			"Filename": filename,
-			"Title":    "Image File",
-		}
-		return c.Render("image", data)
-	}
-
-	// Data Matrix Barcode QR code landing page
-	if qr.Content["type"] == "barcode_2d" || qr.Content["data"] != nil {
-		textData, _ := qr.Content["data"].(string)
-		if textData == "" {
-			textData = "No data available"
+			"FileURL":    fileURL,
+			"PreviewURL": fileURL,
+			"Filename":   filename,
+			"Title":      "Image File",
 		}
-
-		// Get the file URL from content
-		fileURL, _ := qr.Content["url"].(string)
-		filename := "datamatrix_barcode.png"
-		if storedFilename, ok := qr.Content["filename"].(string); ok && storedFilename != "" {
-			filename = storedFilename
-		}
-
-		data := fiber.Map{
-			"TextData":    textData,
-			"BarcodeType": "Data Matrix",
-			"Size":        "200x200",
-			"FileURL":     fileURL,
-			"Filename":    filename,
-			"Title":       "Data Matrix Barcode",
+		if ref := contentFileRef(qr); ref != nil {
+			if status, msg := quarantined(ref); status != 0 {
+				return fileUnavailable(c, status, msg)
+			}
+			if len(ref.Variants) > 0 {
+				data["PreviewURL"], data["Sources"] = imageSources(ref.Variants)
+				data["Width"], data["Height"] = ref.Width, ref.Height
+			}
 		}
-		return c.Render("barcode", data)
+		return c.Render("image", data)
 	}
 
 	// Handle dynamic website QR codes with proper redirection
@@ -834,12 +1120,21 @@ func GetStaticQRContent(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
 	}
 
-	// Check if QR code is active and not expired
+	// Check if QR code is active and open
 	if !qr.Active {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code is inactive"})
 	}
-	if qr.ExpiresAt != nil && qr.ExpiresAt.Before(time.Now()) {
-		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "QR code has expired"})
+	if cl := qrClosure(qr, time.Now()); cl != nil {
+		return closedError(c, cl)
+	}
+	if !unlocked(c, qr) {
+		return passwordChallenge(c, qr, fiber.StatusOK, "")
+	}
+	// Static codes encoding this URL count against their scan limits too
+	if scanlimit.Limited(qr) {
+		if ok, err := redeemScan(c, qr); !ok {
+			return err
+		}
 	}
 
 	// Handle WiFi QR code specially
@@ -877,47 +1172,15 @@ func UploadFile(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "No file uploaded"})
 	}
 
-	// Validate file size (10MB limit)
-	if file.Size > 10*1024*1024 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "File size exceeds 10MB limit"})
-	}
-
-	// Validate file type
-	allowedTypes := map[string]bool{
-		"image/jpeg":      true,
-		"image/png":       true,
-		"image/gif":       true,
-		"application/pdf": true,
-		"text/plain":      true,
-	}
-
-	contentType := file.Header.Get("Content-Type")
-	if !allowedTypes[contentType] {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "File type not allowed"})
-	}
-
-	// Create uploads directory if it doesn't exist
-	uploadsDir := "uploads"
-	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create upload directory"})
-	}
-
-	// Generate unique filename
-	filename := fmt.Sprintf("%d_%s", time.Now().Unix(), file.Filename)
-	filePath := filepath.Join(uploadsDir, filename)
-
-	// Save file
-	if err := c.SaveFile(file, filePath); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file"})
+	// Validate and store the file based on its content
+	stored, err := upload.Save(file, storageOwner(c), upload.TypeJPEG, upload.TypePNG, upload.TypeGIF, upload.TypeSVG, upload.TypePDF, upload.TypeText)
+	if err != nil {
+		return uploadError(c, err)
 	}
+	defer stored.Release()
 
 	// Create file reference in database
-	fileRef, err := database.DB.FileReference.Create().
-		SetFilename(file.Filename).
-		SetURL(fmt.Sprintf("/uploads/%s", filename)).
-		SetSize(file.Size).
-		SetType(contentType).
-		Save(context.Background())
+	fileRef, err := createFileReference(context.Background(), stored)
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file reference"})
@@ -926,6 +1189,35 @@ func UploadFile(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(fileRef)
 }
 
+// uploadError maps an upload validation error to an HTTP response
+func uploadError(c *fiber.Ctx, err error) error {
+	status, msg := uploadErrorStatus(err)
+	return c.Status(status).JSON(fiber.Map{"error": msg})
+}
+
+// uploadErrorStatus maps an upload validation error to a status and message
+func uploadErrorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, upload.ErrTooLarge):
+		return fiber.StatusRequestEntityTooLarge, fmt.Sprintf("File size exceeds the %d byte limit", upload.MaxSize())
+	case errors.Is(err, upload.ErrImageTooLarge):
+		return fiber.StatusRequestEntityTooLarge, err.Error()
+	case errors.Is(err, upload.ErrTypeNotAllowed):
+		return fiber.StatusUnsupportedMediaType, "File type not allowed"
+	case errors.Is(err, upload.ErrTooManyPages), errors.Is(err, upload.ErrPDFTooLarge):
+		return fiber.StatusRequestEntityTooLarge, err.Error()
+	case errors.Is(err, upload.ErrQuotaExceeded):
+		return fiber.StatusInsufficientStorage, err.Error()
+	case errors.Is(err, upload.ErrNoOwner):
+		return fiber.StatusUnauthorized, "Uploads must come through the authenticating proxy, which names their owner"
+	case errors.Is(err, upload.ErrTypeMismatch), errors.Is(err, upload.ErrUnsafeContent),
+		errors.Is(err, upload.ErrEncryptedPDF), errors.Is(err, upload.ErrMalformedPDF):
+		return fiber.StatusBadRequest, err.Error()
+	default:
+		return fiber.StatusInternalServerError, "Failed to save file"
+	}
+}
+
 // CreatePDFQRCode handles PDF file upload and creates a QR code for it
 func CreatePDFQRCode(c *fiber.Ctx) error {
 	// Parse multipart form
@@ -942,15 +1234,12 @@ func CreatePDFQRCode(c *fiber.Ctx) error {
 
 	file := files[0]
 
-	// Validate file type
-	if file.Header.Get("Content-Type") != "application/pdf" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Only PDF files are allowed"})
-	}
-
-	// Validate file size (10MB limit)
-	if file.Size > 10*1024*1024 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "File size exceeds 10MB limit"})
+	// Validate and store the PDF based on its content
+	stored, err := upload.Save(file, storageOwner(c), upload.TypePDF)
+	if err != nil {
+		return uploadError(c, err)
 	}
+	defer stored.Release()
 
 	// Get form values
 	title := c.FormValue("title")
@@ -959,31 +1248,11 @@ func CreatePDFQRCode(c *fiber.Ctx) error {
 	analytics := c.FormValue("analytics") == "true"
 
 	if title == "" {
-		title = "PDF QR Code - " + file.Filename
-	}
-
-	// Create uploads directory if it doesn't exist
-	uploadsDir := "uploads"
-	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create upload directory"})
+		title = "PDF QR Code - " + stored.Name
 	}
 
-	// Generate unique filename
-	filename := fmt.Sprintf("%d_%s", time.Now().Unix(), file.Filename)
-	filePath := filepath.Join(uploadsDir, filename)
-
-	// Save file
-	if err := c.SaveFile(file, filePath); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save PDF file"})
-	}
-
-	// Create file reference in database
-	fileRef, err := database.DB.FileReference.Create().
-		SetFilename(file.Filename).
-		SetURL(fmt.Sprintf("/uploads/%s", filename)).
-		SetSize(file.Size).
-		SetType("application/pdf").
-		Save(context.Background())
+	// Create file reference in database as the first version of the file
+	fileRef, err := saveFileReference(context.Background(), stored, newFileReference(stored).SetVersion(1))
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file reference"})
@@ -1011,16 +1280,10 @@ func CreatePDFQRCode(c *fiber.Ctx) error {
 	}
 
 	// Create the PDF URL (accessible via your server)
-	pdfURL := fmt.Sprintf("/uploads/%s", filename)
+	pdfURL := stored.URL
 
 	// Create QR code content
-	content := map[string]interface{}{
-		"type":        "pdf",
-		"url":         pdfURL,
-		"filename":    file.Filename,
-		"file_size":   file.Size,
-		"file_ref_id": fileRef.ID,
-	}
+	content := pdfContent(fileRef)
 
 	// Create QR code using Ent
 	qrBuilder := database.DB.QRCode.Create().
@@ -1046,6 +1309,7 @@ func CreatePDFQRCode(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create PDF QR code"})
 	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
 
 	// Return the created QR code with file reference
 	response := fiber.Map{
@@ -1070,33 +1334,15 @@ func CreateImageQRCode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "No file uploaded"})
 	}
 
-	// Validate file type
-	allowedTypes := map[string]bool{
-		"image/jpeg": true,
-		"image/png":  true,
-		"image/gif":  true,
-	}
-	contentType := file.Header.Get("Content-Type")
-	if !allowedTypes[contentType] {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "File type not allowed"})
-	}
-
-	// Save file
-	uploadsDir := "uploads"
-	os.MkdirAll(uploadsDir, 0755)
-	filename := fmt.Sprintf("%d_%s", time.Now().Unix(), file.Filename)
-	filePath := filepath.Join(uploadsDir, filename)
-	if err := c.SaveFile(file, filePath); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file"})
+	// Validate and store the image based on its content
+	stored, err := upload.Save(file, storageOwner(c), upload.ImageTypes...)
+	if err != nil {
+		return uploadError(c, err)
 	}
+	defer stored.Release()
 
-	// Create file reference in DB
-	fileRef, err := database.DB.FileReference.Create().
-		SetFilename(file.Filename).
-		SetURL(fmt.Sprintf("/uploads/%s", filename)).
-		SetSize(file.Size).
-		SetType(contentType).
-		Save(context.Background())
+	// Create file reference in DB as the first version of the file
+	fileRef, err := saveFileReference(context.Background(), stored, newFileReference(stored).SetVersion(1))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to save file reference"})
 	}
@@ -1108,18 +1354,13 @@ func CreateImageQRCode(c *fiber.Ctx) error {
 	}
 
 	// Create QR code content
-	imageURL := fmt.Sprintf("/uploads/%s", filename)
-	content := map[string]interface{}{
-		"type":        "image",
-		"url":         imageURL,
-		"filename":    file.Filename,
-		"file_ref_id": fileRef.ID,
-	}
+	imageURL := stored.URL
+	content := imageContent(fileRef)
 
 	// Create QR code in DB
 	qr, err := database.DB.QRCode.Create().
 		SetType("image").
-		SetTitle("Image QR Code - " + file.Filename).
+		SetTitle("Image QR Code - " + stored.Name).
 		SetContent(content).
 		SetShortURL(shortURL).
 		SetAnalytics(true).
@@ -1129,6 +1370,7 @@ func CreateImageQRCode(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create image QR code"})
 	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
 
 	scanURL := fmt.Sprintf("http://localhost:3000/scan/%s", shortURL)
 
@@ -1142,14 +1384,36 @@ func CreateImageQRCode(c *fiber.Ctx) error {
 	})
 }
 
-// CreateBarcodeQRCode handles creating a QR code for Data Matrix barcodes
+// CreateBarcodeQRCode handles creating a QR code for 2D and linear barcodes
 func CreateBarcodeQRCode(c *fiber.Ctx) error {
 	var req struct {
 		Title       string `json:"title"`
 		Description string `json:"description,omitempty"`
 		Data        string `json:"data"`
+		Symbology   string `json:"symbology,omitempty"` // datamatrix (default), pdf417, aztec, microqr, code128, ean8, ean13, upca, upce, code39, codabar, itf14
+		DPI         int    `json:"dpi,omitempty"`       // Print resolution
 		IsDynamic   bool   `json:"is_dynamic"`
 		Analytics   bool   `json:"analytics"`
+
+		// Linear barcodes
+		Checksum      bool    `json:"checksum,omitempty"`       // Code 39 check character
+		ModuleWidth   int     `json:"module_width,omitempty"`   // Pixels per narrow bar
+		Height        int     `json:"height,omitempty"`         // Bar height in pixels
+		Magnification float64 `json:"magnification,omitempty"`  // Scale of the nominal print size
+		HumanReadable *bool   `json:"human_readable,omitempty"` // Print the data below the bars (default true)
+
+		// 2D barcodes
+		ModuleSize     int     `json:"module_size,omitempty"`      // Pixels per module
+		WidthMM        float64 `json:"width_mm,omitempty"`         // Printed symbol width at dpi, instead of module_size
+		QuietZone      int     `json:"quiet_zone,omitempty"`       // Margin in modules
+		Size           string  `json:"size,omitempty"`             // Data Matrix symbol size: auto, square, rectangle or RxC
+		GS1            bool    `json:"gs1,omitempty"`              // Data Matrix GS1 element strings
+		Columns        int     `json:"columns,omitempty"`          // PDF417 data columns
+		SecurityLevel  int     `json:"security_level,omitempty"`   // PDF417 error correction level
+		Layers         int     `json:"layers,omitempty"`           // Aztec layers, negative for compact
+		ECPercent      int     `json:"ec_percent,omitempty"`       // Aztec error correction percentage
+		MicroQRVersion string  `json:"micro_qr_version,omitempty"` // M1-M4
+		Level          string  `json:"level,omitempty"`            // Micro QR error correction level
 	}
 
 	if err := c.BodyParser(&req); err != nil {
@@ -1160,47 +1424,96 @@ func CreateBarcodeQRCode(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Data is required for barcode generation"})
 	}
 
+	req.Symbology = strings.ToLower(strings.TrimSpace(req.Symbology))
+	if req.Symbology == "" {
+		req.Symbology = barcode.DataMatrix
+	}
+	if !barcode.IsMatrix(req.Symbology) && !barcode.IsLinear(req.Symbology) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Unsupported symbology: " + req.Symbology})
+	}
+	if req.ModuleWidth < 0 || req.ModuleWidth > 20 || req.Height < 0 || req.Height > 2000 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "module_width must be 1-20 and height 1-2000 pixels"})
+	}
+	if req.ModuleSize < 0 || req.ModuleSize > 40 || req.QuietZone < 0 || req.QuietZone > 20 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "module_size must be 1-40 pixels and quiet_zone up to 20 modules"})
+	}
+	if req.DPI < 0 || req.DPI > 1200 || req.Magnification < 0 || req.Magnification > 2 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "dpi must be up to 1200 and magnification up to 2"})
+	}
+	if req.WidthMM < 0 || req.WidthMM > 1000 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "width_mm must be up to 1000"})
+	}
+
 	if req.Title == "" {
-		req.Title = "Data Matrix Barcode"
+		req.Title = barcode.SymbologyName(req.Symbology) + " Barcode"
+	}
+
+	// Resolve the render options, which are stored with the code so
+	// downloads can regenerate it
+	encoded := req.Data
+	var options interface{}
+	if barcode.IsLinear(req.Symbology) {
+		// Validate first so bad data and check digits are reported as such
+		code, err := barcode.EncodeLinear(req.Symbology, req.Data, req.Checksum)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		encoded = code.Data
+		options = barcode.LinearOptions{
+			Checksum:      req.Checksum,
+			ModuleWidth:   req.ModuleWidth,
+			Height:        req.Height,
+			DPI:           req.DPI,
+			Magnification: req.Magnification,
+			HumanReadable: req.HumanReadable == nil || *req.HumanReadable,
+		}
+	} else {
+		version := 0
+		if req.MicroQRVersion != "" {
+			v, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(req.MicroQRVersion), "M"))
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "micro_qr_version must be M1, M2, M3 or M4"})
+			}
+			version = v
+		}
+		options = barcode.MatrixOptions{
+			ModuleSize:    req.ModuleSize,
+			QuietZone:     req.QuietZone,
+			DPI:           req.DPI,
+			WidthMM:       req.WidthMM,
+			Size:          strings.ToLower(strings.TrimSpace(req.Size)),
+			GS1:           req.GS1,
+			Columns:       req.Columns,
+			SecurityLevel: req.SecurityLevel,
+			Layers:        req.Layers,
+			ECPercent:     req.ECPercent,
+			Version:       version,
+			Level:         req.Level,
+		}
 	}
 
 	// Generate the barcode image
-	barcodeData, err := barcode.GenerateDataMatrix(barcode.DataMatrixOptions{
-		Data: req.Data,
-		Size: "auto",
-	})
+	barcodeData, size, err := generateBarcode(req.Symbology, encoded, options)
+	if errors.Is(err, barcode.ErrInvalidData) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to generate barcode: " + err.Error(),
 		})
 	}
 
-	// Ensure uploads directory exists
-	uploadsDir := "uploads"
-	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to create uploads directory: " + err.Error(),
-		})
-	}
-
-	// Create unique filename
-	filename := fmt.Sprintf("%d_datamatrix_barcode.png", time.Now().Unix())
-	filePath := filepath.Join(uploadsDir, filename)
-
 	// Save barcode file
-	if err := os.WriteFile(filePath, barcodeData, 0644); err != nil {
+	stored, err := upload.SaveBytes(req.Symbology+"_barcode.png", storageOwner(c), barcodeData, upload.TypePNG)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to save barcode file: " + err.Error(),
 		})
 	}
+	defer stored.Release()
 
 	// Create file reference in database
-	fileRef, err := database.DB.FileReference.Create().
-		SetFilename("datamatrix_barcode.png").
-		SetURL(fmt.Sprintf("/uploads/%s", filename)).
-		SetSize(int64(len(barcodeData))).
-		SetType("image/png").
-		Save(context.Background())
+	fileRef, err := createFileReference(context.Background(), stored)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "Failed to save file reference: " + err.Error(),
@@ -1228,13 +1541,20 @@ func CreateBarcodeQRCode(c *fiber.Ctx) error {
 	}
 
 	// Create QR code content
-	barcodeURL := fmt.Sprintf("/uploads/%s", filename)
+	barcodeURL := stored.URL
+	contentType := "barcode_2d"
+	if barcode.IsLinear(req.Symbology) {
+		contentType = "barcode_1d"
+	}
 	content := map[string]interface{}{
-		"type":        "barcode_2d",
-		"data":        req.Data,
+		"type":        contentType,
+		"symbology":   req.Symbology,
+		"data":        encoded,
+		"options":     options,
 		"url":         barcodeURL,
-		"filename":    "datamatrix_barcode.png",
+		"filename":    stored.Name,
 		"file_ref_id": fileRef.ID,
+		"size":        size,
 	}
 
 	// Create QR code in database
@@ -1259,13 +1579,15 @@ func CreateBarcodeQRCode(c *fiber.Ctx) error {
 			"error": "Failed to create QR code: " + err.Error(),
 		})
 	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
 
 	// Build response
 	response := fiber.Map{
 		"qr_code":        qr,
 		"file_reference": fileRef,
 		"barcode_url":    barcodeURL,
-		"message":        "Data Matrix barcode QR code created successfully",
+		"size":           size,
+		"message":        barcode.SymbologyName(req.Symbology) + " barcode QR code created successfully",
 	}
 
 	if shortURL != "" {
@@ -1276,6 +1598,112 @@ func CreateBarcodeQRCode(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
 
+// generateBarcode renders a barcode from its symbology, data and render
+// options, given as a barcode.LinearOptions or barcode.MatrixOptions or as
+// the JSON object stored in a barcode QR code's content
+func generateBarcode(symbology, data string, options interface{}) ([]byte, barcode.Size, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, barcode.Size{}, err
+	}
+	if barcode.IsLinear(symbology) {
+		var linear barcode.LinearOptions
+		if err := json.Unmarshal(raw, &linear); err != nil {
+			return nil, barcode.Size{}, err
+		}
+		linear.Symbology, linear.Data = symbology, data
+		return barcode.GenerateLinear(linear)
+	}
+	var matrix barcode.MatrixOptions
+	if err := json.Unmarshal(raw, &matrix); err != nil {
+		return nil, barcode.Size{}, err
+	}
+	matrix.Symbology, matrix.Data = symbology, data
+	return barcode.GenerateMatrix(matrix)
+}
+
+// barcodeDownloadQuery lists the render options a download may override,
+// with their upper bounds
+var barcodeDownloadQuery = map[string]float64{
+	"dpi":           1200,
+	"module_size":   40,
+	"width_mm":      1000,
+	"quiet_zone":    20,
+	"module_width":  20,
+	"height":        2000,
+	"magnification": 2,
+}
+
+// barcodeQuery returns the barcode render options given in the query
+func barcodeQuery(c *fiber.Ctx) map[string]string {
+	query := map[string]string{}
+	for name := range barcodeDownloadQuery {
+		if value := c.Query(name); value != "" {
+			query[name] = value
+		}
+	}
+	return query
+}
+
+// barcodeDownloadOptions returns a copy of a barcode's stored render
+// options with the overrides given in the query
+func barcodeDownloadOptions(query map[string]string, stored interface{}) (map[string]interface{}, error) {
+	options := map[string]interface{}{}
+	if m, ok := stored.(map[string]interface{}); ok {
+		for k, v := range m {
+			options[k] = v
+		}
+	}
+	for name, value := range query {
+		limit, ok := barcodeDownloadQuery[name]
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || v <= 0 || v > limit {
+			return nil, fmt.Errorf("%s must be a number greater than 0 and up to %g", name, limit)
+		}
+		options[name] = v
+	}
+	// An explicit module size replaces a stored physical width
+	if query["module_size"] != "" && query["width_mm"] == "" {
+		delete(options, "width_mm")
+	}
+	for _, name := range []string{"dpi", "module_size", "quiet_zone", "module_width", "height"} {
+		if v, ok := options[name].(float64); ok {
+			options[name] = int(v)
+		}
+	}
+	return options, nil
+}
+
+// isBarcode reports whether a QR code is rendered as a barcode: linear
+// and 2D barcodes, and GS1 product codes in GS1 DataMatrix format
+func isBarcode(qr *ent.QRCode) bool {
+	switch qr.Content["type"] {
+	case "barcode_1d", "barcode_2d":
+		return true
+	case string(model.QRTypeGS1):
+		return qr.Content["format"] == "datamatrix"
+	}
+	return false
+}
+
+// barcodeContent returns the symbology, data and render options of a
+// barcode QR code. Codes created before symbologies were selectable are
+// Data Matrix.
+func barcodeContent(content map[string]interface{}) (string, string, interface{}) {
+	symbology, _ := content["symbology"].(string)
+	if symbology == "" {
+		symbology = barcode.DataMatrix
+	}
+	data, _ := content["data"].(string)
+	if content["type"] == string(model.QRTypeGS1) {
+		data, _ = content["element_string"].(string)
+	}
+	return symbology, data, content["options"]
+}
+
 // GetQRCodeAnalytics retrieves analytics for a QR code
 func GetQRCodeAnalytics(c *fiber.Ctx) error {
 	id, err := c.ParamsInt("id")
@@ -1294,16 +1722,15 @@ func GetQRCodeAnalytics(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve analytics"})
 	}
 
-	// Calculate summary statistics
-	totalScans := len(analytics)
-	uniqueIPs := make(map[string]bool)
-	for _, record := range analytics {
-		uniqueIPs[record.IPAddress] = true
+	// Summary statistics include the daily rollups of purged records
+	summary, err := qranalytics.GetSummary(context.Background(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve analytics"})
 	}
 
 	return c.JSON(fiber.Map{
-		"total_scans":     totalScans,
-		"unique_visitors": len(uniqueIPs),
+		"total_scans":     summary.TotalScans,
+		"unique_visitors": summary.UniqueVisitors,
 		"records":         analytics,
 	})
 }
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+	qranalytics "qr_backend/internal/analytics"
+	"qr_backend/internal/database"
+	"qr_backend/internal/scanlimit"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// visitorCookie identifies a browser for per-visitor scan limits
+const visitorCookie = "qr_visitor"
+
+// scanLimitRequest holds the request fields that limit the scans of a code
+type scanLimitRequest struct {
+	MaxScans        *int   `json:"max_scans,omitempty"`
+	ScansPerVisitor *int   `json:"scans_per_visitor,omitempty"`
+	VisitorKey      string `json:"visitor_key,omitempty"`
+	EndedMessage    string `json:"ended_message,omitempty"`
+}
+
+// validate checks the limits and visitor key
+func (r *scanLimitRequest) validate() error {
+	if r.MaxScans != nil && *r.MaxScans < 1 {
+		return errors.New("max_scans must be at least 1")
+	}
+	if r.ScansPerVisitor != nil && *r.ScansPerVisitor < 1 {
+		return errors.New("scans_per_visitor must be at least 1")
+	}
+	if r.VisitorKey == "" {
+		r.VisitorKey = qrcode.DefaultVisitorKey.String()
+	}
+	if err := qrcode.VisitorKeyValidator(qrcode.VisitorKey(r.VisitorKey)); err != nil {
+		return errors.New("visitor_key must be cookie or fingerprint")
+	}
+	return nil
+}
+
+// redeemScan counts a scan of a code with scan limits. When the scan is
+// over a limit it responds with the offer ended page and returns false.
+func redeemScan(c *fiber.Ctx, qr *ent.QRCode) (bool, error) {
+	// Link previews and crawlers would use up one-time codes
+	if c.Method() == fiber.MethodHead || qranalytics.DeviceClass(c.Get("User-Agent")) == qranalytics.DeviceBot {
+		return false, c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "Scan-limited QR codes can only be opened by visitors"})
+	}
+
+	visitor := ""
+	if qr.ScansPerVisitor != nil {
+		id, err := visitorID(c, qr)
+		if err != nil {
+			return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to identify visitor"})
+		}
+		visitor = scanlimit.Visitor(qr.ID, id)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := scanlimit.Redeem(ctx, qr, visitor)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, scanlimit.ErrExhausted):
+		msg := qr.EndedMessage
+		if msg == "" {
+			msg = "This offer has ended."
+		}
+		return false, offerEnded(c, qr, msg)
+	case errors.Is(err, scanlimit.ErrVisitorLimit):
+		return false, offerEnded(c, qr, "You have already used this code.")
+	default:
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to redeem QR code"})
+	}
+}
+
+// visitorID returns the visitor's cookie, setting a new one on their first
+// scan, or their fingerprint for codes keyed by fingerprint
+func visitorID(c *fiber.Ctx, qr *ent.QRCode) (string, error) {
+	if qr.VisitorKey == qrcode.VisitorKeyFingerprint {
+		return "fingerprint:" + c.IP() + "\x00" + c.Get("User-Agent"), nil
+	}
+	id := c.Cookies(visitorCookie)
+	if id == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		id = hex.EncodeToString(b)
+		c.Cookie(&fiber.Cookie{
+			Name:     visitorCookie,
+			Value:    id,
+			Path:     "/",
+			MaxAge:   365 * 24 * 60 * 60,
+			Secure:   c.Protocol() == "https",
+			HTTPOnly: true,
+			SameSite: fiber.CookieSameSiteLaxMode,
+		})
+	}
+	return "cookie:" + id, nil
+}
+
+// offerEnded renders the page shown instead of a code whose scans are used up
+func offerEnded(c *fiber.Ctx, qr *ent.QRCode, msg string) error {
+	return c.Status(fiber.StatusGone).Render("ended", fiber.Map{
+		"Title":   qr.Title,
+		"Message": msg,
+	})
+}
+
+// GetQRCodeRedemptions returns the scan limits of a QR code and how many
+// scans it has left
+func GetQRCodeRedemptions(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+
+	qr, err := database.DB.QRCode.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
+	}
+	visitors, err := database.DB.QRCodeRedemption.Query().
+		Where(qrcoderedemption.QrCodeID(id)).
+		Count(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to count visitors"})
+	}
+
+	return c.JSON(fiber.Map{
+		"qr_code_id":        qr.ID,
+		"max_scans":         qr.MaxScans,
+		"scans_per_visitor": qr.ScansPerVisitor,
+		"visitor_key":       qr.VisitorKey,
+		"redeemed":          qr.Redeemed,
+		"remaining":         scanlimit.Remaining(qr),
+		"visitors":          visitors,
+		"exhausted":         qr.MaxScans != nil && qr.Redeemed >= *qr.MaxScans,
+	})
+}
+
+// ResetQRCodeRedemptions sets the scans of a QR code back to zero and
+// forgets its visitors, so its limits apply afresh
+func ResetQRCodeRedemptions(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+
+	ctx := context.Background()
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to reset redemptions"})
+	}
+	n, err := tx.QRCode.Update().Where(qrcode.IDEQ(id)).SetRedeemed(0).Save(ctx)
+	if err == nil && n > 0 {
+		_, err = tx.QRCodeRedemption.Delete().Where(qrcoderedemption.QrCodeID(id)).Exec(ctx)
+	}
+	if err != nil || n == 0 {
+		tx.Rollback()
+		if n == 0 && err == nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to reset redemptions"})
+	}
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to reset redemptions"})
+	}
+	return GetQRCodeRedemptions(c)
+}
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/color"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/database"
+	"qr_backend/pkg/barcode"
+	"qr_backend/pkg/labels"
+	qrgen "qr_backend/pkg/qrcode"
+
+	"github.com/gofiber/fiber/v2"
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// maxSheetLabels caps the number of labels in one PDF
+const maxSheetLabels = 2000
+
+// CreateLabelSheet renders QR codes, given by ID or group, onto sheets of
+// labels as a vector PDF. The layout is an Avery template or a grid of
+// rows by columns on A4 or Letter.
+func CreateLabelSheet(c *fiber.Ctx) error {
+	var req struct {
+		IDs       []int    `json:"ids"`
+		GroupID   int      `json:"group_id"`
+		Template  string   `json:"template"` // e.g. avery-l7160; overrides the grid
+		Paper     string   `json:"paper"`    // a4 (default) or letter
+		Columns   int      `json:"columns"`
+		Rows      int      `json:"rows"`
+		MarginMM  *float64 `json:"margin_mm"` // Default 10
+		GapMM     float64  `json:"gap_mm"`
+		BleedMM   float64  `json:"bleed_mm"`
+		CropMarks bool     `json:"crop_marks"`
+		Outline   bool     `json:"outline"` // Draw label outlines for test prints
+		Copies    int      `json:"copies"`  // Labels per code (default 1)
+		Skip      int      `json:"skip"`    // Labels already used on the first sheet
+	}
+
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+	if len(req.IDs) == 0 && req.GroupID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "ids or group_id is required"})
+	}
+	if req.Copies == 0 {
+		req.Copies = 1
+	}
+	if req.Copies < 1 || req.Copies > 100 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "copies must be 1 to 100"})
+	}
+
+	var layout labels.Layout
+	var err error
+	if req.Template != "" {
+		layout, err = labels.Template(req.Template)
+	} else {
+		if req.Paper == "" {
+			req.Paper = "a4"
+		}
+		margin := 10.0
+		if req.MarginMM != nil {
+			margin = *req.MarginMM
+		}
+		layout, err = labels.Grid(req.Paper, req.Columns, req.Rows, margin, req.GapMM)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	layout.Bleed = req.BleedMM
+	layout.CropMarks = req.CropMarks
+	layout.Outline = req.Outline
+
+	codes, status, msg := sheetQRCodes(req.IDs, req.GroupID)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	if len(codes)*req.Copies > maxSheetLabels {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": fmt.Sprintf("A sheet export can hold at most %d labels", maxSheetLabels)})
+	}
+
+	var sheet []labels.Label
+	for _, qr := range codes {
+		label, err := qrLabel(qr, c.BaseURL())
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": fmt.Sprintf("Failed to render QR code %d: %v", qr.ID, err)})
+		}
+		for i := 0; i < req.Copies; i++ {
+			sheet = append(sheet, label)
+		}
+	}
+
+	pdf, err := labels.Render(layout, sheet, req.Skip)
+	if errors.Is(err, labels.ErrInvalidLayout) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to render labels: " + err.Error()})
+	}
+
+	c.Set("Content-Type", "application/pdf")
+	c.Set("Content-Disposition", "attachment; filename=\"labels.pdf\"")
+	return c.Send(pdf)
+}
+
+// sheetQRCodes loads the listed QR codes in the order given, followed by
+// the codes of a group not already listed
+func sheetQRCodes(ids []int, groupID int) ([]*ent.QRCode, int, string) {
+	ctx := context.Background()
+	var codes []*ent.QRCode
+	seen := map[int]bool{}
+	if len(ids) > 0 {
+		found, err := database.DB.QRCode.Query().Where(qrcode.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fiber.StatusInternalServerError, "Failed to retrieve QR codes"
+		}
+		byID := map[int]*ent.QRCode{}
+		for _, qr := range found {
+			byID[qr.ID] = qr
+		}
+		for _, id := range ids {
+			qr, ok := byID[id]
+			if !ok {
+				return nil, fiber.StatusNotFound, fmt.Sprintf("QR code %d not found", id)
+			}
+			if !seen[id] {
+				codes = append(codes, qr)
+				seen[id] = true
+			}
+		}
+	}
+	if groupID != 0 {
+		found, err := database.DB.QRCode.Query().
+			Where(qrcode.GroupIDEQ(groupID)).
+			Order(ent.Asc(qrcode.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, fiber.StatusInternalServerError, "Failed to retrieve QR codes"
+		}
+		if len(found) == 0 {
+			return nil, fiber.StatusNotFound, "No QR codes found in the group"
+		}
+		for _, qr := range found {
+			if !seen[qr.ID] {
+				codes = append(codes, qr)
+				seen[qr.ID] = true
+			}
+		}
+	}
+	return codes, 0, ""
+}
+
+// qrLabel returns the label of a QR code: its modules, or the barcode image
+// for barcodes, with the title and the URL scans go through
+func qrLabel(qr *ent.QRCode, baseURL string) (labels.Label, error) {
+	label := labels.Label{Title: qr.Title}
+	if fg, ok := parseHexColor(qr.Design["foreground_color"]); ok {
+		label.Color = fg
+	}
+	if bg, ok := parseHexColor(qr.Design["background_color"]); ok {
+		label.Background = bg
+	}
+
+	if isBarcode(qr) {
+		symbology, data, stored := barcodeContent(qr.Content)
+		// 2D barcodes are drawn with one pixel per module and scaled
+		// without smoothing; linear ones keep their bars and text
+		options := map[string]interface{}{}
+		if m, ok := stored.(map[string]interface{}); ok {
+			for k, v := range m {
+				options[k] = v
+			}
+		}
+		if barcode.IsMatrix(symbology) {
+			delete(options, "width_mm")
+			options["module_size"] = 1
+		}
+		imageData, _, err := generateBarcode(symbology, data, options)
+		if err != nil {
+			return label, err
+		}
+		img, err := png.Decode(bytes.NewReader(imageData))
+		if err != nil {
+			return label, err
+		}
+		label.Image = img
+		if link, ok := qr.Content["digital_link"].(string); ok {
+			label.Caption = trimScheme(link)
+		}
+		return label, nil
+	}
+
+	data := qrData(qr, baseURL)
+	modules, err := qrgen.Modules(data, goqrcode.Medium)
+	if err != nil {
+		return label, err
+	}
+	label.Modules = modules
+	switch {
+	case qr.ShortURL != "":
+		label.Caption = trimScheme(fmt.Sprintf("%s/scan/%s", baseURL, qr.ShortURL))
+	case strings.HasPrefix(data, "http://") || strings.HasPrefix(data, "https://"):
+		label.Caption = trimScheme(data)
+	}
+	return label, nil
+}
+
+// trimScheme shortens a URL for printing by dropping its scheme
+func trimScheme(u string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(u, "https://"), "http://")
+}
+
+// parseHexColor reads a "#rrggbb" or "#rgb" design colour
+func parseHexColor(value interface{}) (color.Color, bool) {
+	s, _ := value.(string)
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return nil, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return nil, false
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, true
+}
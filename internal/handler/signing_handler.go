@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/signingkey"
+	"qr_backend/internal/database"
+	"qr_backend/internal/signing"
+	"qr_backend/pkg/qrsign"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// signingRequest holds the field that makes a code encode a signed payload:
+// "url" for a verification URL carrying the token, "embedded" for the token
+// itself
+type signingRequest struct {
+	Sign string `json:"sign,omitempty"`
+}
+
+// validate checks the signing mode, which cannot be combined with a
+// password since the payload carries the content in the clear
+func (r *signingRequest) validate(password bool) error {
+	if r.Sign == "" {
+		return nil
+	}
+	if err := qrcode.SigningValidator(qrcode.Signing(r.Sign)); err != nil {
+		return errors.New("sign must be url or embedded")
+	}
+	if password {
+		return errors.New("password-protected QR codes cannot be signed")
+	}
+	return nil
+}
+
+// signQRCode stores a freshly signed payload of a code
+func signQRCode(ctx context.Context, qr *ent.QRCode) (*ent.QRCode, error) {
+	token, err := signing.SignQRCode(ctx, qr)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Update().SetSignedToken(token).Save(ctx)
+}
+
+// signNewQRCode signs a code that was just created. When that fails the code
+// is deleted again, so no unsigned code is left behind.
+func signNewQRCode(ctx context.Context, qr *ent.QRCode) (*ent.QRCode, error) {
+	signed, err := signQRCode(ctx, qr)
+	if err != nil {
+		database.DB.QRCode.DeleteOneID(qr.ID).Exec(ctx)
+		return nil, err
+	}
+	return signed, nil
+}
+
+// SignQRCode signs a QR code's payload again with the active key, after a
+// rotation or revocation. A sign field in the body changes the mode.
+func SignQRCode(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid QR code ID"})
+	}
+	var req signingRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+		}
+	}
+
+	ctx := context.Background()
+	qr, err := database.DB.QRCode.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve QR code"})
+	}
+	if req.Sign == "" && qr.Signing == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "sign must be url or embedded"})
+	}
+	if err := req.validate(qr.PasswordHash != ""); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.Sign != "" {
+		if qr, err = qr.Update().SetSigning(qrcode.Signing(req.Sign)).Save(ctx); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update QR code"})
+		}
+	}
+	if qr, err = signQRCode(ctx, qr); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to sign QR code"})
+	}
+	return c.JSON(qr)
+}
+
+// ListSigningKeys lists the signing keys, newest first
+func ListSigningKeys(c *fiber.Ctx) error {
+	keys, err := database.DB.SigningKey.Query().
+		Order(ent.Desc(signingkey.FieldCreatedAt), ent.Desc(signingkey.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to list signing keys"})
+	}
+	return c.JSON(fiber.Map{"data": keys})
+}
+
+// RotateSigningKey creates a new active signing key. The previous key is
+// retired: it signs nothing new, but what it signed still verifies.
+func RotateSigningKey(c *fiber.Ctx) error {
+	key, err := signing.Rotate(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create signing key"})
+	}
+	return c.Status(fiber.StatusCreated).JSON(key)
+}
+
+// RevokeSigningKey revokes a signing key, so that the payloads it signed no
+// longer verify
+func RevokeSigningKey(c *fiber.Ctx) error {
+	key, err := signing.Revoke(context.Background(), c.Params("kid"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Signing key not found"})
+		}
+		if errors.Is(err, signing.ErrKeyRevoked) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to revoke signing key"})
+	}
+	return c.JSON(key)
+}
+
+// GetJWKS publishes the public keys of active and retired signing keys
+func GetJWKS(c *fiber.Ctx) error {
+	set, err := signing.JWKS(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to list signing keys"})
+	}
+	c.Set("Cache-Control", "public, max-age=300")
+	return c.JSON(set)
+}
+
+// verification is the outcome of checking a signed payload
+type verification struct {
+	Valid    bool           `json:"valid"`
+	Reason   string         `json:"reason,omitempty"`
+	KeyID    string         `json:"kid,omitempty"`
+	Claims   *qrsign.Claims `json:"claims,omitempty"`
+	IssuedAt *time.Time     `json:"issued_at,omitempty"`
+}
+
+// verifyPayload checks the signature and validity period of a scanned
+// payload, and whether it is the current payload of a QR code that is still
+// active. Payloads replaced by re-signing, and payloads whose code has
+// changed since, are rejected.
+func verifyPayload(ctx context.Context, payload string) (*verification, error) {
+	t, err := signing.Verify(ctx, qrsign.Extract(payload))
+	v := &verification{}
+	if t != nil {
+		issued := time.Unix(t.Claims.IssuedAt, 0).UTC()
+		v.KeyID, v.Claims, v.IssuedAt = t.KeyID, &t.Claims, &issued
+	}
+	switch {
+	case errors.Is(err, qrsign.ErrMalformed):
+		v.Reason = "This is not a signed QR code."
+	case errors.Is(err, qrsign.ErrUnknownKey), errors.Is(err, qrsign.ErrSignature):
+		v.Reason = "The signature is not genuine."
+	case errors.Is(err, signing.ErrRevoked):
+		v.Reason = "This code was signed with a revoked key."
+	case errors.Is(err, qrsign.ErrExpired):
+		v.Reason = "This code expired on " + time.Unix(t.Claims.Expires, 0).UTC().Format(time.RFC1123) + "."
+	case errors.Is(err, qrsign.ErrNotYetValid):
+		v.Reason = "This code is not valid until " + time.Unix(t.Claims.NotBefore, 0).UTC().Format(time.RFC1123) + "."
+	case err != nil:
+		return nil, err
+	}
+	if v.Reason != "" {
+		return v, nil
+	}
+
+	// The signature is genuine; the code must not have been withdrawn since
+	id, err := strconv.Atoi(t.Claims.Subject)
+	if err != nil {
+		v.Reason = "This is not a signed QR code."
+		return v, nil
+	}
+	qr, err := database.DB.QRCode.Get(ctx, id)
+	switch {
+	case ent.IsNotFound(err):
+		v.Reason = "This code has been withdrawn."
+	case err != nil:
+		return nil, err
+	case qr.Signing == nil:
+		v.Reason = "This code has been withdrawn."
+	case qr.SignedToken != qrsign.Extract(payload):
+		v.Reason = "This code has been replaced by a newer version."
+	case !signing.Matches(t.Claims, qr):
+		v.Reason = "This code has changed since it was signed."
+	case !qr.Active:
+		v.Reason = "This code has been deactivated."
+	default:
+		v.Valid = true
+	}
+	return v, nil
+}
+
+// VerifyPage checks the signed payload in the t parameter of a verification
+// URL. Browsers get a page, clients asking for JSON the verification.
+func VerifyPage(c *fiber.Ctx) error {
+	wantsJSON := c.Accepts(fiber.MIMETextHTML, fiber.MIMEApplicationJSON) == fiber.MIMEApplicationJSON
+	token := c.Query("t")
+	if token == "" {
+		if wantsJSON {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "t is required"})
+		}
+		return c.Status(fiber.StatusBadRequest).Render("verify", &verification{Reason: "No signed payload to verify."})
+	}
+
+	v, err := verifyPayload(context.Background(), token)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to verify payload"})
+	}
+	c.Set("Cache-Control", "no-store")
+	if wantsJSON {
+		return c.JSON(v)
+	}
+	return c.Render("verify", v)
+}
+
+// VerifyPayload checks scanned QR code data: a verification URL or an
+// embedded signed payload
+func VerifyPayload(c *fiber.Ctx) error {
+	var req struct {
+		Payload string `json:"payload"`
+	}
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+	if req.Payload == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "payload is required"})
+	}
+
+	v, err := verifyPayload(context.Background(), req.Payload)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to verify payload"})
+	}
+	return c.JSON(v)
+}
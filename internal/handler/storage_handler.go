@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"qr_backend/internal/upload"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetStorageUsage returns the bytes stored by the requesting owner and their quota
+func GetStorageUsage(c *fiber.Ctx) error {
+	usage, err := upload.CurrentUsage(context.Background(), storageOwner(c))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to compute storage usage"})
+	}
+	return c.JSON(usage)
+}
+
+// storageOwner returns whose storage quota a request's uploads count
+// towards: the value of the configured owner header, or "" for the
+// workspace when none is configured. The header is set by an authenticating
+// proxy, so it is ignored on requests that did not come through a trusted
+// proxy; their uploads are refused while quotas apply.
+func storageOwner(c *fiber.Ctx) string {
+	if header := upload.OwnerHeader(); header != "" && c.IsProxyTrusted() {
+		return strings.TrimSpace(c.Get(header))
+	}
+	return ""
+}
+
+// CollectStorageGarbage removes orphaned file references and stored files
+// right away instead of waiting for the background job. With
+// ?dry_run=true it only reports what would be removed.
+func CollectStorageGarbage(c *fiber.Ctx) error {
+	report, err := upload.CollectGarbage(context.Background(), upload.GCGrace(), c.QueryBool("dry_run"))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(report)
+}
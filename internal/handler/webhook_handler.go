@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"qr_backend/ent"
+	"qr_backend/ent/predicate"
+	entwebhook "qr_backend/ent/webhook"
+	"qr_backend/ent/webhookdelivery"
+	"qr_backend/internal/database"
+	"qr_backend/internal/webhook"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// webhookRequest is the body accepted when creating or updating a webhook
+type webhookRequest struct {
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	QRCodeID    *int     `json:"qr_code_id,omitempty"`
+	Secret      string   `json:"secret,omitempty"`
+}
+
+// validate checks the endpoint URL and the subscribed events
+func (r *webhookRequest) validate() string {
+	u, err := url.Parse(r.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "url must be an absolute http or https URL"
+	}
+	// Host names are checked again on every delivery, once resolved
+	if !webhook.AllowPrivate() {
+		host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+		addr, err := netip.ParseAddr(host)
+		if host == "localhost" || strings.HasSuffix(host, ".localhost") || (err == nil && !webhook.PublicAddr(addr)) {
+			return "url must point at a public address"
+		}
+	}
+	if len(r.Events) == 0 {
+		return "At least one event is required"
+	}
+	for _, event := range r.Events {
+		if !webhook.ValidEvent(event) {
+			return "Unknown event: " + event
+		}
+	}
+	return ""
+}
+
+// CreateWebhook registers a webhook for the whole workspace or a single QR code.
+// The signing secret is only returned by this call.
+func CreateWebhook(c *fiber.Ctx) error {
+	var req webhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+	if msg := req.validate(); msg != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": msg})
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		secret, err = webhook.GenerateSecret()
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to generate webhook secret"})
+		}
+	}
+
+	builder := database.DB.Webhook.Create().
+		SetURL(req.URL).
+		SetEvents(req.Events).
+		SetSecret(secret).
+		SetNillableQrCodeID(req.QRCodeID)
+	if req.Description != "" {
+		builder.SetDescription(req.Description)
+	}
+	if req.Active != nil {
+		builder.SetActive(*req.Active)
+	}
+
+	hook, err := builder.Save(context.Background())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to create webhook"})
+	}
+	webhook.Invalidate()
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"webhook": hook,
+		"secret":  secret,
+	})
+}
+
+// ListWebhooks lists webhooks, optionally only those of one QR code
+func ListWebhooks(c *fiber.Ctx) error {
+	query := database.DB.Webhook.Query().Order(ent.Desc(entwebhook.FieldCreatedAt))
+	if qrID := c.QueryInt("qr_code_id", 0); qrID != 0 {
+		query.Where(entwebhook.QrCodeID(qrID))
+	}
+
+	hooks, err := query.All(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve webhooks"})
+	}
+	return c.JSON(fiber.Map{"data": hooks})
+}
+
+// GetWebhookQueueStats returns the webhook event queue metrics
+func GetWebhookQueueStats(c *fiber.Ctx) error {
+	return c.JSON(webhook.Stats())
+}
+
+// GetWebhook retrieves a webhook
+func GetWebhook(c *fiber.Ctx) error {
+	hook, status, msg := findWebhook(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	return c.JSON(hook)
+}
+
+// UpdateWebhook replaces the URL, events and settings of a webhook
+func UpdateWebhook(c *fiber.Ctx) error {
+	hook, status, msg := findWebhook(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	var req webhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid input"})
+	}
+	if msg := req.validate(); msg != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": msg})
+	}
+
+	update := hook.Update().
+		SetURL(req.URL).
+		SetEvents(req.Events).
+		SetDescription(req.Description)
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+	if req.QRCodeID != nil {
+		update.SetQrCodeID(*req.QRCodeID)
+	} else {
+		update.ClearQrCodeID()
+	}
+	if req.Secret != "" {
+		update.SetSecret(req.Secret)
+	}
+
+	hook, err := update.Save(context.Background())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "QR code not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to update webhook"})
+	}
+	webhook.Invalidate()
+
+	return c.JSON(hook)
+}
+
+// DeleteWebhook deletes a webhook together with its delivery log
+func DeleteWebhook(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid webhook ID"})
+	}
+
+	if err := database.DB.Webhook.DeleteOneID(id).Exec(context.Background()); err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Webhook not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to delete webhook"})
+	}
+	webhook.Invalidate()
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ListWebhookDeliveries returns the delivery log of a webhook, newest first,
+// optionally filtered by ?status=pending|succeeded|dead
+func ListWebhookDeliveries(c *fiber.Ctx) error {
+	hook, status, msg := findWebhook(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+	return listDeliveries(c, webhookdelivery.WebhookID(hook.ID))
+}
+
+// ListDeadLetters returns the deliveries of all webhooks that ran out of attempts
+func ListDeadLetters(c *fiber.Ctx) error {
+	return listDeliveries(c, webhookdelivery.StatusEQ(webhookdelivery.StatusDead))
+}
+
+// listDeliveries returns a page of deliveries matching the predicate, newest first
+func listDeliveries(c *fiber.Ctx, where predicate.WebhookDelivery) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 50)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	query := database.DB.WebhookDelivery.Query().Where(where)
+	if status := c.Query("status"); status != "" {
+		if err := webhookdelivery.StatusValidator(webhookdelivery.Status(status)); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid status"})
+		}
+		query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to count deliveries"})
+	}
+
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		Offset((page - 1) * limit).
+		All(context.Background())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retrieve deliveries"})
+	}
+
+	return c.JSON(fiber.Map{
+		"data": deliveries,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// RetryWebhookDelivery moves a delivery back to the pending queue
+func RetryWebhookDelivery(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid delivery ID"})
+	}
+
+	delivery, err := webhook.Redeliver(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Delivery not found"})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to retry delivery"})
+	}
+	return c.JSON(delivery)
+}
+
+// SendTestWebhook sends a test event to a webhook and returns the delivery result
+func SendTestWebhook(c *fiber.Ctx) error {
+	hook, status, msg := findWebhook(c)
+	if status != 0 {
+		return c.Status(status).JSON(fiber.Map{"error": msg})
+	}
+
+	delivery, err := webhook.SendTest(context.Background(), hook)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to send test event"})
+	}
+	return c.JSON(delivery)
+}
+
+// findWebhook loads the webhook named by the :id parameter. On failure it
+// returns the HTTP status and error message to respond with.
+func findWebhook(c *fiber.Ctx) (*ent.Webhook, int, string) {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return nil, fiber.StatusBadRequest, "Invalid webhook ID"
+	}
+
+	hook, err := database.DB.Webhook.Get(context.Background(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fiber.StatusNotFound, "Webhook not found"
+		}
+		return nil, fiber.StatusInternalServerError, "Failed to retrieve webhook"
+	}
+	return hook, 0, ""
+}
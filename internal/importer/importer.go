@@ -0,0 +1,653 @@
+// Package importer creates QR codes in bulk from CSV or NDJSON files. Every
+// row is validated before any code is created.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcodegroup"
+	"qr_backend/internal/database"
+	"qr_backend/internal/signing"
+	"qr_backend/internal/webhook"
+	"qr_backend/pkg/schedule"
+	"qr_backend/pkg/shorturl"
+)
+
+// Fields rows can be mapped to. Content and design values are mapped with a
+// prefix, such as content.url or design.foreground_color; NDJSON objects may
+// also give whole content and design objects.
+var fields = map[string]bool{
+	"type":              true, // static (default) or dynamic
+	"title":             true,
+	"description":       true,
+	"redirect_url":      true,
+	"content":           true,
+	"design":            true,
+	"tags":              true, // Separated by "|" or ","
+	"group":             true, // Group ID or name; unknown names are created
+	"expires_at":        true, // RFC 3339 or YYYY-MM-DD
+	"starts_at":         true,
+	"schedule":          true, // Object, or its JSON text in CSV
+	"fallback_url":      true,
+	"fallback_message":  true,
+	"max_scans":         true,
+	"scans_per_visitor": true,
+	"visitor_key":       true, // cookie (default) or fingerprint
+	"ended_message":     true,
+	"sign":              true, // url or embedded, for a signed payload
+	"active":            true, // Default true
+	"analytics":         true,
+}
+
+// designShapes are the module shapes a design may ask for
+var designShapes = map[string]bool{"square": true, "rounded": true, "circular": true}
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Mapping maps column names to fields. Columns named after a field map to
+// it unless mapped otherwise; columns mapped to "" are ignored.
+type Mapping map[string]string
+
+// Validate rejects mappings to unknown fields
+func (m Mapping) Validate() error {
+	for column, field := range m {
+		if field != "" && !validField(field) {
+			return fmt.Errorf("column %q is mapped to unknown field %q", column, field)
+		}
+	}
+	return nil
+}
+
+// field returns the field a column is mapped to, if any
+func (m Mapping) field(column string) string {
+	if field, ok := m[column]; ok {
+		return field
+	}
+	if validField(column) {
+		return column
+	}
+	return ""
+}
+
+func validField(field string) bool {
+	if prefix, key, ok := strings.Cut(field, "."); ok {
+		return (prefix == "content" || prefix == "design") && key != ""
+	}
+	return fields[field]
+}
+
+// Spec is a validated row, ready to be created
+type Spec struct {
+	Line            int                    `json:"line"`
+	Title           string                 `json:"title"`
+	Description     string                 `json:"description,omitempty"`
+	RedirectURL     string                 `json:"redirect_url,omitempty"`
+	Dynamic         bool                   `json:"dynamic"`
+	Active          bool                   `json:"active"`
+	Analytics       bool                   `json:"analytics"`
+	Content         map[string]interface{} `json:"content"`
+	Design          map[string]interface{} `json:"design,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+	GroupID         *int                   `json:"group_id,omitempty"`
+	GroupName       string                 `json:"group,omitempty"` // Created on import
+	ExpiresAt       *time.Time             `json:"expires_at,omitempty"`
+	StartsAt        *time.Time             `json:"starts_at,omitempty"`
+	Schedule        *schedule.Schedule     `json:"schedule,omitempty"`
+	FallbackURL     string                 `json:"fallback_url,omitempty"`
+	FallbackMessage string                 `json:"fallback_message,omitempty"`
+	MaxScans        *int                   `json:"max_scans,omitempty"`
+	ScansPerVisitor *int                   `json:"scans_per_visitor,omitempty"`
+	VisitorKey      string                 `json:"visitor_key,omitempty"`
+	EndedMessage    string                 `json:"ended_message,omitempty"`
+	Sign            string                 `json:"sign,omitempty"`
+}
+
+// RowError is a problem with one row of an import file
+type RowError struct {
+	Line   int    `json:"line"`
+	Field  string `json:"field,omitempty"`
+	Column string `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// Plan is the outcome of validating an import file
+type Plan struct {
+	Specs     []Spec     `json:"-"`
+	Rows      int        `json:"rows"`
+	Valid     int        `json:"valid"`
+	Errors    []RowError `json:"errors"`
+	NewGroups []string   `json:"new_groups"` // Group names that do not exist yet
+	JobID     int        `json:"-"`          // Background job creating the plan, whose rows are created once across retries
+}
+
+// Validate maps and validates every record. Groups are looked up by ID or
+// name; names that do not exist yet are listed to be created.
+func Validate(ctx context.Context, records []Record, mapping Mapping) (*Plan, error) {
+	plan := &Plan{Rows: len(records), Errors: []RowError{}, NewGroups: []string{}}
+	groups := newGroupCache()
+	newGroups := map[string]bool{}
+
+	for _, record := range records {
+		spec, errs := buildSpec(record, mapping)
+		if spec.GroupName != "" {
+			id, found, err := groups.resolve(ctx, spec.GroupName)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case found:
+				spec.GroupID, spec.GroupName = &id, ""
+			case isNumber(spec.GroupName):
+				errs = append(errs, RowError{Line: record.Line, Field: "group", Error: "group " + spec.GroupName + " does not exist"})
+			case !newGroups[spec.GroupName]:
+				newGroups[spec.GroupName] = true
+				plan.NewGroups = append(plan.NewGroups, spec.GroupName)
+			}
+		}
+		if len(errs) > 0 {
+			plan.Errors = append(plan.Errors, errs...)
+			continue
+		}
+		plan.Specs = append(plan.Specs, spec)
+	}
+	plan.Valid = len(plan.Specs)
+	return plan, nil
+}
+
+// buildSpec maps one record onto a spec and checks its values
+func buildSpec(record Record, mapping Mapping) (Spec, []RowError) {
+	spec := Spec{Line: record.Line, Active: true, Content: map[string]interface{}{}}
+	var errs []RowError
+	columnOf := map[string]string{} // Column each field was read from
+	fail := func(column, field, msg string) {
+		if column == "" {
+			column = columnOf[field]
+		}
+		errs = append(errs, RowError{Line: record.Line, Field: field, Column: column, Error: msg})
+	}
+
+	// Columns are applied in name order so errors are reported stably
+	columns := make([]string, 0, len(record.Values))
+	for column := range record.Values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		field := mapping.field(column)
+		value := record.Values[column]
+		if field == "" || isEmpty(value) {
+			continue
+		}
+		columnOf[field] = column
+		if prefix, key, ok := strings.Cut(field, "."); ok {
+			target := spec.Content
+			if prefix == "design" {
+				if spec.Design == nil {
+					spec.Design = map[string]interface{}{}
+				}
+				target = spec.Design
+			}
+			target[key] = scalar(value)
+			continue
+		}
+
+		switch field {
+		case "type":
+			switch strings.ToLower(text(value)) {
+			case "static":
+				spec.Dynamic = false
+			case "dynamic":
+				spec.Dynamic = true
+			default:
+				fail(column, field, "type must be static or dynamic")
+			}
+		case "title":
+			spec.Title = text(value)
+		case "description":
+			spec.Description = text(value)
+		case "redirect_url":
+			spec.RedirectURL = text(value)
+		case "content", "design":
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				fail(column, field, field+" must be an object; map columns to "+field+".<key> instead")
+				continue
+			}
+			if field == "design" && spec.Design == nil {
+				spec.Design = map[string]interface{}{}
+			}
+			for k, v := range object {
+				if field == "content" {
+					spec.Content[k] = v
+				} else {
+					spec.Design[k] = v
+				}
+			}
+		case "tags":
+			spec.Tags = append(spec.Tags, splitTags(value)...)
+		case "group":
+			spec.GroupName = text(value)
+		case "expires_at", "starts_at":
+			t, err := parseTime(text(value))
+			if err != nil {
+				fail(column, field, field+" "+err.Error())
+				continue
+			}
+			if field == "expires_at" {
+				spec.ExpiresAt = &t
+			} else {
+				spec.StartsAt = &t
+			}
+		case "schedule":
+			sched, err := parseSchedule(value)
+			if err != nil {
+				fail(column, field, err.Error())
+				continue
+			}
+			spec.Schedule = sched
+		case "fallback_url":
+			spec.FallbackURL = text(value)
+		case "fallback_message":
+			spec.FallbackMessage = text(value)
+		case "max_scans", "scans_per_visitor":
+			n, err := strconv.Atoi(text(value))
+			if err != nil || n < 1 {
+				fail(column, field, field+" must be a whole number of at least 1")
+				continue
+			}
+			if field == "max_scans" {
+				spec.MaxScans = &n
+			} else {
+				spec.ScansPerVisitor = &n
+			}
+		case "visitor_key":
+			spec.VisitorKey = strings.ToLower(text(value))
+			if qrcode.VisitorKeyValidator(qrcode.VisitorKey(spec.VisitorKey)) != nil {
+				fail(column, field, "visitor_key must be cookie or fingerprint")
+			}
+		case "ended_message":
+			spec.EndedMessage = text(value)
+		case "sign":
+			spec.Sign = strings.ToLower(text(value))
+			if qrcode.SigningValidator(qrcode.Signing(spec.Sign)) != nil {
+				fail(column, field, "sign must be url or embedded")
+			}
+		case "active", "analytics":
+			b, err := parseBool(value)
+			if err != nil {
+				fail(column, field, field+" must be true or false")
+				continue
+			}
+			if field == "active" {
+				spec.Active = b
+			} else {
+				spec.Analytics = b
+			}
+		}
+	}
+
+	if spec.Title == "" {
+		fail("", "title", "title is required")
+	}
+	if len(spec.Content) == 0 {
+		fail("", "content", "at least one content field is required")
+	}
+	if u, ok := spec.Content["url"].(string); ok && !isHTTPURL(u) {
+		fail("", "content.url", "content.url must be an http or https URL")
+	}
+	if spec.RedirectURL != "" && !isHTTPURL(spec.RedirectURL) {
+		fail("", "redirect_url", "redirect_url must be an http or https URL")
+	}
+	if spec.Dynamic {
+		// Dynamic codes always count their scans
+		spec.Analytics = true
+		if spec.RedirectURL == "" && spec.Content["url"] == nil {
+			fail("", "content.url", "dynamic codes need content.url or redirect_url")
+		}
+	}
+	if spec.ExpiresAt != nil && !spec.ExpiresAt.After(time.Now()) {
+		fail("", "expires_at", "expires_at is in the past")
+	}
+	if spec.StartsAt != nil && spec.ExpiresAt != nil && !spec.StartsAt.Before(*spec.ExpiresAt) {
+		fail("", "starts_at", "starts_at must be before expires_at")
+	}
+	if spec.FallbackURL != "" && !isHTTPURL(spec.FallbackURL) {
+		fail("", "fallback_url", "fallback_url must be an http or https URL")
+	}
+	for _, key := range []string{"foreground_color", "background_color"} {
+		if v, ok := spec.Design[key]; ok && !hexColor.MatchString(text(v)) {
+			fail("", "design."+key, "design."+key+" must be a hex colour such as #000000")
+		}
+	}
+	if v, ok := spec.Design["shape"]; ok && !designShapes[text(v)] {
+		fail("", "design.shape", "design.shape must be square, rounded or circular")
+	}
+	if v, ok := spec.Design["logo_size"]; ok {
+		n, err := strconv.Atoi(text(v))
+		if err != nil || n < 0 {
+			fail("", "design.logo_size", "design.logo_size must be a whole number")
+		} else {
+			spec.Design["logo_size"] = n
+		}
+	}
+	return spec, errs
+}
+
+// Result is the outcome of creating one row
+type Result struct {
+	Line     int    `json:"line"`
+	ID       int    `json:"id,omitempty"`
+	ShortURL string `json:"short_url,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Create creates the groups a plan needs and then its QR codes, one row at
+// a time, calling progress after each. It stops early when ctx is done.
+func Create(ctx context.Context, plan *Plan, progress func(Result)) error {
+	// Groups may exist already when a background import is retried
+	groupIDs := map[string]int{}
+	for _, name := range plan.NewGroups {
+		group, err := database.DB.QRCodeGroup.Query().Where(qrcodegroup.NameEQ(name)).First(ctx)
+		if ent.IsNotFound(err) {
+			group, err = database.DB.QRCodeGroup.Create().SetName(name).Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create group %q: %w", name, err)
+		}
+		groupIDs[name] = group.ID
+	}
+
+	for _, spec := range plan.Specs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if spec.GroupName != "" {
+			id := groupIDs[spec.GroupName]
+			spec.GroupID = &id
+		}
+		// A row being created is finished and reported even if ctx is
+		// cancelled meanwhile, so a retried import does not create it twice
+		importKey := ""
+		if plan.JobID != 0 {
+			importKey = fmt.Sprintf("%d:%d", plan.JobID, spec.Line)
+		}
+		qr, err := createQRCode(context.WithoutCancel(ctx), spec, importKey)
+		if err != nil {
+			progress(Result{Line: spec.Line, Error: err.Error()})
+			continue
+		}
+		progress(Result{Line: spec.Line, ID: qr.ID, ShortURL: qr.ShortURL})
+	}
+	return nil
+}
+
+// createQRCode creates one code the way the create endpoint does: dynamic
+// codes and static codes with analytics get a short URL. A code with an
+// import key is created once; when an earlier attempt of the import already
+// created it, that code is returned.
+func createQRCode(ctx context.Context, spec Spec, importKey string) (*ent.QRCode, error) {
+	if importKey != "" {
+		qr, err := findImported(ctx, importKey)
+		if err == nil {
+			return qr, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, errors.New("failed to check for an earlier import")
+		}
+	}
+
+	qrType := "static"
+	if spec.Dynamic {
+		qrType = "dynamic"
+	}
+	builder := database.DB.QRCode.Create().
+		SetType(qrType).
+		SetTitle(spec.Title).
+		SetContent(spec.Content).
+		SetAnalytics(spec.Analytics).
+		SetActive(spec.Active)
+	if spec.Analytics {
+		shortURL, err := shorturl.Generate()
+		if err != nil {
+			return nil, errors.New("failed to generate short URL")
+		}
+		builder.SetShortURL(shortURL)
+	}
+	if spec.Description != "" {
+		builder.SetDescription(spec.Description)
+	}
+	if spec.RedirectURL != "" {
+		builder.SetRedirectURL(spec.RedirectURL)
+	}
+	if spec.ExpiresAt != nil {
+		builder.SetExpiresAt(*spec.ExpiresAt)
+	}
+	if spec.StartsAt != nil {
+		builder.SetStartsAt(*spec.StartsAt)
+	}
+	if spec.Schedule != nil {
+		builder.SetSchedule(spec.Schedule)
+	}
+	if spec.FallbackURL != "" {
+		builder.SetFallbackURL(spec.FallbackURL)
+	}
+	if spec.FallbackMessage != "" {
+		builder.SetFallbackMessage(spec.FallbackMessage)
+	}
+	if spec.MaxScans != nil {
+		builder.SetMaxScans(*spec.MaxScans)
+	}
+	if spec.ScansPerVisitor != nil {
+		builder.SetScansPerVisitor(*spec.ScansPerVisitor)
+	}
+	if spec.VisitorKey != "" {
+		builder.SetVisitorKey(qrcode.VisitorKey(spec.VisitorKey))
+	}
+	if spec.EndedMessage != "" {
+		builder.SetEndedMessage(spec.EndedMessage)
+	}
+	if spec.Sign != "" {
+		builder.SetSigning(qrcode.Signing(spec.Sign))
+	}
+	if len(spec.Tags) > 0 {
+		builder.SetTags(spec.Tags)
+	}
+	if len(spec.Design) > 0 {
+		builder.SetDesign(spec.Design)
+	}
+	if spec.GroupID != nil {
+		builder.SetGroupID(*spec.GroupID)
+	}
+	if importKey != "" {
+		builder.SetImportKey(importKey)
+	}
+
+	var qr *ent.QRCode
+	err := database.RetryLocked(ctx, func() (err error) {
+		qr, err = builder.Save(ctx)
+		return err
+	})
+	if ent.IsConstraintError(err) && importKey != "" {
+		// Another attempt of the same job created the row meanwhile
+		if existing, findErr := findImported(ctx, importKey); findErr == nil {
+			return existing, nil
+		}
+	}
+	if err != nil {
+		return nil, errors.New("failed to create QR code")
+	}
+	if qr.Signing != nil {
+		token, err := signing.SignQRCode(ctx, qr)
+		var signed *ent.QRCode
+		if err == nil {
+			signed, err = qr.Update().SetSignedToken(token).Save(ctx)
+		}
+		if err != nil {
+			// Leave no unsigned code behind
+			database.DB.QRCode.DeleteOneID(qr.ID).Exec(ctx)
+			return nil, errors.New("failed to sign QR code")
+		}
+		qr = signed
+	}
+	webhook.Emit(webhook.EventCreated, qr, nil)
+	return qr, nil
+}
+
+// findImported returns the code created for an import key. Overlapping
+// attempts of a job look it up while another one writes.
+func findImported(ctx context.Context, importKey string) (qr *ent.QRCode, err error) {
+	err = database.RetryLocked(ctx, func() error {
+		qr, err = database.DB.QRCode.Query().Where(qrcode.ImportKey(importKey)).Only(ctx)
+		return err
+	})
+	return qr, err
+}
+
+// groupCache resolves group IDs and names once per import
+type groupCache struct {
+	ids map[string]int
+}
+
+func newGroupCache() *groupCache {
+	return &groupCache{ids: map[string]int{}}
+}
+
+// resolve finds a group by ID, when value is a number, or by name
+func (g *groupCache) resolve(ctx context.Context, value string) (int, bool, error) {
+	if id, ok := g.ids[value]; ok {
+		return id, id != 0, nil
+	}
+	query := database.DB.QRCodeGroup.Query().Where(qrcodegroup.NameEQ(value))
+	if id, err := strconv.Atoi(value); err == nil {
+		query = database.DB.QRCodeGroup.Query().Where(qrcodegroup.IDEQ(id))
+	}
+	group, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		g.ids[value] = 0
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to look up group %q: %w", value, err)
+	}
+	g.ids[value] = group.ID
+	return group.ID, true, nil
+}
+
+// isEmpty reports whether a value was left blank
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	return false
+}
+
+// text returns a value as trimmed text
+func text(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
+
+// scalar returns a content or design value: NDJSON values as given, with
+// numbers converted from json.Number, and CSV text trimmed
+func scalar(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+func splitTags(value interface{}) []string {
+	var raw []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			raw = append(raw, text(item))
+		}
+	default:
+		raw = strings.FieldsFunc(text(value), func(r rune) bool { return r == '|' || r == ',' })
+	}
+	var tags []string
+	for _, tag := range raw {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("must be an RFC 3339 time or a YYYY-MM-DD date")
+}
+
+// parseSchedule reads a schedule object, or its JSON text
+func parseSchedule(value interface{}) (*schedule.Schedule, error) {
+	data, ok := value.(string)
+	if !ok {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.New("schedule must be an object")
+		}
+		data = string(encoded)
+	}
+	var sched schedule.Schedule
+	if err := json.Unmarshal([]byte(data), &sched); err != nil {
+		return nil, errors.New("schedule must be a JSON object with timezone and windows")
+	}
+	if err := sched.Validate(); err != nil {
+		return nil, err
+	}
+	return &sched, nil
+}
+
+func parseBool(value interface{}) (bool, error) {
+	if b, ok := value.(bool); ok {
+		return b, nil
+	}
+	switch strings.ToLower(text(value)) {
+	case "true", "yes", "y", "1":
+		return true, nil
+	case "false", "no", "n", "0":
+		return false, nil
+	}
+	return false, errors.New("not a boolean")
+}
+
+func isNumber(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
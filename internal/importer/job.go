@@ -0,0 +1,69 @@
+package importer
+
+import (
+	"context"
+
+	"qr_backend/ent"
+	"qr_backend/internal/jobs"
+)
+
+// JobType is the type of background import jobs
+const JobType = "import"
+
+// jobPayload is the validated import a job creates
+type jobPayload struct {
+	Specs     []Spec   `json:"specs"`
+	NewGroups []string `json:"new_groups"`
+}
+
+// JobResult is the result of an import job, saved as it progresses
+type JobResult struct {
+	Created int      `json:"created"`
+	Failed  int      `json:"failed"`
+	Results []Result `json:"results"`
+}
+
+// Register makes the job workers run background imports
+func Register() {
+	jobs.Register(JobType, runJob)
+}
+
+// Enqueue queues a validated import to run in the background
+func Enqueue(ctx context.Context, plan *Plan) (*ent.Job, error) {
+	return jobs.Enqueue(ctx, JobType, jobPayload{Specs: plan.Specs, NewGroups: plan.NewGroups}, len(plan.Specs))
+}
+
+// runJob creates the codes of an import job. A retried job skips the rows
+// an earlier attempt recorded in its result, and finds the codes of rows it
+// created after the result was last saved by their import key.
+func runJob(ctx context.Context, run *jobs.Run) error {
+	var payload jobPayload
+	if err := run.Decode(&payload); err != nil {
+		return jobs.Permanent(err)
+	}
+	var result JobResult
+	run.Result(&result)
+	done := map[int]bool{}
+	for _, r := range result.Results {
+		done[r.Line] = true
+	}
+
+	plan := &Plan{NewGroups: payload.NewGroups, JobID: run.ID}
+	for _, spec := range payload.Specs {
+		if !done[spec.Line] {
+			plan.Specs = append(plan.Specs, spec)
+		}
+	}
+
+	total := len(payload.Specs)
+	run.SetResult(&result)
+	return Create(ctx, plan, func(r Result) {
+		result.Results = append(result.Results, r)
+		if r.Error != "" {
+			result.Failed++
+		} else {
+			result.Created++
+		}
+		run.Progress(ctx, len(result.Results), total)
+	})
+}
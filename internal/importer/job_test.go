@@ -0,0 +1,183 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"qr_backend/ent/job"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/internal/jobs"
+)
+
+func connect(t *testing.T) {
+	t.Helper()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(t.TempDir(), "test.db")},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+}
+
+func testPlan(rows int) *Plan {
+	plan := &Plan{NewGroups: []string{"Imported"}}
+	for line := 2; line < rows+2; line++ {
+		plan.Specs = append(plan.Specs, Spec{
+			Line:        line,
+			Title:       fmt.Sprintf("Row %d", line),
+			RedirectURL: fmt.Sprintf("https://example.com/%d", line),
+			Dynamic:     true,
+			Active:      true,
+			Content:     map[string]interface{}{"url": fmt.Sprintf("https://example.com/%d", line)},
+			GroupName:   "Imported",
+		})
+	}
+	return plan
+}
+
+func TestRetriedImportCreatesEachRowOnce(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	const rows = 30
+	plan := testPlan(rows)
+
+	j, err := Enqueue(ctx, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first attempt creates 12 rows, but its last saved progress only
+	// lists 5 of them when its worker stops
+	first := &Plan{Specs: plan.Specs[:12], NewGroups: plan.NewGroups, JobID: j.ID}
+	var stale JobResult
+	err = Create(ctx, first, func(r Result) {
+		if r.Error != "" {
+			t.Errorf("line %d: %s", r.Line, r.Error)
+		}
+		if len(stale.Results) < 5 {
+			stale.Results = append(stale.Results, r)
+			stale.Created++
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, _ := json.Marshal(stale)
+	err = database.DB.Job.UpdateOneID(j.ID).
+		SetStatus(job.StatusFailed).
+		SetAttempts(1).
+		SetProcessed(len(stale.Results)).
+		SetResult(saved).
+		SetLastError("worker stopped responding").
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Retry the job on the workers
+	Register()
+	if _, err := jobs.Retry(ctx, j.ID); err != nil {
+		t.Fatal(err)
+	}
+	jobs.Start(ctx, config.JobsConfig{Workers: 2, PollInterval: 20 * time.Millisecond})
+	defer jobs.Shutdown(ctx)
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		j, err = database.DB.Job.Get(ctx, j.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j.Status == job.StatusSucceeded || j.Status == job.StatusFailed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job still %s", j.Status)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if j.Status != job.StatusSucceeded {
+		t.Fatalf("job %s: %v", j.Status, j.LastError)
+	}
+
+	// Every line has exactly one code, which the result reports
+	var result JobResult
+	if err := json.Unmarshal(j.Result, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != rows || result.Failed != 0 || len(result.Results) != rows {
+		t.Errorf("result: %d created, %d failed, %d results; want %d created", result.Created, result.Failed, len(result.Results), rows)
+	}
+	reported := map[int]int{}
+	for _, r := range result.Results {
+		if _, ok := reported[r.Line]; ok {
+			t.Errorf("line %d reported twice", r.Line)
+		}
+		reported[r.Line] = r.ID
+	}
+	for _, spec := range plan.Specs {
+		codes, err := database.DB.QRCode.Query().
+			Where(qrcode.ImportKey(fmt.Sprintf("%d:%d", j.ID, spec.Line))).
+			All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(codes) != 1 {
+			t.Errorf("line %d created %d codes, want 1", spec.Line, len(codes))
+			continue
+		}
+		if reported[spec.Line] != codes[0].ID {
+			t.Errorf("line %d reported code %d, created %d", spec.Line, reported[spec.Line], codes[0].ID)
+		}
+	}
+	if n := database.DB.QRCode.Query().CountX(ctx); n != rows {
+		t.Errorf("%d codes, want %d", n, rows)
+	}
+	if n := database.DB.QRCodeGroup.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d groups, want 1", n)
+	}
+}
+
+func TestConcurrentAttemptsCreateARowOnce(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	spec := testPlan(1).Specs[0]
+	spec.GroupName = ""
+
+	// Attempts of a job whose lease ran out may overlap
+	const attempts = 8
+	ids := make([]int, attempts)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			qr, err := createQRCode(ctx, spec, "1:2")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			ids[i] = qr.ID
+		}(i)
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		if id != ids[0] {
+			t.Errorf("attempts returned codes %v, want one", ids)
+			break
+		}
+	}
+	if n := database.DB.QRCode.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d codes, want 1", n)
+	}
+}
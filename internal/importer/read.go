@@ -0,0 +1,123 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Import file formats
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// MaxRows is the largest number of rows one import may hold
+const MaxRows = 10000
+
+// ErrInvalidFile is returned for files that cannot be read as a whole, as
+// opposed to rows with invalid values
+var ErrInvalidFile = errors.New("invalid import file")
+
+// Record is one row of an import file: its line number and its values by
+// column name. CSV values are strings; NDJSON values keep their JSON type.
+type Record struct {
+	Line   int
+	Values map[string]interface{}
+}
+
+// Read parses a CSV file with a header row, or an NDJSON file of one object
+// per line
+func Read(r io.Reader, format string) ([]Record, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatNDJSON:
+		return readNDJSON(r)
+	}
+	return nil, fmt.Errorf("%w: format must be %s or %s", ErrInvalidFile, FormatCSV, FormatNDJSON)
+}
+
+func readCSV(r io.Reader) ([]Record, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if i == 0 {
+			// Spreadsheet exports often start with a byte order mark
+			header[i] = strings.TrimPrefix(header[i], "\ufeff")
+		}
+	}
+
+	var records []Record
+	for {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(fields) > len(header) {
+			return nil, fmt.Errorf("%w: line %d has %d fields but the header has %d", ErrInvalidFile, line, len(fields), len(header))
+		}
+		values := map[string]interface{}{}
+		blank := true
+		for i, field := range fields {
+			values[header[i]] = field
+			if strings.TrimSpace(field) != "" {
+				blank = false
+			}
+		}
+		if blank {
+			continue
+		}
+		if len(records) == MaxRows {
+			return nil, fmt.Errorf("%w: an import may hold at most %d rows", ErrInvalidFile, MaxRows)
+		}
+		records = append(records, Record{Line: line, Values: values})
+	}
+	return records, nil
+}
+
+func readNDJSON(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	var records []Record
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		values := map[string]interface{}{}
+		dec := json.NewDecoder(bytes.NewReader(text))
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return nil, fmt.Errorf("%w: line %d is not a JSON object: %v", ErrInvalidFile, line, err)
+		}
+		if len(records) == MaxRows {
+			return nil, fmt.Errorf("%w: an import may hold at most %d rows", ErrInvalidFile, MaxRows)
+		}
+		records = append(records, Record{Line: line, Values: values})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidFile)
+	}
+	return records, nil
+}
@@ -0,0 +1,172 @@
+// Package jobs runs long operations, such as imports, in the background.
+// Jobs are stored in the database, so they survive restarts and need no
+// broker, and run on a pool of worker goroutines with retries, progress
+// reporting and cancellation.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/job"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// Handler runs one attempt of a job. Returning an error retries the job
+// until its attempts run out, unless the error is Permanent. Handlers
+// should stop when ctx is done, which happens when the job is cancelled or
+// the server shuts down.
+type Handler func(ctx context.Context, run *Run) error
+
+// ErrNotCancellable and ErrNotRetryable are returned for jobs that have
+// already finished, or have not
+var (
+	ErrNotCancellable = errors.New("job has already finished")
+	ErrNotRetryable   = errors.New("only failed or cancelled jobs can be retried")
+)
+
+var (
+	settings = config.JobsConfig{
+		Workers:      2,
+		PollInterval: 2 * time.Second,
+		Lease:        time.Minute,
+		MaxAttempts:  3,
+		BaseBackoff:  10 * time.Second,
+		MaxBackoff:   10 * time.Minute,
+		Retention:    7 * 24 * time.Hour,
+	}
+	handlersMu sync.RWMutex
+	handlers   = map[string]Handler{}
+	wake       = make(chan struct{}, 1)
+)
+
+// Register sets the handler of a job type. Only registered types are
+// claimed by this process's workers.
+func Register(jobType string, handler Handler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers[jobType] = handler
+}
+
+// permanentError is a job error that retrying will not fix
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error so the job fails without further attempts
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// Enqueue stores a job of the given type with its JSON encoded payload and
+// wakes a worker. total is the number of items the job will process, if
+// known in advance.
+func Enqueue(ctx context.Context, jobType string, payload interface{}, total int) (*ent.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job payload: %w", err)
+	}
+	j, err := database.DB.Job.Create().
+		SetType(jobType).
+		SetPayload(data).
+		SetTotal(total).
+		SetMaxAttempts(settings.MaxAttempts).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	Wake()
+	return j, nil
+}
+
+// Wake asks an idle worker to look for queued jobs without waiting for the
+// next poll
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Cancel cancels a queued job at once, or asks the worker running a job to
+// stop it
+func Cancel(ctx context.Context, id int) (*ent.Job, error) {
+	now := time.Now()
+	n, err := database.DB.Job.Update().
+		Where(job.IDEQ(id), job.StatusEQ(job.StatusQueued)).
+		SetStatus(job.StatusCancelled).
+		SetFinishedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		n, err = database.DB.Job.Update().
+			Where(job.IDEQ(id), job.StatusEQ(job.StatusRunning)).
+			SetCancelRequested(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	j, err := database.DB.Job.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return j, ErrNotCancellable
+	}
+	return j, nil
+}
+
+// Retry queues a failed or cancelled job again with a fresh set of
+// attempts. Its saved result is kept so handlers can resume.
+func Retry(ctx context.Context, id int) (*ent.Job, error) {
+	n, err := database.DB.Job.Update().
+		Where(job.IDEQ(id), job.StatusIn(job.StatusFailed, job.StatusCancelled)).
+		SetStatus(job.StatusQueued).
+		SetAttempts(0).
+		SetCancelRequested(false).
+		SetRunAt(time.Now()).
+		ClearLastError().
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	j, err := database.DB.Job.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return j, ErrNotRetryable
+	}
+	Wake()
+	return j, nil
+}
+
+// Progress returns the share of a job's items processed, from 0 to 1, or
+// nil while the total is unknown
+func Progress(j *ent.Job) *float64 {
+	if j.Status == job.StatusSucceeded {
+		p := 1.0
+		return &p
+	}
+	if j.Total <= 0 {
+		return nil
+	}
+	p := float64(j.Processed) / float64(j.Total)
+	if p > 1 {
+		p = 1
+	}
+	return &p
+}
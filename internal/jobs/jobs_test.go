@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"qr_backend/ent/job"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+func connect(t *testing.T) {
+	t.Helper()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(t.TempDir(), "test.db")},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+}
+
+func TestClaimIsExclusive(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	Register("test", func(ctx context.Context, run *Run) error { return nil })
+
+	const jobCount, workers = 50, 16
+	for i := 0; i < jobCount; i++ {
+		if _, err := Enqueue(ctx, "test", i, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Every worker claims until the queue is empty, all starting at once
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	claimedBy := map[int][]string{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(worker string) {
+			defer wg.Done()
+			<-start
+			for {
+				j, err := claim(ctx, worker)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if j == nil {
+					return
+				}
+				mu.Lock()
+				claimedBy[j.ID] = append(claimedBy[j.ID], worker)
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("worker-%d", w))
+	}
+	close(start)
+	wg.Wait()
+
+	if len(claimedBy) != jobCount {
+		t.Errorf("%d jobs claimed, want %d", len(claimedBy), jobCount)
+	}
+	for id, by := range claimedBy {
+		if len(by) != 1 {
+			t.Errorf("job %d claimed by %v, want a single worker", id, by)
+		}
+	}
+
+	all, err := database.DB.Job.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, j := range all {
+		if by := claimedBy[j.ID]; j.Status != job.StatusRunning || j.Attempts != 1 || len(by) == 0 || j.LockedBy != by[0] {
+			t.Errorf("job %d: status %s, attempts %d, locked by %q; want running once by %v", j.ID, j.Status, j.Attempts, j.LockedBy, by)
+		}
+	}
+}
+
+func TestClaimSkipsJobsNotDue(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	Register("test", func(ctx context.Context, run *Run) error { return nil })
+
+	later, err := Enqueue(ctx, "test", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := database.DB.Job.UpdateOneID(later.ID).SetRunAt(time.Now().Add(time.Hour)).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Enqueue(ctx, "unregistered", nil, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	j, err := claim(ctx, "worker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j != nil {
+		t.Errorf("claimed job %d (%s), want none", j.ID, j.Type)
+	}
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/job"
+	"qr_backend/internal/database"
+)
+
+// progressInterval limits how often progress is written to the database
+const progressInterval = time.Second
+
+// Run is one attempt of a job, handed to its handler
+type Run struct {
+	ID      int
+	Type    string
+	Attempt int // 1 for the first attempt
+
+	worker    string
+	payload   json.RawMessage
+	saved     json.RawMessage // Result saved by this or an earlier attempt
+	result    interface{}     // Result set since the last save
+	processed int
+	total     int
+	dirty     bool
+	savedAt   time.Time
+}
+
+func newRun(j *ent.Job, worker string) *Run {
+	return &Run{
+		ID:        j.ID,
+		Type:      j.Type,
+		Attempt:   j.Attempts,
+		worker:    worker,
+		payload:   j.Payload,
+		saved:     j.Result,
+		processed: j.Processed,
+		total:     j.Total,
+	}
+}
+
+// Decode decodes the job payload into v
+func (r *Run) Decode(v interface{}) error {
+	return json.Unmarshal(r.payload, v)
+}
+
+// Result decodes the result saved by an earlier attempt into v, so a
+// retried job can resume where it stopped. It reports whether there was one.
+func (r *Run) Result(v interface{}) bool {
+	if len(r.saved) == 0 || string(r.saved) == "null" {
+		return false
+	}
+	return json.Unmarshal(r.saved, v) == nil
+}
+
+// SetResult sets the result shown with the job. It is encoded and saved with
+// the next progress update and when the attempt ends, so v must not be
+// changed from other goroutines.
+func (r *Run) SetResult(v interface{}) {
+	r.result = v
+	r.dirty = true
+}
+
+// Progress records the number of items processed out of total, saving it at
+// most once a second
+func (r *Run) Progress(ctx context.Context, processed, total int) {
+	r.processed, r.total = processed, total
+	r.dirty = true
+	if time.Since(r.savedAt) >= progressInterval && ctx.Err() == nil {
+		r.save(ctx)
+	}
+}
+
+// save writes progress and result if they changed
+func (r *Run) save(ctx context.Context) {
+	if !r.dirty {
+		return
+	}
+	update := database.DB.Job.Update().
+		Where(job.IDEQ(r.ID), job.LockedByEQ(r.worker)).
+		SetProcessed(r.processed).
+		SetTotal(r.total)
+	if r.result != nil {
+		data, err := json.Marshal(r.result)
+		if err != nil {
+			log.Printf("Failed to encode result of job %d: %v", r.ID, err)
+		} else {
+			r.saved = data
+			update.SetResult(data)
+		}
+	}
+	if err := database.RetryLocked(ctx, func() error { _, err := update.Save(ctx); return err }); err != nil {
+		log.Printf("Failed to save progress of job %d: %v", r.ID, err)
+		return
+	}
+	r.dirty = false
+	r.savedAt = time.Now()
+}
@@ -0,0 +1,348 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/job"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// claimBatchSize is the number of queued jobs a worker tries to claim from
+// per lookup; another worker may take some of them first
+const claimBatchSize = 5
+
+var (
+	stop    context.CancelFunc = func() {}
+	running sync.WaitGroup
+)
+
+// Start runs the workers and the lease and retention maintenance until ctx
+// is cancelled or Shutdown is called. Handlers must be registered first.
+func Start(ctx context.Context, cfg config.JobsConfig) {
+	ctx, stop = context.WithCancel(ctx)
+	if cfg.Workers > 0 {
+		settings.Workers = cfg.Workers
+	}
+	if cfg.PollInterval > 0 {
+		settings.PollInterval = cfg.PollInterval
+	}
+	if cfg.Lease > 0 {
+		settings.Lease = cfg.Lease
+	}
+	if cfg.MaxAttempts > 0 {
+		settings.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BaseBackoff > 0 {
+		settings.BaseBackoff = cfg.BaseBackoff
+	}
+	if cfg.MaxBackoff > 0 {
+		settings.MaxBackoff = cfg.MaxBackoff
+	}
+	if cfg.Retention > 0 {
+		settings.Retention = cfg.Retention
+	}
+
+	// Workers are named so that a worker only updates jobs it holds
+	host, _ := os.Hostname()
+	for i := 0; i < settings.Workers; i++ {
+		running.Add(1)
+		go func(worker string) {
+			defer running.Done()
+			work(ctx, worker)
+		}(fmt.Sprintf("%s:%d:%d", host, os.Getpid(), i))
+	}
+	go maintain(ctx)
+}
+
+// Shutdown stops the workers and waits until the jobs they were running
+// have been queued again, so they resume on the next start
+func Shutdown(ctx context.Context) error {
+	stop()
+	done := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// work claims and runs jobs on every poll or wake-up
+func work(ctx context.Context, worker string) {
+	ticker := time.NewTicker(settings.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			j, err := claim(ctx, worker)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Failed to claim job: %v", err)
+				}
+				break
+			}
+			if j == nil {
+				break
+			}
+			execute(ctx, j, worker)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wake:
+		}
+	}
+}
+
+// claim takes the oldest due job of a registered type. The status switch
+// only succeeds for one worker, which makes this safe across processes on
+// SQLite and Postgres alike.
+func claim(ctx context.Context, worker string) (*ent.Job, error) {
+	handlersMu.RLock()
+	types := make([]string, 0, len(handlers))
+	for t := range handlers {
+		types = append(types, t)
+	}
+	handlersMu.RUnlock()
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	candidates, err := database.DB.Job.Query().
+		Where(job.StatusEQ(job.StatusQueued), job.RunAtLTE(now), job.TypeIn(types...)).
+		Order(ent.Asc(job.FieldRunAt), ent.Asc(job.FieldID)).
+		Limit(claimBatchSize).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range candidates {
+		var n int
+		err := database.RetryLocked(ctx, func() (err error) {
+			n, err = database.DB.Job.Update().
+				Where(job.IDEQ(id), job.StatusEQ(job.StatusQueued)).
+				SetStatus(job.StatusRunning).
+				SetLockedBy(worker).
+				SetLockedUntil(now.Add(settings.Lease)).
+				SetStartedAt(now).
+				AddAttempts(1).
+				Save(ctx)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			return database.DB.Job.Get(ctx, id)
+		}
+	}
+	return nil, nil
+}
+
+// execute runs one attempt of a claimed job and records its outcome
+func execute(ctx context.Context, j *ent.Job, worker string) {
+	handlersMu.RLock()
+	handler := handlers[j.Type]
+	handlersMu.RUnlock()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cancelled := make(chan struct{})
+	go heartbeat(runCtx, cancel, cancelled, j.ID, worker)
+
+	run := newRun(j, worker)
+	err := call(runCtx, handler, run)
+	cancel()
+
+	// The outcome is saved even while shutting down
+	saveCtx, saveCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer saveCancel()
+	run.save(saveCtx)
+
+	update := database.DB.Job.Update().
+		Where(job.IDEQ(j.ID), job.LockedByEQ(worker)).
+		ClearLockedBy().
+		ClearLockedUntil()
+	now := time.Now()
+	isCancelled := false
+	select {
+	case <-cancelled:
+		isCancelled = true
+	default:
+	}
+
+	var permanent permanentError
+	switch {
+	case err == nil:
+		update.SetStatus(job.StatusSucceeded).SetFinishedAt(now).ClearLastError()
+	case isCancelled:
+		update.SetStatus(job.StatusCancelled).SetFinishedAt(now).SetLastError("cancelled")
+	case ctx.Err() != nil:
+		// Interrupted by shutdown: run again later without using up an attempt
+		update.SetStatus(job.StatusQueued).SetRunAt(now).AddAttempts(-1)
+	case errors.As(err, &permanent) || j.Attempts >= j.MaxAttempts:
+		update.SetStatus(job.StatusFailed).SetFinishedAt(now).SetLastError(err.Error())
+	default:
+		update.SetStatus(job.StatusQueued).SetRunAt(now.Add(backoff(j.Attempts))).SetLastError(err.Error())
+	}
+	if err := database.RetryLocked(saveCtx, func() error { _, err := update.Save(saveCtx); return err }); err != nil {
+		log.Printf("Failed to record outcome of job %d: %v", j.ID, err)
+	}
+	if err != nil && !isCancelled && ctx.Err() == nil {
+		log.Printf("Job %d (%s) attempt %d failed: %v", j.ID, j.Type, j.Attempts, err)
+	}
+}
+
+// call runs a handler, turning a panic into a permanent error
+func call(ctx context.Context, handler Handler, run *Run) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Permanent(fmt.Errorf("job panicked: %v", r))
+		}
+	}()
+	if handler == nil {
+		return Permanent(fmt.Errorf("no handler for job type %q", run.Type))
+	}
+	return handler(ctx, run)
+}
+
+// heartbeat renews the lease of a running job and cancels it when a
+// cancellation is requested or the lease is lost
+func heartbeat(ctx context.Context, cancel context.CancelFunc, cancelled chan<- struct{}, id int, worker string) {
+	ticker := time.NewTicker(heartbeatInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		j, err := database.DB.Job.Get(ctx, id)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to check job %d: %v", id, err)
+			}
+			continue
+		}
+		if j.CancelRequested {
+			close(cancelled)
+			cancel()
+			return
+		}
+		var n int
+		err = database.RetryLocked(ctx, func() (err error) {
+			n, err = database.DB.Job.Update().
+				Where(job.IDEQ(id), job.LockedByEQ(worker)).
+				SetLockedUntil(time.Now().Add(settings.Lease)).
+				Save(ctx)
+			return err
+		})
+		if err == nil && n == 0 {
+			// Another worker took the job over after the lease ran out
+			log.Printf("Lost the lease of job %d", id)
+			cancel()
+			return
+		}
+	}
+}
+
+// heartbeatInterval renews leases well before they run out, and checks for
+// cancellation at least every second
+func heartbeatInterval() time.Duration {
+	interval := settings.Lease / 3
+	if interval > time.Second {
+		interval = time.Second
+	}
+	if interval < 100*time.Millisecond {
+		interval = 100 * time.Millisecond
+	}
+	return interval
+}
+
+// backoff returns the delay before the next attempt after the given number of attempts
+func backoff(attempts int) time.Duration {
+	delay := settings.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= settings.MaxBackoff {
+			return settings.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// maintain requeues jobs whose worker stopped renewing their lease, such as
+// after a crash, and deletes finished jobs past the retention period
+func maintain(ctx context.Context) {
+	ticker := time.NewTicker(settings.Lease)
+	defer ticker.Stop()
+	for {
+		if err := recoverExpired(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to recover expired jobs: %v", err)
+		}
+		n, err := database.DB.Job.Delete().
+			Where(
+				job.StatusIn(job.StatusSucceeded, job.StatusFailed, job.StatusCancelled),
+				job.FinishedAtLT(time.Now().Add(-settings.Retention)),
+			).
+			Exec(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to delete old jobs: %v", err)
+		} else if n > 0 {
+			log.Printf("Deleted %d finished jobs", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recoverExpired queues running jobs with an expired lease again, or fails
+// them when they have used up their attempts
+func recoverExpired(ctx context.Context) error {
+	now := time.Now()
+	expired, err := database.DB.Job.Query().
+		Where(job.StatusEQ(job.StatusRunning), job.LockedUntilLT(now)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, j := range expired {
+		update := database.DB.Job.Update().
+			Where(job.IDEQ(j.ID), job.StatusEQ(job.StatusRunning), job.LockedUntilLT(now)).
+			ClearLockedBy().
+			ClearLockedUntil()
+		if j.Attempts >= j.MaxAttempts {
+			update.SetStatus(job.StatusFailed).SetFinishedAt(now).SetLastError("worker stopped responding")
+		} else {
+			update.SetStatus(job.StatusQueued).SetRunAt(now).SetLastError("worker stopped responding")
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return err
+		}
+	}
+	if len(expired) > 0 {
+		log.Printf("Recovered %d jobs from stopped workers", len(expired))
+		Wake()
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package malware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamdChunkSize is the size of the chunks streamed with INSTREAM
+const clamdChunkSize = 64 * 1024
+
+// Clamd scans content with a ClamAV daemon over its INSTREAM protocol
+type Clamd struct {
+	network string // "tcp" or "unix"
+	address string
+	timeout time.Duration
+}
+
+// NewClamd returns a client for the daemon at address, given as
+// "tcp://host:port", "unix:///path/to/clamd.sock" or plain "host:port"
+func NewClamd(address string, timeout time.Duration) (*Clamd, error) {
+	network, addr := "tcp", address
+	switch {
+	case strings.HasPrefix(address, "tcp://"):
+		addr = strings.TrimPrefix(address, "tcp://")
+	case strings.HasPrefix(address, "unix://"):
+		network, addr = "unix", strings.TrimPrefix(address, "unix://")
+	}
+	if addr == "" {
+		return nil, fmt.Errorf("invalid clamd address %q", address)
+	}
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	return &Clamd{network: network, address: addr, timeout: timeout}, nil
+}
+
+// Ping checks that the daemon is reachable
+func (c *Clamd) Ping(ctx context.Context) error {
+	reply, err := c.command(ctx, "zPING\x00", nil)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected clamd reply %q", reply)
+	}
+	return nil
+}
+
+// Scan streams r to the daemon and parses its verdict
+func (c *Clamd) Scan(ctx context.Context, r io.Reader) (Result, error) {
+	reply, err := c.command(ctx, "zINSTREAM\x00", r)
+	if err != nil {
+		return Result{}, err
+	}
+	return parseReply(reply)
+}
+
+// command sends a null-terminated command, followed by the chunked content
+// of body if given, and returns the daemon's reply
+func (c *Clamd) command(ctx context.Context, cmd string, body io.Reader) (string, error) {
+	dialer := net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	sendErr := c.send(conn, cmd, body)
+
+	// clamd replies and closes the connection early when it rejects a stream,
+	// for example over its size limit, so read the reply even if sending failed
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !(errors.Is(err, io.EOF) && reply != "") {
+		if sendErr != nil {
+			return "", fmt.Errorf("failed to send to clamd: %w", sendErr)
+		}
+		return "", fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	return strings.TrimRight(reply, "\x00\n"), nil
+}
+
+// send writes the command and streams body as length-prefixed chunks,
+// terminated by a zero-length chunk
+func (c *Clamd) send(conn net.Conn, cmd string, body io.Reader) error {
+	if _, err := io.WriteString(conn, cmd); err != nil {
+		return err
+	}
+	if body == nil {
+		return nil
+	}
+
+	buf := make([]byte, 4+clamdChunkSize)
+	for {
+		n, err := io.ReadFull(body, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, werr := conn.Write(buf[:4+n]); werr != nil {
+				return werr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+	}
+	_, err := conn.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// parseReply turns "stream: OK", "stream: <signature> FOUND" or
+// "<message> ERROR" into a result
+func parseReply(reply string) (Result, error) {
+	_, verdict, found := strings.Cut(reply, ": ")
+	if !found {
+		verdict = reply
+	}
+	switch {
+	case verdict == "OK":
+		return Result{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return Result{Infected: true, Signature: strings.TrimSuffix(verdict, " FOUND")}, nil
+	case strings.HasSuffix(reply, " ERROR"):
+		return Result{}, fmt.Errorf("clamd: %s", strings.TrimSuffix(reply, " ERROR"))
+	default:
+		return Result{}, fmt.Errorf("unexpected clamd reply %q", bytes.TrimSpace([]byte(reply)))
+	}
+}
@@ -0,0 +1,217 @@
+package malware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/internal/storage"
+)
+
+// fakeClamd is a clamd stand-in speaking the INSTREAM protocol on a local listener
+type fakeClamd struct {
+	ln       net.Listener
+	reply    string // Verdict sent after a complete stream
+	maxBytes int    // Streams larger than this are rejected like StreamMaxLength; 0 means no limit
+	received chan []byte
+}
+
+func startFakeClamd(t *testing.T, reply string, maxBytes int) *fakeClamd {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeClamd{ln: ln, reply: reply, maxBytes: maxBytes, received: make(chan []byte, 16)}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f
+}
+
+func (f *fakeClamd) client(t *testing.T) *Clamd {
+	t.Helper()
+	c, err := NewClamd("tcp://"+f.ln.Addr().String(), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func (f *fakeClamd) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	cmd, err := r.ReadString(0)
+	if err != nil {
+		return
+	}
+	switch cmd {
+	case "zPING\x00":
+		io.WriteString(conn, "PONG\x00")
+		return
+	case "zINSTREAM\x00":
+	default:
+		io.WriteString(conn, "UNKNOWN COMMAND\x00")
+		return
+	}
+
+	var body []byte
+	var size [4]byte
+	for {
+		if _, err := io.ReadFull(r, size[:]); err != nil {
+			return
+		}
+		n := binary.BigEndian.Uint32(size[:])
+		if n == 0 {
+			break
+		}
+		chunk := make([]byte, n)
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return
+		}
+		body = append(body, chunk...)
+		if f.maxBytes > 0 && len(body) > f.maxBytes {
+			// clamd replies and hangs up without reading the rest of the stream
+			io.WriteString(conn, "INSTREAM size limit exceeded. ERROR\x00")
+			return
+		}
+	}
+	f.received <- body
+	io.WriteString(conn, f.reply+"\x00")
+}
+
+func TestClamdPing(t *testing.T) {
+	f := startFakeClamd(t, "stream: OK", 0)
+	if err := f.client(t).Ping(context.Background()); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+}
+
+func TestClamdScanOK(t *testing.T) {
+	f := startFakeClamd(t, "stream: OK", 0)
+
+	// More than one chunk, with a partial last chunk
+	data := bytes.Repeat([]byte("0123456789"), clamdChunkSize/4)
+	result, err := f.client(t).Scan(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if result.Infected {
+		t.Fatalf("clean stream reported as infected: %+v", result)
+	}
+	if got := <-f.received; !bytes.Equal(got, data) {
+		t.Fatalf("clamd received %d bytes, want %d", len(got), len(data))
+	}
+}
+
+func TestClamdScanFound(t *testing.T) {
+	f := startFakeClamd(t, "stream: Eicar-Test-Signature FOUND", 0)
+
+	result, err := f.client(t).Scan(context.Background(), strings.NewReader("X5O!P%@AP"))
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !result.Infected || result.Signature != "Eicar-Test-Signature" {
+		t.Fatalf("got %+v, want infected with Eicar-Test-Signature", result)
+	}
+}
+
+func TestClamdScanError(t *testing.T) {
+	f := startFakeClamd(t, "INSTREAM: Can't allocate memory ERROR", 0)
+
+	_, err := f.client(t).Scan(context.Background(), strings.NewReader("data"))
+	if err == nil || !strings.Contains(err.Error(), "Can't allocate memory") {
+		t.Fatalf("got error %v, want the clamd error message", err)
+	}
+}
+
+func TestClamdScanOversize(t *testing.T) {
+	f := startFakeClamd(t, "stream: OK", clamdChunkSize)
+
+	data := bytes.Repeat([]byte{'x'}, 64*clamdChunkSize)
+	_, err := f.client(t).Scan(context.Background(), bytes.NewReader(data))
+	if err == nil || !strings.Contains(err.Error(), "size limit exceeded") {
+		t.Fatalf("got error %v, want the size limit reply", err)
+	}
+}
+
+func TestClamdUnexpectedReply(t *testing.T) {
+	f := startFakeClamd(t, "stream: MAYBE", 0)
+
+	if _, err := f.client(t).Scan(context.Background(), strings.NewReader("data")); err == nil {
+		t.Fatal("unexpected reply was accepted")
+	}
+}
+
+// TestScanFileReleasesQuarantine runs pending files through the fake daemon
+// and checks that only clean content leaves quarantine
+func TestScanFileReleasesQuarantine(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(dir, "test.db")},
+		Upload:   config.UploadConfig{Path: filepath.Join(dir, "uploads")},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+	if err := storage.Init(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		reply string
+		want  filereference.ScanStatus
+	}{
+		{"stream: OK", filereference.ScanStatusClean},
+		{"stream: Eicar-Test-Signature FOUND", filereference.ScanStatusInfected},
+	}
+	for i, tt := range tests {
+		f := startFakeClamd(t, tt.reply, 0)
+		scanner = f.client(t)
+		settings = config.MalwareConfig{PollInterval: time.Second, MaxAttempts: 3}
+
+		key := string(rune('a'+i)) + ".txt"
+		if err := storage.Default().Put(ctx, key, strings.NewReader(tt.reply), int64(len(tt.reply)), "text/plain"); err != nil {
+			t.Fatal(err)
+		}
+		ref, err := database.DB.FileReference.Create().
+			SetFilename(key).
+			SetURL("/files/" + key).
+			SetSize(int64(len(tt.reply))).
+			SetType("text/plain").
+			SetKey(key).
+			SetScanStatus(filereference.ScanStatusPending).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := scanDue(ctx); err != nil {
+			t.Fatal(err)
+		}
+		ref = database.DB.FileReference.GetX(ctx, ref.ID)
+		if ref.ScanStatus != tt.want {
+			t.Errorf("%q: scan status %s, want %s", tt.reply, ref.ScanStatus, tt.want)
+		}
+	}
+	scanner = nil
+}
@@ -0,0 +1,205 @@
+package malware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/internal/storage"
+)
+
+// scanBatchSize limits how many pending files are scanned per poll
+const scanBatchSize = 20
+
+// Result is the verdict of a scan
+type Result struct {
+	Infected  bool
+	Signature string // Name of the detected malware
+}
+
+// Scanner checks file content for malware
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (Result, error)
+}
+
+var (
+	scanner  Scanner
+	settings config.MalwareConfig
+	wake     = make(chan struct{}, 1)
+)
+
+// Init configures the scanner. Without one, uploads are not quarantined.
+func Init(cfg config.MalwareConfig) error {
+	settings = cfg
+	switch cfg.Scanner {
+	case "", "none":
+		scanner = nil
+	case "clamd":
+		clamd, err := NewClamd(cfg.ClamdAddress, cfg.Timeout)
+		if err != nil {
+			return err
+		}
+		scanner = clamd
+	default:
+		return fmt.Errorf("unknown malware scanner %q", cfg.Scanner)
+	}
+	return nil
+}
+
+// Enabled reports whether new files are quarantined until they are scanned
+func Enabled() bool {
+	return scanner != nil
+}
+
+// Start scans pending files in the background until ctx is cancelled.
+// Pending files left over from a previous run are picked up as well.
+func Start(ctx context.Context) {
+	if !Enabled() {
+		return
+	}
+	if settings.PollInterval <= 0 {
+		settings.PollInterval = 10 * time.Second
+	}
+	if settings.MaxAttempts <= 0 {
+		settings.MaxAttempts = 5
+	}
+
+	go func() {
+		ticker := time.NewTicker(settings.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			for {
+				n, err := scanDue(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Printf("Malware scan failed: %v", err)
+					}
+					break
+				}
+				if n < scanBatchSize {
+					break
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-wake:
+			}
+		}
+	}()
+}
+
+// Wake asks the worker to scan pending files without waiting for the next poll
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// scanDue scans one batch of pending files whose next attempt is due
+func scanDue(ctx context.Context) (int, error) {
+	refs, err := database.DB.FileReference.
+		Query().
+		Where(
+			filereference.ScanStatusEQ(filereference.ScanStatusPending),
+			filereference.Or(
+				filereference.NextScanAtIsNil(),
+				filereference.NextScanAtLTE(time.Now()),
+			),
+		).
+		Order(ent.Asc(filereference.FieldID)).
+		Limit(scanBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load pending files: %w", err)
+	}
+
+	for _, ref := range refs {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		scanFile(ctx, ref)
+	}
+	return len(refs), nil
+}
+
+// scanFile scans a stored file and records the verdict. Content that was
+// already scanned under another file reference reuses that verdict. Failed
+// scans are retried with backoff until MaxAttempts is reached, after which
+// the file is marked failed and stays unavailable.
+func scanFile(ctx context.Context, ref *ent.FileReference) {
+	update := ref.Update()
+
+	previous, err := database.DB.FileReference.
+		Query().
+		Where(
+			filereference.Checksum(ref.Checksum),
+			filereference.ChecksumNEQ(""),
+			filereference.ScanStatusIn(filereference.ScanStatusClean, filereference.ScanStatusInfected),
+		).
+		First(ctx)
+	if err == nil {
+		update.SetScanStatus(previous.ScanStatus).
+			SetScanResult(previous.ScanResult).
+			SetScannedAt(time.Now())
+	} else {
+		result, scanErr := scan(ctx, ref)
+		attempts := ref.ScanAttempts + 1
+		update.SetScanAttempts(attempts)
+
+		switch {
+		case scanErr == nil && result.Infected:
+			log.Printf("Malware found in file reference %d (%s): %s", ref.ID, ref.Filename, result.Signature)
+			update.SetScanStatus(filereference.ScanStatusInfected).
+				SetScanResult(result.Signature).
+				SetScannedAt(time.Now())
+		case scanErr == nil:
+			update.SetScanStatus(filereference.ScanStatusClean).
+				ClearScanResult().
+				SetScannedAt(time.Now())
+		case attempts >= settings.MaxAttempts:
+			update.SetScanStatus(filereference.ScanStatusFailed).
+				SetScanResult(scanErr.Error())
+		default:
+			update.SetScanResult(scanErr.Error()).
+				SetNextScanAt(time.Now().Add(backoff(attempts)))
+		}
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("Failed to record malware scan of file reference %d: %v", ref.ID, err)
+	}
+}
+
+// scan reads a file from its storage backend and scans it
+func scan(ctx context.Context, ref *ent.FileReference) (Result, error) {
+	backend, err := storage.Backend(ref.Backend)
+	if err != nil {
+		return Result{}, err
+	}
+	body, err := backend.Get(ctx, ref.Key)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to read file: %w", err)
+	}
+	defer body.Close()
+	return scanner.Scan(ctx, body)
+}
+
+// backoff returns the delay before the next attempt after the given number of attempts
+func backoff(attempts int) time.Duration {
+	delay := settings.PollInterval
+	for i := 1; i < attempts && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	return delay
+}
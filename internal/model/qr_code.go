@@ -17,6 +17,7 @@ type QRCode struct {
 	UpdatedAt   time.Time              `json:"updated_at" db:"updated_at"`
 	ExpiresAt   *time.Time             `json:"expires_at,omitempty" db:"expires_at"`
 	Analytics   bool                   `json:"analytics" db:"analytics"`
+	CountsOnly  bool                   `json:"analytics_counts_only" db:"analytics_counts_only"` // Store scan counts only, no IP or user agent
 	Active      bool                   `json:"active" db:"active"`
 	Tags        []string               `json:"tags,omitempty" db:"tags"`
 	GroupID     *string                `json:"group_id,omitempty" db:"group_id"`
@@ -41,6 +42,8 @@ const (
 	QRTypeBusiness    QRCodeType = "business"
 	QRTypeEvent       QRCodeType = "event"
 	QRTypeBarcode2D   QRCodeType = "barcode_2d"
+	QRTypeBarcode1D   QRCodeType = "barcode_1d"
+	QRTypeGS1         QRCodeType = "gs1"
 	QRTypeFeedback    QRCodeType = "feedback"
 	QRTypeRating      QRCodeType = "rating"
 	QRTypeEmail       QRCodeType = "email"
@@ -109,8 +112,20 @@ type InstagramContent struct {
 }
 
 type ImagesContent struct {
-	GalleryURL string   `json:"gallery_url"`
-	ImageURLs  []string `json:"image_urls"`
+	GalleryURL string         `json:"gallery_url"`
+	ImageURLs  []string       `json:"image_urls"`
+	Images     []GalleryImage `json:"images,omitempty"` // Uploaded gallery images in display order
+}
+
+// GalleryImage is one uploaded image of a gallery QR code
+type GalleryImage struct {
+	FileRefID    int    `json:"file_ref_id"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	Filename     string `json:"filename"`
+	Caption      string `json:"caption,omitempty"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
 }
 
 type AppContent struct {
@@ -140,6 +155,26 @@ type Barcode2DContent struct {
 	Data string `json:"data"`
 }
 
+type Barcode1DContent struct {
+	Symbology string `json:"symbology"` // code128, ean8, ean13, upca, upce, code39, codabar, itf14
+	Data      string `json:"data"`
+}
+
+type GS1Content struct {
+	Format        string     `json:"format"`         // qr (Digital Link URI) or datamatrix (GS1 DataMatrix element string)
+	ElementString string     `json:"element_string"` // e.g. (01)09501101530003(10)AB12
+	DigitalLink   string     `json:"digital_link"`
+	Product       GS1Product `json:"product"`
+}
+
+type GS1Product struct {
+	Name        string `json:"name"`
+	Brand       string `json:"brand,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	URL         string `json:"url,omitempty"` // Manufacturer's product page
+}
+
 type FeedbackContent struct {
 	FormURL        string `json:"form_url"`
 	ThankYouMsg    string `json:"thank_you_msg,omitempty"`
@@ -1,35 +1,104 @@
 package router
 
 import (
+	"qr_backend/internal/config"
 	"qr_backend/internal/handler"
+	"qr_backend/pkg/gs1"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupRoutes(app *fiber.App) {
+func SetupRoutes(app *fiber.App, cfg *config.Config) {
 	api := app.Group("/api")
 
 	// QR Code routes
 	qr := api.Group("/qr")
-	qr.Get("/", handler.ListQRCodes)                     // List QR codes with pagination
-	qr.Post("/", handler.CreateQRCode)                   // Create a new QR code
-	qr.Post("/pdf", handler.CreatePDFQRCode)             // Create PDF QR code with file upload
-	qr.Post("/image", handler.CreateImageQRCode)         // Create Image QR code with file upload
-	qr.Post("/barcode", handler.CreateBarcodeQRCode)     // Create Data Matrix barcode QR code
-	qr.Get("/:id", handler.GetQRCode)                    // Get a QR code by ID
-	qr.Put("/:id", handler.UpdateQRCode)                 // Update a QR code
-	qr.Delete("/:id", handler.DeleteQRCode)              // Delete a QR code
-	qr.Delete("/", handler.BulkDeleteQRCodes)            // Bulk delete all QR codes
-	qr.Get("/:id/download", handler.DownloadQRCode)      // Download QR code image
-	qr.Get("/:id/analytics", handler.GetQRCodeAnalytics) // Get QR code analytics
+	qr.Get("/", handler.ListQRCodes)                                // List QR codes with pagination and filters
+	qr.Post("/", handler.CreateQRCode)                              // Create a new QR code
+	qr.Post("/pdf", handler.CreatePDFQRCode)                        // Create PDF QR code with file upload
+	qr.Post("/image", handler.CreateImageQRCode)                    // Create Image QR code with file upload
+	qr.Post("/barcode", handler.CreateBarcodeQRCode)                // Create Data Matrix barcode QR code
+	qr.Post("/gallery", handler.CreateGalleryQRCode)                // Create image gallery QR code from several uploads
+	qr.Post("/gs1", handler.CreateGS1QRCode)                        // Create GS1 Digital Link QR or GS1 DataMatrix product code
+	qr.Post("/sheet", handler.CreateLabelSheet)                     // Print-ready PDF label sheet of many codes
+	qr.Get("/download", handler.DownloadQRCodesZip)                 // ZIP of the images of the codes matching the list filters
+	qr.Post("/import", handler.ImportQRCodes)                       // Bulk create QR codes from a CSV or NDJSON file
+	qr.Get("/:id", handler.GetQRCode)                               // Get a QR code by ID
+	qr.Put("/:id", handler.UpdateQRCode)                            // Update a QR code
+	qr.Delete("/:id", handler.DeleteQRCode)                         // Delete a QR code
+	qr.Delete("/", handler.BulkDeleteQRCodes)                       // Bulk delete all QR codes
+	qr.Get("/:id/download", handler.DownloadQRCode)                 // Download QR code image
+	qr.Get("/:id/analytics", handler.GetQRCodeAnalytics)            // Get QR code analytics
+	qr.Get("/:id/analytics/daily", handler.GetQRCodeAnalyticsDaily) // Get per-day scan series
+	qr.Get("/:id/analytics/export", handler.ExportQRCodeAnalytics)  // Export raw scans as CSV, NDJSON or Parquet
+	qr.Get("/:id/redemptions", handler.GetQRCodeRedemptions)        // Scan limits and remaining scans
+	qr.Delete("/:id/redemptions", handler.ResetQRCodeRedemptions)   // Reset counted scans and visitors
+	qr.Post("/:id/sign", handler.SignQRCode)                        // Sign a code's payload again with the active key
+	qr.Post("/:id/file", handler.ReplaceQRCodeFile)                 // Upload a new version of a PDF or image QR code's file
+	qr.Get("/:id/file/versions", handler.ListQRCodeFileVersions)    // List file versions
+	qr.Post("/:id/file/rollback", handler.RollbackQRCodeFile)       // Restore an earlier file version
+	qr.Post("/:id/images", handler.AddGalleryImages)                // Add images to a gallery
+	qr.Put("/:id/images/order", handler.ReorderGalleryImages)       // Reorder gallery images
+	qr.Put("/:id/images/:fileId", handler.UpdateGalleryImage)       // Update a gallery image caption
+	qr.Delete("/:id/images/:fileId", handler.DeleteGalleryImage)    // Remove an image from a gallery
+
+	// Analytics routes
+	api.Get("/analytics/ingest", handler.GetAnalyticsIngestStats) // Scan ingestion queue metrics
+	api.Get("/analytics/export", handler.ExportAnalytics)         // Export raw scans of all QR codes
+
+	// Webhook routes
+	webhooks := api.Group("/webhooks")
+	webhooks.Get("/", handler.ListWebhooks)                              // List webhooks
+	webhooks.Post("/", handler.CreateWebhook)                            // Create a webhook
+	webhooks.Get("/dead-letters", handler.ListDeadLetters)               // Deliveries that ran out of attempts
+	webhooks.Get("/queue", handler.GetWebhookQueueStats)                 // Event queue metrics
+	webhooks.Post("/deliveries/:id/retry", handler.RetryWebhookDelivery) // Requeue a delivery
+	webhooks.Get("/:id", handler.GetWebhook)                             // Get a webhook
+	webhooks.Put("/:id", handler.UpdateWebhook)                          // Update a webhook
+	webhooks.Delete("/:id", handler.DeleteWebhook)                       // Delete a webhook
+	webhooks.Get("/:id/deliveries", handler.ListWebhookDeliveries)       // Delivery log of a webhook
+	webhooks.Post("/:id/test", handler.SendTestWebhook)                  // Send a test event
+
+	// Background job routes
+	jobs := api.Group("/jobs")
+	jobs.Get("/", handler.ListJobs)             // List jobs, newest first
+	jobs.Get("/:id", handler.GetJob)            // Status, progress and result of a job
+	jobs.Post("/:id/cancel", handler.CancelJob) // Cancel a queued or running job
+	jobs.Post("/:id/retry", handler.RetryJob)   // Queue a failed or cancelled job again
+
+	// Signing key routes
+	signing := api.Group("/signing/keys")
+	signing.Get("/", handler.ListSigningKeys)              // List signing keys, newest first
+	signing.Post("/", handler.RotateSigningKey)            // Create a new active key and retire the current one
+	signing.Post("/:kid/revoke", handler.RevokeSigningKey) // Revoke a key; its payloads no longer verify
+	api.Post("/verify", handler.VerifyPayload)             // Verify scanned signed QR code data
 
 	// File upload routes
 	api.Post("/upload", handler.UploadFile) // Upload files
 
+	// Storage routes
+	api.Get("/storage/usage", handler.GetStorageUsage)     // Stored bytes and quota of the requesting owner
+	api.Post("/storage/gc", handler.CollectStorageGarbage) // Remove orphaned files now
+
 	// Scan/redirect routes (outside API group for clean URLs)
-	app.Get("/scan/:shortcode", handler.ScanQRCode) // QR code scanning and redirection
-	app.Get("/qr/:id", handler.GetStaticQRContent)  // Static QR content display
+	app.Get("/scan/:shortcode", handler.ScanQRCode)    // QR code scanning and redirection
+	app.Post("/scan/:shortcode", handler.UnlockScan)   // Password of a protected QR code
+	app.Get("/qr/:id", handler.GetStaticQRContent)     // Static QR content display
+	app.Post("/qr/:id", handler.UnlockStaticQRContent) // Password of protected static content
+	app.Get("/verify", handler.VerifyPage)             // Verification URL of a signed QR code
+	app.Get("/.well-known/jwks.json", handler.GetJWKS) // Public signing keys for offline verification
+
+	// GS1 Digital Link paths such as /01/09501101530003/10/AB12, resolved to product pages
+	for _, ai := range gs1.PrimaryKeys() {
+		app.Get("/"+ai+"/*", handler.ResolveDigitalLink)
+		app.Post("/"+ai+"/*", handler.UnlockDigitalLink) // Password of a protected product code
+	}
+
+	// Stored files, proxied or redirected to the storage backend
+	app.Get("/files/:key", handler.ServeFile)
 
-	// Static file serving for uploads
-	app.Static("/uploads", "./uploads")
+	// Static file serving for uploads stored before storage backends; files
+	// that have not passed the malware scan are refused
+	app.Use("/uploads", handler.GuardUploads)
+	app.Static("/uploads", cfg.Upload.Path)
 }
@@ -0,0 +1,164 @@
+// Package scanlimit enforces the scan limits of coupons and tickets: a code
+// stops working after a number of scans in total, or per visitor. Scans are
+// counted in a transaction with their limits, so concurrent scans never
+// redeem a code more often than allowed.
+package scanlimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/ent/qrcoderedemption"
+	"qr_backend/internal/database"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	// ErrExhausted is returned once a code has been scanned max_scans times
+	ErrExhausted = errors.New("the code has no scans left")
+	// ErrVisitorLimit is returned once a visitor has used up their scans
+	ErrVisitorLimit = errors.New("the visitor has no scans left")
+)
+
+// errEdited makes Redeem retry when the code was edited while it was scanned
+var errEdited = errors.New("QR code was edited")
+
+// Limited reports whether scans of a code are limited
+func Limited(qr *ent.QRCode) bool {
+	return qr.MaxScans != nil || qr.ScansPerVisitor != nil
+}
+
+// Remaining returns the scans a code has left, or nil without a max_scans
+func Remaining(qr *ent.QRCode) *int {
+	if qr.MaxScans == nil {
+		return nil
+	}
+	remaining := *qr.MaxScans - qr.Redeemed
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
+
+// Visitor returns the key a visitor's scans of a code are counted under: a
+// hash of their cookie or fingerprint, so neither is stored
+func Visitor(qrID int, id string) string {
+	h := sha256.Sum256([]byte(strconv.Itoa(qrID) + "\x00" + id))
+	return hex.EncodeToString(h[:16])
+}
+
+// Redeem counts a scan of a limited code by visitor. It returns
+// ErrExhausted or ErrVisitorLimit, without counting the scan, when it is
+// over a limit.
+func Redeem(ctx context.Context, qr *ent.QRCode, visitor string) error {
+	current := qr
+	for attempt := 0; attempt < 3; attempt++ {
+		err := database.RetryLocked(ctx, func() error { return redeem(ctx, current, visitor) })
+		switch {
+		case errors.Is(err, errEdited):
+			if current, err = database.DB.QRCode.Get(ctx, qr.ID); err != nil {
+				return err
+			}
+		case ent.IsConstraintError(err):
+			// Another scan by the same visitor counted their first scan first
+		default:
+			return err
+		}
+	}
+	return errors.New("failed to redeem scan: too many concurrent changes")
+}
+
+func redeem(ctx context.Context, qr *ent.QRCode, visitor string) error {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start redemption transaction: %w", err)
+	}
+	if err := redeemTx(ctx, tx, qr, visitor); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rollback failed: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func redeemTx(ctx context.Context, tx *ent.Tx, qr *ent.QRCode, visitor string) error {
+	now := time.Now()
+	if qr.ScansPerVisitor != nil {
+		n, err := tx.QRCodeRedemption.Update().
+			Where(
+				qrcoderedemption.QrCodeID(qr.ID),
+				qrcoderedemption.Visitor(visitor),
+				qrcoderedemption.CountLT(*qr.ScansPerVisitor),
+			).
+			AddCount(1).
+			SetLastAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			exists, err := tx.QRCodeRedemption.Query().
+				Where(qrcoderedemption.QrCodeID(qr.ID), qrcoderedemption.Visitor(visitor)).
+				Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if exists || *qr.ScansPerVisitor < 1 {
+				return ErrVisitorLimit
+			}
+			_, err = tx.QRCodeRedemption.Create().
+				SetQrCodeID(qr.ID).
+				SetVisitor(visitor).
+				SetCount(1).
+				SetFirstAt(now).
+				SetLastAt(now).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// The limit is compared with the stored max_scans, and updated_at is
+	// kept as it was since a scan is not an edit. A code edited since it
+	// was loaded matches nothing and is loaded again.
+	n, err := tx.QRCode.Update().
+		Where(qrcode.IDEQ(qr.ID), qrcode.UpdatedAtEQ(qr.UpdatedAt), belowMaxScans()).
+		AddRedeemed(1).
+		SetUpdatedAt(qr.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		current, err := tx.QRCode.Get(ctx, qr.ID)
+		if err != nil {
+			return err
+		}
+		if !current.UpdatedAt.Equal(qr.UpdatedAt) {
+			return errEdited
+		}
+		return ErrExhausted
+	}
+	return nil
+}
+
+// belowMaxScans matches codes without max_scans or with scans left
+func belowMaxScans() predicate.QRCode {
+	return predicate.QRCode(func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.IsNull(s.C(qrcode.FieldMaxScans)),
+			sql.ColumnsLT(s.C(qrcode.FieldRedeemed), s.C(qrcode.FieldMaxScans)),
+		))
+	})
+}
@@ -0,0 +1,147 @@
+package scanlimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"qr_backend/ent"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+func connect(t *testing.T) {
+	t.Helper()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(t.TempDir(), "test.db")},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+}
+
+func createCode(t *testing.T, maxScans, perVisitor *int) *ent.QRCode {
+	t.Helper()
+	qr, err := database.DB.QRCode.Create().
+		SetType("dynamic").
+		SetTitle("Coupon").
+		SetContent(map[string]interface{}{"url": "https://example.com"}).
+		SetNillableMaxScans(maxScans).
+		SetNillableScansPerVisitor(perVisitor).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return qr
+}
+
+// redeemAll redeems qr once per visitor, all at the same time, and counts
+// the outcomes
+func redeemAll(t *testing.T, qr *ent.QRCode, visitors []string) (ok, exhausted, visitorLimit int) {
+	t.Helper()
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, visitor := range visitors {
+		wg.Add(1)
+		go func(visitor string) {
+			defer wg.Done()
+			err := Redeem(context.Background(), qr, visitor)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				ok++
+			case errors.Is(err, ErrExhausted):
+				exhausted++
+			case errors.Is(err, ErrVisitorLimit):
+				visitorLimit++
+			default:
+				t.Errorf("Redeem: %v", err)
+			}
+		}(visitor)
+	}
+	wg.Wait()
+	return ok, exhausted, visitorLimit
+}
+
+func TestRedeemConcurrentScansStopAtMaxScans(t *testing.T) {
+	connect(t)
+	const maxScans, scans = 5, 40
+	qr := createCode(t, intPtr(maxScans), nil)
+
+	visitors := make([]string, scans)
+	for i := range visitors {
+		visitors[i] = Visitor(qr.ID, fmt.Sprint("visitor-", i))
+	}
+	ok, exhausted, _ := redeemAll(t, qr, visitors)
+	if ok != maxScans || exhausted != scans-maxScans {
+		t.Errorf("%d redeemed and %d exhausted, want %d and %d", ok, exhausted, maxScans, scans-maxScans)
+	}
+
+	qr = database.DB.QRCode.GetX(context.Background(), qr.ID)
+	if qr.Redeemed != maxScans {
+		t.Errorf("redeemed = %d, want %d", qr.Redeemed, maxScans)
+	}
+	if remaining := Remaining(qr); remaining == nil || *remaining != 0 {
+		t.Errorf("Remaining = %v, want 0", remaining)
+	}
+}
+
+func TestRedeemPerVisitorLimit(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	qr := createCode(t, nil, intPtr(2))
+	alice, bob := Visitor(qr.ID, "alice"), Visitor(qr.ID, "bob")
+
+	for i, want := range []error{nil, nil, ErrVisitorLimit, ErrVisitorLimit} {
+		if err := Redeem(ctx, qr, alice); !errors.Is(err, want) || (err == nil) != (want == nil) {
+			t.Errorf("scan %d by alice: got %v, want %v", i+1, err, want)
+		}
+	}
+	if err := Redeem(ctx, qr, bob); err != nil {
+		t.Errorf("first scan by bob: %v", err)
+	}
+
+	// Rejected repeats are not counted against the code
+	qr = database.DB.QRCode.GetX(ctx, qr.ID)
+	if qr.Redeemed != 3 {
+		t.Errorf("redeemed = %d, want 3", qr.Redeemed)
+	}
+}
+
+func TestRedeemConcurrentRepeatsByOneVisitor(t *testing.T) {
+	connect(t)
+	qr := createCode(t, intPtr(100), intPtr(1))
+
+	visitor := Visitor(qr.ID, "carol")
+	visitors := make([]string, 20)
+	for i := range visitors {
+		visitors[i] = visitor
+	}
+	ok, _, visitorLimit := redeemAll(t, qr, visitors)
+	if ok != 1 || visitorLimit != len(visitors)-1 {
+		t.Errorf("%d redeemed and %d refused, want 1 and %d", ok, visitorLimit, len(visitors)-1)
+	}
+}
+
+func TestRedeemAfterEdit(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	stale := createCode(t, intPtr(1), nil)
+
+	// Raising max_scans after the code was loaded counts against the new limit
+	database.DB.QRCode.UpdateOneID(stale.ID).SetMaxScans(2).SaveX(ctx)
+	for i, want := range []error{nil, nil, ErrExhausted} {
+		if err := Redeem(ctx, stale, Visitor(stale.ID, fmt.Sprint(i))); !errors.Is(err, want) || (err == nil) != (want == nil) {
+			t.Errorf("scan %d: got %v, want %v", i+1, err, want)
+		}
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
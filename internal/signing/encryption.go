@@ -0,0 +1,115 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log"
+
+	"qr_backend/ent"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// sealedPrefix marks a private key encrypted at rest; keys stored before
+// encryption was configured are the bare 64-byte Ed25519 key
+var sealedPrefix = []byte("qrk1")
+
+// ErrKeyEncrypted is returned for signing with an encrypted key while no
+// encryption key is configured
+var ErrKeyEncrypted = errors.New("signing key is encrypted; set SIGNING_KEY_ENCRYPTION_KEY")
+
+// keyCipher encrypts private keys at rest, or is nil when they are stored
+// unencrypted
+var keyCipher cipher.AEAD
+
+// Init sets the key encrypting private signing keys at rest and encrypts
+// the keys stored without it. It fails when the configured key does not
+// decrypt the stored keys, rather than signing with new keys from then on.
+func Init(ctx context.Context, cfg config.SigningConfig) error {
+	if cfg.KeyEncryptionKey == "" {
+		keyCipher = nil
+		log.Printf("SIGNING_KEY_ENCRYPTION_KEY is not set; signing keys are stored unencrypted, so anyone reading the database can sign payloads")
+		return nil
+	}
+	sum := sha256.Sum256([]byte(cfg.KeyEncryptionKey))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return err
+	}
+	if keyCipher, err = cipher.NewGCM(block); err != nil {
+		return err
+	}
+
+	keys, err := database.DB.SigningKey.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	// Every key is checked before any is encrypted, so a wrong encryption
+	// key never encrypts some keys with it
+	for _, key := range keys {
+		if _, err := privateKey(key); err != nil {
+			return err
+		}
+	}
+	for _, key := range keys {
+		if sealed(key.PrivateKey) {
+			continue
+		}
+		priv := ed25519.PrivateKey(key.PrivateKey)
+		if err := key.Update().SetPrivateKey(sealKey(key.Kid, priv)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to encrypt signing key %s: %w", key.Kid, err)
+		}
+	}
+	return nil
+}
+
+// sealed reports whether a stored private key is encrypted
+func sealed(stored []byte) bool {
+	return bytes.HasPrefix(stored, sealedPrefix)
+}
+
+// sealKey returns a private key as it is stored: encrypted when an
+// encryption key is configured. The key ID is authenticated with it, so an
+// encrypted key cannot be moved to another key's row.
+func sealKey(kid string, priv ed25519.PrivateKey) []byte {
+	if keyCipher == nil {
+		return priv
+	}
+	nonce := make([]byte, keyCipher.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err) // crypto/rand does not fail on supported platforms
+	}
+	out := append(append([]byte{}, sealedPrefix...), nonce...)
+	return keyCipher.Seal(out, nonce, priv, []byte(kid))
+}
+
+// privateKey returns the private key of a stored signing key
+func privateKey(key *ent.SigningKey) (ed25519.PrivateKey, error) {
+	stored := key.PrivateKey
+	if !sealed(stored) {
+		if len(stored) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("signing key %s is not an Ed25519 private key", key.Kid)
+		}
+		return ed25519.PrivateKey(stored), nil
+	}
+	if keyCipher == nil {
+		return nil, ErrKeyEncrypted
+	}
+	stored = stored[len(sealedPrefix):]
+	if len(stored) < keyCipher.NonceSize() {
+		return nil, fmt.Errorf("signing key %s is truncated", key.Kid)
+	}
+	nonce, ciphertext := stored[:keyCipher.NonceSize()], stored[keyCipher.NonceSize():]
+	priv, err := keyCipher.Open(nil, nonce, ciphertext, []byte(key.Kid))
+	if err != nil || len(priv) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("SIGNING_KEY_ENCRYPTION_KEY does not decrypt signing key %s", key.Kid)
+	}
+	return ed25519.PrivateKey(priv), nil
+}
@@ -0,0 +1,186 @@
+// Package signing keeps the Ed25519 keys that sign QR code payloads and
+// signs and verifies payloads with them. One key is active and signs new
+// payloads. Rotating creates a new active key and retires the old one,
+// whose payloads still verify; revoking a key makes its payloads invalid.
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/signingkey"
+	"qr_backend/internal/database"
+	"qr_backend/pkg/qrsign"
+)
+
+// Key errors
+var (
+	ErrRevoked    = errors.New("signed with a revoked key")
+	ErrKeyRevoked = errors.New("signing key is already revoked")
+)
+
+// Rotate creates a new active key and retires the current one
+func Rotate(ctx context.Context) (*ent.SigningKey, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	var key *ent.SigningKey
+	err = database.RetryLocked(ctx, func() error {
+		tx, err := database.DB.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.SigningKey.Update().
+			Where(signingkey.StatusEQ(signingkey.StatusActive)).
+			SetStatus(signingkey.StatusRetired).
+			SetRetiredAt(time.Now()).
+			Save(ctx)
+		if err == nil {
+			key, err = tx.SigningKey.Create().
+				SetKid(qrsign.Thumbprint(pub)).
+				SetPublicKey(pub).
+				SetPrivateKey(sealKey(qrsign.Thumbprint(pub), priv)).
+				Save(ctx)
+		}
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+	return key, err
+}
+
+// Revoke revokes a key. Payloads it signed no longer verify, so codes that
+// carry them should be signed again. Revoking the active key leaves none;
+// the next signature creates a new one.
+func Revoke(ctx context.Context, kid string) (*ent.SigningKey, error) {
+	key, err := database.DB.SigningKey.Query().Where(signingkey.Kid(kid)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key.Status == signingkey.StatusRevoked {
+		return nil, ErrKeyRevoked
+	}
+	return key.Update().
+		SetStatus(signingkey.StatusRevoked).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// activeKey returns the newest active key, creating the first one
+func activeKey(ctx context.Context) (*ent.SigningKey, error) {
+	key, err := database.DB.SigningKey.Query().
+		Where(signingkey.StatusEQ(signingkey.StatusActive)).
+		Order(ent.Desc(signingkey.FieldCreatedAt), ent.Desc(signingkey.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return Rotate(ctx)
+	}
+	return key, err
+}
+
+// Claims returns the claims signed for a code: its title and content, valid
+// from its start time until it expires. IssuedAt is left to the signer.
+func Claims(qr *ent.QRCode) qrsign.Claims {
+	claims := qrsign.Claims{
+		Subject: strconv.Itoa(qr.ID),
+		Title:   qr.Title,
+		Data:    qr.Content,
+	}
+	if qr.StartsAt != nil {
+		claims.NotBefore = qr.StartsAt.Unix()
+	}
+	if qr.ExpiresAt != nil {
+		claims.Expires = qr.ExpiresAt.Unix()
+	}
+	return claims
+}
+
+// SignQRCode signs the claims of a code with the active key
+func SignQRCode(ctx context.Context, qr *ent.QRCode) (string, error) {
+	key, err := activeKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	priv, err := privateKey(key)
+	if err != nil {
+		return "", err
+	}
+	claims := Claims(qr)
+	claims.IssuedAt = time.Now().Unix()
+	return qrsign.Sign(priv, key.Kid, claims)
+}
+
+// Matches reports whether signed claims still describe a code as stored
+func Matches(claims qrsign.Claims, qr *ent.QRCode) bool {
+	want := Claims(qr)
+	want.IssuedAt = claims.IssuedAt
+	a, err1 := json.Marshal(claims)
+	b, err2 := json.Marshal(want)
+	return err1 == nil && err2 == nil && bytes.Equal(a, b)
+}
+
+// Verify checks a signed payload against the stored keys. Like
+// qrsign.Verify, it returns the token along with ErrExpired or
+// ErrNotYetValid; payloads of revoked keys fail with ErrRevoked.
+func Verify(ctx context.Context, token string) (*qrsign.Token, error) {
+	keys := &storedKeys{ctx: ctx}
+	t, err := qrsign.Verify(token, keys, time.Now())
+	switch {
+	case keys.err != nil:
+		return nil, keys.err
+	case keys.revoked:
+		return nil, ErrRevoked
+	}
+	return t, err
+}
+
+// storedKeys looks up the public keys of payloads in the database
+type storedKeys struct {
+	ctx     context.Context
+	revoked bool
+	err     error
+}
+
+func (s *storedKeys) PublicKey(kid string) (ed25519.PublicKey, bool) {
+	key, err := database.DB.SigningKey.Query().Where(signingkey.Kid(kid)).Only(s.ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			s.err = err
+		}
+		return nil, false
+	}
+	if key.Status == signingkey.StatusRevoked {
+		s.revoked = true
+		return nil, false
+	}
+	return ed25519.PublicKey(key.PublicKey), true
+}
+
+// JWKS returns the public keys that still verify payloads, for verifiers
+// that check them offline
+func JWKS(ctx context.Context) (qrsign.JWKS, error) {
+	keys, err := database.DB.SigningKey.Query().
+		Where(signingkey.StatusNEQ(signingkey.StatusRevoked)).
+		Order(ent.Desc(signingkey.FieldCreatedAt), ent.Desc(signingkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		return qrsign.JWKS{}, err
+	}
+	set := qrsign.JWKS{Keys: []qrsign.JWK{}}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, qrsign.PublicJWK(k.Kid, ed25519.PublicKey(k.PublicKey)))
+	}
+	return set, nil
+}
@@ -0,0 +1,192 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"qr_backend/ent"
+	"qr_backend/ent/signingkey"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/pkg/qrsign"
+)
+
+func connect(t *testing.T) {
+	t.Helper()
+	cfg := &config.Config{
+		Database: config.DatabaseConfig{Type: "sqlite", Path: filepath.Join(t.TempDir(), "test.db")},
+	}
+	if err := database.Connect(cfg); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+}
+
+func createTicket(t *testing.T) *ent.QRCode {
+	t.Helper()
+	qr, err := database.DB.QRCode.Create().
+		SetType("static").
+		SetTitle("Ticket").
+		SetContent(map[string]interface{}{"text": "Row 4, seat 12"}).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return qr
+}
+
+func signTicket(t *testing.T, qr *ent.QRCode) (string, *qrsign.Token) {
+	t.Helper()
+	ctx := context.Background()
+	token, err := SignQRCode(ctx, qr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verified, err := Verify(ctx, token)
+	if err != nil {
+		t.Fatalf("Verify of a fresh token: %v", err)
+	}
+	return token, verified
+}
+
+func TestRotateAndRevoke(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	qr := createTicket(t)
+
+	// The first signature creates the first key
+	oldToken, old := signTicket(t, qr)
+	if !Matches(old.Claims, qr) {
+		t.Error("claims of a fresh token do not match the code")
+	}
+
+	rotated, err := Rotate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.Kid == old.KeyID || rotated.Status != signingkey.StatusActive {
+		t.Fatalf("Rotate returned %+v", rotated)
+	}
+	retired := database.DB.SigningKey.Query().Where(signingkey.Kid(old.KeyID)).OnlyX(ctx)
+	if retired.Status != signingkey.StatusRetired || retired.RetiredAt == nil {
+		t.Errorf("old key is %s after rotation, want retired", retired.Status)
+	}
+
+	// Tokens of the retired key still verify; new ones use the new key
+	if _, err := Verify(ctx, oldToken); err != nil {
+		t.Errorf("token of the retired key: %v", err)
+	}
+	newToken, fresh := signTicket(t, qr)
+	if fresh.KeyID != rotated.Kid {
+		t.Errorf("signed with %s, want the new key %s", fresh.KeyID, rotated.Kid)
+	}
+
+	jwks, err := JWKS(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jwks.Keys) != 2 {
+		t.Errorf("JWKS has %d keys, want the active and retired ones", len(jwks.Keys))
+	}
+
+	// Revoking the retired key invalidates its tokens only
+	if _, err := Revoke(ctx, old.KeyID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Verify(ctx, oldToken); !errors.Is(err, ErrRevoked) {
+		t.Errorf("token of the revoked key: got %v, want ErrRevoked", err)
+	}
+	if _, err := Verify(ctx, newToken); err != nil {
+		t.Errorf("token of the active key after revoking the old one: %v", err)
+	}
+	if _, err := Revoke(ctx, old.KeyID); !errors.Is(err, ErrKeyRevoked) {
+		t.Errorf("revoking twice: got %v, want ErrKeyRevoked", err)
+	}
+	if jwks, _ := JWKS(ctx); len(jwks.Keys) != 1 || jwks.Keys[0].KeyID != rotated.Kid {
+		t.Errorf("JWKS after revocation = %+v, want only the active key", jwks.Keys)
+	}
+
+	// Revoking the active key leaves none; the next signature creates one
+	if _, err := Revoke(ctx, rotated.Kid); err != nil {
+		t.Fatal(err)
+	}
+	if _, next := signTicket(t, qr); next.KeyID == rotated.Kid || next.KeyID == old.KeyID {
+		t.Errorf("signed with revoked key %s", next.KeyID)
+	}
+}
+
+func TestVerifyUnknownKey(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	token, _ := signTicket(t, createTicket(t))
+
+	// A token from another deployment names a key this one does not have
+	database.DB.SigningKey.Delete().ExecX(ctx)
+	if _, err := Verify(ctx, token); !errors.Is(err, qrsign.ErrUnknownKey) {
+		t.Errorf("got %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestMatchesDetectsEdits(t *testing.T) {
+	connect(t)
+	qr := createTicket(t)
+	_, token := signTicket(t, qr)
+
+	edited := database.DB.QRCode.UpdateOne(qr).SetTitle("VIP ticket").SaveX(context.Background())
+	if Matches(token.Claims, edited) {
+		t.Error("claims match a code whose title changed")
+	}
+}
+
+func TestPrivateKeysAreEncrypted(t *testing.T) {
+	connect(t)
+	ctx := context.Background()
+	t.Cleanup(func() { keyCipher = nil })
+
+	// A key stored before encryption was configured is encrypted by Init
+	legacyToken, legacy := signTicket(t, createTicket(t))
+	stored := database.DB.SigningKey.Query().Where(signingkey.Kid(legacy.KeyID)).OnlyX(ctx)
+	plain := append([]byte{}, stored.PrivateKey...)
+	if err := Init(ctx, config.SigningConfig{KeyEncryptionKey: "correct horse battery staple"}); err != nil {
+		t.Fatal(err)
+	}
+	stored = database.DB.SigningKey.GetX(ctx, stored.ID)
+	if !sealed(stored.PrivateKey) || bytes.Contains(stored.PrivateKey, plain[:32]) {
+		t.Fatal("existing private key was not encrypted")
+	}
+
+	// New keys are stored encrypted, and both keys still sign and verify
+	rotated, err := Rotate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sealed(rotated.PrivateKey) {
+		t.Error("new private key is stored unencrypted")
+	}
+	if _, token := signTicket(t, createTicket(t)); token.KeyID != rotated.Kid {
+		t.Errorf("signed with %s, want %s", token.KeyID, rotated.Kid)
+	}
+	if _, err := Verify(ctx, legacyToken); err != nil {
+		t.Errorf("token of the re-encrypted key: %v", err)
+	}
+
+	// An encrypted key cannot be used without the right encryption key
+	if err := Init(ctx, config.SigningConfig{KeyEncryptionKey: "wrong"}); err == nil {
+		t.Error("Init accepted a key that does not decrypt the stored keys")
+	}
+	Init(ctx, config.SigningConfig{})
+	if _, err := SignQRCode(ctx, createTicket(t)); !errors.Is(err, ErrKeyEncrypted) {
+		t.Errorf("signing without the encryption key: got %v, want ErrKeyEncrypted", err)
+	}
+
+	// A sealed key moved to another key's row does not decrypt
+	Init(ctx, config.SigningConfig{KeyEncryptionKey: "correct horse battery staple"})
+	moved := *rotated
+	moved.Kid = legacy.KeyID
+	if _, err := privateKey(&moved); err == nil {
+		t.Error("a sealed key decrypted under another key ID")
+	}
+}
@@ -0,0 +1,287 @@
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"regexp"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/ent/predicate"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+	"qr_backend/internal/storage"
+)
+
+// gcBatchSize limits how many rows are loaded at once while collecting
+const gcBatchSize = 500
+
+var (
+	// fileURLPattern finds stored files linked from QR code content, designs
+	// and redirect URLs
+	fileURLPattern = regexp.MustCompile(`/(?:files|uploads)/([A-Za-z0-9._-]+)`)
+	// managedKeyPattern matches the objects this service writes: content-hash
+	// keys and their variants, files named before storage backends existed
+	// and interrupted local writes. Other objects are never collected.
+	managedKeyPattern = regexp.MustCompile(`^([0-9a-f]{64}(-[a-z0-9]+)?\.[a-z0-9]+|[0-9]{9,}_[^/]+|\.upload-[0-9]+)$`)
+	// checksumKeyPattern extracts the content hash from the key of an
+	// original or variant
+	checksumKeyPattern = regexp.MustCompile(`^([0-9a-f]{64})[-.]`)
+)
+
+// gcGrace is the minimum age of an orphan before it is collected
+var gcGrace = 24 * time.Hour
+
+// GCGrace returns the configured minimum age of collected orphans
+func GCGrace() time.Duration {
+	return gcGrace
+}
+
+// GCReport summarises a garbage collection run
+type GCReport struct {
+	DryRun      bool  `json:"dry_run"`
+	OrphanRows  int   `json:"orphan_rows"`
+	OrphanFiles int   `json:"orphan_files"`
+	FreedBytes  int64 `json:"freed_bytes"`
+}
+
+// StartGC collects orphaned file references and stored files in the
+// background every cfg.GCInterval until ctx is cancelled
+func StartGC(ctx context.Context, cfg config.StorageConfig) {
+	if cfg.GCInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(cfg.GCInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			report, err := CollectGarbage(ctx, cfg.GCGrace, false)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("File garbage collection failed: %v", err)
+				continue
+			}
+			if report.OrphanRows > 0 || report.OrphanFiles > 0 {
+				log.Printf("File garbage collection: removed %d file reference(s) and %d file(s), freed %d bytes",
+					report.OrphanRows, report.OrphanFiles, report.FreedBytes)
+			}
+		}
+	}()
+}
+
+// CollectGarbage removes file references that no QR code uses and stored
+// files that no file reference records, once they are older than grace.
+// A file reference is in use when it is attached to a QR code or its URL
+// appears in a QR code's content, design or redirect URL, which covers
+// logos uploaded separately. With dryRun nothing is deleted.
+func CollectGarbage(ctx context.Context, grace time.Duration, dryRun bool) (GCReport, error) {
+	report := GCReport{DryRun: dryRun}
+	cutoff := time.Now().Add(-grace)
+
+	linked, err := linkedKeys(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	// Sort file references into orphans and the ones whose objects are kept
+	kept := map[string]bool{}
+	var orphans []*ent.FileReference
+	lastID := 0
+	for {
+		refs, err := database.DB.FileReference.Query().
+			Where(filereference.IDGT(lastID)).
+			WithQrCode(func(q *ent.QRCodeQuery) {
+				q.Select(qrcode.FieldID)
+			}).
+			Order(ent.Asc(filereference.FieldID)).
+			Limit(gcBatchSize).
+			All(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to load file references: %w", err)
+		}
+		for _, ref := range refs {
+			lastID = ref.ID
+			keys := refKeys(ref)
+			if ref.Edges.QrCode == nil && (ref.CreatedAt == nil || ref.CreatedAt.Before(cutoff)) && !anyLinked(linked, keys) {
+				orphans = append(orphans, ref)
+				continue
+			}
+			for _, key := range keys {
+				kept[key] = true
+			}
+		}
+		if len(refs) < gcBatchSize {
+			break
+		}
+	}
+
+	// Delete orphaned rows, then their objects unless another row shares them
+	removed := map[string]bool{}
+	for _, ref := range orphans {
+		if !dryRun {
+			if err := database.DB.FileReference.DeleteOne(ref).Exec(ctx); err != nil {
+				return report, fmt.Errorf("failed to delete file reference %d: %w", ref.ID, err)
+			}
+		}
+		report.OrphanRows++
+
+		backend, err := storage.Backend(ref.Backend)
+		if err != nil {
+			continue
+		}
+		for _, key := range refKeys(ref) {
+			if kept[key] || removed[backend.Name()+"/"+key] {
+				continue
+			}
+			if !dryRun {
+				deleted, err := deleteUnused(ctx, backend, key)
+				if err != nil {
+					return report, err
+				}
+				if !deleted {
+					continue
+				}
+			}
+			removed[backend.Name()+"/"+key] = true
+			report.OrphanFiles++
+		}
+		report.FreedBytes += ref.Size + ref.VariantsSize
+	}
+
+	// Delete stored files that no row records, e.g. after a failed upload
+	for _, backend := range storage.All() {
+		err := backend.List(ctx, func(obj storage.Object) error {
+			key := obj.Key
+			if !managedKeyPattern.MatchString(key) || kept[key] || linked[key] ||
+				removed[backend.Name()+"/"+key] || !obj.Modified.Before(cutoff) {
+				return nil
+			}
+			if !dryRun {
+				deleted, err := deleteUnused(ctx, backend, key)
+				if err != nil || !deleted {
+					return err
+				}
+			}
+			removed[backend.Name()+"/"+key] = true
+			report.OrphanFiles++
+			report.FreedBytes += obj.Size
+			return nil
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to list %s storage: %w", backend.Name(), err)
+		}
+	}
+	return report, nil
+}
+
+// deleteUnused deletes a stored object unless a file reference saved since
+// the collection started or an upload in progress uses it. Content being
+// deleted is marked first, so an upload of the same content waits for the
+// delete and stores it again instead of reusing an object about to go.
+func deleteUnused(ctx context.Context, backend storage.Storage, key string) (bool, error) {
+	users := []predicate.FileReference{filereference.Key(key), filereference.URL("/uploads/" + key)}
+	if match := checksumKeyPattern.FindStringSubmatch(key); match != nil {
+		checksum := match[1]
+		quotaMu.Lock()
+		if inFlight[checksum] > 0 {
+			quotaMu.Unlock()
+			return false, nil
+		}
+		deleting[checksum]++
+		quotaMu.Unlock()
+		defer func() {
+			quotaMu.Lock()
+			if deleting[checksum]--; deleting[checksum] <= 0 {
+				delete(deleting, checksum)
+			}
+			deleted.Broadcast()
+			quotaMu.Unlock()
+		}()
+		users = append(users, filereference.Checksum(checksum))
+	}
+
+	// Uploads save their file reference before they stop holding their
+	// content, so one that finished before the mark is found here
+	used, err := database.DB.FileReference.Query().
+		Where(filereference.Backend(backend.Name()), filereference.Or(users...)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check file references of %s: %w", key, err)
+	}
+	if used {
+		return false, nil
+	}
+	if err := backend.Delete(ctx, key); err != nil {
+		return false, fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// linkedKeys returns the keys of every stored file a QR code links to
+func linkedKeys(ctx context.Context) (map[string]bool, error) {
+	linked := map[string]bool{}
+	lastID := 0
+	for {
+		codes, err := database.DB.QRCode.Query().
+			Where(qrcode.IDGT(lastID)).
+			Select(qrcode.FieldID, qrcode.FieldContent, qrcode.FieldDesign, qrcode.FieldRedirectURL).
+			Order(ent.Asc(qrcode.FieldID)).
+			Limit(gcBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load QR codes: %w", err)
+		}
+		for _, qr := range codes {
+			lastID = qr.ID
+			data, err := json.Marshal([]interface{}{qr.Content, qr.Design, qr.RedirectURL})
+			if err != nil {
+				continue
+			}
+			for _, match := range fileURLPattern.FindAllStringSubmatch(string(data), -1) {
+				linked[match[1]] = true
+			}
+		}
+		if len(codes) < gcBatchSize {
+			return linked, nil
+		}
+	}
+}
+
+// refKeys returns the object keys of a file reference and its variants.
+// References recorded before storage backends existed have no key; their
+// file is named by the last element of their /uploads URL.
+func refKeys(ref *ent.FileReference) []string {
+	key := ref.Key
+	if key == "" {
+		key = path.Base(ref.URL)
+	}
+	keys := []string{key}
+	for _, v := range ref.Variants {
+		if v.Key != key {
+			keys = append(keys, v.Key)
+		}
+	}
+	return keys
+}
+
+// anyLinked reports whether any of the keys is linked from a QR code
+func anyLinked(linked map[string]bool, keys []string) bool {
+	for _, key := range keys {
+		if linked[key] {
+			return true
+		}
+	}
+	return false
+}
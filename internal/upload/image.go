@@ -0,0 +1,98 @@
+package upload
+
+import (
+	"errors"
+	"log"
+
+	"qr_backend/ent/schema"
+	"qr_backend/internal/config"
+	"qr_backend/internal/storage"
+	"qr_backend/pkg/imageproc"
+)
+
+// ErrImageTooLarge is returned for images with too many pixels to process
+var ErrImageTooLarge = errors.New("image dimensions exceed the allowed maximum")
+
+// imageOptions configures the image pipeline; nil disables it
+var imageOptions *imageproc.Options
+
+// initImages sets up image processing, disabling WebP and AVIF output when
+// their encoders are not installed
+func initImages(cfg config.ImageConfig) {
+	if !cfg.Process {
+		imageOptions = nil
+		return
+	}
+
+	opts := imageproc.Options{
+		Sizes: []imageproc.Size{
+			{Name: "thumbnail", Width: cfg.ThumbnailSize},
+			{Name: "medium", Width: cfg.MediumSize},
+		},
+		MaxDimension: cfg.MaxDimension,
+		MaxPixels:    cfg.MaxPixels,
+		Quality:      cfg.Quality,
+		WebP:         cfg.WebP,
+		AVIF:         cfg.AVIF,
+	}
+	if opts.WebP && !imageproc.Available(imageproc.FormatWebP) {
+		log.Println("IMAGE_WEBP is set but cwebp is not installed; WebP variants are disabled")
+		opts.WebP = false
+	}
+	if opts.AVIF && !imageproc.Available(imageproc.FormatAVIF) {
+		log.Println("IMAGE_AVIF is set but avifenc is not installed; AVIF variants are disabled")
+		opts.AVIF = false
+	}
+	imageOptions = &opts
+}
+
+func imagesEnabled() bool {
+	return imageOptions != nil
+}
+
+// storeImage processes a validated image and stores every variant. The
+// original variant replaces the uploaded bytes, so the stored file is
+// upright and carries no EXIF metadata.
+func storeImage(p *pending, name string, data []byte) (*File, error) {
+	result, err := imageproc.Process(data, *imageOptions)
+	if errors.Is(err, imageproc.ErrTooManyPixels) {
+		return nil, ErrImageTooLarge
+	}
+	if err != nil {
+		return nil, ErrTypeMismatch
+	}
+
+	original := result.Variants[0]
+	file, err := store(p, name, original.ContentType, original.Data)
+	if err != nil {
+		return nil, err
+	}
+	file.Width, file.Height = result.Width, result.Height
+
+	backend := storage.Default()
+	for _, v := range result.Variants {
+		key := file.Key
+		if v.Name != imageproc.Original || v.Format != original.Format {
+			key = VariantKey(file.Checksum, v.Name, v.Format)
+			if err := put(backend, key, v.Data, v.ContentType); err != nil {
+				return nil, err
+			}
+		}
+		file.Variants = append(file.Variants, schema.ImageVariant{
+			Name:   v.Name,
+			Format: v.Format,
+			Type:   v.ContentType,
+			Width:  v.Width,
+			Height: v.Height,
+			Size:   int64(len(v.Data)),
+			Key:    key,
+			URL:    FileURL(key),
+		})
+	}
+	return file, nil
+}
+
+// VariantKey returns the object key of an image variant
+func VariantKey(checksum, name, format string) string {
+	return checksum + "-" + name + "." + format
+}
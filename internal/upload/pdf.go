@@ -0,0 +1,83 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+
+	"qr_backend/ent/schema"
+	"qr_backend/internal/storage"
+	"qr_backend/pkg/imageproc"
+	"qr_backend/pkg/pdfinfo"
+)
+
+// Errors returned for rejected PDF uploads
+var (
+	ErrEncryptedPDF = errors.New("encrypted or password-protected PDFs are not allowed")
+	ErrMalformedPDF = errors.New("the PDF document is malformed or unreadable")
+	ErrTooManyPages = errors.New("the PDF document has too many pages")
+	ErrPDFTooLarge  = errors.New("the PDF document expands to too much data")
+)
+
+// storePDF reads the metadata of a validated PDF, enforces the page limit
+// and stores it. When the first page is a scanned JPEG, a thumbnail of it is
+// stored as the "thumbnail" variant; other pages cannot be rendered.
+func storePDF(p *pending, name string, data []byte) (*File, error) {
+	info, err := pdfinfo.Parse(data)
+	switch {
+	case errors.Is(err, pdfinfo.ErrEncrypted):
+		return nil, ErrEncryptedPDF
+	case errors.Is(err, pdfinfo.ErrTooLarge):
+		return nil, ErrPDFTooLarge
+	case err != nil:
+		return nil, ErrMalformedPDF
+	case settings.MaxPDFPages > 0 && info.Pages > settings.MaxPDFPages:
+		return nil, fmt.Errorf("%w (%d pages, limit is %d)", ErrTooManyPages, info.Pages, settings.MaxPDFPages)
+	}
+
+	file, err := store(p, name, TypePDF, data)
+	if err != nil {
+		return nil, err
+	}
+	file.PDF = info
+
+	if imagesEnabled() && len(info.FirstPageImage) > 0 {
+		// A thumbnail is best effort; the PDF is stored either way
+		file.Variants, _ = storePDFThumbnail(file.Checksum, info.FirstPageImage)
+	}
+	return file, nil
+}
+
+// storePDFThumbnail stores a thumbnail-sized rendition of a page image
+func storePDFThumbnail(checksum string, page []byte) ([]schema.ImageVariant, error) {
+	opts := *imageOptions
+	opts.Sizes = nil
+	for _, size := range imageOptions.Sizes {
+		if size.Name == "thumbnail" {
+			opts.MaxDimension = size.Width
+		}
+	}
+	result, err := imageproc.Process(page, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := storage.Default()
+	var variants []schema.ImageVariant
+	for _, v := range result.Variants {
+		key := VariantKey(checksum, "thumbnail", v.Format)
+		if err := put(backend, key, v.Data, v.ContentType); err != nil {
+			return nil, err
+		}
+		variants = append(variants, schema.ImageVariant{
+			Name:   "thumbnail",
+			Format: v.Format,
+			Type:   v.ContentType,
+			Width:  v.Width,
+			Height: v.Height,
+			Size:   int64(len(v.Data)),
+			Key:    key,
+			URL:    FileURL(key),
+		})
+	}
+	return variants, nil
+}
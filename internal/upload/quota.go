@@ -0,0 +1,242 @@
+package upload
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"qr_backend/ent"
+	"qr_backend/ent/filereference"
+	"qr_backend/internal/database"
+)
+
+var (
+	// ErrQuotaExceeded is returned when an upload would exceed the storage quota
+	ErrQuotaExceeded = errors.New("storage quota exceeded")
+	// ErrNoOwner is returned for uploads that do not name their owner while
+	// quotas apply to each owner
+	ErrNoOwner = errors.New("upload does not name its owner")
+)
+
+var (
+	// quota is the maximum number of bytes each owner may store; 0 means unlimited
+	quota int64
+	// ownerHeader is the request header naming the owner of an upload
+	ownerHeader string
+
+	// quotaMu guards the bytes reserved by uploads whose file reference is
+	// not saved yet, the content they store and the content the garbage
+	// collector is deleting. It is only held to update these maps, never
+	// across database or storage I/O.
+	quotaMu    sync.Mutex
+	reserved   = map[string]int64{}      // By owner
+	inFlight   = map[string]int{}        // Checksums of content being stored
+	deleting   = map[string]int{}        // Checksums of content being deleted
+	ownerLocks = map[string]*ownerLock{} // Locks of the owners uploading
+	// deleted is signalled when the garbage collector finishes a delete
+	deleted = sync.NewCond(&quotaMu)
+)
+
+// OwnerHeader returns the request header naming the owner of uploads, or
+// "" when the workspace is the only owner. Only a trusted proxy may set it.
+func OwnerHeader() string {
+	return ownerHeader
+}
+
+// Usage is the storage used by an owner. Every file reference counts with
+// its original and variants, so the same content uploaded twice counts
+// twice even though it is stored once.
+type Usage struct {
+	Owner string `json:"owner,omitempty"`
+	Files int    `json:"files"`
+	Bytes int64  `json:"bytes"`
+	Quota int64  `json:"quota,omitempty"`
+}
+
+// CurrentUsage sums the size of every file recorded for owner
+func CurrentUsage(ctx context.Context, owner string) (Usage, error) {
+	var rows []struct {
+		Files    int           `json:"files"`
+		Size     sql.NullInt64 `json:"size"`
+		Variants sql.NullInt64 `json:"variants"`
+	}
+	err := database.DB.FileReference.Query().
+		Where(filereference.Owner(owner)).
+		Aggregate(
+			ent.As(ent.Count(), "files"),
+			ent.As(ent.Sum(filereference.FieldSize), "size"),
+			ent.As(ent.Sum(filereference.FieldVariantsSize), "variants"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return Usage{}, fmt.Errorf("failed to sum storage usage: %w", err)
+	}
+
+	usage := Usage{Owner: owner, Quota: quota}
+	if len(rows) > 0 {
+		usage.Files = rows[0].Files
+		usage.Bytes = rows[0].Size.Int64 + rows[0].Variants.Int64
+	}
+	return usage, nil
+}
+
+// pending tracks an upload from validation until its file reference is
+// saved: the bytes it reserved and the content it stored
+type pending struct {
+	owner     string
+	size      int64
+	checksums []string
+}
+
+// ownerLock serializes the quota checks and reservations of one owner, so
+// that two uploads cannot both fit into room for one. Uploads of different
+// owners do not wait for each other.
+type ownerLock struct {
+	sync.Mutex
+	waiters int
+}
+
+// lockOwner locks the quota of owner and returns the function unlocking it
+func lockOwner(owner string) func() {
+	quotaMu.Lock()
+	lock := ownerLocks[owner]
+	if lock == nil {
+		lock = &ownerLock{}
+		ownerLocks[owner] = lock
+	}
+	lock.waiters++
+	quotaMu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		quotaMu.Lock()
+		if lock.waiters--; lock.waiters == 0 {
+			delete(ownerLocks, owner)
+		}
+		quotaMu.Unlock()
+	}
+}
+
+// checkQuota rejects content of the given size up front when it would take
+// the owner over their quota, or when it has no owner to count against.
+// It reserves nothing; reserve does once the stored size is known.
+func (p *pending) checkQuota(size int64) error {
+	if quota <= 0 {
+		return nil
+	}
+	// Otherwise leaving out the owner header would escape every owner's quota
+	if ownerHeader != "" && p.owner == "" {
+		return ErrNoOwner
+	}
+	defer lockOwner(p.owner)()
+	return p.fits(size)
+}
+
+// reserve counts size more bytes against the owner's quota until release,
+// failing when they would take the owner over it
+func (p *pending) reserve(size int64) error {
+	if quota > 0 {
+		defer lockOwner(p.owner)()
+		if err := p.fits(size); err != nil {
+			return err
+		}
+	}
+	quotaMu.Lock()
+	reserved[p.owner] += size
+	quotaMu.Unlock()
+	p.size += size
+	return nil
+}
+
+// fits checks size more bytes against the recorded and reserved usage of
+// the owner; the owner's lock must be held. Other uploads save their file
+// references meanwhile, so the sum is retried while SQLite reports the
+// table locked.
+func (p *pending) fits(size int64) error {
+	ctx := context.Background()
+	var usage Usage
+	err := database.RetryLocked(ctx, func() (err error) {
+		usage, err = CurrentUsage(ctx, p.owner)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	quotaMu.Lock()
+	used := usage.Bytes + reserved[p.owner]
+	quotaMu.Unlock()
+	if used+size > quota {
+		return fmt.Errorf("%w (%d of %d bytes used)", ErrQuotaExceeded, used, quota)
+	}
+	return nil
+}
+
+// attach reserves the stored size of a file and hands the reservation to
+// it; on failure the upload is abandoned
+func (p *pending) attach(file *File, err error) (*File, error) {
+	if err == nil {
+		err = p.reserve(file.Size + file.VariantsSize())
+	}
+	if err != nil {
+		p.release()
+		return nil, err
+	}
+	file.Owner = p.owner
+	file.pending = p
+	return file, nil
+}
+
+// hold keeps the garbage collector from deleting content stored under
+// checksum until release. Content the collector is deleting right now is
+// waited for, so that it is stored again rather than reused.
+func (p *pending) hold(checksum string) {
+	quotaMu.Lock()
+	defer quotaMu.Unlock()
+	for deleting[checksum] > 0 {
+		deleted.Wait()
+	}
+	inFlight[checksum]++
+	p.checksums = append(p.checksums, checksum)
+}
+
+// commit runs save, which saves the file reference, and returns the
+// reserved bytes and held content once it succeeds. Both happen under the
+// owner's lock, so a quota check counts the bytes once: either as reserved
+// or as saved.
+func (p *pending) commit(save func() error) error {
+	if quota > 0 {
+		defer lockOwner(p.owner)()
+	}
+	if err := save(); err != nil {
+		return err
+	}
+	p.drop()
+	return nil
+}
+
+// release returns the reserved bytes and held content of an upload that is
+// abandoned or already committed
+func (p *pending) release() {
+	if quota > 0 && p.size > 0 {
+		defer lockOwner(p.owner)()
+	}
+	p.drop()
+}
+
+// drop returns the reserved bytes and held content
+func (p *pending) drop() {
+	quotaMu.Lock()
+	defer quotaMu.Unlock()
+	if reserved[p.owner] -= p.size; reserved[p.owner] <= 0 {
+		delete(reserved, p.owner)
+	}
+	for _, checksum := range p.checksums {
+		if inFlight[checksum]--; inFlight[checksum] <= 0 {
+			delete(inFlight, checksum)
+		}
+	}
+	p.size, p.checksums = 0, nil
+}
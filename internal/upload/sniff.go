@@ -0,0 +1,163 @@
+package upload
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// sniffLen is the number of leading bytes inspected to detect a content type
+const sniffLen = 512
+
+// markupMarkers reveal HTML or script content hidden in a file that is
+// served as something else
+var markupMarkers = []string{"<script", "<html", "<!doctype html", "<?php", "<iframe", "<object", "<embed"}
+
+// Sniff detects the content type from the file bytes. It returns an empty
+// string for content that is not one of the recognised types.
+func Sniff(data []byte) string {
+	head := data
+	if len(head) > sniffLen {
+		head = head[:sniffLen]
+	}
+
+	detected := http.DetectContentType(head)
+	mediaType := strings.TrimSpace(strings.SplitN(detected, ";", 2)[0])
+	switch mediaType {
+	case TypeJPEG, TypePNG, TypeGIF, TypePDF:
+		return mediaType
+	case "text/xml", "text/plain":
+		if isSVG(head) {
+			return TypeSVG
+		}
+		if mediaType == "text/plain" && !bytesContainsAnyMarker(data) {
+			return TypeText
+		}
+	}
+	return ""
+}
+
+// isSVG reports whether an XML or text document has an <svg> root element
+func isSVG(head []byte) bool {
+	lower := bytes.ToLower(head)
+	i := bytes.Index(lower, []byte("<svg"))
+	if i < 0 {
+		return false
+	}
+	// Only an XML declaration, comments or a doctype may precede the root
+	prefix := bytes.TrimSpace(lower[:i])
+	return len(prefix) == 0 || bytes.HasPrefix(prefix, []byte("<?xml")) || bytes.HasPrefix(prefix, []byte("<!"))
+}
+
+// checkContent rejects files that do not decode as their sniffed type or
+// that also carry another format, such as an image with an appended ZIP
+// archive or a PDF with embedded HTML
+func checkContent(data []byte, contentType string) error {
+	switch contentType {
+	case TypeJPEG, TypePNG, TypeGIF:
+		if _, _, err := image.DecodeConfig(bytes.NewReader(data)); err != nil {
+			return ErrTypeMismatch
+		}
+		if bytesContainsAnyMarker(data) || hasForeignSignature(data) {
+			return ErrUnsafeContent
+		}
+	case TypePDF:
+		if !bytes.HasPrefix(data, []byte("%PDF-")) || !bytes.Contains(tail(data, 2048), []byte("%%EOF")) {
+			return ErrTypeMismatch
+		}
+		if bytesContainsAnyMarker(data) || bytes.Contains(tail(data, 64*1024), []byte("PK\x05\x06")) {
+			return ErrUnsafeContent
+		}
+	case TypeSVG:
+		return checkSVG(data)
+	}
+	return nil
+}
+
+// checkSVG parses an SVG and rejects script elements, foreign HTML content,
+// event handler attributes and script URLs, whatever namespace prefix or
+// character references they are written with. Documents that do not parse,
+// including those declaring their own entities, are rejected too. SVGs are
+// still served sandboxed, as this cannot catch everything a browser runs.
+func checkSVG(data []byte) error {
+	lower := bytes.ToLower(data)
+	if bytes.Contains(lower, []byte("<script")) || bytes.Contains(lower, []byte("<!entity")) {
+		return ErrUnsafeContent
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return ErrTypeMismatch
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(start.Name.Local) {
+		case "script", "foreignobject", "iframe", "object", "embed":
+			return ErrUnsafeContent
+		}
+		for _, attr := range start.Attr {
+			if strings.HasPrefix(strings.ToLower(attr.Name.Local), "on") || isScriptURL(attr.Value) {
+				return ErrUnsafeContent
+			}
+		}
+	}
+}
+
+// isScriptURL reports whether an attribute value is a javascript: or
+// vbscript: URL once the whitespace and control characters browsers skip
+// are removed
+func isScriptURL(value string) bool {
+	compact := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, value)
+	return strings.HasPrefix(compact, "javascript:") || strings.HasPrefix(compact, "vbscript:")
+}
+
+// bytesContainsAnyMarker reports whether data contains HTML or script markup
+func bytesContainsAnyMarker(data []byte) bool {
+	lower := bytes.ToLower(data)
+	for _, marker := range markupMarkers {
+		if bytes.Contains(lower, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasForeignSignature looks for the signatures of other formats where
+// polyglot files put them: a PDF header near the start, or a ZIP archive
+// appended at the end
+func hasForeignSignature(data []byte) bool {
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	return bytes.Contains(head, []byte("%PDF-")) ||
+		bytes.Contains(head[1:], []byte("PK\x03\x04")) ||
+		bytes.Contains(tail(data, 64*1024), []byte("PK\x05\x06"))
+}
+
+// tail returns the last n bytes of data
+func tail(data []byte, n int) []byte {
+	if len(data) > n {
+		return data[len(data)-n:]
+	}
+	return data
+}
@@ -0,0 +1,39 @@
+package upload
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckSVG(t *testing.T) {
+	tests := []struct {
+		name string
+		svg  string
+		want error
+	}{
+		{"plain", `<svg xmlns="http://www.w3.org/2000/svg"><rect width="10" height="10" fill="red"/></svg>`, nil},
+		{"xml declaration and link", `<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink"><a xlink:href="https://example.com"><text>hi</text></a></svg>`, nil},
+		{"script", `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`, ErrUnsafeContent},
+		{"namespaced script", `<svg xmlns="http://www.w3.org/2000/svg"><x:script xmlns:x="http://www.w3.org/1999/xhtml">alert(document.cookie)</x:script></svg>`, ErrUnsafeContent},
+		{"default namespaced script", `<svg xmlns="http://www.w3.org/2000/svg"><g><SCRIPT xmlns="http://www.w3.org/1999/xhtml">alert(1)</SCRIPT></g></svg>`, ErrUnsafeContent},
+		{"foreign object", `<svg xmlns="http://www.w3.org/2000/svg"><foreignObject><div xmlns="http://www.w3.org/1999/xhtml">x</div></foreignObject></svg>`, ErrUnsafeContent},
+		{"event handler", `<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"/>`, ErrUnsafeContent},
+		{"namespaced event handler", `<svg xmlns="http://www.w3.org/2000/svg" xmlns:x="urn:x"><rect x:onclick="alert(1)"/></svg>`, ErrUnsafeContent},
+		{"javascript URL", `<svg xmlns="http://www.w3.org/2000/svg"><a href="javascript:alert(1)"><text>x</text></a></svg>`, ErrUnsafeContent},
+		{"entity-encoded javascript URL", `<svg xmlns="http://www.w3.org/2000/svg"><a href="&#106;ava&#x73;cript&#58;alert(1)"><text>x</text></a></svg>`, ErrUnsafeContent},
+		{"javascript URL split by whitespace", `<svg xmlns="http://www.w3.org/2000/svg"><a href=" java&#9;script:alert(1)"><text>x</text></a></svg>`, ErrUnsafeContent},
+		{"declared entity", `<!DOCTYPE svg [<!ENTITY js "javascript:alert(1)">]><svg xmlns="http://www.w3.org/2000/svg"><a href="&js;"><text>x</text></a></svg>`, ErrUnsafeContent},
+		{"malformed", `<svg xmlns="http://www.w3.org/2000/svg"><g></svg>`, ErrTypeMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.svg)
+			if got := Sniff(data); got != TypeSVG {
+				t.Fatalf("Sniff = %q, want %q", got, TypeSVG)
+			}
+			if err := checkContent(data, TypeSVG); !errors.Is(err, tt.want) || (err == nil) != (tt.want == nil) {
+				t.Errorf("checkContent = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"qr_backend/ent/schema"
+	"qr_backend/internal/config"
+	"qr_backend/internal/storage"
+	"qr_backend/pkg/pdfinfo"
+)
+
+// Errors returned for rejected uploads
+var (
+	ErrTooLarge       = errors.New("file exceeds the maximum upload size")
+	ErrTypeNotAllowed = errors.New("file type not allowed")
+	ErrTypeMismatch   = errors.New("file content does not match its name or declared type")
+	ErrUnsafeContent  = errors.New("file contains unsafe or embedded content")
+)
+
+// MIME types recognised from file content
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeGIF  = "image/gif"
+	TypeSVG  = "image/svg+xml"
+	TypePDF  = "application/pdf"
+	TypeText = "text/plain"
+)
+
+// ImageTypes are the raster image types accepted by image endpoints
+var ImageTypes = []string{TypeJPEG, TypePNG, TypeGIF}
+
+// extensions maps each recognised type to its file extensions; the first one
+// is used for stored files
+var extensions = map[string][]string{
+	TypeJPEG: {"jpg", "jpeg"},
+	TypePNG:  {"png"},
+	TypeGIF:  {"gif"},
+	TypeSVG:  {"svg"},
+	TypePDF:  {"pdf"},
+	TypeText: {"txt"},
+}
+
+// settings holds the upload configuration
+var settings = config.UploadConfig{
+	MaxSize:      10 * 1024 * 1024,
+	AllowedTypes: []string{"pdf", "jpg", "jpeg", "png", "gif", "svg", "txt"},
+}
+
+// File describes a stored upload
+type File struct {
+	Name        string // Sanitized display name
+	Key         string // Content-addressed object key
+	Backend     string // Storage backend holding the object
+	URL         string
+	Size        int64
+	ContentType string // Sniffed from the file content
+	Checksum    string // Hex SHA-256 of the stored content
+	Data        []byte // Stored content, for post-processing
+	Width       int    // Image dimensions after processing; zero for other files
+	Height      int
+	Variants    []schema.ImageVariant // Stored image renditions, the original first
+	PDF         *pdfinfo.Info         // Metadata of PDF documents
+	Owner       string                // Whose storage quota the file counts towards
+
+	pending *pending
+}
+
+// VariantsSize returns the bytes stored for the variants besides the original
+func (f *File) VariantsSize() int64 {
+	var size int64
+	for _, v := range f.Variants {
+		if v.Key != f.Key {
+			size += v.Size
+		}
+	}
+	return size
+}
+
+// Commit saves the file reference of a stored upload with save and ends its
+// quota reservation in the same step, so the owner's quota counts its bytes
+// once. If save fails the reservation is kept until Release.
+func (f *File) Commit(save func() error) error {
+	if f.pending == nil {
+		return save()
+	}
+	return f.pending.commit(save)
+}
+
+// Release ends the quota reservation of a stored upload that was not
+// committed. Until then its bytes count against the owner's quota on top of
+// the recorded files and the garbage collector keeps its content, so
+// callers release every upload once they are done with it; releasing a
+// committed upload does nothing.
+func (f *File) Release() {
+	if f.pending != nil {
+		f.pending.release()
+	}
+}
+
+// Init sets the upload and image processing configuration
+func Init(cfg *config.Config) {
+	settings = cfg.Upload
+	quota = cfg.Storage.Quota
+	ownerHeader = cfg.Storage.OwnerHeader
+	gcGrace = cfg.Storage.GCGrace
+	initImages(cfg.Image)
+}
+
+// MaxSize returns the configured maximum upload size in bytes
+func MaxSize() int64 {
+	return settings.MaxSize
+}
+
+// Save validates an uploaded file and stores it in the default storage
+// backend. accept lists the content types the calling endpoint takes; the
+// type is sniffed from the file bytes and must also be enabled in the
+// configured AllowedTypes. Raster images are processed into variants and
+// PDFs are checked for encryption, structure and page count. The stored
+// size counts against owner's quota until the file is released.
+func Save(fh *multipart.FileHeader, owner string, accept ...string) (*File, error) {
+	if fh.Size > settings.MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open upload: %w", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, settings.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	p := &pending{owner: owner}
+	contentType, err := validate(p, fh.Filename, fh.Header.Get("Content-Type"), data, accept)
+	if err != nil {
+		return nil, err
+	}
+	if imagesEnabled() && contains(ImageTypes, contentType) {
+		return p.attach(storeImage(p, fh.Filename, data))
+	}
+	if contentType == TypePDF {
+		return p.attach(storePDF(p, fh.Filename, data))
+	}
+	return p.attach(store(p, fh.Filename, contentType, data))
+}
+
+// SaveBytes validates and stores generated content, such as a rendered
+// barcode, exactly as given. Like Save, the file must be released.
+func SaveBytes(name, owner string, data []byte, accept ...string) (*File, error) {
+	p := &pending{owner: owner}
+	contentType, err := validate(p, name, "", data, accept)
+	if err != nil {
+		return nil, err
+	}
+	return p.attach(store(p, name, contentType, data))
+}
+
+// validate runs every check on the content and returns its sniffed type
+func validate(p *pending, name, declaredType string, data []byte, accept []string) (string, error) {
+	if int64(len(data)) > settings.MaxSize {
+		return "", ErrTooLarge
+	}
+	if err := p.checkQuota(int64(len(data))); err != nil {
+		return "", err
+	}
+
+	contentType := Sniff(data)
+	if contentType == "" || !contains(accept, contentType) || !allowed(contentType) {
+		return "", ErrTypeNotAllowed
+	}
+	if !matchesName(name, contentType) || !matchesDeclared(declaredType, contentType) {
+		return "", ErrTypeMismatch
+	}
+	if err := checkContent(data, contentType); err != nil {
+		return "", err
+	}
+	return contentType, nil
+}
+
+// store writes validated content under its hash
+func store(p *pending, name, contentType string, data []byte) (*File, error) {
+	checksum := hash(data)
+	p.hold(checksum)
+	key := checksum + "." + extensions[contentType][0]
+
+	backend := storage.Default()
+	if err := put(backend, key, data, contentType); err != nil {
+		return nil, err
+	}
+
+	return &File{
+		Name:        SanitizeName(name, contentType),
+		Key:         key,
+		Backend:     backend.Name(),
+		URL:         FileURL(key),
+		Size:        int64(len(data)),
+		ContentType: contentType,
+		Checksum:    checksum,
+		Data:        data,
+	}, nil
+}
+
+// hash returns the hex SHA-256 of data
+func hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// FileURL returns the public download URL of a stored object
+func FileURL(key string) string {
+	return "/files/" + key
+}
+
+// put stores data under its content-hash key; identical content already
+// stored is reused
+func put(backend storage.Storage, key string, data []byte, contentType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	if exists, err := backend.Exists(ctx, key); err == nil && exists {
+		return nil
+	}
+	if err := backend.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return fmt.Errorf("failed to store upload: %w", err)
+	}
+	return nil
+}
+
+// allowed reports whether the configured AllowedTypes enable a content type
+func allowed(contentType string) bool {
+	for _, ext := range extensions[contentType] {
+		for _, allowedType := range settings.AllowedTypes {
+			if strings.EqualFold(strings.TrimPrefix(allowedType, "."), ext) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesName reports whether the file name extension, if it has a known
+// one, agrees with the sniffed content type
+func matchesName(name, contentType string) bool {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(baseName(name)), "."))
+	if ext == "" {
+		return true
+	}
+	for t, exts := range extensions {
+		if contains(exts, ext) {
+			return t == contentType
+		}
+	}
+	return true
+}
+
+// matchesDeclared reports whether the client-declared Content-Type, if it is
+// specific, agrees with the sniffed content type
+func matchesDeclared(declared, contentType string) bool {
+	if declared == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return false
+	}
+	switch mediaType {
+	case "application/octet-stream", "binary/octet-stream":
+		return true
+	case "image/jpg", "image/pjpeg":
+		mediaType = TypeJPEG
+	}
+	return mediaType == contentType
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SanitizeName turns a client-supplied file name into a safe display name:
+// directories, control and reserved characters are removed and the length is
+// capped. The extension of the sniffed content type is kept or added.
+func SanitizeName(name, contentType string) string {
+	name = baseName(name)
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			continue
+		case strings.ContainsRune(`<>:"/\|?*`, r):
+			b.WriteRune('_')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	name = strings.Join(strings.Fields(b.String()), " ")
+	name = strings.Trim(name, " .")
+
+	exts := extensions[contentType]
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+	stem := strings.TrimSuffix(name, filepath.Ext(name))
+	if len(exts) > 0 && !contains(exts, ext) {
+		stem = name
+		ext = exts[0]
+	}
+
+	if runes := []rune(stem); len(runes) > 200 {
+		stem = string(runes[:200])
+	}
+	stem = strings.Trim(stem, " .")
+	if stem == "" {
+		stem = "file"
+	}
+	if ext == "" {
+		return stem
+	}
+	return stem + "." + ext
+}
+
+// baseName strips any directory part, whichever separator the client used
+func baseName(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,334 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/webhookdelivery"
+	"qr_backend/internal/config"
+	"qr_backend/internal/database"
+)
+
+// Request headers sent with every delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// deliveryBatchSize limits how many due deliveries are attempted per poll
+const deliveryBatchSize = 50
+
+var (
+	settings = config.WebhookConfig{
+		MaxAttempts:  8,
+		Timeout:      10 * time.Second,
+		PollInterval: 5 * time.Second,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   6 * time.Hour,
+		QueueSize:    1000,
+		Dispatchers:  2,
+	}
+	client = newClient(settings)
+	wake   = make(chan struct{}, 1)
+)
+
+// ErrPrivateAddress is returned for webhook endpoints that are not on the
+// public internet, such as loopback, private or link-local addresses
+var ErrPrivateAddress = errors.New("webhook endpoint is not a public address")
+
+// reservedPrefixes are ranges that are neither private nor loopback but are
+// not reachable public hosts either
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// newClient returns the HTTP client sending deliveries. Unless private
+// endpoints are allowed, its dialer refuses non-public addresses. The check
+// runs on the resolved address of every connection, redirects included, so
+// a host name cannot be rebound to an internal address after it is checked.
+func newClient(cfg config.WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	if !cfg.AllowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !PublicAddr(addr) {
+				return fmt.Errorf("%w: %s", ErrPrivateAddress, addr)
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &http.Transport{
+			// No proxy from the environment: the dialer must see the endpoint itself
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: cfg.Timeout,
+			MaxIdleConnsPerHost: 4,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// PublicAddr reports whether addr is a public unicast address a webhook
+// may be delivered to
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowPrivate reports whether webhooks may be delivered to non-public addresses
+func AllowPrivate() bool {
+	return settings.AllowPrivate
+}
+
+// Start runs the event dispatchers, the delivery worker and the expiration
+// watcher until ctx is cancelled
+func Start(ctx context.Context, cfg config.WebhookConfig) {
+	settings = cfg
+	client = newClient(cfg)
+	if settings.PollInterval <= 0 {
+		settings.PollInterval = 5 * time.Second
+	}
+	if settings.QueueSize <= 0 {
+		settings.QueueSize = 1000
+	}
+	if settings.Dispatchers <= 0 {
+		settings.Dispatchers = 2
+	}
+	queue = make(chan Payload, settings.QueueSize)
+
+	for i := 0; i < settings.Dispatchers; i++ {
+		go runDispatcher(ctx)
+	}
+	go runDeliveries(ctx)
+	go watchExpirations(ctx)
+}
+
+// Wake asks the worker to look for due deliveries without waiting for the next poll
+func Wake() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Sign returns the signature header value for a payload sent at timestamp.
+// Receivers recompute HMAC-SHA256(secret, "<t>.<body>") and compare it to v1.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// runDeliveries attempts due deliveries on every poll or wake-up
+func runDeliveries(ctx context.Context) {
+	ticker := time.NewTicker(settings.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-wake:
+		}
+
+		for {
+			n, err := deliverDue(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Webhook delivery failed: %v", err)
+				}
+				break
+			}
+			if n < deliveryBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// deliverDue attempts one batch of pending deliveries whose next attempt is due
+func deliverDue(ctx context.Context) (int, error) {
+	deliveries, err := database.DB.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(deliveryBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load due deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		claimed, err := claim(ctx, delivery)
+		if err != nil {
+			return 0, err
+		}
+		if claimed {
+			attempt(ctx, delivery.Edges.Webhook, delivery)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// claim leases a due delivery to this worker by moving its next attempt past
+// the attempt's timeout, unless another worker or instance did so first. A
+// delivery whose worker dies mid-attempt becomes due again once the lease
+// runs out.
+func claim(ctx context.Context, delivery *ent.WebhookDelivery) (bool, error) {
+	now := time.Now()
+	var n int
+	err := database.RetryLocked(ctx, func() (err error) {
+		n, err = database.DB.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(delivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAtLTE(now),
+			).
+			SetNextAttemptAt(now.Add(2 * settings.Timeout)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim delivery %d: %w", delivery.ID, err)
+	}
+	return n == 1, nil
+}
+
+// attempt sends a delivery once and records the outcome. Failed deliveries
+// are rescheduled with exponential backoff until MaxAttempts is reached,
+// after which they are marked dead.
+func attempt(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) *ent.WebhookDelivery {
+	statusCode, sendErr := send(ctx, hook, delivery)
+	attempts := delivery.Attempts + 1
+
+	update := delivery.Update().
+		SetAttempts(attempts)
+	if statusCode != 0 {
+		update.SetResponseStatus(statusCode)
+	}
+
+	switch {
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearLastError()
+	case attempts >= settings.MaxAttempts:
+		update.SetStatus(webhookdelivery.StatusDead).
+			SetLastError(sendErr.Error())
+	default:
+		update.SetLastError(sendErr.Error()).
+			SetNextAttemptAt(time.Now().Add(backoff(attempts)))
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		log.Printf("Failed to record webhook delivery %d: %v", delivery.ID, err)
+		return delivery
+	}
+	return updated
+}
+
+// send posts the delivery payload and returns the response status code
+func send(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) (int, error) {
+	if hook == nil {
+		return 0, fmt.Errorf("webhook no longer exists")
+	}
+	if !hook.Active && delivery.Event != EventTest {
+		return 0, fmt.Errorf("webhook is inactive")
+	}
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "QR-Platform-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(delivery.ID))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, time.Now().Unix(), body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the next attempt after the given number of attempts
+func backoff(attempts int) time.Duration {
+	delay := settings.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= settings.MaxBackoff {
+			return settings.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// Redeliver moves a delivery, typically a dead one, back to the pending
+// queue with a fresh set of attempts
+func Redeliver(ctx context.Context, id int) (*ent.WebhookDelivery, error) {
+	delivery, err := database.DB.WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	Wake()
+	return delivery, nil
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"qr_backend/ent"
+	"qr_backend/ent/checkpoint"
+	"qr_backend/ent/qrcode"
+	"qr_backend/internal/database"
+)
+
+// expiryCheckInterval is how often QR codes are checked for having expired
+const expiryCheckInterval = time.Minute
+
+// expiryCheckpoint names the checkpoint recording up to when expired events
+// have been emitted
+const expiryCheckpoint = "webhook_expiry"
+
+// watchExpirations emits an expired event for every QR code whose expiry
+// time passes. It resumes from its checkpoint, so codes that expired while
+// the server was down get their event on the next start.
+func watchExpirations(ctx context.Context) {
+	ticker := time.NewTicker(expiryCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := emitExpirations(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to check for expired QR codes: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// emitExpirations emits the expired events of the codes that expired since
+// the checkpoint and moves it to now. The checkpoint is moved with a
+// conditional update before the events are stored, so when several
+// instances share the database only the one that moved it emits them.
+func emitExpirations(ctx context.Context) error {
+	now := time.Now().UTC()
+	cp, err := database.DB.Checkpoint.Query().
+		Where(checkpoint.Name(expiryCheckpoint)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		// Codes that expired before the first start had no webhooks to notify
+		err = database.DB.Checkpoint.Create().
+			SetName(expiryCheckpoint).
+			SetAt(now).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	expired, err := database.DB.QRCode.
+		Query().
+		Where(
+			qrcode.ExpiresAtGT(cp.At),
+			qrcode.ExpiresAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var n int
+	err = database.RetryLocked(ctx, func() (err error) {
+		n, err = database.DB.Checkpoint.Update().
+			Where(checkpoint.ID(cp.ID), checkpoint.At(cp.At)).
+			SetAt(now).
+			Save(ctx)
+		return err
+	})
+	if err != nil || n == 0 {
+		return err
+	}
+
+	// Stored right away rather than queued, as the checkpoint has moved past them
+	for _, qr := range expired {
+		payload := newPayload(EventExpired, qr, map[string]interface{}{"expires_at": qr.ExpiresAt})
+		if err := dispatch(ctx, payload); err != nil {
+			log.Printf("Failed to dispatch %s webhook for QR code %d: %v", EventExpired, qr.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"qr_backend/ent"
+	entwebhook "qr_backend/ent/webhook"
+	"qr_backend/internal/database"
+)
+
+// Events a webhook can subscribe to
+const (
+	EventScan        = "scan"
+	EventCreated     = "created"
+	EventUpdated     = "updated"
+	EventExpired     = "expired"
+	EventDeactivated = "deactivated"
+	EventTest        = "test"
+)
+
+// Events lists the events webhooks can subscribe to
+var Events = []string{EventScan, EventCreated, EventUpdated, EventExpired, EventDeactivated}
+
+// ValidEvent reports whether event can be subscribed to
+func ValidEvent(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload is the JSON body sent to webhook endpoints
+type Payload struct {
+	Event      string                 `json:"event"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	QRCode     *QRCodeSummary         `json:"qr_code,omitempty"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+}
+
+// QRCodeSummary is the part of a QR code included in webhook payloads
+type QRCodeSummary struct {
+	ID       int    `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	ShortURL string `json:"short_url,omitempty"`
+	Active   bool   `json:"active"`
+}
+
+// subscription is the cached part of an active webhook used to match events
+type subscription struct {
+	id       int
+	qrCodeID *int
+	events   map[string]bool
+}
+
+// subscriptions caches the active webhooks so that scans of QR codes without
+// subscribers do not cost a database query
+var subscriptions struct {
+	sync.Mutex
+	loaded bool
+	items  []subscription
+}
+
+// Invalidate drops the subscription cache; call it after webhooks change
+func Invalidate() {
+	subscriptions.Lock()
+	subscriptions.loaded = false
+	subscriptions.items = nil
+	subscriptions.Unlock()
+}
+
+// GenerateSecret returns a random signing secret for a new webhook
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// matching returns the IDs of the active webhooks subscribed to event for the
+// given QR code, including workspace-wide webhooks
+func matching(ctx context.Context, event string, qrID int) ([]int, error) {
+	subscriptions.Lock()
+	defer subscriptions.Unlock()
+
+	if !subscriptions.loaded {
+		hooks, err := database.DB.Webhook.Query().Where(entwebhook.Active(true)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load webhooks: %w", err)
+		}
+		items := make([]subscription, 0, len(hooks))
+		for _, hook := range hooks {
+			events := make(map[string]bool, len(hook.Events))
+			for _, e := range hook.Events {
+				events[e] = true
+			}
+			items = append(items, subscription{id: hook.ID, qrCodeID: hook.QrCodeID, events: events})
+		}
+		subscriptions.items = items
+		subscriptions.loaded = true
+	}
+
+	var ids []int
+	for _, sub := range subscriptions.items {
+		if !sub.events[event] {
+			continue
+		}
+		if sub.qrCodeID != nil && *sub.qrCodeID != qrID {
+			continue
+		}
+		ids = append(ids, sub.id)
+	}
+	return ids, nil
+}
+
+// QueueStats reports the state of the queue of emitted events
+type QueueStats struct {
+	QueueDepth    int    `json:"queue_depth"`
+	QueueCapacity int    `json:"queue_capacity"`
+	Emitted       uint64 `json:"emitted"`
+	Dropped       uint64 `json:"dropped"`
+	Failed        uint64 `json:"failed"`
+}
+
+// queue buffers emitted events until a dispatcher stores their deliveries.
+// It is bounded, so a burst of scans costs neither unbounded goroutines nor
+// unbounded memory; events that do not fit are dropped and counted.
+var (
+	queue   = make(chan Payload, 1000)
+	emitted atomic.Uint64
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+)
+
+// Stats returns the event queue metrics
+func Stats() QueueStats {
+	return QueueStats{
+		QueueDepth:    len(queue),
+		QueueCapacity: cap(queue),
+		Emitted:       emitted.Load(),
+		Dropped:       dropped.Load(),
+		Failed:        failed.Load(),
+	}
+}
+
+// Emit queues event for delivery to every matching webhook. It never blocks
+// the caller on the database or the network, and drops the event when the
+// queue is full.
+func Emit(event string, qr *ent.QRCode, data map[string]interface{}) {
+	if qr == nil {
+		return
+	}
+
+	select {
+	case queue <- newPayload(event, qr, data):
+		emitted.Add(1)
+	default:
+		// Log the first drop and every thousandth rather than every one
+		if n := dropped.Add(1); n == 1 || n%1000 == 0 {
+			log.Printf("Webhook event queue is full; dropped %d event(s) so far", n)
+		}
+	}
+}
+
+// newPayload builds the payload of an event about a QR code
+func newPayload(event string, qr *ent.QRCode, data map[string]interface{}) Payload {
+	return Payload{
+		Event:      event,
+		OccurredAt: time.Now().UTC(),
+		QRCode: &QRCodeSummary{
+			ID:       qr.ID,
+			Type:     qr.Type,
+			Title:    qr.Title,
+			ShortURL: qr.ShortURL,
+			Active:   qr.Active,
+		},
+		Data: data,
+	}
+}
+
+// runDispatcher stores the deliveries of queued events until ctx is cancelled
+func runDispatcher(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case payload := <-queue:
+			dctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			if err := dispatch(dctx, payload); err != nil {
+				failed.Add(1)
+				log.Printf("Failed to dispatch %s webhook for QR code %d: %v", payload.Event, payload.QRCode.ID, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// dispatch stores one pending delivery per matching webhook and wakes the worker
+func dispatch(ctx context.Context, payload Payload) error {
+	ids, err := matching(ctx, payload.Event, payload.QRCode.ID)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.WebhookDeliveryCreate, 0, len(ids))
+	for _, id := range ids {
+		builders = append(builders, database.DB.WebhookDelivery.Create().
+			SetWebhookID(id).
+			SetEvent(payload.Event).
+			SetPayload(string(body)))
+	}
+	// The delivery worker updates the table meanwhile, so the insert is
+	// retried while SQLite reports it locked
+	err = database.RetryLocked(ctx, func() error {
+		return database.DB.WebhookDelivery.CreateBulk(builders...).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue deliveries: %w", err)
+	}
+
+	Wake()
+	return nil
+}
+
+// SendTest delivers a test event to a webhook right away and returns the
+// resulting delivery. The delivery is retried like any other if it fails.
+func SendTest(ctx context.Context, hook *ent.Webhook) (*ent.WebhookDelivery, error) {
+	body, err := json.Marshal(Payload{
+		Event:      EventTest,
+		OccurredAt: time.Now().UTC(),
+		Data:       map[string]interface{}{"message": "This is a test event"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the worker away from the delivery while it is attempted here
+	delivery, err := database.DB.WebhookDelivery.Create().
+		SetWebhookID(hook.ID).
+		SetEvent(EventTest).
+		SetPayload(string(body)).
+		SetNextAttemptAt(time.Now().Add(2 * settings.Timeout)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test delivery: %w", err)
+	}
+
+	return attempt(ctx, hook, delivery), nil
+}
@@ -0,0 +1,294 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+
+	"qr_backend/pkg/gs1"
+)
+
+// Data Matrix ASCII encodation codewords
+const (
+	dmPad        = 129
+	dmDigitPairs = 130 // 130-229 encode the digit pairs 00-99
+	dmFNC1       = 232
+	dmUpperShift = 235
+
+	// fnc1 marks an FNC1 between the bytes of GS1 data
+	fnc1 = -1
+)
+
+// dmSize is an ECC 200 symbol size
+type dmSize struct {
+	rows, cols            int
+	vRegions, hRegions    int // Data regions vertically and horizontally
+	ecCodewords, ecBlocks int
+}
+
+var dmSizes = []dmSize{
+	{10, 10, 1, 1, 5, 1}, {12, 12, 1, 1, 7, 1}, {14, 14, 1, 1, 10, 1},
+	{16, 16, 1, 1, 12, 1}, {18, 18, 1, 1, 14, 1}, {20, 20, 1, 1, 18, 1},
+	{22, 22, 1, 1, 20, 1}, {24, 24, 1, 1, 24, 1}, {26, 26, 1, 1, 28, 1},
+	{32, 32, 2, 2, 36, 1}, {36, 36, 2, 2, 42, 1}, {40, 40, 2, 2, 48, 1},
+	{44, 44, 2, 2, 56, 1}, {48, 48, 2, 2, 68, 1}, {52, 52, 2, 2, 84, 2},
+	{64, 64, 4, 4, 112, 2}, {72, 72, 4, 4, 144, 4}, {80, 80, 4, 4, 192, 4},
+	{88, 88, 4, 4, 224, 4}, {96, 96, 4, 4, 272, 4}, {104, 104, 4, 4, 336, 6},
+	{120, 120, 6, 6, 408, 6}, {132, 132, 6, 6, 496, 8}, {144, 144, 6, 6, 620, 10},
+	// Rectangular sizes
+	{8, 18, 1, 1, 7, 1}, {8, 32, 1, 2, 11, 1}, {12, 26, 1, 1, 14, 1},
+	{12, 36, 1, 2, 18, 1}, {16, 36, 1, 2, 24, 1}, {16, 48, 1, 2, 28, 1},
+}
+
+func (s dmSize) regionRows() int { return s.rows/s.vRegions - 2 }
+func (s dmSize) regionCols() int { return s.cols/s.hRegions - 2 }
+func (s dmSize) square() bool    { return s.rows == s.cols }
+
+// dataCodewords returns the data capacity in codewords
+func (s dmSize) dataCodewords() int {
+	return s.regionRows()*s.vRegions*s.regionCols()*s.hRegions/8 - s.ecCodewords
+}
+
+// dmSymbolSize picks the smallest symbol for the data with the given size
+// option: "auto" or "square", "rectangle", or a fixed size like "16x16"
+func dmSymbolSize(option string, codewords int) (dmSize, error) {
+	option = strings.ToLower(strings.TrimSpace(option))
+	switch option {
+	case "", "auto", "square", "rectangle", "rectangular":
+		rectangle := strings.HasPrefix(option, "rect")
+		for _, s := range dmSizes {
+			if s.square() != rectangle && s.dataCodewords() >= codewords {
+				return s, nil
+			}
+		}
+		return dmSize{}, fmt.Errorf("%w: data too long for a Data Matrix %s", ErrInvalidData, map[bool]string{true: "rectangle", false: "square"}[rectangle])
+	}
+
+	var rows, cols int
+	if _, err := fmt.Sscanf(option, "%dx%d", &rows, &cols); err == nil {
+		for _, s := range dmSizes {
+			if s.rows != rows || s.cols != cols {
+				continue
+			}
+			if s.dataCodewords() < codewords {
+				return dmSize{}, fmt.Errorf("%w: data needs %d codewords, a %s Data Matrix holds %d", ErrInvalidData, codewords, option, s.dataCodewords())
+			}
+			return s, nil
+		}
+	}
+	return dmSize{}, fmt.Errorf("%w: unknown Data Matrix size %q", ErrInvalidData, option)
+}
+
+// encodeDataMatrix encodes data in an ECC 200 Data Matrix symbol using
+// ASCII encodation. In GS1 mode, data holds element strings such as
+// "(01)09501101530003(10)ABC" and starts with FNC1.
+func encodeDataMatrix(data, size string, gs1 bool) (*grid, error) {
+	var input []int
+	if gs1 {
+		elements, err := gs1Codewords(data)
+		if err != nil {
+			return nil, err
+		}
+		input = append([]int{fnc1}, elements...)
+	} else {
+		for i := 0; i < len(data); i++ {
+			input = append(input, int(data[i]))
+		}
+	}
+
+	codewords := dmASCII(input)
+	s, err := dmSymbolSize(size, len(codewords))
+	if err != nil {
+		return nil, err
+	}
+	codewords = dmPadding(codewords, s.dataCodewords())
+	codewords = dmErrorCorrection(codewords, s)
+	return dmSymbol(codewords, s), nil
+}
+
+// dmASCII encodes bytes with digit pairs compacted into one codeword
+func dmASCII(input []int) []byte {
+	isDigit := func(c int) bool { return c >= '0' && c <= '9' }
+
+	var codewords []byte
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case c == fnc1:
+			codewords = append(codewords, dmFNC1)
+		case isDigit(c) && i+1 < len(input) && isDigit(input[i+1]):
+			codewords = append(codewords, byte(dmDigitPairs+(c-'0')*10+input[i+1]-'0'))
+			i++
+		case c > 127:
+			codewords = append(codewords, dmUpperShift, byte(c-127))
+		default:
+			codewords = append(codewords, byte(c+1))
+		}
+	}
+	return codewords
+}
+
+// dmPadding fills the data capacity with a pad codeword followed by
+// pseudo-randomised pad codewords
+func dmPadding(codewords []byte, capacity int) []byte {
+	if len(codewords) < capacity {
+		codewords = append(codewords, dmPad)
+	}
+	for len(codewords) < capacity {
+		r := 149*(len(codewords)+1)%253 + 1
+		v := dmPad + r
+		if v > 254 {
+			v -= 254
+		}
+		codewords = append(codewords, byte(v))
+	}
+	return codewords
+}
+
+// dmErrorCorrection appends the interleaved error correction codewords of
+// each block; block i holds every ecBlocks-th data codeword from i
+func dmErrorCorrection(data []byte, s dmSize) []byte {
+	perBlock := s.ecCodewords / s.ecBlocks
+	out := make([]byte, len(data)+s.ecCodewords)
+	copy(out, data)
+	for block := 0; block < s.ecBlocks; block++ {
+		var blockData []byte
+		for i := block; i < len(data); i += s.ecBlocks {
+			blockData = append(blockData, data[i])
+		}
+		for j, cw := range reedSolomon(blockData, perBlock, 0x12d, 1) {
+			out[len(data)+block+j*s.ecBlocks] = cw
+		}
+	}
+	return out
+}
+
+// dmSymbol places the codewords in the data regions (ISO/IEC 16022,
+// Annex F) and adds the finder and timing patterns around each region
+func dmSymbol(codewords []byte, s dmSize) *grid {
+	nrow, ncol := s.regionRows()*s.vRegions, s.regionCols()*s.hRegions
+	modules := make([]bool, nrow*ncol)
+	placed := make([]bool, nrow*ncol)
+
+	module := func(row, col int, cw byte, bit int) {
+		if row < 0 {
+			row += nrow
+			col += 4 - (nrow+4)%8
+		}
+		if col < 0 {
+			col += ncol
+			row += 4 - (ncol+4)%8
+		}
+		placed[row*ncol+col] = true
+		modules[row*ncol+col] = cw&(0x80>>bit) != 0
+	}
+	// utah places a codeword in the L-shaped block ending at row, col
+	utah := func(row, col int, cw byte) {
+		module(row-2, col-2, cw, 0)
+		module(row-2, col-1, cw, 1)
+		module(row-1, col-2, cw, 2)
+		module(row-1, col-1, cw, 3)
+		module(row-1, col, cw, 4)
+		module(row, col-2, cw, 5)
+		module(row, col-1, cw, 6)
+		module(row, col, cw, 7)
+	}
+	corner := func(cw byte, positions [8][2]int) {
+		for bit, p := range positions {
+			module(p[0], p[1], cw, bit)
+		}
+	}
+
+	next := 0
+	take := func() byte {
+		cw := codewords[next]
+		next++
+		return cw
+	}
+
+	row, col := 4, 0
+	for row < nrow || col < ncol {
+		if row == nrow && col == 0 {
+			corner(take(), [8][2]int{{nrow - 1, 0}, {nrow - 1, 1}, {nrow - 1, 2}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+		}
+		if row == nrow-2 && col == 0 && ncol%4 != 0 {
+			corner(take(), [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 4}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}})
+		}
+		if row == nrow-2 && col == 0 && ncol%8 == 4 {
+			corner(take(), [8][2]int{{nrow - 3, 0}, {nrow - 2, 0}, {nrow - 1, 0}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 1}, {2, ncol - 1}, {3, ncol - 1}})
+		}
+		if row == nrow+4 && col == 2 && ncol%8 == 0 {
+			corner(take(), [8][2]int{{nrow - 1, 0}, {nrow - 1, ncol - 1}, {0, ncol - 3}, {0, ncol - 2}, {0, ncol - 1}, {1, ncol - 3}, {1, ncol - 2}, {1, ncol - 1}})
+		}
+
+		// Sweep upwards to the right, then downwards to the left
+		for {
+			if row < nrow && col >= 0 && !placed[row*ncol+col] {
+				utah(row, col, take())
+			}
+			row, col = row-2, col+2
+			if row < 0 || col >= ncol {
+				break
+			}
+		}
+		row, col = row+1, col+3
+		for {
+			if row >= 0 && col < ncol && !placed[row*ncol+col] {
+				utah(row, col, take())
+			}
+			row, col = row+2, col-2
+			if row >= nrow || col < 0 {
+				break
+			}
+		}
+		row, col = row+3, col+1
+	}
+
+	// Sizes whose modules are not all used get a fixed pattern in the corner
+	if !placed[nrow*ncol-1] {
+		modules[nrow*ncol-1] = true
+		modules[(nrow-2)*ncol+ncol-2] = true
+	}
+
+	// Each region has a solid L along its left and bottom edges and
+	// alternating modules along its top and right edges
+	g := newGrid(s.cols, s.rows)
+	regionH, regionW := s.regionRows()+2, s.regionCols()+2
+	for y := 0; y < s.rows; y++ {
+		for x := 0; x < s.cols; x++ {
+			ry, rx := y%regionH, x%regionW
+			switch {
+			case rx == 0 || ry == regionH-1:
+				g.set(x, y, true)
+			case ry == 0:
+				g.set(x, y, x%2 == 0)
+			case rx == regionW-1:
+				g.set(x, y, y%2 == 1)
+			default:
+				r := y/regionH*s.regionRows() + ry - 1
+				c := x/regionW*s.regionCols() + rx - 1
+				g.set(x, y, modules[r*ncol+c])
+			}
+		}
+	}
+	return g
+}
+
+// gs1Codewords converts a GS1 element string, such as
+// "(01)09501101530003(10)ABC", into its characters with FNC1 separators
+// after variable-length values
+func gs1Codewords(data string) ([]int, error) {
+	elements, err := gs1.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+	}
+	var out []int
+	for i, el := range elements {
+		for _, c := range []byte(el.AI + el.Value) {
+			out = append(out, int(c))
+		}
+		if i < len(elements)-1 && !gs1.PredefinedLength(el.AI) {
+			out = append(out, fnc1)
+		}
+	}
+	return out, nil
+}
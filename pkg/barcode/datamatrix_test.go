@@ -0,0 +1,144 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		n           int
+		poly, first int
+		want        []byte
+	}{
+		// ISO/IEC 16022 Annex O: "123456" in a 10x10 symbol
+		{"data matrix", []byte{142, 164, 186}, 5, 0x12d, 1, []byte{114, 25, 5, 88, 102}},
+		// QR 1-M "HELLO WORLD"
+		{"qr", []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}, 10, 0x11d, 0,
+			[]byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}},
+	}
+	for _, tt := range tests {
+		if got := reedSolomon(tt.data, tt.n, tt.poly, tt.first); !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: reedSolomon = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDataMatrixCodewords(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []byte
+	}{
+		{"digit pairs", []int{'1', '2', '3', '4', '5', '6'}, []byte{142, 164, 186}},
+		{"odd digit", []int{'1', '2', '3'}, []byte{142, '3' + 1}},
+		{"text", []int{'A', 'b'}, []byte{66, 99}},
+		{"extended", []int{0xe9}, []byte{dmUpperShift, 0xe9 - 127}},
+		{"fnc1", []int{fnc1, '0', '1'}, []byte{dmFNC1, 131}},
+	}
+	for _, tt := range tests {
+		if got := dmASCII(tt.input); !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: dmASCII = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// The first pad codeword is 129, later ones are randomised by position
+	if got, want := dmPadding([]byte{142, 164, 186}, 5), []byte{142, 164, 186, 129, 115}; !bytes.Equal(got, want) {
+		t.Errorf("dmPadding = %v, want %v", got, want)
+	}
+}
+
+func TestDataMatrixErrorCorrection(t *testing.T) {
+	s, err := dmSymbolSize("auto", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.rows != 10 || s.cols != 10 {
+		t.Fatalf("size = %dx%d, want 10x10", s.rows, s.cols)
+	}
+	want := []byte{142, 164, 186, 114, 25, 5, 88, 102}
+	if got := dmErrorCorrection([]byte{142, 164, 186}, s); !bytes.Equal(got, want) {
+		t.Errorf("dmErrorCorrection = %v, want %v", got, want)
+	}
+
+	// Blocks of a 52x52 symbol take alternate data codewords and interleave
+	// their error correction codewords the same way
+	s, err = dmSymbolSize("52x52", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, s.dataCodewords())
+	for i := range data {
+		data[i] = byte(i)
+	}
+	got := dmErrorCorrection(data, s)
+	var even, odd []byte
+	for i := 0; i < len(data); i += 2 {
+		even, odd = append(even, data[i]), append(odd, data[i+1])
+	}
+	ecEven, ecOdd := reedSolomon(even, s.ecCodewords/2, 0x12d, 1), reedSolomon(odd, s.ecCodewords/2, 0x12d, 1)
+	for j := 0; j < s.ecCodewords/2; j++ {
+		if got[len(data)+2*j] != ecEven[j] || got[len(data)+2*j+1] != ecOdd[j] {
+			t.Fatalf("error correction codeword %d is not interleaved", j)
+		}
+	}
+}
+
+func TestDataMatrixSymbolSize(t *testing.T) {
+	tests := []struct {
+		option     string
+		codewords  int
+		rows, cols int
+		err        error
+	}{
+		{"auto", 3, 10, 10, nil},
+		{"square", 4, 12, 12, nil},
+		{"rectangle", 5, 8, 18, nil},
+		{"rectangle", 6, 8, 32, nil},
+		{"16x48", 10, 16, 48, nil},
+		{"10x10", 4, 0, 0, ErrInvalidData},
+		{"11x11", 1, 0, 0, ErrInvalidData},
+		{"auto", 1559, 0, 0, ErrInvalidData},
+	}
+	for _, tt := range tests {
+		s, err := dmSymbolSize(tt.option, tt.codewords)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%s/%d: err = %v, want %v", tt.option, tt.codewords, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || s.rows != tt.rows || s.cols != tt.cols {
+			t.Errorf("%s/%d = %dx%d, %v; want %dx%d", tt.option, tt.codewords, s.rows, s.cols, err, tt.rows, tt.cols)
+		}
+	}
+}
+
+func TestEncodeDataMatrix(t *testing.T) {
+	symbol, err := encodeDataMatrix("123456", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if symbol.width != 10 || symbol.height != 10 {
+		t.Fatalf("size = %dx%d, want 10x10", symbol.width, symbol.height)
+	}
+	// Solid L finder on the left and bottom, alternating timing on the top and right
+	for i := 0; i < 10; i++ {
+		if !symbol.at(0, i) || !symbol.at(i, 9) {
+			t.Fatalf("finder pattern broken at %d", i)
+		}
+		if symbol.at(i, 0) != (i%2 == 0) || symbol.at(9, i) != (i%2 == 1) {
+			t.Fatalf("timing pattern broken at %d", i)
+		}
+	}
+
+	if _, err := encodeDataMatrix("(01)09501101530003(10)AB", "", true); err != nil {
+		t.Errorf("GS1: %v", err)
+	}
+	if _, err := encodeDataMatrix("(01)09501101530004", "", true); err == nil {
+		t.Error("GS1 data with a wrong check digit was accepted")
+	}
+}
@@ -0,0 +1,288 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/codabar"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/code39"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/twooffive"
+)
+
+// Supported symbologies
+const (
+	Code128    = "code128"
+	EAN8       = "ean8"
+	EAN13      = "ean13"
+	UPCA       = "upca"
+	UPCE       = "upce"
+	Code39     = "code39"
+	Codabar    = "codabar"
+	ITF14      = "itf14"
+	DataMatrix = "datamatrix"
+)
+
+var (
+	// ErrUnknownSymbology is returned for symbology names this package does not support
+	ErrUnknownSymbology = errors.New("unknown barcode symbology")
+	// ErrInvalidData is returned when the data cannot be encoded in the symbology
+	ErrInvalidData = errors.New("invalid barcode data")
+	// ErrCheckDigit is returned when the data carries a wrong check digit
+	ErrCheckDigit = errors.New("invalid check digit")
+)
+
+// symbology describes the print layout of a linear symbology. Dimensions
+// are the nominal sizes at 100% magnification, in millimetres.
+type symbology struct {
+	Name       string
+	XDimension float64 // Narrow bar width
+	BarHeight  float64
+	QuietLeft  int // Minimum quiet zones, in modules
+	QuietRight int
+	TextSize   float64 // Height of human-readable text, in modules
+	MaxLength  int     // Longest data accepted, for variable-length symbologies
+}
+
+var symbologies = map[string]symbology{
+	Code128: {"Code 128", 0.25, 13, 10, 10, 7, 80},
+	EAN8:    {"EAN-8", 0.33, 18.23, 7, 7, 8, 8},
+	EAN13:   {"EAN-13", 0.33, 22.85, 11, 7, 8, 13},
+	UPCA:    {"UPC-A", 0.33, 22.85, 9, 9, 8, 12},
+	UPCE:    {"UPC-E", 0.33, 22.85, 9, 7, 8, 8},
+	Code39:  {"Code 39", 0.25, 13, 10, 10, 7, 43},
+	Codabar: {"Codabar", 0.25, 13, 10, 10, 7, 62},
+	ITF14:   {"ITF-14", 1.016, 32, 10, 10, 4, 14},
+}
+
+// IsLinear reports whether name is a supported linear symbology
+func IsLinear(name string) bool {
+	_, ok := symbologies[name]
+	return ok
+}
+
+// SymbologyName returns the display name of a symbology
+func SymbologyName(name string) string {
+	if s, ok := symbologies[name]; ok {
+		return s.Name
+	}
+	if s, ok := matrixSymbologies[name]; ok {
+		return s.Name
+	}
+	return name
+}
+
+// textSegment is human-readable text centred below modules [From, To).
+// Negative positions and positions past the last module lie in the quiet zones.
+type textSegment struct {
+	Text     string
+	From, To int
+}
+
+// Linear is an encoded linear barcode
+type Linear struct {
+	Symbology string
+	Data      string // Encoded data including any check digit
+	Modules   []bool // Dark modules, left to right
+	Guards    []bool // Modules extended below the bars (EAN/UPC guard bars)
+	text      []textSegment
+}
+
+// EncodeLinear encodes data in a linear symbology. EAN and UPC data may be
+// given with or without its check digit; a given check digit is verified.
+// checksum adds the optional modulo 43 check character to Code 39.
+func EncodeLinear(name, data string, checksum bool) (*Linear, error) {
+	data = strings.TrimSpace(data)
+	if data == "" {
+		return nil, fmt.Errorf("%w: data is empty", ErrInvalidData)
+	}
+	// Codabar counts its start and stop characters, added below
+	if name != Codabar {
+		if err := checkLength(name, data); err != nil {
+			return nil, err
+		}
+	}
+
+	switch name {
+	case EAN8, EAN13:
+		length := 8
+		if name == EAN13 {
+			length = 13
+		}
+		full, err := withCheckDigit(data, length)
+		if err != nil {
+			return nil, err
+		}
+		code, err := ean.Encode(full)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return eanLayout(name, full, modules(code)), nil
+
+	case UPCA:
+		full, err := withCheckDigit(data, 12)
+		if err != nil {
+			return nil, err
+		}
+		// UPC-A is EAN-13 with a leading zero
+		code, err := ean.Encode("0" + full)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return eanLayout(name, full, modules(code)), nil
+
+	case UPCE:
+		return encodeUPCE(data)
+
+	case ITF14:
+		full, err := withCheckDigit(data, 14)
+		if err != nil {
+			return nil, err
+		}
+		code, err := twooffive.Encode(full, true)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return plainLayout(name, full, modules(code)), nil
+
+	case Code128:
+		code, err := code128.Encode(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return plainLayout(name, data, modules(code)), nil
+
+	case Code39:
+		code, err := code39.Encode(strings.ToUpper(data), checksum, false)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return plainLayout(name, strings.ToUpper(data), modules(code)), nil
+
+	case Codabar:
+		content := strings.ToUpper(data)
+		// Add the default A...B start and stop characters when they are missing
+		if !strings.ContainsAny(content[:1], "ABCD") {
+			content = "A" + content + "B"
+		}
+		if err := checkLength(name, content); err != nil {
+			return nil, err
+		}
+		code, err := codabar.Encode(content)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return plainLayout(name, content, modules(code)), nil
+	}
+	return nil, fmt.Errorf("%w %q", ErrUnknownSymbology, name)
+}
+
+// checkLength refuses data longer than a symbology allows
+func checkLength(name, data string) error {
+	if s, ok := symbologies[name]; ok && len(data) > s.MaxLength {
+		return fmt.Errorf("%w: %s data is limited to %d characters", ErrInvalidData, s.Name, s.MaxLength)
+	}
+	return nil
+}
+
+// modules reads the bar pattern of an unscaled boombuler code
+func modules(code barcode.Barcode) []bool {
+	bounds := code.Bounds()
+	bars := make([]bool, bounds.Dx())
+	for x := range bars {
+		r, _, _, _ := code.At(bounds.Min.X+x, bounds.Min.Y).RGBA()
+		bars[x] = r < 0x8000
+	}
+	return bars
+}
+
+// plainLayout prints the data centred below the bars
+func plainLayout(name, data string, bars []bool) *Linear {
+	return &Linear{
+		Symbology: name,
+		Data:      data,
+		Modules:   bars,
+		Guards:    make([]bool, len(bars)),
+		text:      []textSegment{{Text: data, From: 0, To: len(bars)}},
+	}
+}
+
+// eanLayout extends the guard bars and splits the digits around them the
+// way EAN-13, EAN-8 and UPC-A are printed
+func eanLayout(name, data string, bars []bool) *Linear {
+	l := &Linear{Symbology: name, Data: data, Modules: bars, Guards: make([]bool, len(bars))}
+
+	guard := func(from, to int) {
+		for i := from; i < to && i < len(bars); i++ {
+			l.Guards[i] = bars[i]
+		}
+	}
+	last := len(bars)
+	center := last / 2
+	guard(0, 3)
+	guard(center-2, center+3)
+	guard(last-3, last)
+
+	switch name {
+	case EAN13:
+		l.text = []textSegment{
+			{data[:1], -8, -1},
+			{data[1:7], 3, center - 2},
+			{data[7:], center + 3, last - 3},
+		}
+	case EAN8:
+		l.text = []textSegment{
+			{data[:4], 3, center - 2},
+			{data[4:], center + 3, last - 3},
+		}
+	case UPCA:
+		// The bars of the first and last digit are extended as well
+		guard(3, 10)
+		guard(last-10, last-3)
+		l.text = []textSegment{
+			{data[:1], -8, -1},
+			{data[1:6], 10, center - 2},
+			{data[6:11], center + 3, last - 10},
+			{data[11:], last + 1, last + 8},
+		}
+	}
+	return l
+}
+
+// withCheckDigit validates digit-only data of the given full length,
+// appending the GS1 modulo 10 check digit when it is missing
+func withCheckDigit(data string, length int) (string, error) {
+	for _, r := range data {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("%w: only digits are allowed", ErrInvalidData)
+		}
+	}
+	switch len(data) {
+	case length - 1:
+		return data + string(rune('0'+checkDigit(data))), nil
+	case length:
+		want := checkDigit(data[:length-1])
+		if int(data[length-1]-'0') != want {
+			return "", fmt.Errorf("%w: expected %d", ErrCheckDigit, want)
+		}
+		return data, nil
+	}
+	return "", fmt.Errorf("%w: expected %d or %d digits", ErrInvalidData, length-1, length)
+}
+
+// checkDigit computes the GS1 modulo 10 check digit: digits are weighted
+// 3, 1, 3, ... from the right
+func checkDigit(digits string) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
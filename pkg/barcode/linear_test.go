@@ -0,0 +1,140 @@
+package barcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   int
+	}{
+		{"9638507", 4},       // EAN-8 96385074
+		{"400638133393", 1},  // EAN-13 4006381333931
+		{"03600029145", 2},   // UPC-A 036000291452
+		{"1061414100041", 5}, // ITF-14 10614141000415
+		{"000000000000", 0},
+	}
+	for _, tt := range tests {
+		if got := checkDigit(tt.digits); got != tt.want {
+			t.Errorf("checkDigit(%q) = %d, want %d", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeLinearCheckDigits(t *testing.T) {
+	tests := []struct {
+		symbology, data string
+		want            string
+		err             error
+	}{
+		{EAN13, "400638133393", "4006381333931", nil},
+		{EAN13, "4006381333931", "4006381333931", nil},
+		{EAN13, "4006381333932", "", ErrCheckDigit},
+		{EAN13, "40063813339", "", ErrInvalidData},
+		{EAN13, "40063813339A", "", ErrInvalidData},
+		{EAN8, "9638507", "96385074", nil},
+		{EAN8, "96385070", "", ErrCheckDigit},
+		{UPCA, "03600029145", "036000291452", nil},
+		{UPCA, "036000291453", "", ErrCheckDigit},
+		{ITF14, "1061414100041", "10614141000415", nil},
+		{ITF14, "10614141000410", "", ErrCheckDigit},
+		{UPCE, "425261", "04252614", nil},
+		{UPCE, "0425261", "04252614", nil},
+		{UPCE, "04252614", "04252614", nil},
+		{UPCE, "04252615", "", ErrCheckDigit},
+		{UPCE, "1234567", "12345670", nil},
+		{UPCE, "2425261", "", ErrInvalidData},
+		{Code39, "abc-1", "ABC-1", nil},
+		{Codabar, "40156", "A40156B", nil},
+		{"code93", "1", "", ErrUnknownSymbology},
+	}
+	for _, tt := range tests {
+		t.Run(tt.symbology+"/"+tt.data, func(t *testing.T) {
+			l, err := EncodeLinear(tt.symbology, tt.data, false)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l.Data != tt.want {
+				t.Errorf("Data = %q, want %q", l.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandUPCE(t *testing.T) {
+	tests := []struct{ upce, upca string }{
+		{"0425261", "04210000526"},
+		{"0123456", "01234500006"},
+		{"0123453", "01230000045"},
+		{"0123454", "01234000005"},
+		{"1123450", "11200000345"},
+	}
+	for _, tt := range tests {
+		if got := ExpandUPCE(tt.upce); got != tt.upca {
+			t.Errorf("ExpandUPCE(%q) = %q, want %q", tt.upce, got, tt.upca)
+		}
+	}
+}
+
+// bars renders modules as a string of 1s and 0s
+func bars(modules []bool) string {
+	var b strings.Builder
+	for _, m := range modules {
+		if m {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+func TestEncodeUPCEModules(t *testing.T) {
+	// 01234565: check digit 5 selects the parity EOOEEO
+	want := "101" +
+		"0110011" + // 1 even
+		"0010011" + // 2 odd
+		"0111101" + // 3 odd
+		"0011101" + // 4 even
+		"0111001" + // 5 even
+		"0101111" + // 6 odd
+		"010101"
+	l, err := EncodeLinear(UPCE, "0123456", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Data != "01234565" {
+		t.Fatalf("Data = %q, want 01234565", l.Data)
+	}
+	if got := bars(l.Modules); got != want {
+		t.Errorf("modules =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEncodeEAN13Modules(t *testing.T) {
+	l, err := EncodeLinear(EAN13, "4006381333931", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := bars(l.Modules)
+	if len(got) != 95 {
+		t.Fatalf("%d modules, want 95", len(got))
+	}
+	// Start, centre and end guards
+	if got[:3] != "101" || got[45:50] != "01010" || got[92:] != "101" {
+		t.Errorf("guards missing in %s", got)
+	}
+	// The leading 4 selects the parity LGLLGG; the first left digit (0) is odd
+	if got[3:10] != upcOdd[0] || got[10:17] != upcEven[0] {
+		t.Errorf("left digits = %s %s, want %s %s", got[3:10], got[10:17], upcOdd[0], upcEven[0])
+	}
+}
@@ -0,0 +1,190 @@
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/aztec"
+)
+
+// Supported 2D symbologies besides Data Matrix
+const (
+	PDF417  = "pdf417"
+	Aztec   = "aztec"
+	MicroQR = "microqr"
+)
+
+// matrixSymbology describes a 2D symbology
+type matrixSymbology struct {
+	Name      string
+	QuietZone int // Minimum quiet zone, in modules
+}
+
+var matrixSymbologies = map[string]matrixSymbology{
+	DataMatrix: {"Data Matrix", 1},
+	PDF417:     {"PDF417", 2},
+	Aztec:      {"Aztec", 1},
+	MicroQR:    {"Micro QR", 2},
+}
+
+// IsMatrix reports whether name is a supported 2D symbology
+func IsMatrix(name string) bool {
+	_, ok := matrixSymbologies[name]
+	return ok
+}
+
+// MatrixOptions holds configuration for 2D barcode generation. Options of
+// other symbologies are ignored.
+type MatrixOptions struct {
+	Symbology  string  `json:"symbology,omitempty"`
+	Data       string  `json:"data,omitempty"`
+	ModuleSize int     `json:"module_size,omitempty"` // Pixels per module (default 4)
+	QuietZone  int     `json:"quiet_zone,omitempty"`  // Margin in modules (0 uses the symbology's minimum)
+	DPI        int     `json:"dpi,omitempty"`         // Print resolution recorded in the PNG
+	WidthMM    float64 `json:"width_mm,omitempty"`    // Printed width of the symbol without quiet zone; with DPI, sets the module size
+
+	// Data Matrix
+	Size string `json:"size,omitempty"` // "auto" or "square", "rectangle", or a fixed size such as "16x16" or "8x18"
+	GS1  bool   `json:"gs1,omitempty"`  // Encode GS1 element strings written as "(01)09501101530003(10)ABC"
+
+	// PDF417
+	Columns       int `json:"columns,omitempty"`        // Data columns, 1-30 (0 picks a roughly 3:1 symbol)
+	SecurityLevel int `json:"security_level,omitempty"` // Error correction level 1-8 (0 picks the level recommended for the data length)
+
+	// Aztec
+	Layers    int `json:"layers,omitempty"`     // 1-32 full-range or -1 to -4 compact layers (0 picks the smallest)
+	ECPercent int `json:"ec_percent,omitempty"` // Minimum error correction percentage (default 23)
+
+	// Micro QR
+	Version int    `json:"version,omitempty"` // M1-M4 as 1-4 (0 picks the smallest)
+	Level   string `json:"level,omitempty"`   // Error correction level L, M or Q (default L; M1 only detects errors)
+}
+
+// GenerateMatrix encodes and renders a 2D barcode as PNG
+func GenerateMatrix(options MatrixOptions) ([]byte, Size, error) {
+	if options.Data == "" {
+		return nil, Size{}, fmt.Errorf("%w: data is empty", ErrInvalidData)
+	}
+
+	var symbol *grid
+	var err error
+	switch options.Symbology {
+	case DataMatrix:
+		symbol, err = encodeDataMatrix(options.Data, options.Size, options.GS1)
+	case PDF417:
+		symbol, err = encodePDF417([]byte(options.Data), options.Columns, options.SecurityLevel)
+	case Aztec:
+		symbol, err = encodeAztec([]byte(options.Data), options.ECPercent, options.Layers)
+	case MicroQR:
+		symbol, err = encodeMicroQR(options.Data, options.Version, options.Level)
+	default:
+		return nil, Size{}, fmt.Errorf("%w %q", ErrUnknownSymbology, options.Symbology)
+	}
+	if err != nil {
+		if !errors.Is(err, ErrInvalidData) {
+			err = fmt.Errorf("%w: %v", ErrInvalidData, err)
+		}
+		return nil, Size{}, err
+	}
+
+	module := options.ModuleSize
+	if options.WidthMM > 0 {
+		if options.DPI <= 0 {
+			return nil, Size{}, fmt.Errorf("%w: width_mm needs a DPI", ErrInvalidData)
+		}
+		// Round down so the symbol is never printed wider than asked
+		module = int(options.WidthMM / 25.4 * float64(options.DPI) / float64(symbol.width))
+		if module < 1 {
+			return nil, Size{}, fmt.Errorf("%w: %d modules do not fit in %.1f mm at %d dpi", ErrInvalidData, symbol.width, options.WidthMM, options.DPI)
+		}
+	}
+	if module <= 0 {
+		module = 4
+	}
+	quiet := options.QuietZone
+	if quiet <= 0 {
+		quiet = matrixSymbologies[options.Symbology].QuietZone
+	}
+	if (symbol.width+2*quiet)*module > maxImageSize || (symbol.height+2*quiet)*module > maxImageSize {
+		return nil, Size{}, fmt.Errorf("%w: image would exceed %d pixels", ErrInvalidData, maxImageSize)
+	}
+
+	img := symbol.render(module, quiet)
+	data, err := encodePNG(img, options.DPI)
+	if err != nil {
+		return nil, Size{}, err
+	}
+	return data, sizeOf(img, options.DPI), nil
+}
+
+// encodeAztec wraps the boombuler encoder, checking the layer count it
+// would otherwise reject with a less helpful message
+func encodeAztec(data []byte, ecPercent, layers int) (*grid, error) {
+	if ecPercent <= 0 {
+		ecPercent = 23
+	}
+	if ecPercent > 90 {
+		return nil, fmt.Errorf("%w: error correction must be at most 90%%", ErrInvalidData)
+	}
+	if layers < -4 || layers > 32 {
+		return nil, fmt.Errorf("%w: layers must be -4 to -1 (compact) or 1 to 32", ErrInvalidData)
+	}
+	code, err := aztec.Encode(data, ecPercent, layers)
+	if err != nil {
+		return nil, err
+	}
+	return gridOf(code), nil
+}
+
+// grid is an encoded 2D symbol as dark modules, row by row
+type grid struct {
+	width, height int
+	dark          []bool
+}
+
+func newGrid(width, height int) *grid {
+	return &grid{width: width, height: height, dark: make([]bool, width*height)}
+}
+
+func (g *grid) at(x, y int) bool {
+	return g.dark[y*g.width+x]
+}
+
+func (g *grid) set(x, y int, dark bool) {
+	g.dark[y*g.width+x] = dark
+}
+
+// gridOf reads the modules of an unscaled boombuler code
+func gridOf(code barcode.Barcode) *grid {
+	bounds := code.Bounds()
+	g := newGrid(bounds.Dx(), bounds.Dy())
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			r, _, _, _ := code.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			g.set(x, y, r < 0x8000)
+		}
+	}
+	return g
+}
+
+// render draws the symbol with module pixels per module and a quiet zone
+// of the given number of modules
+func (g *grid) render(module, quiet int) image.Image {
+	width := (g.width + 2*quiet) * module
+	height := (g.height + 2*quiet) * module
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			if !g.at(x, y) {
+				continue
+			}
+			px, py := (x+quiet)*module, (y+quiet)*module
+			draw.Draw(img, image.Rect(px, py, px+module, py+module), image.Black, image.Point{}, draw.Src)
+		}
+	}
+	return img
+}
@@ -0,0 +1,283 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Micro QR encoding modes, in the order of their mode indicators
+const (
+	mqrNumeric = iota
+	mqrAlphanumeric
+	mqrByte
+)
+
+const mqrAlphanumericChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+var (
+	// mqrECWords lists the error correction codewords by version (M1-M4)
+	// and level (L, M, Q); 0 marks unsupported combinations
+	mqrECWords = [5][3]int{{}, {2, 0, 0}, {5, 6, 0}, {6, 8, 0}, {8, 10, 14}}
+	// mqrCountBits lists the character count indicator length by mode and
+	// version; 0 marks modes a version cannot encode
+	mqrCountBits = [3][5]int{
+		mqrNumeric:      {0, 3, 4, 5, 6},
+		mqrAlphanumeric: {0, 0, 3, 4, 5},
+		mqrByte:         {0, 0, 0, 4, 5},
+	}
+	// mqrSymbolNumber identifies the version and level in the format information
+	mqrSymbolNumber = [5][3]int{{}, {0, -1, -1}, {1, 2, -1}, {3, 4, -1}, {5, 6, 7}}
+)
+
+// encodeMicroQR encodes data in a Micro QR symbol (ISO/IEC 18004). version
+// 1-4 selects M1-M4, 0 the smallest that fits; level is L, M or Q.
+func encodeMicroQR(data string, version int, level string) (*grid, error) {
+	if version < 0 || version > 4 {
+		return nil, fmt.Errorf("%w: Micro QR version must be M1 to M4", ErrInvalidData)
+	}
+	ecLevel := strings.Index("LMQ", strings.ToUpper(level))
+	if level == "" {
+		ecLevel = 0
+	}
+	if ecLevel < 0 || len(level) > 1 {
+		return nil, fmt.Errorf("%w: Micro QR error correction level must be L, M or Q", ErrInvalidData)
+	}
+
+	mode := mqrByte
+	switch {
+	case strings.Trim(data, "0123456789") == "":
+		mode = mqrNumeric
+	case strings.Trim(data, mqrAlphanumericChars) == "":
+		mode = mqrAlphanumeric
+	}
+
+	first, last := 1, 4
+	if version > 0 {
+		first, last = version, version
+	}
+	for v := first; v <= last; v++ {
+		if mqrECWords[v][ecLevel] == 0 || !mqrFits(mode, len(data), v, ecLevel) {
+			continue
+		}
+		return mqrSymbol(mqrCodewords(data, mode, v, ecLevel), v, ecLevel), nil
+	}
+	if version > 0 {
+		return nil, fmt.Errorf("%w: data does not fit in Micro QR M%d-%s", ErrInvalidData, version, "LMQ"[ecLevel:ecLevel+1])
+	}
+	return nil, fmt.Errorf("%w: data too long for Micro QR", ErrInvalidData)
+}
+
+// mqrDataBits returns the number of data bits of a version and level; M1
+// and M3 end in a 4-bit codeword
+func mqrDataBits(version, level int) int {
+	width := 2*version + 9
+	return (width-1)*(width-1) - 64 - 8*mqrECWords[version][level]
+}
+
+// mqrFits reports whether n characters in mode fit the version and level
+func mqrFits(mode, n, version, level int) bool {
+	countBits := mqrCountBits[mode][version]
+	if countBits == 0 || n >= 1<<countBits {
+		return false
+	}
+	var bits int
+	switch mode {
+	case mqrNumeric:
+		bits = 10*(n/3) + [3]int{0, 4, 7}[n%3]
+	case mqrAlphanumeric:
+		bits = 11*(n/2) + 6*(n%2)
+	default:
+		bits = 8 * n
+	}
+	return version-1+countBits+bits <= mqrDataBits(version, level)
+}
+
+// mqrCodewords builds the data codewords followed by the error correction codewords
+func mqrCodewords(data string, mode, version, level int) []byte {
+	var bits bitList
+	bits.add(mode, version-1)
+	bits.add(len(data), mqrCountBits[mode][version])
+	switch mode {
+	case mqrNumeric:
+		for i := 0; i < len(data); i += 3 {
+			group := data[i:min(i+3, len(data))]
+			value := 0
+			for _, d := range group {
+				value = value*10 + int(d-'0')
+			}
+			bits.add(value, [4]int{0, 4, 7, 10}[len(group)])
+		}
+	case mqrAlphanumeric:
+		for i := 0; i < len(data); i += 2 {
+			if i+1 < len(data) {
+				bits.add(strings.IndexByte(mqrAlphanumericChars, data[i])*45+strings.IndexByte(mqrAlphanumericChars, data[i+1]), 11)
+			} else {
+				bits.add(strings.IndexByte(mqrAlphanumericChars, data[i]), 6)
+			}
+		}
+	default:
+		for i := 0; i < len(data); i++ {
+			bits.add(int(data[i]), 8)
+		}
+	}
+
+	// Terminator, then zeros to the codeword boundary, then alternating pad
+	// codewords; a final 4-bit codeword is padded with zeros
+	capacity := mqrDataBits(version, level)
+	bits.add(0, min(2*version+1, capacity-len(bits)))
+	if rem := len(bits) % 8; rem != 0 {
+		bits.add(0, min(8-rem, capacity-len(bits)))
+	}
+	for pad := 0; len(bits)+8 <= capacity; pad++ {
+		bits.add([2]int{0xec, 0x11}[pad%2], 8)
+	}
+	bits.add(0, capacity-len(bits))
+
+	dataWords := make([]byte, (capacity+7)/8)
+	for i, b := range bits {
+		if b {
+			dataWords[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return append(dataWords, reedSolomon(dataWords, mqrECWords[version][level], 0x11d, 0)...)
+}
+
+// mqrSymbol places the codewords in the symbol, choosing the best mask
+func mqrSymbol(codewords []byte, version, level int) *grid {
+	width := 2*version + 9
+	function := newGrid(width, width)
+	base := newGrid(width, width)
+
+	// Finder pattern and its separator
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			function.set(x, y, true)
+			d := max(abs(x-3), abs(y-3))
+			base.set(x, y, d == 3 || d <= 1)
+		}
+	}
+	// Timing patterns along the top and left edges
+	for i := 8; i < width; i++ {
+		function.set(i, 0, true)
+		function.set(0, i, true)
+		base.set(i, 0, i%2 == 0)
+		base.set(0, i, i%2 == 0)
+	}
+	// Format information
+	for i := 1; i <= 8; i++ {
+		function.set(8, i, true)
+		function.set(i, 8, true)
+	}
+
+	// Data bits fill column pairs from the right, alternately upwards and
+	// downwards. The last data codeword of M1 and M3 has only four bits.
+	dataCount := (mqrDataBits(version, level) + 7) / 8
+	var bits bitList
+	for i, cw := range codewords {
+		if i == dataCount-1 && mqrDataBits(version, level)%8 != 0 {
+			bits.add(int(cw>>4), 4)
+		} else {
+			bits.add(int(cw), 8)
+		}
+	}
+	next := 0
+	upward := true
+	for right := width - 1; right > 0; right -= 2 {
+		for i := 0; i < width; i++ {
+			y := i
+			if upward {
+				y = width - 1 - i
+			}
+			for x := right; x > right-2; x-- {
+				if function.at(x, y) || next >= len(bits) {
+					continue
+				}
+				base.set(x, y, bits[next])
+				next++
+			}
+		}
+		upward = !upward
+	}
+
+	// The mask with the most dark modules along the right and bottom edges wins
+	var best *grid
+	bestScore := -1
+	for mask := 0; mask < 4; mask++ {
+		symbol := newGrid(width, width)
+		for y := 0; y < width; y++ {
+			for x := 0; x < width; x++ {
+				dark := base.at(x, y)
+				if !function.at(x, y) && mqrMask(mask, x, y) {
+					dark = !dark
+				}
+				symbol.set(x, y, dark)
+			}
+		}
+		mqrFormat(symbol, mqrSymbolNumber[version][level], mask)
+
+		right, bottom := 0, 0
+		for i := 1; i < width; i++ {
+			if symbol.at(width-1, i) {
+				right++
+			}
+			if symbol.at(i, width-1) {
+				bottom++
+			}
+		}
+		score := 16*min(right, bottom) + max(right, bottom)
+		if score > bestScore {
+			best, bestScore = symbol, score
+		}
+	}
+	return best
+}
+
+// mqrMask reports whether the module in column x and row y is inverted by a mask
+func mqrMask(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return y%2 == 0
+	case 1:
+		return (y/2+x/3)%2 == 0
+	case 2:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// mqrFormat writes the BCH-protected symbol number and mask next to the
+// finder pattern, least significant bit at the top
+func mqrFormat(symbol *grid, symbolNumber, mask int) {
+	info := symbolNumber<<2 | mask
+	rem := info << 10
+	for bit := 14; bit >= 10; bit-- {
+		if rem&(1<<bit) != 0 {
+			rem ^= 0x537 << (bit - 10)
+		}
+	}
+	format := (info<<10 | rem) ^ 0x4445
+
+	for i := 0; i < 8; i++ {
+		symbol.set(8, i+1, format&(1<<i) != 0)
+	}
+	for i := 0; i < 7; i++ {
+		symbol.set(7-i, 8, format&(1<<(i+8)) != 0)
+	}
+}
+
+// bitList collects bits most significant first
+type bitList []bool
+
+func (b *bitList) add(value, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, value&(1<<i) != 0)
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,91 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestMicroQRCodewords(t *testing.T) {
+	// ISO/IEC 18004 Annex I example: "01234567" in M2-L
+	want := []byte{
+		0x40, 0x18, 0xac, 0xc3, 0x00, // Data
+		0x86, 0x0d, 0x22, 0xae, 0x30, // Error correction
+	}
+	if got := mqrCodewords("01234567", mqrNumeric, 2, 0); !bytes.Equal(got, want) {
+		t.Errorf("mqrCodewords = % x, want % x", got, want)
+	}
+}
+
+func TestMicroQRFormat(t *testing.T) {
+	// Format information of symbol number 0 with masks 0-3 and symbol
+	// number 1 with mask 0, from the ISO/IEC 18004 format table
+	tests := []struct {
+		symbolNumber, mask int
+		want               int
+	}{
+		{0, 0, 0x4445},
+		{0, 1, 0x4172},
+		{0, 2, 0x4e2b},
+		{0, 3, 0x4b1c},
+		{1, 0, 0x55ae},
+	}
+	for _, tt := range tests {
+		symbol := newGrid(11, 11)
+		mqrFormat(symbol, tt.symbolNumber, tt.mask)
+		got := 0
+		for i := 0; i < 8; i++ {
+			if symbol.at(8, i+1) {
+				got |= 1 << i
+			}
+		}
+		for i := 0; i < 7; i++ {
+			if symbol.at(7-i, 8) {
+				got |= 1 << (i + 8)
+			}
+		}
+		if got != tt.want {
+			t.Errorf("symbol %d mask %d: format = %#x, want %#x", tt.symbolNumber, tt.mask, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeMicroQR(t *testing.T) {
+	tests := []struct {
+		data    string
+		version int
+		level   string
+		width   int
+		err     error
+	}{
+		{"12345", 0, "", 11, nil}, // M1 holds five digits
+		{"123456", 0, "", 13, nil},
+		{"01234567", 2, "L", 13, nil},
+		{"AB", 0, "L", 13, nil}, // M1 has no alphanumeric mode
+		{"hello", 0, "M", 15, nil},
+		{"hello", 2, "", 0, ErrInvalidData},
+		{"1", 1, "M", 0, ErrInvalidData},
+		{"1", 4, "H", 0, ErrInvalidData},
+		{"1", 5, "", 0, ErrInvalidData},
+	}
+	for _, tt := range tests {
+		symbol, err := encodeMicroQR(tt.data, tt.version, tt.level)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%q M%d-%s: err = %v, want %v", tt.data, tt.version, tt.level, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q M%d-%s: %v", tt.data, tt.version, tt.level, err)
+			continue
+		}
+		if symbol.width != tt.width || symbol.height != tt.width {
+			t.Errorf("%q M%d-%s: %dx%d, want %dx%d", tt.data, tt.version, tt.level, symbol.width, symbol.height, tt.width, tt.width)
+		}
+		// Finder pattern corner and timing pattern
+		if !symbol.at(0, 0) || !symbol.at(8, 0) || symbol.at(9, 0) || !symbol.at(0, 10) {
+			t.Errorf("%q: finder or timing pattern missing", tt.data)
+		}
+	}
+}
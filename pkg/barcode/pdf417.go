@@ -0,0 +1,419 @@
+package barcode
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// PDF417 mode latches and limits
+const (
+	pdfLatchText    = 900
+	pdfLatchByte    = 901 // Byte compaction of a length not divisible by 6
+	pdfLatchNumeric = 902
+	pdfLatchByte6   = 924 // Byte compaction of a length divisible by 6
+	pdfPad          = 900
+
+	pdfMinNumeric = 13 // Shorter digit runs are cheaper in text compaction
+	pdfMinText    = 5  // Shorter text runs between binary data are kept in byte compaction
+	pdfMaxCols    = 30
+	pdfMinRows    = 3
+	pdfMaxRows    = 90
+	pdfMaxWords   = 928
+	pdfRowHeight  = 3 // Row height in modules
+
+	pdfStart = 0x1fea8 // 17 modules
+	pdfStop  = 0x3fa29 // 18 modules
+)
+
+// Text compaction submodes
+const (
+	pdfAlpha = iota
+	pdfLower
+	pdfMixed
+	pdfPunct
+)
+
+var (
+	pdfMixedChars = "0123456789&\r\t,:#-.$/+%*=^"
+	pdfPunctChars = ";<>@[\\]_`~!\r\t,:\n-.$/\"|*()?{}'"
+)
+
+// encodePDF417 encodes data in a PDF417 symbol with the given number of
+// data columns and error correction level, each 0 to choose automatically
+func encodePDF417(data []byte, columns, level int) (*grid, error) {
+	if columns < 0 || columns > pdfMaxCols {
+		return nil, fmt.Errorf("%w: columns must be 1 to %d", ErrInvalidData, pdfMaxCols)
+	}
+	if level < 0 || level > 8 {
+		return nil, fmt.Errorf("%w: security level must be 1 to 8", ErrInvalidData)
+	}
+
+	words := pdfHighLevel(data)
+	if level == 0 {
+		level = pdfRecommendedLevel(len(words))
+	}
+	ecCount := 2 << level
+
+	// The symbol length descriptor is the first data codeword
+	needed := len(words) + 1 + ecCount
+	if columns == 0 {
+		columns = pdfColumnsFor(needed)
+	}
+	rows := (needed + columns - 1) / columns
+	if rows < pdfMinRows {
+		rows = pdfMinRows
+	}
+	if rows > pdfMaxRows || columns*rows > pdfMaxWords {
+		return nil, fmt.Errorf("%w: data too long for PDF417 with %d columns and security level %d", ErrInvalidData, columns, level)
+	}
+
+	dataCount := columns*rows - ecCount
+	codewords := make([]int, 0, columns*rows)
+	codewords = append(codewords, dataCount)
+	codewords = append(codewords, words...)
+	for len(codewords) < dataCount {
+		codewords = append(codewords, pdfPad)
+	}
+	codewords = append(codewords, pdfErrorCorrection(codewords, level)...)
+
+	rowWidth := 17*(columns+4) + 1
+	g := newGrid(rowWidth, rows*pdfRowHeight)
+	for r := 0; r < rows; r++ {
+		cluster := r % 3
+		left, right := pdfRowIndicators(r, rows, columns, level)
+
+		row := make([]uint32, 0, columns+4)
+		row = append(row, pdfStart, pdf417Patterns[cluster][left])
+		for _, cw := range codewords[r*columns : (r+1)*columns] {
+			row = append(row, pdf417Patterns[cluster][cw])
+		}
+		row = append(row, pdf417Patterns[cluster][right])
+
+		x := 0
+		put := func(pattern uint32, width int) {
+			for bit := width - 1; bit >= 0; bit-- {
+				if pattern&(1<<bit) != 0 {
+					for y := r * pdfRowHeight; y < (r+1)*pdfRowHeight; y++ {
+						g.set(x, y, true)
+					}
+				}
+				x++
+			}
+		}
+		for _, pattern := range row {
+			put(pattern, 17)
+		}
+		put(pdfStop, 18)
+	}
+	return g, nil
+}
+
+// pdfRecommendedLevel returns the minimum error correction level
+// recommended for the number of data codewords (ISO/IEC 15438, Annex E)
+func pdfRecommendedLevel(dataWords int) int {
+	switch {
+	case dataWords <= 40:
+		return 2
+	case dataWords <= 160:
+		return 3
+	case dataWords <= 320:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// pdfColumnsFor picks the column count whose symbol is closest to three
+// times as wide as it is high
+func pdfColumnsFor(codewords int) int {
+	best, bestDiff := pdfMaxCols, math.MaxFloat64
+	for c := 1; c <= pdfMaxCols; c++ {
+		rows := (codewords + c - 1) / c
+		if rows > pdfMaxRows {
+			continue
+		}
+		if rows < pdfMinRows {
+			rows = pdfMinRows
+		}
+		ratio := float64(17*(c+4)+1) / float64(rows*pdfRowHeight)
+		if diff := math.Abs(ratio - 3); diff < bestDiff {
+			best, bestDiff = c, diff
+		}
+	}
+	return best
+}
+
+// pdfRowIndicators returns the left and right row indicator codewords,
+// which encode the row count, column count and error correction level
+func pdfRowIndicators(row, rows, columns, level int) (int, int) {
+	base := 30 * (row / 3)
+	rowsPart := (rows - 1) / 3
+	levelPart := level*3 + (rows-1)%3
+	colsPart := columns - 1
+	switch row % 3 {
+	case 0:
+		return base + rowsPart, base + colsPart
+	case 1:
+		return base + levelPart, base + rowsPart
+	default:
+		return base + colsPart, base + levelPart
+	}
+}
+
+// pdfHighLevel converts data into codewords, using numeric compaction for
+// long digit runs, text compaction for text and byte compaction otherwise
+func pdfHighLevel(data []byte) []int {
+	var words []int
+	text, submode := true, pdfAlpha
+	for p := 0; p < len(data); {
+		if n := pdfDigitRun(data[p:]); n >= pdfMinNumeric {
+			words = append(words, pdfLatchNumeric)
+			words = append(words, pdfNumeric(data[p:p+n])...)
+			text = false
+			p += n
+			continue
+		}
+		if n := pdfTextRun(data[p:]); n >= pdfMinText || (n > 0 && p+n == len(data)) {
+			if !text {
+				words = append(words, pdfLatchText)
+				text, submode = true, pdfAlpha
+			}
+			var encoded []int
+			encoded, submode = pdfText(data[p:p+n], submode)
+			words = append(words, encoded...)
+			p += n
+			continue
+		}
+		n := pdfBinaryRun(data[p:])
+		words = append(words, pdfBytes(data[p:p+n])...)
+		text = false
+		p += n
+	}
+	return words
+}
+
+func pdfDigitRun(data []byte) int {
+	n := 0
+	for n < len(data) && data[n] >= '0' && data[n] <= '9' {
+		n++
+	}
+	return n
+}
+
+func pdfIsText(ch byte) bool {
+	return ch == '\t' || ch == '\n' || ch == '\r' || (ch >= ' ' && ch <= '~')
+}
+
+// pdfTextRun counts the text characters before the next non-text byte or
+// long digit run
+func pdfTextRun(data []byte) int {
+	n := 0
+	for n < len(data) && pdfIsText(data[n]) && pdfDigitRun(data[n:]) < pdfMinNumeric {
+		n++
+	}
+	return n
+}
+
+// pdfBinaryRun counts the bytes before the next long text or digit run
+func pdfBinaryRun(data []byte) int {
+	for i := 1; i < len(data); i++ {
+		if pdfDigitRun(data[i:]) >= pdfMinNumeric || pdfTextRun(data[i:]) >= pdfMinText {
+			return i
+		}
+	}
+	return len(data)
+}
+
+// pdfNumeric encodes digits in groups of up to 44 as base 900 numbers
+func pdfNumeric(digits []byte) []int {
+	var words []int
+	base := big.NewInt(900)
+	for start := 0; start < len(digits); start += 44 {
+		end := min(start+44, len(digits))
+		value, _ := new(big.Int).SetString("1"+string(digits[start:end]), 10)
+
+		var group []int
+		mod := new(big.Int)
+		for value.Sign() > 0 {
+			value.DivMod(value, base, mod)
+			group = append([]int{int(mod.Int64())}, group...)
+		}
+		words = append(words, group...)
+	}
+	return words
+}
+
+// pdfBytes encodes bytes in byte compaction, six bytes per five codewords
+func pdfBytes(data []byte) []int {
+	latch := pdfLatchByte
+	if len(data)%6 == 0 {
+		latch = pdfLatchByte6
+	}
+	words := []int{latch}
+	i := 0
+	for ; len(data)-i >= 6; i += 6 {
+		var value uint64
+		for _, b := range data[i : i+6] {
+			value = value<<8 | uint64(b)
+		}
+		var group [5]int
+		for j := 4; j >= 0; j-- {
+			group[j] = int(value % 900)
+			value /= 900
+		}
+		words = append(words, group[:]...)
+	}
+	for _, b := range data[i:] {
+		words = append(words, int(b))
+	}
+	return words
+}
+
+// pdfText encodes text in text compaction starting in the given submode,
+// two characters per codeword, and returns the final submode
+func pdfText(text []byte, submode int) ([]int, int) {
+	isUpper := func(ch byte) bool { return ch == ' ' || (ch >= 'A' && ch <= 'Z') }
+	isLower := func(ch byte) bool { return ch == ' ' || (ch >= 'a' && ch <= 'z') }
+	mixed := func(ch byte) int {
+		if ch == ' ' {
+			return 26
+		}
+		return pdfIndex(pdfMixedChars, ch)
+	}
+	punct := func(ch byte) int { return pdfIndex(pdfPunctChars, ch) }
+
+	var values []int
+	for i := 0; i < len(text); {
+		ch := text[i]
+		switch submode {
+		case pdfAlpha:
+			switch {
+			case isUpper(ch):
+				values = append(values, letterValue(ch, 'A'))
+			case isLower(ch):
+				values = append(values, 27) // Latch to lower
+				submode = pdfLower
+				continue
+			case mixed(ch) >= 0:
+				values = append(values, 28) // Latch to mixed
+				submode = pdfMixed
+				continue
+			default:
+				values = append(values, 29, punct(ch)) // Shift to punctuation
+			}
+		case pdfLower:
+			switch {
+			case isLower(ch):
+				values = append(values, letterValue(ch, 'a'))
+			case isUpper(ch):
+				values = append(values, 27, int(ch-'A')) // Shift to alpha
+			case mixed(ch) >= 0:
+				values = append(values, 28) // Latch to mixed
+				submode = pdfMixed
+				continue
+			default:
+				values = append(values, 29, punct(ch))
+			}
+		case pdfMixed:
+			switch {
+			case mixed(ch) >= 0:
+				values = append(values, mixed(ch))
+			case isUpper(ch):
+				values = append(values, 28) // Latch to alpha
+				submode = pdfAlpha
+				continue
+			case isLower(ch):
+				values = append(values, 27) // Latch to lower
+				submode = pdfLower
+				continue
+			case i+1 < len(text) && punct(text[i+1]) >= 0:
+				values = append(values, 25) // Latch to punctuation
+				submode = pdfPunct
+				continue
+			default:
+				values = append(values, 29, punct(ch))
+			}
+		case pdfPunct:
+			if p := punct(ch); p >= 0 {
+				values = append(values, p)
+			} else {
+				values = append(values, 29) // Latch to alpha
+				submode = pdfAlpha
+				continue
+			}
+		}
+		i++
+	}
+
+	// An odd value count is padded with a harmless shift or latch
+	if len(values)%2 == 1 {
+		values = append(values, 29)
+	}
+	words := make([]int, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		words = append(words, values[i]*30+values[i+1])
+	}
+	return words, submode
+}
+
+func letterValue(ch, first byte) int {
+	if ch == ' ' {
+		return 26
+	}
+	return int(ch - first)
+}
+
+func pdfIndex(chars string, ch byte) int {
+	for i := 0; i < len(chars); i++ {
+		if chars[i] == ch {
+			return i
+		}
+	}
+	return -1
+}
+
+// pdfGenerators holds the generator polynomial of each error correction
+// level, indexed by level
+var pdfGenerators [9][]int
+
+func init() {
+	for level := range pdfGenerators {
+		// Coefficients of the generator polynomial, lowest order first
+		gen := []int{1}
+		root := 1
+		for i := 0; i < 2<<level; i++ {
+			root = root * 3 % 929
+			next := make([]int, len(gen)+1)
+			for j, c := range gen {
+				next[j+1] = (next[j+1] + c) % 929
+				next[j] = (next[j] + 929 - c*root%929) % 929
+			}
+			gen = next
+		}
+		pdfGenerators[level] = gen
+	}
+}
+
+// pdfErrorCorrection computes the k = 2^(level+1) Reed-Solomon error
+// correction codewords over GF(929) with the generator
+// (x-3)(x-3^2)...(x-3^k)
+func pdfErrorCorrection(data []int, level int) []int {
+	gen := pdfGenerators[level]
+	k := len(gen) - 1
+
+	ec := make([]int, k)
+	for _, d := range data {
+		t := (d + ec[k-1]) % 929
+		for j := k - 1; j > 0; j-- {
+			ec[j] = (ec[j-1] + 929 - t*gen[j]%929) % 929
+		}
+		ec[0] = (929 - t*gen[0]%929) % 929
+	}
+
+	words := make([]int, k)
+	for j := range ec {
+		words[k-1-j] = (929 - ec[j]) % 929
+	}
+	return words
+}
@@ -0,0 +1,88 @@
+package barcode
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPDFErrorCorrection(t *testing.T) {
+	// ISO/IEC 15438 example: symbol length descriptor 5, four data
+	// codewords, security level 1
+	data := []int{5, 453, 178, 121, 239}
+	want := []int{452, 327, 657, 619}
+	if got := pdfErrorCorrection(data, 1); !reflect.DeepEqual(got, want) {
+		t.Errorf("pdfErrorCorrection = %v, want %v", got, want)
+	}
+	for level := 0; level <= 8; level++ {
+		if got := len(pdfErrorCorrection(data, level)); got != 2<<level {
+			t.Errorf("level %d: %d codewords, want %d", level, got, 2<<level)
+		}
+	}
+}
+
+func TestPDFNumeric(t *testing.T) {
+	// ISO/IEC 15438 numeric compaction example
+	want := []int{1, 624, 434, 632, 282, 200}
+	if got := pdfNumeric([]byte("000213298174000")); !reflect.DeepEqual(got, want) {
+		t.Errorf("pdfNumeric = %v, want %v", got, want)
+	}
+}
+
+func TestPDFHighLevel(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []int
+	}{
+		// Text compaction starts in upper case: P=15 D=3, F=5 latch-mixed=28,
+		// 4=4 1=1, 7=7 pad=29
+		{"text", "PDF417", []int{15*30 + 3, 5*30 + 28, 4*30 + 1, 7*30 + 29}},
+		{"lower", "ab", []int{27*30 + 0, 1*30 + 29}},
+		{"numeric", "000213298174000", []int{pdfLatchNumeric, 1, 624, 434, 632, 282, 200}},
+		// Six bytes in five base 900 codewords
+		{"bytes", "\x00\x00\x00\x00\x00\x01", []int{pdfLatchByte6, 0, 0, 0, 0, 1}},
+		{"short bytes", "\x80", []int{pdfLatchByte, 0x80}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pdfHighLevel([]byte(tt.data)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pdfHighLevel(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodePDF417(t *testing.T) {
+	symbol, err := encodePDF417([]byte("PDF417"), 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start pattern, left indicator, two data columns, right indicator and
+	// stop pattern: 17 + 4*17 + 18 modules
+	if symbol.width != 17*6+1 {
+		t.Errorf("width = %d, want %d", symbol.width, 17*6+1)
+	}
+	if symbol.height%pdfRowHeight != 0 || symbol.height/pdfRowHeight < pdfMinRows {
+		t.Errorf("height = %d, want a multiple of %d with at least %d rows", symbol.height, pdfRowHeight, pdfMinRows)
+	}
+	var start, stop []bool
+	for x := 0; x < 17; x++ {
+		start = append(start, symbol.at(x, 0))
+	}
+	for x := symbol.width - 18; x < symbol.width; x++ {
+		stop = append(stop, symbol.at(x, symbol.height-1))
+	}
+	if got := bars(start); got != "11111111010101000" {
+		t.Errorf("start pattern = %s", got)
+	}
+	if got := bars(stop); got != "111111101000101001" {
+		t.Errorf("stop pattern = %s", got)
+	}
+
+	for _, tt := range []struct{ columns, level int }{{-1, 0}, {pdfMaxCols + 1, 0}, {0, 9}} {
+		if _, err := encodePDF417([]byte("x"), tt.columns, tt.level); !errors.Is(err, ErrInvalidData) {
+			t.Errorf("columns %d level %d: err = %v, want ErrInvalidData", tt.columns, tt.level, err)
+		}
+	}
+}
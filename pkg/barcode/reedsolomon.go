@@ -0,0 +1,47 @@
+package barcode
+
+// reedSolomon computes n error correction codewords over the GF(256)
+// defined by poly, with generator roots α^first to α^(first+n-1). QR codes
+// use x^8+x^4+x^3+x^2+1 (0x11d) from α^0, Data Matrix x^8+x^5+x^3+x^2+1
+// (0x12d) from α^1.
+func reedSolomon(data []byte, n, poly, first int) []byte {
+	var exp [512]byte
+	var log [256]int
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i], exp[i+255] = byte(x), byte(x)
+		log[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= poly
+		}
+	}
+	mul := func(a, b byte) byte {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return exp[log[a]+log[b]]
+	}
+
+	// Generator polynomial, highest order first
+	gen := []byte{1}
+	for i := 0; i < n; i++ {
+		next := make([]byte, len(gen)+1)
+		for j, c := range gen {
+			next[j] ^= c
+			next[j+1] ^= mul(c, exp[(first+i)%255])
+		}
+		gen = next
+	}
+
+	ec := make([]byte, n)
+	for _, d := range data {
+		factor := d ^ ec[0]
+		copy(ec, ec[1:])
+		ec[n-1] = 0
+		for j := 0; j < n; j++ {
+			ec[j] ^= mul(gen[j+1], factor)
+		}
+	}
+	return ec
+}
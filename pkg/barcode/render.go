@@ -0,0 +1,247 @@
+package barcode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// maxImageSize limits the width and height of rendered barcodes, in pixels
+const maxImageSize = 10000
+
+// LinearOptions holds configuration for linear barcode generation
+type LinearOptions struct {
+	Symbology     string  `json:"symbology,omitempty"`
+	Data          string  `json:"data,omitempty"`
+	Checksum      bool    `json:"checksum,omitempty"`      // Add the optional check character of Code 39
+	ModuleWidth   int     `json:"module_width,omitempty"`  // Pixels per module (narrow bar); ignored when DPI is set (default 2)
+	Height        int     `json:"height,omitempty"`        // Bar height in pixels; ignored when DPI is set (default 60 modules)
+	DPI           int     `json:"dpi,omitempty"`           // Print resolution; sizes the code from the symbology's nominal dimensions
+	Magnification float64 `json:"magnification,omitempty"` // Scale of the nominal print size, e.g. 0.8–2.0 for EAN/UPC (default 1)
+	HumanReadable bool    `json:"human_readable"`          // Print the data below the bars
+}
+
+// Size is the pixel size of a rendered barcode and, when rendered for a
+// DPI, its printed size
+type Size struct {
+	Width    int     `json:"width"`
+	Height   int     `json:"height"`
+	WidthMM  float64 `json:"width_mm,omitempty"`
+	HeightMM float64 `json:"height_mm,omitempty"`
+	DPI      int     `json:"dpi,omitempty"`
+}
+
+// GenerateLinear encodes and renders a linear barcode as PNG. With a DPI,
+// the PNG records it so the code prints at its nominal size.
+func GenerateLinear(options LinearOptions) ([]byte, Size, error) {
+	code, err := EncodeLinear(options.Symbology, options.Data, options.Checksum)
+	if err != nil {
+		return nil, Size{}, err
+	}
+	return code.PNG(options)
+}
+
+// PNG renders the encoded barcode as PNG using the sizing and text options
+func (l *Linear) PNG(options LinearOptions) ([]byte, Size, error) {
+	img, err := l.Render(options)
+	if err != nil {
+		return nil, Size{}, err
+	}
+	data, err := encodePNG(img, options.DPI)
+	if err != nil {
+		return nil, Size{}, err
+	}
+	return data, sizeOf(img, options.DPI), nil
+}
+
+// Render draws the barcode with its quiet zones, guard bar extensions,
+// ITF-14 bearer bars and optional human-readable text. Images larger than
+// maxImageSize are refused before anything is allocated.
+func (l *Linear) Render(options LinearOptions) (image.Image, error) {
+	spec := symbologies[l.Symbology]
+
+	module, barHeight := options.ModuleWidth, options.Height
+	if options.DPI > 0 {
+		mag := options.Magnification
+		if mag <= 0 {
+			mag = 1
+		}
+		module = int(math.Round(spec.XDimension * mag * float64(options.DPI) / 25.4))
+		barHeight = int(math.Round(spec.BarHeight * mag * float64(options.DPI) / 25.4))
+	}
+	if module <= 0 {
+		module = 2
+	}
+	if barHeight <= 0 {
+		barHeight = 60 * module
+	}
+
+	bearer := 0
+	if l.Symbology == ITF14 {
+		bearer = 3 * module
+	}
+	tooLarge := fmt.Errorf("%w: image would exceed %d pixels", ErrInvalidData, maxImageSize)
+	if module > maxImageSize || barHeight > maxImageSize ||
+		(spec.QuietLeft+len(l.Modules)+spec.QuietRight)*module > maxImageSize || 2*bearer+barHeight > maxImageSize {
+		return nil, tooLarge
+	}
+
+	// Quiet zones must also hold the digits printed beside the bars
+	quietLeft, quietRight := spec.QuietLeft, spec.QuietRight
+	var face font.Face
+	if options.HumanReadable {
+		face = textFace(math.Max(10, spec.TextSize*float64(module)))
+		for _, seg := range l.text {
+			if seg.From < 0 && -seg.From > quietLeft {
+				quietLeft = -seg.From
+			}
+			if over := seg.To - len(l.Modules); over > quietRight {
+				quietRight = over
+			}
+		}
+	}
+
+	width := (quietLeft + len(l.Modules) + quietRight) * module
+	height := bearer + barHeight + bearer
+	guardExt, textTop, textHeight := 0, height, 0
+	if face != nil {
+		metrics := face.Metrics()
+		textHeight = (metrics.Ascent + metrics.Descent).Ceil()
+		gap := module
+		if hasGuards(l.Guards) {
+			// Digits sit between the extended guard bars
+			guardExt = gap + metrics.Ascent.Ceil()/2
+		}
+		textTop = height + gap
+		height = textTop + textHeight + gap
+		for _, seg := range l.text {
+			if w := font.MeasureString(face, seg.Text).Ceil() + 2*module; w > width {
+				width = w
+			}
+		}
+	}
+
+	if width > maxImageSize || height > maxImageSize {
+		return nil, tooLarge
+	}
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	left := (width - (quietLeft+len(l.Modules)+quietRight)*module) / 2
+	origin := left + quietLeft*module
+
+	for i, dark := range l.Modules {
+		if !dark {
+			continue
+		}
+		bottom := bearer + barHeight
+		if l.Guards[i] {
+			bottom += guardExt
+		}
+		x := origin + i*module
+		draw.Draw(img, image.Rect(x, bearer, x+module, bottom), image.Black, image.Point{}, draw.Src)
+	}
+	if bearer > 0 {
+		draw.Draw(img, image.Rect(0, 0, width, bearer), image.Black, image.Point{}, draw.Src)
+		draw.Draw(img, image.Rect(0, bearer+barHeight, width, 2*bearer+barHeight), image.Black, image.Point{}, draw.Src)
+	}
+
+	if face != nil {
+		drawer := &font.Drawer{Dst: img, Src: image.NewUniform(color.Black), Face: face}
+		baseline := textTop + face.Metrics().Ascent.Ceil()
+		for _, seg := range l.text {
+			textWidth := font.MeasureString(face, seg.Text).Ceil()
+			center := origin + (seg.From+seg.To)*module/2
+			x := center - textWidth/2
+			if x < 0 {
+				x = 0
+			}
+			drawer.Dot = fixed.P(x, baseline)
+			drawer.DrawString(seg.Text)
+		}
+	}
+	return img, nil
+}
+
+// hasGuards reports whether any module is extended below the bars
+func hasGuards(guards []bool) bool {
+	for _, g := range guards {
+		if g {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	monoFont     *opentype.Font
+	monoFontOnce sync.Once
+)
+
+// textFace returns a monospaced face whose digits are about px pixels tall
+func textFace(px float64) font.Face {
+	monoFontOnce.Do(func() {
+		monoFont, _ = opentype.Parse(gomono.TTF)
+	})
+	// Digits are about 0.7 em tall
+	face, err := opentype.NewFace(monoFont, &opentype.FaceOptions{Size: px / 0.7, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil
+	}
+	return face
+}
+
+// sizeOf returns the pixel and printed size of an image
+func sizeOf(img image.Image, dpi int) Size {
+	b := img.Bounds()
+	size := Size{Width: b.Dx(), Height: b.Dy()}
+	if dpi > 0 {
+		size.DPI = dpi
+		size.WidthMM = math.Round(float64(b.Dx())*25.4/float64(dpi)*10) / 10
+		size.HeightMM = math.Round(float64(b.Dy())*25.4/float64(dpi)*10) / 10
+	}
+	return size
+}
+
+// encodePNG encodes img as PNG, recording the print resolution in a pHYs
+// chunk when dpi is set
+func encodePNG(img image.Image, dpi int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	data := buf.Bytes()
+	if dpi <= 0 {
+		return data, nil
+	}
+
+	// The pHYs chunk must precede the image data; insert it right after
+	// the 8-byte signature and the 25-byte IHDR chunk
+	const ihdrEnd = 8 + 25
+	ppm := uint32(math.Round(float64(dpi) / 0.0254))
+	chunk := make([]byte, 4+4+9+4)
+	binary.BigEndian.PutUint32(chunk[0:], 9)
+	copy(chunk[4:], "pHYs")
+	binary.BigEndian.PutUint32(chunk[8:], ppm)
+	binary.BigEndian.PutUint32(chunk[12:], ppm)
+	chunk[16] = 1 // Unit: metre
+	binary.BigEndian.PutUint32(chunk[17:], crc32.ChecksumIEEE(chunk[4:17]))
+
+	out := make([]byte, 0, len(data)+len(chunk))
+	out = append(out, data[:ihdrEnd]...)
+	out = append(out, chunk...)
+	out = append(out, data[ihdrEnd:]...)
+	return out, nil
+}
@@ -0,0 +1,90 @@
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Left-hand digit patterns of EAN/UPC; UPC-E uses odd (L) and even (G)
+// parity, selected per digit by the number system and check digit
+var (
+	upcOdd  = [10]string{"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011"}
+	upcEven = [10]string{"0100111", "0110011", "0011011", "0100001", "0011101", "0111001", "0000101", "0010001", "0001001", "0010111"}
+	// upcEParity lists the parity of the six digits for number system 0 by
+	// check digit; number system 1 uses the inverse
+	upcEParity = [10]string{"EEEOOO", "EEOEOO", "EEOOEO", "EEOOOE", "EOEEOO", "EOOEEO", "EOOOEE", "EOEOEO", "EOEOOE", "EOOEOE"}
+)
+
+// encodeUPCE encodes a zero-suppressed UPC-E code given as 6 digits
+// (number system 0), 7 digits (number system and digits) or 8 digits
+// (including the check digit)
+func encodeUPCE(data string) (*Linear, error) {
+	for _, r := range data {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("%w: only digits are allowed", ErrInvalidData)
+		}
+	}
+	switch len(data) {
+	case 6:
+		data = "0" + data
+	case 7, 8:
+	default:
+		return nil, fmt.Errorf("%w: expected 6, 7 or 8 digits", ErrInvalidData)
+	}
+	if data[0] != '0' && data[0] != '1' {
+		return nil, fmt.Errorf("%w: UPC-E number system must be 0 or 1", ErrInvalidData)
+	}
+
+	check := checkDigit(ExpandUPCE(data[:7]))
+	if len(data) == 8 && int(data[7]-'0') != check {
+		return nil, fmt.Errorf("%w: expected %d", ErrCheckDigit, check)
+	}
+	data = data[:7] + string(rune('0'+check))
+
+	parity := upcEParity[check]
+	var bits strings.Builder
+	bits.WriteString("101")
+	for i, r := range data[1:7] {
+		even := parity[i] == 'E'
+		if data[0] == '1' {
+			even = !even
+		}
+		if even {
+			bits.WriteString(upcEven[r-'0'])
+		} else {
+			bits.WriteString(upcOdd[r-'0'])
+		}
+	}
+	bits.WriteString("010101")
+
+	bars := make([]bool, bits.Len())
+	for i, b := range bits.String() {
+		bars[i] = b == '1'
+	}
+	l := &Linear{Symbology: UPCE, Data: data, Modules: bars, Guards: make([]bool, len(bars))}
+	for _, i := range []int{0, 1, 2, 45, 46, 47, 48, 49, 50} {
+		l.Guards[i] = bars[i]
+	}
+	l.text = []textSegment{
+		{data[:1], -8, -1},
+		{data[1:7], 3, 45},
+		{data[7:], 52, 59},
+	}
+	return l, nil
+}
+
+// ExpandUPCE returns the 11-digit UPC-A equivalent, without check digit, of
+// a number system digit followed by six UPC-E digits
+func ExpandUPCE(code string) string {
+	ns, d := code[:1], code[1:7]
+	switch d[5] {
+	case '0', '1', '2':
+		return ns + d[0:2] + d[5:6] + "0000" + d[2:5]
+	case '3':
+		return ns + d[0:3] + "00000" + d[3:5]
+	case '4':
+		return ns + d[0:4] + "00000" + d[4:5]
+	default:
+		return ns + d[0:5] + "0000" + d[5:6]
+	}
+}
@@ -0,0 +1,108 @@
+package gs1
+
+// AI describes the format of a GS1 application identifier
+type AI struct {
+	Code  string // e.g. "01", "10" or "3103"
+	Title string // Data title from the GS1 General Specifications, e.g. "GTIN"
+	Min   int    // Minimum value length
+	Max   int    // Maximum value length
+	// Charset is the character set of the value: 'N' digits, 'X' GS1 AI
+	// encodable character set 82, 'Y' character set 39
+	Charset byte
+	// CheckDigit is the length of the value's leading digits that end in a
+	// GS1 check digit, or 0 when the value has none
+	CheckDigit int
+	Date       bool // The value is a YYMMDD date; a day of 00 means the end of the month
+	Digits     int  // Length of a leading numeric part of an otherwise Charset value
+}
+
+// ais lists the supported application identifiers
+var ais = map[string]AI{
+	"00":   {Title: "SSCC", Min: 18, Max: 18, Charset: 'N', CheckDigit: 18},
+	"01":   {Title: "GTIN", Min: 14, Max: 14, Charset: 'N', CheckDigit: 14},
+	"02":   {Title: "CONTENT", Min: 14, Max: 14, Charset: 'N', CheckDigit: 14},
+	"10":   {Title: "BATCH/LOT", Min: 1, Max: 20, Charset: 'X'},
+	"11":   {Title: "PROD DATE", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"12":   {Title: "DUE DATE", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"13":   {Title: "PACK DATE", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"15":   {Title: "BEST BEFORE or BEST BY", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"16":   {Title: "SELL BY", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"17":   {Title: "USE BY or EXPIRY", Min: 6, Max: 6, Charset: 'N', Date: true},
+	"20":   {Title: "VARIANT", Min: 2, Max: 2, Charset: 'N'},
+	"21":   {Title: "SERIAL", Min: 1, Max: 20, Charset: 'X'},
+	"22":   {Title: "CPV", Min: 1, Max: 20, Charset: 'X'},
+	"235":  {Title: "TPX", Min: 1, Max: 28, Charset: 'X'},
+	"240":  {Title: "ADDITIONAL ID", Min: 1, Max: 30, Charset: 'X'},
+	"241":  {Title: "CUST. PART No.", Min: 1, Max: 30, Charset: 'X'},
+	"242":  {Title: "MTO VARIANT", Min: 1, Max: 6, Charset: 'N'},
+	"250":  {Title: "SECONDARY SERIAL", Min: 1, Max: 30, Charset: 'X'},
+	"251":  {Title: "REF. TO SOURCE", Min: 1, Max: 30, Charset: 'X'},
+	"253":  {Title: "GDTI", Min: 13, Max: 30, Charset: 'X', CheckDigit: 13, Digits: 13},
+	"254":  {Title: "GLN EXTENSION COMPONENT", Min: 1, Max: 20, Charset: 'X'},
+	"255":  {Title: "GCN", Min: 13, Max: 25, Charset: 'N', CheckDigit: 13},
+	"30":   {Title: "VAR. COUNT", Min: 1, Max: 8, Charset: 'N'},
+	"37":   {Title: "COUNT", Min: 1, Max: 8, Charset: 'N'},
+	"400":  {Title: "ORDER NUMBER", Min: 1, Max: 30, Charset: 'X'},
+	"401":  {Title: "GINC", Min: 1, Max: 30, Charset: 'X'},
+	"402":  {Title: "GSIN", Min: 17, Max: 17, Charset: 'N', CheckDigit: 17},
+	"410":  {Title: "SHIP TO LOC", Min: 13, Max: 13, Charset: 'N', CheckDigit: 13},
+	"414":  {Title: "LOC No.", Min: 13, Max: 13, Charset: 'N', CheckDigit: 13},
+	"415":  {Title: "PAY TO", Min: 13, Max: 13, Charset: 'N', CheckDigit: 13},
+	"416":  {Title: "PROD/SERV LOC", Min: 13, Max: 13, Charset: 'N', CheckDigit: 13},
+	"417":  {Title: "PARTY", Min: 13, Max: 13, Charset: 'N', CheckDigit: 13},
+	"420":  {Title: "SHIP TO POST", Min: 1, Max: 20, Charset: 'X'},
+	"422":  {Title: "ORIGIN", Min: 3, Max: 3, Charset: 'N'},
+	"7003": {Title: "EXPIRY TIME", Min: 10, Max: 10, Charset: 'N'},
+	"7040": {Title: "UIC+EXT", Min: 4, Max: 4, Charset: 'X'},
+	"8003": {Title: "GRAI", Min: 14, Max: 30, Charset: 'X', CheckDigit: 14, Digits: 14},
+	"8004": {Title: "GIAI", Min: 1, Max: 30, Charset: 'X'},
+	"8006": {Title: "ITIP", Min: 18, Max: 18, Charset: 'N', CheckDigit: 14},
+	"8008": {Title: "PROD TIME", Min: 8, Max: 12, Charset: 'N'},
+	"8010": {Title: "CPID", Min: 1, Max: 30, Charset: 'Y'},
+	"8011": {Title: "CPID SERIAL", Min: 1, Max: 12, Charset: 'N'},
+	"8013": {Title: "GMN", Min: 1, Max: 25, Charset: 'X'},
+	"8017": {Title: "GSRN - PROVIDER", Min: 18, Max: 18, Charset: 'N', CheckDigit: 18},
+	"8018": {Title: "GSRN - RECIPIENT", Min: 18, Max: 18, Charset: 'N', CheckDigit: 18},
+	"8019": {Title: "SRIN", Min: 1, Max: 10, Charset: 'N'},
+	"8020": {Title: "REF No.", Min: 1, Max: 25, Charset: 'X'},
+	"90":   {Title: "INTERNAL", Min: 1, Max: 30, Charset: 'X'},
+}
+
+// measures lists the AI families whose fourth digit gives the position of
+// the decimal point, such as 3103 for a net weight in kg with three decimals
+var measures = map[string]AI{
+	"310": {Title: "NET WEIGHT (kg)", Min: 6, Max: 6, Charset: 'N'},
+	"311": {Title: "LENGTH (m)", Min: 6, Max: 6, Charset: 'N'},
+	"320": {Title: "NET WEIGHT (lb)", Min: 6, Max: 6, Charset: 'N'},
+	"330": {Title: "GROSS WEIGHT (kg)", Min: 6, Max: 6, Charset: 'N'},
+	"392": {Title: "PRICE", Min: 1, Max: 15, Charset: 'N'},
+}
+
+// predefinedLength lists the two-digit AI prefixes whose values have a
+// predefined length and so need no FNC1 separator in barcodes. The list is
+// fixed by the GS1 General Specifications and does not follow the AI table.
+var predefinedLength = map[string]bool{
+	"00": true, "01": true, "02": true, "03": true, "04": true,
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true, "20": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "41": true,
+}
+
+// Lookup returns the format of an application identifier
+func Lookup(code string) (AI, bool) {
+	ai, ok := ais[code]
+	if !ok && len(code) == 4 && code[3] >= '0' && code[3] <= '9' {
+		ai, ok = measures[code[:3]]
+		ok = ok && (code[3] <= '5' || code[:3] == "392")
+	}
+	if !ok && len(code) == 2 && code >= "91" && code <= "99" {
+		ai, ok = AI{Title: "INTERNAL", Min: 1, Max: 90, Charset: 'X'}, true
+	}
+	ai.Code = code
+	return ai, ok
+}
+
+// PredefinedLength reports whether the values of an application identifier
+// have a predefined length, so that no FNC1 separator follows them
+func PredefinedLength(code string) bool {
+	return len(code) >= 2 && predefinedLength[code[:2]]
+}
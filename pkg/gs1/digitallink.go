@@ -0,0 +1,205 @@
+package gs1
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DefaultResolver is the GS1 global resolver, used by Digital Link URIs
+// without a domain of their own
+const DefaultResolver = "https://id.gs1.org"
+
+// primaryKeys lists the AIs that identify the subject of a Digital Link URI,
+// with the key qualifiers that may follow them in the path, in order
+var primaryKeys = map[string][]string{
+	"00":   nil,
+	"01":   {"22", "10", "21"},
+	"253":  nil,
+	"255":  nil,
+	"401":  nil,
+	"402":  nil,
+	"414":  {"254", "7040"},
+	"415":  {"8020"},
+	"417":  {"7040"},
+	"8003": nil,
+	"8004": {"7040"},
+	"8006": {"22", "10", "21"},
+	"8010": {"8011"},
+	"8013": {"21"},
+	"8017": {"8019"},
+	"8018": {"8019"},
+}
+
+// PrimaryKeys returns the AIs that may start a Digital Link URI path
+func PrimaryKeys() []string {
+	keys := make([]string, 0, len(primaryKeys))
+	for ai := range primaryKeys {
+		keys = append(keys, ai)
+	}
+	return keys
+}
+
+// DigitalLink builds the GS1 Digital Link URI of an element string on the
+// domain of base, such as "https://example.com" or DefaultResolver. The
+// element string must hold exactly one primary key; its qualifiers go in
+// the path and all other elements in the query.
+func DigitalLink(base string, e ElementString) (string, error) {
+	if err := e.Validate(); err != nil {
+		return "", err
+	}
+	key := ""
+	for _, el := range e {
+		if _, ok := primaryKeys[el.AI]; ok {
+			if key != "" {
+				return "", fmt.Errorf("%w: a Digital Link has one primary key, found (%s) and (%s)", ErrInvalid, key, el.AI)
+			}
+			key = el.AI
+		}
+	}
+	if key == "" {
+		return "", fmt.Errorf("%w: a Digital Link needs a primary key such as a GTIN (01)", ErrInvalid)
+	}
+
+	if base == "" {
+		base = DefaultResolver
+	}
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(base, "/"))
+	b.WriteString(Path(e))
+
+	// Other elements are data attributes
+	query := ""
+	for _, el := range e {
+		if el.AI == key || qualifies(key, el.AI) {
+			continue
+		}
+		query += "&" + el.AI + "=" + url.QueryEscape(el.Value)
+	}
+	if query != "" {
+		b.WriteString("?" + query[1:])
+	}
+	return b.String(), nil
+}
+
+// Path returns the primary key and qualifier segments of the Digital Link
+// URI of an element string, such as "/01/09501101530003/10/AB12", or "" if
+// it has no primary key. Qualifiers are written in their defined order.
+func Path(e ElementString) string {
+	for _, el := range e {
+		qualifiers, ok := primaryKeys[el.AI]
+		if !ok {
+			continue
+		}
+		path := "/" + el.AI + "/" + url.PathEscape(el.Value)
+		for _, q := range qualifiers {
+			if v := e.Get(q); v != "" {
+				path += "/" + q + "/" + url.PathEscape(v)
+			}
+		}
+		return path
+	}
+	return ""
+}
+
+// Keys returns the paths a Digital Link can be resolved by, from the most
+// specific to the primary key alone: "/01/x/10/y/21/z", "/01/x/10/y",
+// "/01/x"
+func Keys(e ElementString) []string {
+	path := Path(e)
+	if path == "" {
+		return nil
+	}
+	segments := strings.Split(path[1:], "/")
+	var keys []string
+	for n := len(segments); n >= 2; n -= 2 {
+		keys = append(keys, "/"+strings.Join(segments[:n], "/"))
+	}
+	return keys
+}
+
+// ParseDigitalLink reads the element string of a GS1 Digital Link URI or
+// of its path and query alone. The path may start with any prefix before
+// the primary key; query parameters that are not AIs, such as linkType,
+// are ignored.
+func ParseDigitalLink(uri string) (ElementString, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+
+	var e ElementString
+	for start := 0; start < len(segments) && e == nil; start++ {
+		e = parsePath(segments[start:])
+	}
+	if e == nil {
+		return nil, fmt.Errorf("%w: no GS1 primary key in the URI path", ErrInvalid)
+	}
+
+	query := u.Query()
+	ais := make([]string, 0, len(query))
+	for ai := range query {
+		if _, ok := Lookup(ai); ok {
+			ais = append(ais, ai)
+		}
+	}
+	sort.Strings(ais)
+	for _, ai := range ais {
+		values := query[ai]
+		if _, ok := primaryKeys[ai]; ok || e.Get(ai) != "" {
+			return nil, fmt.Errorf("%w: AI (%s) in the query repeats or qualifies the path", ErrInvalid, ai)
+		}
+		e = append(e, Element{AI: ai, Value: values[0]})
+	}
+	return e, e.Validate()
+}
+
+// parsePath reads a primary key and its qualifiers from path segments, or
+// returns nil if the segments are not one
+func parsePath(segments []string) ElementString {
+	if len(segments) < 2 || len(segments)%2 != 0 {
+		return nil
+	}
+	qualifiers, ok := primaryKeys[segments[0]]
+	if !ok {
+		return nil
+	}
+	var e ElementString
+	next := 0
+	for i := 0; i < len(segments); i += 2 {
+		value, err := url.PathUnescape(segments[i+1])
+		if err != nil || value == "" {
+			return nil
+		}
+		if i == 0 && segments[0] == "01" && len(value) < 14 {
+			// GTIN-8, -12 and -13 are padded to the 14 digits of AI (01)
+			if gtin, err := NormalizeGTIN(value); err == nil {
+				value = gtin
+			}
+		}
+		if i > 0 {
+			// Qualifiers may be skipped but not reordered
+			for next < len(qualifiers) && qualifiers[next] != segments[i] {
+				next++
+			}
+			if next == len(qualifiers) {
+				return nil
+			}
+			next++
+		}
+		e = append(e, Element{AI: segments[i], Value: value})
+	}
+	return e
+}
+
+// qualifies reports whether ai is a key qualifier of the primary key
+func qualifies(key, ai string) bool {
+	for _, q := range primaryKeys[key] {
+		if q == ai {
+			return true
+		}
+	}
+	return false
+}
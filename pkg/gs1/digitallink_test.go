@@ -0,0 +1,128 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDigitalLinkRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		elements string
+		base     string
+		uri      string
+	}{
+		{"gtin", "(01)09501101530003", "", "https://id.gs1.org/01/09501101530003"},
+		{"qualifiers in order", "(01)09501101530003(21)X1(10)AB12", "https://example.com/", "https://example.com/01/09501101530003/10/AB12/21/X1"},
+		{"data attributes", "(01)09501101530003(10)AB12(17)251231(3103)001250", "https://example.com",
+			"https://example.com/01/09501101530003/10/AB12?17=251231&3103=001250"},
+		{"escaped", "(01)09501101530003(21)A/B%1", "https://example.com", "https://example.com/01/09501101530003/21/A%2FB%251"},
+		{"sscc", "(00)106141412345678908", "https://example.com", "https://example.com/00/106141412345678908"},
+		{"gln extension", "(414)9521321000018(254)32a", "https://example.com", "https://example.com/414/9521321000018/254/32a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.elements)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uri, err := DigitalLink(tt.base, e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if uri != tt.uri {
+				t.Errorf("DigitalLink = %q, want %q", uri, tt.uri)
+			}
+
+			back, err := ParseDigitalLink(uri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Qualifiers come back in path order and attributes sorted
+			if got, want := sorted(back), sorted(e); !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseDigitalLink(%q) = %v, want %v", uri, back, e)
+			}
+		})
+	}
+}
+
+// sorted returns the elements keyed by AI
+func sorted(e ElementString) map[string]string {
+	m := map[string]string{}
+	for _, el := range e {
+		m[el.AI] = el.Value
+	}
+	return m
+}
+
+func TestParseDigitalLink(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want ElementString
+	}{
+		// Short GTINs are padded to 14 digits
+		{"https://example.com/01/4006381333931", ElementString{{"01", "04006381333931"}}},
+		// A path prefix before the primary key and non-AI parameters are ignored
+		{"https://example.com/products/gs1/01/09501101530003/10/AB12?linkType=gs1:pip&17=251231",
+			ElementString{{"01", "09501101530003"}, {"10", "AB12"}, {"17", "251231"}}},
+		{"/01/09501101530003/21/X1", ElementString{{"01", "09501101530003"}, {"21", "X1"}}},
+	}
+	for _, tt := range tests {
+		got, err := ParseDigitalLink(tt.uri)
+		if err != nil {
+			t.Errorf("ParseDigitalLink(%q): %v", tt.uri, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseDigitalLink(%q) = %v, want %v", tt.uri, got, tt.want)
+		}
+	}
+
+	for _, uri := range []string{
+		"https://example.com/",
+		"https://example.com/01/09501101530004",               // Check digit
+		"https://example.com/01/09501101530003/21",            // Missing value
+		"https://example.com/01/09501101530003/21/X1/10/AB12", // Qualifiers out of order
+		"https://example.com/01/09501101530003?10=AB12&21=X1&01=09501101530003",
+		"https://example.com/01/09501101530003/10/AB12?10=CD34",
+		"https://example.com/01/09501101530003?17=251301",
+	} {
+		if _, err := ParseDigitalLink(uri); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseDigitalLink(%q): err = %v, want ErrInvalid", uri, err)
+		}
+	}
+}
+
+func TestDigitalLinkErrors(t *testing.T) {
+	for _, s := range []string{
+		"(10)AB12", // No primary key
+		"(01)09501101530003(00)106141412345678908", // Two primary keys
+	} {
+		e, err := Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := DigitalLink("", e); !errors.Is(err, ErrInvalid) {
+			t.Errorf("DigitalLink(%s): err = %v, want ErrInvalid", s, err)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	e, err := Parse("(01)09501101530003(10)AB12(21)X1(17)251231")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"/01/09501101530003/10/AB12/21/X1",
+		"/01/09501101530003/10/AB12",
+		"/01/09501101530003",
+	}
+	if got := Keys(e); !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys = %v, want %v", got, want)
+	}
+	if got := Keys(ElementString{{"10", "AB12"}}); got != nil {
+		t.Errorf("Keys without a primary key = %v, want nil", got)
+	}
+}
@@ -0,0 +1,273 @@
+// Package gs1 parses and validates GS1 element strings, the application
+// identifier (AI) and value pairs carried by GS1 barcodes, and converts them
+// to and from GS1 Digital Link URIs.
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid is returned for element strings, values and URIs that do not
+// follow the GS1 General Specifications
+var ErrInvalid = errors.New("invalid GS1 data")
+
+// GroupSeparator separates variable-length values in unbracketed element
+// strings, as transmitted by scanners in place of FNC1
+const GroupSeparator = '\x1d'
+
+// Element is an application identifier and its value
+type Element struct {
+	AI    string `json:"ai"`
+	Value string `json:"value"`
+}
+
+// ElementString is a sequence of elements in the order they are encoded
+type ElementString []Element
+
+// Parse reads an element string in its human readable form, such as
+// "(01)09501101530003(10)AB12", or as transmitted by a scanner: AIs
+// followed by their values, variable-length values ended by a group
+// separator, optionally after a symbology identifier such as "]d2". The
+// elements are validated.
+func Parse(s string) (ElementString, error) {
+	s = strings.TrimSpace(s)
+	var e ElementString
+	var err error
+	if strings.HasPrefix(s, "(") {
+		e, err = parseBracketed(s)
+	} else {
+		e, err = parseRaw(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, e.Validate()
+}
+
+func parseBracketed(s string) (ElementString, error) {
+	var e ElementString
+	for s != "" {
+		end := strings.IndexByte(s, ')')
+		if s[0] != '(' || end < 0 {
+			return nil, fmt.Errorf("%w: element strings must be written as (AI)value", ErrInvalid)
+		}
+		ai := s[1:end]
+		s = s[end+1:]
+		value := s
+		if next := strings.IndexByte(s, '('); next >= 0 {
+			value = s[:next]
+		}
+		s = s[len(value):]
+		e = append(e, Element{AI: ai, Value: value})
+	}
+	return e, nil
+}
+
+func parseRaw(s string) (ElementString, error) {
+	for _, id := range []string{"]C1", "]d2", "]Q3", "]e0", "]J1"} {
+		s = strings.TrimPrefix(s, id)
+	}
+	s = strings.TrimPrefix(s, string(GroupSeparator))
+	var e ElementString
+	for s != "" {
+		// AIs are prefix-free, so the first known prefix is the AI
+		var ai AI
+		ok := false
+		for n := 2; n <= 4 && n <= len(s) && !ok; n++ {
+			ai, ok = Lookup(s[:n])
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown application identifier at %q", ErrInvalid, s)
+		}
+		s = s[len(ai.Code):]
+		n := strings.IndexByte(s, GroupSeparator)
+		if n < 0 {
+			n = len(s)
+		}
+		if PredefinedLength(ai.Code) && ai.Max < n {
+			n = ai.Max
+		}
+		e = append(e, Element{AI: ai.Code, Value: s[:n]})
+		s = strings.TrimPrefix(s[n:], string(GroupSeparator))
+	}
+	return e, nil
+}
+
+// Validate checks that every AI is known and used once, and that each value
+// has the AI's length, character set, check digit and date format
+func (e ElementString) Validate() error {
+	if len(e) == 0 {
+		return fmt.Errorf("%w: no elements", ErrInvalid)
+	}
+	seen := map[string]bool{}
+	for _, el := range e {
+		if seen[el.AI] {
+			return fmt.Errorf("%w: AI (%s) appears more than once", ErrInvalid, el.AI)
+		}
+		seen[el.AI] = true
+		if err := ValidateValue(el.AI, el.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateValue checks a value against the format of its AI
+func ValidateValue(code, value string) error {
+	ai, ok := Lookup(code)
+	if !ok {
+		return fmt.Errorf("%w: unknown application identifier (%s)", ErrInvalid, code)
+	}
+	if len(value) < ai.Min || len(value) > ai.Max {
+		if ai.Min == ai.Max {
+			return fmt.Errorf("%w: %s (%s) must be %d characters", ErrInvalid, ai.Title, code, ai.Min)
+		}
+		return fmt.Errorf("%w: %s (%s) must be %d to %d characters", ErrInvalid, ai.Title, code, ai.Min, ai.Max)
+	}
+	if !digits(value[:ai.Digits]) || !inCharset(value[ai.Digits:], ai.Charset) {
+		return fmt.Errorf("%w: invalid character in %s (%s)", ErrInvalid, ai.Title, code)
+	}
+	if ai.CheckDigit > 0 && !ValidCheckDigit(value[:ai.CheckDigit]) {
+		return fmt.Errorf("%w: wrong check digit in %s (%s)", ErrInvalid, ai.Title, code)
+	}
+	if ai.Date {
+		if _, err := ParseDate(value); err != nil {
+			return fmt.Errorf("%w: %s (%s) is not a YYMMDD date", ErrInvalid, ai.Title, code)
+		}
+	}
+	return nil
+}
+
+// String returns the human readable form, with AIs in parentheses
+func (e ElementString) String() string {
+	var b strings.Builder
+	for _, el := range e {
+		b.WriteString("(" + el.AI + ")" + el.Value)
+	}
+	return b.String()
+}
+
+// Get returns the value of an AI, or "" when the element string lacks it
+func (e ElementString) Get(ai string) string {
+	for _, el := range e {
+		if el.AI == ai {
+			return el.Value
+		}
+	}
+	return ""
+}
+
+// Set replaces the value of an AI, or appends the element
+func (e *ElementString) Set(ai, value string) {
+	for i, el := range *e {
+		if el.AI == ai {
+			(*e)[i].Value = value
+			return
+		}
+	}
+	*e = append(*e, Element{AI: ai, Value: value})
+}
+
+// CheckDigit computes the GS1 modulo 10 check digit of digits, which is
+// everything but the check digit
+func CheckDigit(digits string) int {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+// ValidCheckDigit reports whether the last digit of s is the check digit
+// of the others
+func ValidCheckDigit(s string) bool {
+	if len(s) < 2 || !digits(s) {
+		return false
+	}
+	return CheckDigit(s[:len(s)-1]) == int(s[len(s)-1]-'0')
+}
+
+// NormalizeGTIN checks a GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or
+// GTIN-14 and returns it as the 14 digits of AI (01)
+func NormalizeGTIN(gtin string) (string, error) {
+	gtin = strings.ReplaceAll(strings.TrimSpace(gtin), " ", "")
+	switch len(gtin) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("%w: a GTIN has 8, 12, 13 or 14 digits", ErrInvalid)
+	}
+	if !ValidCheckDigit(gtin) {
+		return "", fmt.Errorf("%w: GTIN %s has a wrong check digit", ErrInvalid, gtin)
+	}
+	return strings.Repeat("0", 14-len(gtin)) + gtin, nil
+}
+
+// ParseDate reads a YYMMDD date. Years are placed within 49 years before
+// and 50 years after the current year; a day of 00 means the last day of
+// the month.
+func ParseDate(value string) (time.Time, error) {
+	if len(value) != 6 || !digits(value) {
+		return time.Time{}, fmt.Errorf("%w: date must be YYMMDD", ErrInvalid)
+	}
+	yy, _ := strconv.Atoi(value[:2])
+	month, _ := strconv.Atoi(value[2:4])
+	day, _ := strconv.Atoi(value[4:])
+
+	current := time.Now().Year()
+	year := current - current%100 + yy
+	switch diff := yy - current%100; {
+	case diff >= 51:
+		year -= 100
+	case diff <= -50:
+		year += 100
+	}
+
+	if month < 1 || month > 12 {
+		return time.Time{}, fmt.Errorf("%w: invalid month in date %s", ErrInvalid, value)
+	}
+	last := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day == 0 {
+		day = last
+	}
+	if day > last {
+		return time.Time{}, fmt.Errorf("%w: invalid day in date %s", ErrInvalid, value)
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+}
+
+func digits(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// cset82 lists the punctuation of the GS1 AI encodable character set 82
+const cset82 = "!\"%&'()*+,-./:;<=>?_"
+
+func inCharset(s string, charset byte) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		alnum := c >= '0' && c <= '9' || c >= 'A' && c <= 'Z'
+		switch charset {
+		case 'N':
+			if c < '0' || c > '9' {
+				return false
+			}
+		case 'Y':
+			if !alnum && c != '#' && c != '-' && c != '/' {
+				return false
+			}
+		default:
+			if !alnum && !(c >= 'a' && c <= 'z') && strings.IndexByte(cset82, c) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
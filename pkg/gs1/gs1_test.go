@@ -0,0 +1,137 @@
+package gs1
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   int
+	}{
+		{"9638507", 4},           // GTIN-8
+		{"03600029145", 2},       // GTIN-12
+		{"400638133393", 1},      // GTIN-13
+		{"0950110153000", 3},     // GTIN-14
+		{"10614141234567890", 8}, // SSCC
+		{"0000000", 0},
+	}
+	for _, tt := range tests {
+		if got := CheckDigit(tt.digits); got != tt.want {
+			t.Errorf("CheckDigit(%q) = %d, want %d", tt.digits, got, tt.want)
+		}
+	}
+
+	for s, want := range map[string]bool{"96385074": true, "96385075": false, "4": false, "": false, "9638507A": false} {
+		if got := ValidCheckDigit(s); got != want {
+			t.Errorf("ValidCheckDigit(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestNormalizeGTIN(t *testing.T) {
+	tests := []struct {
+		gtin string
+		want string
+		ok   bool
+	}{
+		{"96385074", "00000096385074", true},
+		{"96385075", "", false},
+		{"036000291452", "00036000291452", true},
+		{"036000291453", "", false},
+		{"4006381333931", "04006381333931", true},
+		{"4006381333930", "", false},
+		{"09501101530003", "09501101530003", true},
+		{"09501101530004", "", false},
+		{" 4006381 333931 ", "04006381333931", true},
+		{"963850740", "", false},
+		{"0950110153000A", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeGTIN(tt.gtin)
+		if tt.ok != (err == nil) || got != tt.want {
+			t.Errorf("NormalizeGTIN(%q) = %q, %v; want %q", tt.gtin, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, ErrInvalid) {
+			t.Errorf("NormalizeGTIN(%q): err = %v, want ErrInvalid", tt.gtin, err)
+		}
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	tests := []struct {
+		ai, value string
+		ok        bool
+	}{
+		{"01", "09501101530003", true},
+		{"01", "09501101530004", false}, // Check digit
+		{"01", "9501101530003", false},  // Length
+		{"01", "0950110153000A", false}, // Charset
+		{"00", "106141412345678908", true},
+		{"00", "106141412345678907", false},
+		{"10", "AB-12/x", true},
+		{"10", "", false},
+		{"10", "123456789012345678901", false},
+		{"10", "AB 12", false}, // Space is not in character set 82
+		{"10", "AB#12", false},
+		{"8010", "AB#12-3/4", true},
+		{"8010", "ab", false}, // Character set 39 has no lower case
+		{"253", "4712345000015ABC", true},
+		{"253", "4712345000016ABC", false},
+		{"253", "471234500001AABC", false}, // The leading 13 characters are digits
+		{"17", "251231", true},
+		{"17", "250200", true}, // Day 00 is the end of the month
+		{"17", "250230", false},
+		{"17", "251301", false},
+		{"3103", "001250", true},
+		{"3106", "001250", false}, // Weights have up to five decimals
+		{"3929", "1999", true},
+		{"99", "anything", true},
+		{"23", "1", false}, // Unknown AI
+	}
+	for _, tt := range tests {
+		err := ValidateValue(tt.ai, tt.value)
+		if tt.ok != (err == nil) {
+			t.Errorf("ValidateValue(%q, %q) = %v, want ok %v", tt.ai, tt.value, err, tt.ok)
+		}
+		if err != nil && !errors.Is(err, ErrInvalid) {
+			t.Errorf("ValidateValue(%q, %q): err = %v, want ErrInvalid", tt.ai, tt.value, err)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	want := ElementString{{"01", "09501101530003"}, {"17", "251231"}, {"10", "AB12"}, {"21", "X1"}}
+	for _, s := range []string{
+		"(01)09501101530003(17)251231(10)AB12(21)X1",
+		"01095011015300031725123110AB12\x1d21X1",
+		"]d201095011015300031725123110AB12\x1d21X1",
+	} {
+		got, err := Parse(s)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", s, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Parse(%q) = %v, want %v", s, got, want)
+		}
+	}
+	if got := want.String(); got != "(01)09501101530003(17)251231(10)AB12(21)X1" {
+		t.Errorf("String = %q", got)
+	}
+
+	for _, s := range []string{
+		"",
+		"01)09501101530003",
+		"(01)09501101530003(01)09501101530003",
+		"(01)09501101530004",
+		"2309501101530003",
+	} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q): err = %v, want ErrInvalid", s, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package imageproc
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// encoders are the command line tools used for formats the Go standard
+// library cannot encode
+var encoders = map[string]string{
+	FormatWebP: "cwebp",
+	FormatAVIF: "avifenc",
+}
+
+// Available reports whether the encoder for a format is installed
+func Available(format string) bool {
+	tool, ok := encoders[format]
+	if !ok {
+		return false
+	}
+	_, err := exec.LookPath(tool)
+	return err == nil
+}
+
+// encodeExternal encodes img by writing it as a lossless PNG and running
+// the format's encoder on it
+func encodeExternal(img image.Image, format string, quality int) ([]byte, error) {
+	tool, ok := encoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+
+	dir, err := os.MkdirTemp("", "imageproc-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	in := filepath.Join(dir, "in.png")
+	out := filepath.Join(dir, "out."+format)
+	encoded, err := encode(img, FormatPNG, quality)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(in, encoded, 0600); err != nil {
+		return nil, err
+	}
+
+	var args []string
+	switch format {
+	case FormatWebP:
+		args = []string{"-quiet", "-metadata", "none", "-q", strconv.Itoa(quality), in, "-o", out}
+	case FormatAVIF:
+		args = []string{"-q", strconv.Itoa(quality), in, out}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if output, err := exec.CommandContext(ctx, tool, args...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", tool, err, output)
+	}
+	return os.ReadFile(out)
+}
@@ -0,0 +1,227 @@
+package imageproc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+)
+
+// Output formats
+const (
+	FormatJPEG = "jpg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+	FormatWebP = "webp"
+	FormatAVIF = "avif"
+)
+
+// Original is the name of the full-size variant
+const Original = "original"
+
+// ErrTooManyPixels is returned for images whose decoded size exceeds
+// Options.MaxPixels
+var ErrTooManyPixels = errors.New("image dimensions exceed the allowed maximum")
+
+// Size is a named variant width
+type Size struct {
+	Name  string
+	Width int
+}
+
+// Options configures image processing
+type Options struct {
+	Sizes        []Size // Downscaled variants; sizes not smaller than the original are skipped
+	MaxDimension int    // Longest side of the original variant; 0 keeps the full size
+	MaxPixels    int    // Refuse images with more pixels than this; 0 means no limit
+	Quality      int    // JPEG, WebP and AVIF quality (1-100)
+	WebP         bool   // Also encode variants as WebP with the cwebp tool
+	AVIF         bool   // Also encode variants as AVIF with the avifenc tool
+}
+
+// DefaultOptions returns thumbnail and medium variants at quality 85
+func DefaultOptions() Options {
+	return Options{
+		Sizes:        []Size{{Name: "thumbnail", Width: 320}, {Name: "medium", Width: 1024}},
+		MaxDimension: 4096,
+		MaxPixels:    50_000_000,
+		Quality:      85,
+	}
+}
+
+// Variant is one encoded rendition of an image
+type Variant struct {
+	Name        string // Original or one of Options.Sizes
+	Format      string // File extension, e.g. "jpg" or "webp"
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Result holds the processed image
+type Result struct {
+	Width    int // Upright dimensions of the original variant
+	Height   int
+	Variants []Variant // The original first, then downscaled sizes, each followed by its WebP/AVIF encodings
+}
+
+// Process decodes an image, rotates it upright according to its EXIF
+// orientation and re-encodes it as the original and each smaller size.
+// Re-encoding drops all metadata, including EXIF GPS coordinates. Animated
+// GIFs are kept byte for byte as the original so the animation survives;
+// GIF files carry no EXIF data.
+func Process(data []byte, opts Options) (*Result, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if opts.MaxPixels > 0 && cfg.Width*cfg.Height > opts.MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+	if opts.Quality <= 0 || opts.Quality > 100 {
+		opts.Quality = 85
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	img := toNRGBA(decoded)
+
+	// Variants are encoded in the source format, except GIF sizes which
+	// become PNG to avoid palette quantization
+	outFormat := FormatPNG
+	if format == "jpeg" {
+		outFormat = FormatJPEG
+		img = applyOrientation(img, Orientation(data))
+	}
+
+	result := &Result{}
+	var original Variant
+	if format == "gif" {
+		original = Variant{Name: Original, Format: FormatGIF, ContentType: "image/gif", Width: cfg.Width, Height: cfg.Height, Data: data}
+	} else {
+		img = fit(img, opts.MaxDimension)
+		encoded, err := encode(img, outFormat, opts.Quality)
+		if err != nil {
+			return nil, err
+		}
+		original = Variant{Name: Original, Format: outFormat, ContentType: contentType(outFormat), Width: img.Bounds().Dx(), Height: img.Bounds().Dy(), Data: encoded}
+	}
+	result.Width, result.Height = original.Width, original.Height
+
+	if err := result.add(original, img, opts); err != nil {
+		return nil, err
+	}
+
+	for _, size := range opts.Sizes {
+		if size.Width <= 0 || size.Width >= img.Bounds().Dx() {
+			continue
+		}
+		scaled := resize(img, size.Width)
+		encoded, err := encode(scaled, outFormat, opts.Quality)
+		if err != nil {
+			return nil, err
+		}
+		v := Variant{Name: size.Name, Format: outFormat, ContentType: contentType(outFormat), Width: scaled.Bounds().Dx(), Height: scaled.Bounds().Dy(), Data: encoded}
+		if err := result.add(v, scaled, opts); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// add appends a variant followed by its optional WebP and AVIF encodings
+func (r *Result) add(v Variant, img image.Image, opts Options) error {
+	r.Variants = append(r.Variants, v)
+
+	for _, extra := range []struct {
+		enabled bool
+		format  string
+	}{{opts.WebP, FormatWebP}, {opts.AVIF, FormatAVIF}} {
+		if !extra.enabled {
+			continue
+		}
+		encoded, err := encodeExternal(img, extra.format, opts.Quality)
+		if err != nil {
+			return err
+		}
+		r.Variants = append(r.Variants, Variant{
+			Name: v.Name, Format: extra.format, ContentType: contentType(extra.format),
+			Width: v.Width, Height: v.Height, Data: encoded,
+		})
+	}
+	return nil
+}
+
+// toNRGBA copies an image into an NRGBA image with its origin at 0,0
+func toNRGBA(src image.Image) *image.NRGBA {
+	b := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+	return dst
+}
+
+// fit downscales img so its longest side is at most maxDimension
+func fit(img *image.NRGBA, maxDimension int) *image.NRGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if maxDimension <= 0 || (w <= maxDimension && h <= maxDimension) {
+		return img
+	}
+	if w >= h {
+		return resize(img, maxDimension)
+	}
+	return resize(img, max(1, w*maxDimension/h))
+}
+
+// resize scales img to the given width, keeping the aspect ratio
+func resize(img *image.NRGBA, width int) *image.NRGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	height := max(1, h*width/w)
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+	return dst
+}
+
+// encode encodes img with the standard library encoders
+func encode(img image.Image, format string, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatJPEG:
+		err = jpeg.Encode(&buf, flatten(img), &jpeg.Options{Quality: quality})
+	case FormatPNG:
+		err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, img)
+	case FormatGIF:
+		err = gif.Encode(&buf, img, nil)
+	default:
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", format, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// flatten composites an image onto white, since JPEG has no alpha channel
+func flatten(img image.Image) image.Image {
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
+
+func contentType(format string) string {
+	switch format {
+	case FormatJPEG:
+		return "image/jpeg"
+	default:
+		return "image/" + format
+	}
+}
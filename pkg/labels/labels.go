@@ -0,0 +1,308 @@
+// Package labels lays out codes on printable sheets of sticker labels and
+// writes them as a vector PDF: each label holds a QR code drawn from its
+// modules, or a raster image such as a barcode, with a title and caption
+// below it.
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidLayout is returned for layouts whose labels do not fit the page
+var ErrInvalidLayout = errors.New("invalid label layout")
+
+// Paper sizes in millimetres
+var papers = map[string][2]float64{
+	"a4":     {210, 297},
+	"letter": {215.9, 279.4},
+}
+
+// Layout positions labels in a grid on a page. Lengths are in millimetres.
+type Layout struct {
+	PageWidth, PageHeight   float64
+	Columns, Rows           int
+	LabelWidth, LabelHeight float64
+	Left, Top               float64 // Position of the top left label
+	PitchX, PitchY          float64 // Distance between the edges of neighbouring labels
+	// Bleed extends each label's background beyond its cut line; it must
+	// fit in the gaps between labels and the page margin
+	Bleed     float64
+	CropMarks bool // Draw cut lines in the page margin
+	Outline   bool // Draw the outline of each label, for test prints on plain paper
+}
+
+// templates lists label sheets by their Avery product code
+var templates = map[string]Layout{
+	// A4
+	"avery-l7160": {PageWidth: 210, PageHeight: 297, Columns: 3, Rows: 7, LabelWidth: 63.5, LabelHeight: 38.1, Left: 7.2, Top: 15.1, PitchX: 66, PitchY: 38.1},
+	"avery-l7163": {PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 7, LabelWidth: 99.1, LabelHeight: 38.1, Left: 4.6, Top: 15.1, PitchX: 101.6, PitchY: 38.1},
+	"avery-l7651": {PageWidth: 210, PageHeight: 297, Columns: 5, Rows: 13, LabelWidth: 38.1, LabelHeight: 21.2, Left: 4.7, Top: 10.7, PitchX: 40.6, PitchY: 21.2},
+	"avery-l7165": {PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 4, LabelWidth: 99.1, LabelHeight: 67.7, Left: 4.6, Top: 13.1, PitchX: 101.6, PitchY: 67.7},
+	// US Letter
+	"avery-5160": {PageWidth: 215.9, PageHeight: 279.4, Columns: 3, Rows: 10, LabelWidth: 66.675, LabelHeight: 25.4, Left: 4.763, Top: 12.7, PitchX: 69.85, PitchY: 25.4},
+	"avery-5163": {PageWidth: 215.9, PageHeight: 279.4, Columns: 2, Rows: 5, LabelWidth: 101.6, LabelHeight: 50.8, Left: 3.969, Top: 12.7, PitchX: 106.363, PitchY: 50.8},
+	"avery-5164": {PageWidth: 215.9, PageHeight: 279.4, Columns: 2, Rows: 3, LabelWidth: 101.6, LabelHeight: 84.667, Left: 3.969, Top: 12.7, PitchX: 106.363, PitchY: 84.667},
+}
+
+// Templates returns the names of the predefined label sheets
+func Templates() []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Template returns a predefined label sheet such as "avery-l7160"
+func Template(name string) (Layout, error) {
+	layout, ok := templates[strings.ToLower(name)]
+	if !ok {
+		return Layout{}, fmt.Errorf("%w: unknown template %q, use one of %s", ErrInvalidLayout, name, strings.Join(Templates(), ", "))
+	}
+	return layout, nil
+}
+
+// Grid returns a layout of rows by columns labels filling a page of the
+// given paper size ("a4" or "letter") inside the margin, with gap between
+// neighbouring labels
+func Grid(paper string, columns, rows int, margin, gap float64) (Layout, error) {
+	size, ok := papers[strings.ToLower(paper)]
+	if !ok {
+		return Layout{}, fmt.Errorf("%w: paper must be a4 or letter", ErrInvalidLayout)
+	}
+	if columns < 1 || rows < 1 {
+		return Layout{}, fmt.Errorf("%w: columns and rows must be at least 1", ErrInvalidLayout)
+	}
+	if margin < 0 || gap < 0 {
+		return Layout{}, fmt.Errorf("%w: margin and gap cannot be negative", ErrInvalidLayout)
+	}
+	width := (size[0] - 2*margin - float64(columns-1)*gap) / float64(columns)
+	height := (size[1] - 2*margin - float64(rows-1)*gap) / float64(rows)
+	return Layout{
+		PageWidth:   size[0],
+		PageHeight:  size[1],
+		Columns:     columns,
+		Rows:        rows,
+		LabelWidth:  width,
+		LabelHeight: height,
+		Left:        margin,
+		Top:         margin,
+		PitchX:      width + gap,
+		PitchY:      height + gap,
+	}, nil
+}
+
+// minLabelSize is the smallest label edge that leaves room for a code
+const minLabelSize = 10.0
+
+// Validate checks that labels are large enough, do not overlap and, with
+// their bleed, fit on the page
+func (l Layout) Validate() error {
+	if l.Columns < 1 || l.Rows < 1 {
+		return fmt.Errorf("%w: columns and rows must be at least 1", ErrInvalidLayout)
+	}
+	if l.LabelWidth < minLabelSize || l.LabelHeight < minLabelSize {
+		return fmt.Errorf("%w: labels of %.1f × %.1f mm are smaller than %.0f mm", ErrInvalidLayout, l.LabelWidth, l.LabelHeight, minLabelSize)
+	}
+	if l.Bleed < 0 || l.Bleed > 5 {
+		return fmt.Errorf("%w: bleed must be 0 to 5 mm", ErrInvalidLayout)
+	}
+	gapX, gapY := l.PitchX-l.LabelWidth, l.PitchY-l.LabelHeight
+	if (l.Columns > 1 && gapX < 2*l.Bleed-1e-6) || (l.Rows > 1 && gapY < 2*l.Bleed-1e-6) {
+		return fmt.Errorf("%w: a bleed of %.1f mm needs gaps of at least %.1f mm between labels", ErrInvalidLayout, l.Bleed, 2*l.Bleed)
+	}
+	right := l.Left + float64(l.Columns-1)*l.PitchX + l.LabelWidth
+	bottom := l.Top + float64(l.Rows-1)*l.PitchY + l.LabelHeight
+	if l.Left < l.Bleed-1e-6 || l.Top < l.Bleed-1e-6 || right+l.Bleed > l.PageWidth+1e-6 || bottom+l.Bleed > l.PageHeight+1e-6 {
+		return fmt.Errorf("%w: labels and bleed do not fit on the page", ErrInvalidLayout)
+	}
+	return nil
+}
+
+// PerPage returns the number of labels on a sheet
+func (l Layout) PerPage() int {
+	return l.Columns * l.Rows
+}
+
+// Label is the content of one label
+type Label struct {
+	Modules [][]bool    // QR code modules, dark ones true, without quiet zone
+	Image   image.Image // Raster code, such as a barcode, used when Modules is nil
+	Title   string
+	Caption string      // Second line, such as the short URL
+	Color   color.Color // Code and text colour (default black)
+	// Background fills the label and its bleed (default none, the label
+	// stock shows through)
+	Background color.Color
+}
+
+// quietZone is the margin around QR codes, in modules
+const quietZone = 4
+
+// Render writes labels onto as many sheets as needed, starting skip
+// labels into the first sheet so part-used sheets can be printed on
+func Render(layout Layout, labels []Label, skip int) ([]byte, error) {
+	if err := layout.Validate(); err != nil {
+		return nil, err
+	}
+	if skip < 0 || skip >= layout.PerPage() {
+		return nil, fmt.Errorf("%w: skip must be from 0 to %d, one less than the labels on a sheet", ErrInvalidLayout, layout.PerPage()-1)
+	}
+
+	doc := newDocument(layout.PageWidth, layout.PageHeight)
+	var page *strings.Builder
+	for i, label := range labels {
+		position := (skip + i) % layout.PerPage()
+		if page == nil || position == 0 {
+			if page != nil {
+				doc.addPage(page.String())
+			}
+			page = &strings.Builder{}
+			if layout.CropMarks {
+				cropMarks(page, layout)
+			}
+		}
+		x := layout.Left + float64(position%layout.Columns)*layout.PitchX
+		y := layout.Top + float64(position/layout.Columns)*layout.PitchY
+		drawLabel(doc, page, layout, label, x, y)
+	}
+	if page != nil {
+		doc.addPage(page.String())
+	}
+	return doc.bytes(), nil
+}
+
+// drawLabel draws a label whose top left corner is at x, y
+func drawLabel(doc *document, page *strings.Builder, l Layout, label Label, x, y float64) {
+	w, h := l.LabelWidth, l.LabelHeight
+	if label.Background != nil {
+		fmt.Fprintf(page, "%s rg %s re f\n", rgb(label.Background), doc.rect(x-l.Bleed, y-l.Bleed, w+2*l.Bleed, h+2*l.Bleed))
+	}
+	if l.Outline {
+		fmt.Fprintf(page, "0.8 G 0.2 w %s re S\n", doc.rect(x, y, w, h))
+	}
+
+	ink := label.Color
+	if ink == nil {
+		ink = color.Black
+	}
+	pad := math.Max(1, math.Min(3, math.Min(w, h)*0.05))
+
+	// Title and caption lines below the code
+	titleSize := math.Max(5, math.Min(11, h*0.09*ptPerMM))
+	captionSize := titleSize * 0.8
+	textHeight := 0.0
+	if label.Title != "" {
+		textHeight += titleSize * 1.25 / ptPerMM
+	}
+	if label.Caption != "" {
+		textHeight += captionSize * 1.25 / ptPerMM
+	}
+
+	boxW, boxH := w-2*pad, h-2*pad-textHeight
+	side := math.Min(boxW, boxH)
+	top := y + pad
+	switch {
+	case label.Modules != nil:
+		n := len(label.Modules)
+		module := side / float64(n+2*quietZone)
+		left := x + (w-side)/2 + quietZone*module
+		fmt.Fprintf(page, "%s rg\n", rgb(ink))
+		for r, row := range label.Modules {
+			for c := 0; c < len(row); {
+				if !row[c] {
+					c++
+					continue
+				}
+				run := c
+				for run < len(row) && row[run] {
+					run++
+				}
+				page.WriteString(doc.rect(left+float64(c)*module, top+(quietZone+float64(r))*module, float64(run-c)*module, module) + " re\n")
+				c = run
+			}
+		}
+		page.WriteString("f\n")
+		top += side
+	case label.Image != nil:
+		b := label.Image.Bounds()
+		scale := math.Min(boxW/float64(b.Dx()), boxH/float64(b.Dy()))
+		iw, ih := float64(b.Dx())*scale, float64(b.Dy())*scale
+		name := doc.addImage(label.Image)
+		fmt.Fprintf(page, "q %.3f 0 0 %.3f %.3f %.3f cm /%s Do Q\n", iw*ptPerMM, ih*ptPerMM, (x+(w-iw)/2)*ptPerMM, (l.PageHeight-top-ih)*ptPerMM, name)
+		top += ih
+	}
+
+	// Text is centred and shortened with an ellipsis to fit the label
+	fmt.Fprintf(page, "%s rg\n", rgb(ink))
+	for _, line := range []struct {
+		text string
+		size float64
+	}{{label.Title, titleSize}, {label.Caption, captionSize}} {
+		if line.text == "" {
+			continue
+		}
+		text := fitText(line.text, line.size, boxW*ptPerMM)
+		top += line.size * 1.25 / ptPerMM
+		tx := x + w/2 - textWidth(text, line.size)/2/ptPerMM
+		fmt.Fprintf(page, "BT /F1 %.2f Tf %.3f %.3f Td (%s) Tj ET\n", line.size, tx*ptPerMM, (l.PageHeight-top+line.size*0.25/ptPerMM)*ptPerMM, escapeText(text))
+	}
+}
+
+// cropMarks draws the cut lines of every label column and row in the page
+// margin, outside the bleed
+func cropMarks(page *strings.Builder, l Layout) {
+	const offset, length = 1.0, 5.0
+	right := l.Left + float64(l.Columns-1)*l.PitchX + l.LabelWidth
+	bottom := l.Top + float64(l.Rows-1)*l.PitchY + l.LabelHeight
+	h := l.PageHeight
+
+	var cutsX, cutsY []float64
+	for c := 0; c < l.Columns; c++ {
+		x := l.Left + float64(c)*l.PitchX
+		cutsX = append(cutsX, x, x+l.LabelWidth)
+	}
+	for r := 0; r < l.Rows; r++ {
+		y := l.Top + float64(r)*l.PitchY
+		cutsY = append(cutsY, y, y+l.LabelHeight)
+	}
+
+	page.WriteString("0 G 0.25 w\n")
+	line := func(x1, y1, x2, y2 float64) {
+		fmt.Fprintf(page, "%.3f %.3f m %.3f %.3f l S\n", x1*ptPerMM, (h-y1)*ptPerMM, x2*ptPerMM, (h-y2)*ptPerMM)
+	}
+	// Marks are shortened to the margin and left out where it is too narrow
+	if n := math.Min(length, l.Top-l.Bleed-offset); n >= 1 {
+		for _, x := range cutsX {
+			line(x, l.Top-l.Bleed-offset, x, l.Top-l.Bleed-offset-n)
+		}
+	}
+	if n := math.Min(length, h-bottom-l.Bleed-offset); n >= 1 {
+		for _, x := range cutsX {
+			line(x, bottom+l.Bleed+offset, x, bottom+l.Bleed+offset+n)
+		}
+	}
+	if n := math.Min(length, l.Left-l.Bleed-offset); n >= 1 {
+		for _, y := range cutsY {
+			line(l.Left-l.Bleed-offset, y, l.Left-l.Bleed-offset-n, y)
+		}
+	}
+	if n := math.Min(length, l.PageWidth-right-l.Bleed-offset); n >= 1 {
+		for _, y := range cutsY {
+			line(right+l.Bleed+offset, y, right+l.Bleed+offset+n, y)
+		}
+	}
+}
+
+// rgb returns the PDF colour operands of c
+func rgb(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("%.3f %.3f %.3f", float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
+}
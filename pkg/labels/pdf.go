@@ -0,0 +1,117 @@
+package labels
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/draw"
+	"sort"
+	"strings"
+)
+
+// ptPerMM converts millimetres to PDF points
+const ptPerMM = 72 / 25.4
+
+// document collects the pages and images of a PDF file. Objects 1 to 3 are
+// the catalog, page tree and font; images and pages follow.
+type document struct {
+	width, height float64 // Page size in mm
+	objects       []string
+	pages         []int
+	images        map[image.Image]int // Object numbers of embedded images
+	pageImages    map[string]int      // Image resources of the page being drawn
+}
+
+func newDocument(width, height float64) *document {
+	d := &document{width: width, height: height, images: map[image.Image]int{}, pageImages: map[string]int{}}
+	d.add("<< /Type /Catalog /Pages 2 0 R >>")
+	d.add("") // Page tree, written once the pages are known
+	d.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	return d
+}
+
+// add appends an object and returns its number
+func (d *document) add(object string) int {
+	d.objects = append(d.objects, object)
+	return len(d.objects)
+}
+
+// stream appends a compressed stream object with extra dictionary entries
+func (d *document) stream(dict string, data []byte) int {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	zw.Write(data)
+	zw.Close()
+	return d.add(fmt.Sprintf("<< %s /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream", dict, buf.Len(), buf.String()))
+}
+
+// rect returns the PDF rectangle operands of a box given in mm from the
+// top left of the page
+func (d *document) rect(x, y, w, h float64) string {
+	return fmt.Sprintf("%.3f %.3f %.3f %.3f", x*ptPerMM, (d.height-y-h)*ptPerMM, w*ptPerMM, h*ptPerMM)
+}
+
+// addPage appends a page with the given content stream
+func (d *document) addPage(content string) {
+	contents := d.stream("", []byte(content))
+	var images []string
+	for name, object := range d.pageImages {
+		images = append(images, fmt.Sprintf("/%s %d 0 R", name, object))
+	}
+	sort.Strings(images)
+	page := d.add(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.3f %.3f] /Resources << /Font << /F1 3 0 R >> /XObject << %s >> >> /Contents %d 0 R >>",
+		d.width*ptPerMM, d.height*ptPerMM, strings.Join(images, " "), contents))
+	d.pages = append(d.pages, page)
+	d.pageImages = map[string]int{}
+}
+
+// addImage embeds an image as an 8-bit RGB XObject, shown without
+// smoothing so barcode modules stay sharp, and returns its name on the
+// page being drawn. Images are embedded once however often they are drawn.
+func (d *document) addImage(img image.Image) string {
+	object, ok := d.images[img]
+	if !ok {
+		object = d.embed(img)
+		d.images[img] = object
+	}
+	name := fmt.Sprintf("Im%d", object)
+	d.pageImages[name] = object
+	return name
+}
+
+// embed appends an image object and returns its number
+func (d *document) embed(img image.Image) int {
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	data := make([]byte, 0, b.Dx()*b.Dy()*3)
+	for i := 0; i < len(rgba.Pix); i += 4 {
+		data = append(data, rgba.Pix[i], rgba.Pix[i+1], rgba.Pix[i+2])
+	}
+	return d.stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Interpolate false", b.Dx(), b.Dy()), data)
+}
+
+// bytes writes the PDF file
+func (d *document) bytes() []byte {
+	kids := make([]string, len(d.pages))
+	for i, page := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", page)
+	}
+	d.objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(d.objects))
+	for i, object := range d.objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, xref)
+	return buf.Bytes()
+}
@@ -0,0 +1,84 @@
+package labels
+
+import "strings"
+
+// helveticaWidths holds the advance widths of the printable ASCII
+// characters in Helvetica, in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// winAnsi maps the characters of WinAnsiEncoding outside Latin-1
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b,
+	'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// encode converts text to WinAnsiEncoding, the encoding of the standard
+// fonts; characters it lacks become "?"
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch b, ok := winAnsi[r]; {
+		case ok:
+			out = append(out, b)
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+// textWidth returns the width of text in Helvetica at size, in points.
+// Characters beyond ASCII are estimated.
+func textWidth(text string, size float64) float64 {
+	units := 0
+	for _, b := range encode(text) {
+		switch {
+		case b >= 0x20 && b < 0x7f:
+			units += helveticaWidths[b-0x20]
+		case b == 0x85 || b == 0x97:
+			units += 1000
+		default:
+			units += 556
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// fitText shortens text with an ellipsis until it is at most width points
+// wide at size
+func fitText(text string, size, width float64) string {
+	if textWidth(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && textWidth(string(runes)+"…", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	if len(runes) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(string(runes)) + "…"
+}
+
+// escapeText encodes text as the contents of a PDF literal string
+func escapeText(text string) string {
+	var b strings.Builder
+	for _, c := range encode(text) {
+		if c == '\\' || c == '(' || c == ')' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
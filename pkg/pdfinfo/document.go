@@ -0,0 +1,341 @@
+package pdfinfo
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// maxDecodedStream bounds the size of a decompressed object stream
+	maxDecodedStream = 64 << 20
+	// maxDecodedTotal bounds the bytes decompressed for a whole document
+	maxDecodedTotal = 128 << 20
+)
+
+// objectHeader matches "N G obj" at the start of an indirect object
+var objectHeader = regexp.MustCompile(`(\d+)[ \t\r\n\f\x00]+(\d+)[ \t\r\n\f\x00]+obj\b`)
+
+// location is where an object's latest definition lives: directly in the
+// file at offset, or as entry index of the object stream container
+type location struct {
+	order     int // File offset of the definition; later definitions win
+	offset    int
+	container int // Object stream number, or 0 for direct objects
+	index     int
+}
+
+// document indexes the objects of a PDF file. Objects are found by scanning
+// for "N G obj" headers rather than trusting the cross-reference table, so
+// files with damaged or stale xref offsets can still be read.
+type document struct {
+	data    []byte
+	objects map[int]location
+	cache   map[int]interface{}
+	decoded map[int]*objectStream
+	trailer dict
+	budget  int  // Bytes that may still be decompressed
+	tooBig  bool // Set once decompression ran out of budget
+}
+
+// objectStream is a /Type /ObjStm stream. Its header is read when the
+// document is indexed; the rest is only decoded once one of its objects is
+// loaded.
+type objectStream struct {
+	src     stream
+	data    []byte // Decoded stream, nil until needed
+	entries []int  // Object number of each entry
+	offsets []int  // Offset of each entry, relative to data
+}
+
+func newDocument(data []byte) *document {
+	d := &document{
+		data:    data,
+		objects: map[int]location{},
+		cache:   map[int]interface{}{},
+		decoded: map[int]*objectStream{},
+		trailer: dict{},
+		budget:  maxDecodedTotal,
+	}
+
+	for _, m := range objectHeader.FindAllSubmatchIndex(data, -1) {
+		if m[0] > 0 && !isWhitespace(data[m[0]-1]) && !isDelimiter(data[m[0]-1]) {
+			continue
+		}
+		num, err := strconv.Atoi(string(data[m[2]:m[3]]))
+		if err != nil {
+			continue
+		}
+		d.objects[num] = location{order: m[0], offset: m[1]}
+	}
+
+	d.indexObjectStreams()
+	d.readTrailers()
+	return d
+}
+
+// indexObjectStreams registers the objects compressed in object streams
+func (d *document) indexObjectStreams() {
+	direct := make(map[int]location, len(d.objects))
+	for num, loc := range d.objects {
+		direct[num] = loc
+	}
+	for num, loc := range direct {
+		s, ok := d.resolve(ref{num: num}).(stream)
+		if !ok || s.dict["Type"] != name("ObjStm") {
+			continue
+		}
+		os, err := d.objectStream(num, s)
+		if err != nil {
+			continue
+		}
+		for i, entry := range os.entries {
+			if cur, ok := d.objects[entry]; !ok || cur.order < loc.order {
+				d.objects[entry] = location{order: loc.order, container: num, index: i}
+			}
+		}
+	}
+	// Objects resolved while indexing may have been shadowed by object streams
+	d.cache = map[int]interface{}{}
+}
+
+// readTrailers merges every trailer dictionary and cross-reference stream
+// dictionary in file order, so the last incremental update wins
+func (d *document) readTrailers() {
+	type found struct {
+		order int
+		dict  dict
+	}
+	var trailers []found
+
+	for i := 0; ; {
+		j := bytes.Index(d.data[i:], []byte("trailer"))
+		if j < 0 {
+			break
+		}
+		l := &lexer{data: d.data, pos: i + j + len("trailer")}
+		if v, err := l.object(0); err == nil {
+			if t, ok := v.(dict); ok {
+				trailers = append(trailers, found{i + j, t})
+			}
+		}
+		i += j + len("trailer")
+	}
+	for num, loc := range d.objects {
+		if loc.container != 0 {
+			continue
+		}
+		if s, ok := d.resolve(ref{num: num}).(stream); ok && s.dict["Type"] == name("XRef") {
+			trailers = append(trailers, found{loc.order, s.dict})
+		}
+	}
+
+	// Apply in file order
+	for len(trailers) > 0 {
+		first := 0
+		for i, t := range trailers {
+			if t.order < trailers[first].order {
+				first = i
+			}
+		}
+		for _, key := range []string{"Root", "Info", "Encrypt", "ID"} {
+			if v, ok := trailers[first].dict[key]; ok {
+				d.trailer[key] = v
+			}
+		}
+		trailers = append(trailers[:first], trailers[first+1:]...)
+	}
+}
+
+// resolve follows indirect references; other values are returned as is
+func (d *document) resolve(v interface{}) interface{} {
+	for depth := 0; depth < 16; depth++ {
+		r, ok := v.(ref)
+		if !ok {
+			return v
+		}
+		if cached, ok := d.cache[r.num]; ok {
+			v = cached
+			continue
+		}
+		obj, err := d.load(r.num)
+		if err != nil {
+			obj = nil
+		}
+		d.cache[r.num] = obj
+		v = obj
+	}
+	return nil
+}
+
+// load parses the object with the given number
+func (d *document) load(num int) (interface{}, error) {
+	loc, ok := d.objects[num]
+	if !ok {
+		return nil, fmt.Errorf("object %d not found", num)
+	}
+
+	if loc.container != 0 {
+		os := d.decoded[loc.container]
+		if os == nil || loc.index >= len(os.offsets) {
+			return nil, fmt.Errorf("object %d not found", num)
+		}
+		if os.data == nil {
+			data, err := d.decode(os.src, maxDecodedStream)
+			if err != nil {
+				return nil, err
+			}
+			os.data = data
+		}
+		if os.offsets[loc.index] > len(os.data) {
+			return nil, fmt.Errorf("%w: bad object stream", errSyntax)
+		}
+		l := &lexer{data: os.data, pos: os.offsets[loc.index]}
+		return l.object(0)
+	}
+
+	// Guard against reference cycles through /Length while loading
+	d.cache[num] = nil
+
+	l := &lexer{data: d.data, pos: loc.offset}
+	v, err := l.object(0)
+	if err != nil {
+		return nil, err
+	}
+	dictionary, ok := v.(dict)
+	if !ok {
+		return v, nil
+	}
+
+	l.skipSpace()
+	if !bytes.HasPrefix(d.data[l.pos:], []byte("stream")) {
+		return dictionary, nil
+	}
+	start := l.pos + len("stream")
+	if start < len(d.data) && d.data[start] == '\r' {
+		start++
+	}
+	if start < len(d.data) && d.data[start] == '\n' {
+		start++
+	}
+
+	// Trust /Length when "endstream" follows it; otherwise search for it
+	end := -1
+	if n, ok := d.resolve(dictionary["Length"]).(int64); ok && n >= 0 && start+int(n) <= len(d.data) {
+		after := &lexer{data: d.data, pos: start + int(n)}
+		after.skipSpace()
+		if bytes.HasPrefix(d.data[after.pos:], []byte("endstream")) {
+			end = start + int(n)
+		}
+	}
+	if end < 0 {
+		i := bytes.Index(d.data[start:], []byte("endstream"))
+		if i < 0 {
+			return nil, fmt.Errorf("%w: unterminated stream", errSyntax)
+		}
+		end = start + i
+		for end > start && (d.data[end-1] == '\n' || d.data[end-1] == '\r') {
+			end--
+		}
+	}
+	return stream{dict: dictionary, data: d.data[start:end]}, nil
+}
+
+// objectStream decodes the header of an object stream, its object numbers
+// and offsets
+func (d *document) objectStream(num int, s stream) (*objectStream, error) {
+	n, _ := d.resolve(s.dict["N"]).(int64)
+	first, _ := d.resolve(s.dict["First"]).(int64)
+	if n <= 0 || first <= 0 || first > maxDecodedStream {
+		return nil, fmt.Errorf("%w: bad object stream", errSyntax)
+	}
+	header, err := d.decode(s, int(first))
+	if err != nil {
+		return nil, err
+	}
+	if int(first) > len(header) {
+		return nil, fmt.Errorf("%w: bad object stream", errSyntax)
+	}
+
+	l := &lexer{data: header}
+	os := &objectStream{src: s}
+	for i := int64(0); i < n; i++ {
+		l.skipSpace()
+		objNum, err1 := l.number()
+		l.skipSpace()
+		offset, err2 := l.number()
+		on, ok1 := objNum.(int64)
+		off, ok2 := offset.(int64)
+		if err1 != nil || err2 != nil || !ok1 || !ok2 || off < 0 || off > maxDecodedStream {
+			return nil, fmt.Errorf("%w: bad object stream header", errSyntax)
+		}
+		os.entries = append(os.entries, int(on))
+		os.offsets = append(os.offsets, int(first+off))
+	}
+	d.decoded[num] = os
+	return os, nil
+}
+
+// decode applies a stream's filters, returning at most limit bytes; only
+// FlateDecode is supported. Decompressed bytes count against the document's
+// budget, and nothing more is decoded once it runs out.
+func (d *document) decode(s stream, limit int) ([]byte, error) {
+	filters := []interface{}{d.resolve(s.dict["Filter"])}
+	if arr, ok := filters[0].(array); ok {
+		filters = arr
+	}
+	data := s.data
+	for i, f := range filters {
+		switch d.resolve(f) {
+		case nil:
+		case name("FlateDecode"):
+			// Only the last filter's output may be cut short
+			max := maxDecodedStream
+			if i == len(filters)-1 && limit < max {
+				max = limit
+			}
+			overBudget := d.budget < max
+			if overBudget {
+				max = d.budget
+			}
+			r, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := io.ReadAll(io.LimitReader(r, int64(max)+1))
+			r.Close()
+			if err != nil && len(decoded) == 0 {
+				return nil, err
+			}
+			if len(decoded) > max {
+				if overBudget {
+					d.budget, d.tooBig = 0, true
+					return nil, ErrTooLarge
+				}
+				decoded = decoded[:max]
+			}
+			d.budget -= len(decoded)
+			data = decoded
+		default:
+			return nil, fmt.Errorf("unsupported filter %v", f)
+		}
+	}
+	if len(data) > limit {
+		data = data[:limit]
+	}
+	return data, nil
+}
+
+// dictOf resolves v to a dictionary, accepting a stream's dictionary
+func (d *document) dictOf(v interface{}) dict {
+	switch v := d.resolve(v).(type) {
+	case dict:
+		return v
+	case stream:
+		return v.dict
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package pdfinfo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// PDF object model. Names, strings and dictionaries are distinct Go types so
+// a value's PDF type survives parsing.
+type (
+	name   string
+	dict   map[string]interface{}
+	array  []interface{}
+	ref    struct{ num, gen int }
+	stream struct {
+		dict dict
+		data []byte // Raw, still encoded stream data
+	}
+)
+
+// maxDepth bounds nesting of arrays and dictionaries
+const maxDepth = 64
+
+var errSyntax = errors.New("pdf syntax error")
+
+// lexer reads PDF objects from a byte slice
+type lexer struct {
+	data []byte
+	pos  int
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isDelimiter(c byte) bool {
+	return bytes.IndexByte([]byte("()<>[]{}/%"), c) >= 0
+}
+
+// skipSpace skips whitespace and comments
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case isWhitespace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// keyword reads a run of regular characters
+func (l *lexer) keyword() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isWhitespace(l.data[l.pos]) && !isDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+// object parses the next direct object. Streams are not handled here since
+// their length may depend on other objects.
+func (l *lexer) object(depth int) (interface{}, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("%w: nesting too deep", errSyntax)
+	}
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, fmt.Errorf("%w: unexpected end of data", errSyntax)
+	}
+
+	switch c := l.data[l.pos]; {
+	case c == '/':
+		l.pos++
+		return name(decodeName(l.keyword())), nil
+	case c == '(':
+		return l.literalString()
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		return l.dictionary(depth)
+	case c == '<':
+		return l.hexString()
+	case c == '[':
+		l.pos++
+		var arr array
+		for {
+			l.skipSpace()
+			if l.pos >= len(l.data) {
+				return nil, fmt.Errorf("%w: unterminated array", errSyntax)
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				return arr, nil
+			}
+			v, err := l.object(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return l.number()
+	default:
+		switch kw := l.keyword(); kw {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q", errSyntax, kw)
+		}
+	}
+}
+
+// number parses an integer, a real, or an "N G R" indirect reference
+func (l *lexer) number() (interface{}, error) {
+	tok := l.keyword()
+	if n, err := strconv.ParseInt(tok, 10, 64); err == nil {
+		// Look ahead for "gen R"
+		save := l.pos
+		l.skipSpace()
+		genTok := l.keyword()
+		if gen, err := strconv.Atoi(genTok); err == nil && gen >= 0 {
+			l.skipSpace()
+			if l.keyword() == "R" {
+				return ref{num: int(n), gen: gen}, nil
+			}
+		}
+		l.pos = save
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(tok, 64); err == nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("%w: bad number %q", errSyntax, tok)
+}
+
+// dictionary parses << /Key value ... >>
+func (l *lexer) dictionary(depth int) (dict, error) {
+	l.pos += 2
+	d := dict{}
+	for {
+		l.skipSpace()
+		if l.pos+1 >= len(l.data) {
+			return nil, fmt.Errorf("%w: unterminated dictionary", errSyntax)
+		}
+		if l.data[l.pos] == '>' && l.data[l.pos+1] == '>' {
+			l.pos += 2
+			return d, nil
+		}
+		key, err := l.object(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		k, ok := key.(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: dictionary key is not a name", errSyntax)
+		}
+		v, err := l.object(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		d[string(k)] = v
+	}
+}
+
+// literalString parses a (string) with escapes and balanced parentheses
+func (l *lexer) literalString() (string, error) {
+	l.pos++
+	var b []byte
+	level := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			level++
+		case ')':
+			level--
+			if level == 0 {
+				return string(b), nil
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				break
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(v)
+				} else {
+					c = e
+				}
+			}
+		}
+		b = append(b, c)
+	}
+	return "", fmt.Errorf("%w: unterminated string", errSyntax)
+}
+
+// hexString parses a <hex> string
+func (l *lexer) hexString() (string, error) {
+	l.pos++
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isWhitespace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	if l.pos >= len(l.data) {
+		return "", fmt.Errorf("%w: unterminated hex string", errSyntax)
+	}
+	l.pos++
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	for i := range out {
+		v, err := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+		if err != nil {
+			return "", fmt.Errorf("%w: bad hex string", errSyntax)
+		}
+		out[i] = byte(v)
+	}
+	return string(out), nil
+}
+
+// decodeName resolves #xx escapes in a name
+func decodeName(s string) string {
+	if !bytes.ContainsRune([]byte(s), '#') {
+		return s
+	}
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] == '#' && i+2 < len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+3], 16, 8); err == nil {
+				b = append(b, byte(v))
+				i += 2
+				continue
+			}
+		}
+		b = append(b, s[i])
+	}
+	return string(b)
+}
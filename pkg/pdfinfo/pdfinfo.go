@@ -0,0 +1,272 @@
+// Package pdfinfo reads basic metadata from PDF files: version, page count,
+// document information and encryption, plus the embedded JPEG of the first
+// page when there is one. It does not render pages.
+package pdfinfo
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+var (
+	// ErrMalformed is returned for data that is not a readable PDF document
+	ErrMalformed = errors.New("malformed PDF document")
+	// ErrEncrypted is returned by Parse alongside Info for encrypted documents
+	ErrEncrypted = errors.New("PDF document is encrypted")
+	// ErrTooLarge is returned for documents whose compressed streams expand
+	// to more data than Parse is willing to decode
+	ErrTooLarge = errors.New("PDF document expands to too much data")
+)
+
+// maxPageTreeNodes bounds the page tree walk of documents without /Count
+const maxPageTreeNodes = 100000
+
+// Info is the metadata of a PDF document
+type Info struct {
+	Version   string     `json:"version"`
+	Pages     int        `json:"pages"`
+	Title     string     `json:"title,omitempty"`
+	Author    string     `json:"author,omitempty"`
+	Subject   string     `json:"subject,omitempty"`
+	Creator   string     `json:"creator,omitempty"`
+	Producer  string     `json:"producer,omitempty"`
+	Created   *time.Time `json:"created,omitempty"`
+	Encrypted bool       `json:"encrypted"`
+
+	// FirstPageImage is the largest JPEG image drawn directly on the first
+	// page, such as a scanned page, for use as a thumbnail source
+	FirstPageImage []byte `json:"-"`
+}
+
+var header = regexp.MustCompile(`%PDF-(\d\.\d)`)
+
+// Parse reads the metadata of a PDF document. Encrypted documents return
+// their version and page count, if readable, together with ErrEncrypted.
+func Parse(data []byte) (*Info, error) {
+	// The header may be preceded by junk, but only within the first KB
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	m := header.FindSubmatch(head)
+	if m == nil || !bytes.Contains(tail(data, 2048), []byte("%%EOF")) {
+		return nil, ErrMalformed
+	}
+
+	d := newDocument(data)
+	info, err := d.info(string(m[1]))
+	if d.tooBig {
+		return nil, ErrTooLarge
+	}
+	return info, err
+}
+
+// info reads the metadata of an indexed document
+func (d *document) info(version string) (*Info, error) {
+	info := &Info{Version: version}
+
+	root := d.dictOf(d.trailer["Root"])
+	if root == nil {
+		root = d.findCatalog()
+	}
+	if root == nil {
+		return nil, ErrMalformed
+	}
+	if v, ok := d.resolve(root["Version"]).(name); ok && string(v) > info.Version {
+		info.Version = string(v)
+	}
+
+	if _, ok := d.trailer["Encrypt"]; ok {
+		// Strings of encrypted documents are encrypted; only structure is readable
+		info.Encrypted = true
+		info.Pages, _ = d.pageCount(root)
+		return info, ErrEncrypted
+	}
+
+	pages, firstPage := d.pageCount(root)
+	if pages <= 0 {
+		return nil, ErrMalformed
+	}
+	info.Pages = pages
+
+	if meta := d.dictOf(d.trailer["Info"]); meta != nil {
+		info.Title = d.text(meta["Title"])
+		info.Author = d.text(meta["Author"])
+		info.Subject = d.text(meta["Subject"])
+		info.Creator = d.text(meta["Creator"])
+		info.Producer = d.text(meta["Producer"])
+		if created, ok := parseDate(d.text(meta["CreationDate"])); ok {
+			info.Created = &created
+		}
+	}
+
+	if firstPage != nil {
+		info.FirstPageImage = d.largestJPEG(firstPage)
+	}
+	return info, nil
+}
+
+// findCatalog looks for the document catalog when no trailer names it
+func (d *document) findCatalog() dict {
+	for num := range d.objects {
+		if obj := d.dictOf(ref{num: num}); obj != nil && obj["Type"] == name("Catalog") {
+			return obj
+		}
+	}
+	return nil
+}
+
+// pageCount returns the number of pages and the first page dictionary. The
+// /Count of the page tree root is used when present; otherwise the tree is
+// walked.
+func (d *document) pageCount(root dict) (int, dict) {
+	pages := d.dictOf(root["Pages"])
+	if pages == nil {
+		return 0, nil
+	}
+
+	first := d.firstPage(pages)
+	if n, ok := d.resolve(pages["Count"]).(int64); ok && n > 0 {
+		return int(n), first
+	}
+
+	// Bound the walk by depth and node count, since malformed trees may
+	// contain cycles
+	count, nodes := 0, 0
+	var walk func(node dict, depth int)
+	walk = func(node dict, depth int) {
+		nodes++
+		if depth > maxDepth || nodes > maxPageTreeNodes {
+			return
+		}
+		if node["Type"] == name("Page") {
+			count++
+			return
+		}
+		kids, _ := d.resolve(node["Kids"]).(array)
+		for _, kid := range kids {
+			if child := d.dictOf(kid); child != nil {
+				walk(child, depth+1)
+			}
+		}
+	}
+	walk(pages, 0)
+	return count, first
+}
+
+// firstPage descends the page tree to the first leaf page
+func (d *document) firstPage(node dict) dict {
+	for depth := 0; depth < maxDepth && node != nil; depth++ {
+		if node["Type"] == name("Page") {
+			return node
+		}
+		kids, _ := d.resolve(node["Kids"]).(array)
+		if len(kids) == 0 {
+			if _, ok := node["Kids"]; !ok && node["Contents"] != nil {
+				return node // Page without /Type
+			}
+			return nil
+		}
+		node = d.dictOf(kids[0])
+	}
+	return nil
+}
+
+// largestJPEG returns the largest DCT-encoded image XObject of a page,
+// looking up resources inherited from parent nodes
+func (d *document) largestJPEG(page dict) []byte {
+	var resources dict
+	for node, depth := page, 0; node != nil && depth < maxDepth; depth++ {
+		if resources = d.dictOf(node["Resources"]); resources != nil {
+			break
+		}
+		node = d.dictOf(node["Parent"])
+	}
+	if resources == nil {
+		return nil
+	}
+
+	xobjects := d.dictOf(resources["XObject"])
+	var best []byte
+	var bestArea int64
+	for _, v := range xobjects {
+		s, ok := d.resolve(v).(stream)
+		if !ok || s.dict["Subtype"] != name("Image") {
+			continue
+		}
+		filter := d.resolve(s.dict["Filter"])
+		if arr, ok := filter.(array); ok && len(arr) == 1 {
+			filter = d.resolve(arr[0])
+		}
+		if filter != name("DCTDecode") {
+			continue
+		}
+		w, _ := d.resolve(s.dict["Width"]).(int64)
+		h, _ := d.resolve(s.dict["Height"]).(int64)
+		if w*h > bestArea {
+			best, bestArea = s.data, w*h
+		}
+	}
+	return best
+}
+
+// text decodes a PDF text string: UTF-16BE or UTF-8 with a byte order mark,
+// otherwise PDFDocEncoding, which matches Latin-1 for printable characters
+func (d *document) text(v interface{}) string {
+	s, ok := d.resolve(v).(string)
+	if !ok {
+		return ""
+	}
+	b := []byte(s)
+
+	var out string
+	switch {
+	case bytes.HasPrefix(b, []byte{0xFE, 0xFF}):
+		b = b[2:]
+		units := make([]uint16, len(b)/2)
+		for i := range units {
+			units[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+		}
+		out = string(utf16.Decode(units))
+	case bytes.HasPrefix(b, []byte{0xEF, 0xBB, 0xBF}):
+		out = string(b[3:])
+	default:
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		out = string(runes)
+	}
+
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' {
+			return -1
+		}
+		return r
+	}, out))
+}
+
+// parseDate parses a PDF date such as D:20240131120000+01'00'
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimPrefix(s, "D:")
+	s = strings.ReplaceAll(s, "'", "")
+	layouts := []string{"20060102150405-0700", "20060102150405Z0700", "20060102150405Z", "20060102150405", "200601021504", "20060102", "200601", "2006"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// tail returns the last n bytes of data
+func tail(data []byte, n int) []byte {
+	if len(data) > n {
+		return data[len(data)-n:]
+	}
+	return data
+}
@@ -0,0 +1,133 @@
+package pdfinfo
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readFixture(t testing.TB, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParseFixtures(t *testing.T) {
+	created := time.Date(2024, 1, 31, 11, 0, 0, 0, time.UTC)
+	tests := []struct {
+		file  string
+		want  *Info
+		image bool
+		err   error
+	}{
+		{"simple.pdf", &Info{Version: "1.4", Pages: 2, Title: "Menü", Author: "Jane (Chef)", Producer: "pdfinfo test", Created: &created}, true, nil},
+		// The catalog's /Version overrides the header
+		{"xref-stream.pdf", &Info{Version: "1.7", Pages: 1, Title: "Object streams", Creator: "pdfinfo test"}, false, nil},
+		// Only structure is read from encrypted documents
+		{"encrypted.pdf", &Info{Version: "1.6", Pages: 1, Encrypted: true}, false, ErrEncrypted},
+		{"truncated.pdf", nil, false, ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			info, err := Parse(readFixture(t, tt.file))
+			if !errors.Is(err, tt.err) || (err == nil) != (tt.err == nil) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.want == nil {
+				if info != nil {
+					t.Errorf("info = %+v, want nil", info)
+				}
+				return
+			}
+			if info == nil {
+				t.Fatal("info is nil")
+			}
+			if (len(info.FirstPageImage) > 0) != tt.image {
+				t.Errorf("first page image = %d bytes, want one: %v", len(info.FirstPageImage), tt.image)
+			}
+			info.FirstPageImage = nil
+			if (info.Created == nil) != (tt.want.Created == nil) || (info.Created != nil && !info.Created.Equal(*tt.want.Created)) {
+				t.Errorf("Created = %v, want %v", info.Created, tt.want.Created)
+			}
+			info.Created, tt.want.Created = nil, nil
+			if !reflect.DeepEqual(info, tt.want) {
+				t.Errorf("info = %+v, want %+v", *info, *tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsBadInput(t *testing.T) {
+	simple := readFixture(t, "simple.pdf")
+	tests := map[string][]byte{
+		"empty":             nil,
+		"no header":         bytes.TrimPrefix(simple, []byte("%PDF-1.4")),
+		"header too late":   append(bytes.Repeat([]byte(" "), 2048), simple...),
+		"no catalog":        bytes.ReplaceAll(bytes.ReplaceAll(simple, []byte("/Catalog"), []byte("/Katalog")), []byte("/Root 1 0 R"), nil),
+		"no pages":          []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%EOF\n"),
+		"reference cycle":   []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n2 0 obj\n<< /Kids [2 0 R] >>\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%EOF\n"),
+		"unterminated dict": []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog /Pages <<<<<<\ntrailer\n<< /Root 1 0 R\n%%EOF\n"),
+	}
+	for name, data := range tests {
+		if _, err := Parse(data); !errors.Is(err, ErrMalformed) {
+			t.Errorf("%s: err = %v, want ErrMalformed", name, err)
+		}
+	}
+}
+
+func TestParseStopsAtDecodeBudget(t *testing.T) {
+	// Each page lives in its own object stream, which expands to the
+	// per-stream limit; the third one exceeds the document budget
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.5\n1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	b.WriteString("2 0 obj\n<< /Type /Pages /Kids [10 0 R 11 0 R 12 0 R] >>\nendobj\n")
+	for i := 0; i < maxDecodedTotal/maxDecodedStream+1; i++ {
+		header := fmt.Sprintf("%d 0 ", 10+i)
+		var compressed bytes.Buffer
+		w := zlib.NewWriter(&compressed)
+		w.Write([]byte(header))
+		w.Write(make([]byte, maxDecodedStream))
+		w.Close()
+
+		fmt.Fprintf(&b, "%d 0 obj\n<< /Type /ObjStm /N 1 /First %d /Filter /FlateDecode /Length %d >>\nstream\n", 20+i, len(header), compressed.Len())
+		b.Write(compressed.Bytes())
+		b.WriteString("\nendstream\nendobj\n")
+	}
+	b.WriteString("trailer\n<< /Root 1 0 R >>\n%%EOF\n")
+
+	if _, err := Parse(b.Bytes()); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("err = %v, want ErrTooLarge", err)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, name := range []string{"simple.pdf", "encrypted.pdf", "xref-stream.pdf", "truncated.pdf"} {
+		f.Add(readFixture(f, name))
+	}
+	f.Add([]byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog /Pages 1 0 R /Kids [1 0 R] >>\nendobj\n%%EOF"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		info, err := Parse(data)
+		switch {
+		case err == nil:
+			if info == nil || info.Pages <= 0 {
+				t.Fatalf("Parse succeeded with %+v", info)
+			}
+		case errors.Is(err, ErrEncrypted):
+			if info == nil || !info.Encrypted {
+				t.Fatalf("ErrEncrypted with %+v", info)
+			}
+		case info != nil:
+			t.Fatalf("Parse returned %+v with %v", info, err)
+		}
+	})
+}
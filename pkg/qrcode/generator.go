@@ -1,6 +1,9 @@
 package qrcode
 
 import (
+	"fmt"
+	"strings"
+
 	goqrcode "github.com/skip2/go-qrcode"
 )
 
@@ -18,3 +21,45 @@ func GenerateWithSize(data string, size int) ([]byte, error) {
 func GenerateWithLevel(data string, level goqrcode.RecoveryLevel, size int) ([]byte, error) {
 	return goqrcode.Encode(data, level, size)
 }
+
+// Modules encodes data and returns its modules, dark ones true, row by row
+// and without the quiet zone, for vector output
+func Modules(data string, level goqrcode.RecoveryLevel) ([][]bool, error) {
+	code, err := goqrcode.New(data, level)
+	if err != nil {
+		return nil, err
+	}
+	code.DisableBorder = true
+	return code.Bitmap(), nil
+}
+
+// GenerateSVG creates a QR code as an SVG image size pixels wide, with a
+// quiet zone of four modules
+func GenerateSVG(data string, level goqrcode.RecoveryLevel, size int) ([]byte, error) {
+	modules, err := Modules(data, level)
+	if err != nil {
+		return nil, err
+	}
+	const border = 4
+	n := len(modules) + 2*border
+	var b strings.Builder
+	fmt.Fprintf(&b, `<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">
+<rect width="%d" height="%d" fill="#ffffff"/>
+<path fill="#000000" d="`, size, size, n, n, n, n)
+	// Each run of dark modules in a row is one rectangle
+	for y, row := range modules {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", start+border, y+border, x-start, x-start)
+		}
+	}
+	b.WriteString("\"/>\n</svg>\n")
+	return []byte(b.String()), nil
+}
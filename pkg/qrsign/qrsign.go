@@ -0,0 +1,204 @@
+// Package qrsign signs and verifies QR code payloads with Ed25519, so that
+// certificates and tickets can be checked offline. A signed payload is a
+// compact JWS (RFC 7515) with the EdDSA algorithm (RFC 8037), whose header
+// names the signing key by its ID. It is either encoded in a code as is, or
+// carried in the t parameter of a verification URL.
+//
+// Verifiers only need the public keys, which are published as a JWK set:
+//
+//	keys, _ := qrsign.ParseJWKS(jwks)
+//	token, err := qrsign.Verify(qrsign.Extract(scanned), keys, time.Now())
+package qrsign
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Algorithm is the JWS algorithm of signed payloads
+const Algorithm = "EdDSA"
+
+// Verification errors. A token that fails with ErrExpired or ErrNotYetValid
+// has a valid signature.
+var (
+	ErrMalformed   = errors.New("malformed signed payload")
+	ErrUnknownKey  = errors.New("signed with an unknown key")
+	ErrSignature   = errors.New("signature does not match")
+	ErrExpired     = errors.New("signed payload has expired")
+	ErrNotYetValid = errors.New("signed payload is not valid yet")
+)
+
+// Claims are the signed contents of a payload
+type Claims struct {
+	Subject   string                 `json:"sub,omitempty"` // ID of the QR code
+	Title     string                 `json:"title,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	IssuedAt  int64                  `json:"iat"`
+	NotBefore int64                  `json:"nbf,omitempty"`
+	Expires   int64                  `json:"exp,omitempty"`
+}
+
+// Token is a verified payload
+type Token struct {
+	KeyID  string `json:"kid"`
+	Claims Claims `json:"claims"`
+}
+
+// header is the protected JWS header
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Type      string `json:"typ,omitempty"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// Sign returns claims signed with key as a compact JWS naming the key kid
+func Sign(key ed25519.PrivateKey, kid string, claims Claims) (string, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return "", errors.New("invalid Ed25519 private key")
+	}
+	h, err := json.Marshal(header{Algorithm: Algorithm, KeyID: kid, Type: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode claims: %w", err)
+	}
+	input := b64.EncodeToString(h) + "." + b64.EncodeToString(payload)
+	return input + "." + b64.EncodeToString(ed25519.Sign(key, []byte(input))), nil
+}
+
+// Keys finds public keys by their ID
+type Keys interface {
+	PublicKey(kid string) (ed25519.PublicKey, bool)
+}
+
+// KeySet is a fixed set of public keys by ID
+type KeySet map[string]ed25519.PublicKey
+
+// PublicKey returns the key with the given ID
+func (s KeySet) PublicKey(kid string) (ed25519.PublicKey, bool) {
+	key, ok := s[kid]
+	return key, ok
+}
+
+// Verify checks the signature of a compact JWS and the validity period of
+// its claims at now. The token is returned whenever the signature is
+// valid, even if the claims have expired.
+func Verify(token string, keys Keys, now time.Time) (*Token, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+	var h header
+	if err := decodeJSON(parts[0], &h); err != nil {
+		return nil, ErrMalformed
+	}
+	if h.Algorithm != Algorithm {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrMalformed, h.Algorithm)
+	}
+	key, ok := keys.PublicKey(h.KeyID)
+	if !ok || len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, h.KeyID)
+	}
+	sig, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	if !ed25519.Verify(key, []byte(parts[0]+"."+parts[1]), sig) {
+		return nil, ErrSignature
+	}
+
+	t := &Token{KeyID: h.KeyID}
+	if err := decodeJSON(parts[1], &t.Claims); err != nil {
+		return nil, ErrMalformed
+	}
+	switch {
+	case t.Claims.Expires != 0 && now.Unix() >= t.Claims.Expires:
+		return t, ErrExpired
+	case t.Claims.NotBefore != 0 && now.Unix() < t.Claims.NotBefore:
+		return t, ErrNotYetValid
+	}
+	return t, nil
+}
+
+func decodeJSON(part string, v interface{}) error {
+	data, err := b64.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Extract returns the signed payload in scanned QR code data: the t query
+// parameter of a verification URL, or the data itself
+func Extract(data string) string {
+	data = strings.TrimSpace(data)
+	if u, err := url.Parse(data); err == nil && u.Scheme != "" {
+		if t := u.Query().Get("t"); t != "" {
+			return t
+		}
+	}
+	return data
+}
+
+// VerificationURL returns the URL of a verify endpoint carrying a token
+func VerificationURL(base, token string) string {
+	return strings.TrimRight(base, "/") + "/verify?t=" + token
+}
+
+// JWK is an Ed25519 public key in JSON Web Key form (RFC 8037)
+type JWK struct {
+	KeyType string `json:"kty"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use,omitempty"`
+	Alg     string `json:"alg,omitempty"`
+}
+
+// JWKS is a set of JSON Web Keys
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWK returns the JSON Web Key of a public key
+func PublicJWK(kid string, key ed25519.PublicKey) JWK {
+	return JWK{KeyType: "OKP", Curve: "Ed25519", X: b64.EncodeToString(key), KeyID: kid, Use: "sig", Alg: Algorithm}
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of a public key, which makes
+// a stable key ID
+func Thumbprint(key ed25519.PublicKey) string {
+	sum := sha256.Sum256([]byte(`{"crv":"Ed25519","kty":"OKP","x":"` + b64.EncodeToString(key) + `"}`))
+	return b64.EncodeToString(sum[:])
+}
+
+// ParseJWKS reads the Ed25519 keys of a JWK set; other keys are skipped
+func ParseJWKS(data []byte) (KeySet, error) {
+	var set JWKS
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWK set: %w", err)
+	}
+	keys := KeySet{}
+	for _, k := range set.Keys {
+		if k.KeyType != "OKP" || k.Curve != "Ed25519" {
+			continue
+		}
+		x, err := b64.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid JWK set: key %q is not an Ed25519 public key", k.KeyID)
+		}
+		keys[k.KeyID] = ed25519.PublicKey(x)
+	}
+	return keys, nil
+}
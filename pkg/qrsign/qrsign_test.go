@@ -0,0 +1,174 @@
+package qrsign
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testKey = ed25519.NewKeyFromSeed([]byte("0123456789abcdef0123456789abcdef"))
+	testPub = testKey.Public().(ed25519.PublicKey)
+	testNow = time.Unix(1700000000, 0)
+)
+
+func sign(t *testing.T, claims Claims) string {
+	t.Helper()
+	token, err := Sign(testKey, "k1", claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// withHeader replaces the protected header of a token, keeping its payload
+// and signature
+func withHeader(token string, h header) string {
+	data, _ := json.Marshal(h)
+	parts := strings.Split(token, ".")
+	return b64.EncodeToString(data) + "." + parts[1] + "." + parts[2]
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	claims := Claims{
+		Subject:  "42",
+		Title:    "Concert ticket",
+		Data:     map[string]interface{}{"seat": "A12"},
+		IssuedAt: testNow.Unix(),
+	}
+	got, err := Verify(sign(t, claims), KeySet{"k1": testPub}, testNow)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if got.KeyID != "k1" || got.Claims.Subject != "42" || got.Claims.Title != "Concert ticket" ||
+		got.Claims.Data["seat"] != "A12" || got.Claims.IssuedAt != testNow.Unix() {
+		t.Errorf("Verify returned %+v", got)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	keys := KeySet{"k1": testPub}
+	token := sign(t, Claims{Subject: "42", IssuedAt: testNow.Unix()})
+	parts := strings.Split(token, ".")
+	otherKey := ed25519.NewKeyFromSeed([]byte("fedcba9876543210fedcba9876543210"))
+	otherToken, _ := Sign(otherKey, "k1", Claims{Subject: "42"})
+	tampered, _ := json.Marshal(Claims{Subject: "43", IssuedAt: testNow.Unix()})
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"tampered payload", parts[0] + "." + b64.EncodeToString(tampered) + "." + parts[2], ErrSignature},
+		{"tampered signature", parts[0] + "." + parts[1] + "." + b64.EncodeToString(make([]byte, ed25519.SignatureSize)), ErrSignature},
+		{"signed by another key", otherToken, ErrSignature},
+		{"unknown key ID", withHeader(token, header{Algorithm: Algorithm, KeyID: "k2"}), ErrUnknownKey},
+		{"alg none", withHeader(token, header{Algorithm: "none", KeyID: "k1"}), ErrMalformed},
+		{"alg HS256", withHeader(token, header{Algorithm: "HS256", KeyID: "k1"}), ErrMalformed},
+		{"two parts", parts[0] + "." + parts[1], ErrMalformed},
+		{"header not base64", "!!." + parts[1] + "." + parts[2], ErrMalformed},
+		{"signature not base64", parts[0] + "." + parts[1] + ".!!", ErrMalformed},
+		{"empty", "", ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.token, keys, testNow)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+			if got != nil {
+				t.Errorf("returned a token: %+v", got)
+			}
+		})
+	}
+}
+
+func TestVerifyValidityPeriod(t *testing.T) {
+	keys := KeySet{"k1": testPub}
+	at := testNow.Unix()
+	tests := []struct {
+		name   string
+		claims Claims
+		now    time.Time
+		want   error
+	}{
+		{"before exp", Claims{Expires: at}, time.Unix(at-1, 0), nil},
+		{"at exp", Claims{Expires: at}, time.Unix(at, 0), ErrExpired},
+		{"after exp", Claims{Expires: at}, time.Unix(at+1, 0), ErrExpired},
+		{"before nbf", Claims{NotBefore: at}, time.Unix(at-1, 0), ErrNotYetValid},
+		{"at nbf", Claims{NotBefore: at}, time.Unix(at, 0), nil},
+		{"within nbf and exp", Claims{NotBefore: at, Expires: at + 60}, time.Unix(at+30, 0), nil},
+		{"no period", Claims{}, time.Unix(0, 0), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(sign(t, tt.claims), keys, tt.now)
+			if !errors.Is(err, tt.want) || (err == nil) != (tt.want == nil) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+			// The signature is valid, so the token comes back either way
+			if got == nil {
+				t.Error("no token returned")
+			}
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	jwks, err := json.Marshal(JWKS{Keys: []JWK{
+		PublicJWK("k1", testPub),
+		{KeyType: "RSA", KeyID: "rsa", X: "AQAB"},
+		{KeyType: "OKP", Curve: "X25519", KeyID: "x", X: b64.EncodeToString(testPub)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ParseJWKS(jwks)
+	if err != nil {
+		t.Fatalf("ParseJWKS: %v", err)
+	}
+	if len(keys) != 1 || !testPub.Equal(keys["k1"]) {
+		t.Fatalf("ParseJWKS = %v, want only k1", keys)
+	}
+	if _, err := Verify(sign(t, Claims{}), keys, testNow); err != nil {
+		t.Errorf("Verify with the parsed keys: %v", err)
+	}
+
+	for _, bad := range []string{
+		`not json`,
+		`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"short","x":"AQAB"}]}`,
+		`{"keys":[{"kty":"OKP","crv":"Ed25519","kid":"bad","x":"!!"}]}`,
+	} {
+		if _, err := ParseJWKS([]byte(bad)); err == nil {
+			t.Errorf("ParseJWKS(%s) accepted an invalid set", bad)
+		}
+	}
+}
+
+// TestThumbprint checks the RFC 8037 appendix A.3 example
+func TestThumbprint(t *testing.T) {
+	x, err := b64.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := Thumbprint(ed25519.PublicKey(x)), "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k"; got != want {
+		t.Errorf("Thumbprint = %s, want %s", got, want)
+	}
+}
+
+func TestExtract(t *testing.T) {
+	for data, want := range map[string]string{
+		"a.b.c":                                  "a.b.c",
+		"  a.b.c\n":                              "a.b.c",
+		"https://example.com/verify?t=a.b.c":     "a.b.c",
+		VerificationURL("https://x.test/", "t1"): "t1",
+		"https://example.com/other":              "https://example.com/other",
+	} {
+		if got := Extract(data); got != want {
+			t.Errorf("Extract(%q) = %q, want %q", data, got, want)
+		}
+	}
+}
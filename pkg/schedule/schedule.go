@@ -0,0 +1,194 @@
+// Package schedule describes recurring weekly opening hours, such as a menu
+// that is only available from 11:00 to 22:00, in the local time of a time
+// zone.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	// Time zones must load on hosts without a zoneinfo database
+	_ "time/tzdata"
+)
+
+// ErrInvalid is returned for schedules that cannot be evaluated
+var ErrInvalid = errors.New("invalid schedule")
+
+// Schedule is a set of weekly windows. A time is open when it falls in any
+// of them.
+type Schedule struct {
+	Timezone string   `json:"timezone,omitempty"` // IANA name such as Europe/Berlin; UTC if empty
+	Windows  []Window `json:"windows"`
+}
+
+// Window is a daily time range on some days of the week. A window whose end
+// is not after its start runs past midnight into the next day, and belongs
+// to the day it starts on.
+type Window struct {
+	Days  []string `json:"days,omitempty"` // mon, tue, ... or full names; every day if empty
+	Start string   `json:"start"`          // HH:MM
+	End   string   `json:"end"`            // HH:MM, up to 24:00
+}
+
+// locations caches loaded time zones by name, as schedules are evaluated on
+// every scan. Only valid IANA names are stored, so it stays small.
+var locations sync.Map
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Validate checks the time zone, days and times of a schedule
+func (s *Schedule) Validate() error {
+	if _, err := s.Location(); err != nil {
+		return err
+	}
+	if len(s.Windows) == 0 {
+		return fmt.Errorf("%w: at least one window is required", ErrInvalid)
+	}
+	for i, w := range s.Windows {
+		if _, err := w.days(); err != nil {
+			return fmt.Errorf("%w: window %d: %v", ErrInvalid, i+1, err)
+		}
+		start, err := parseClock(w.Start)
+		if err == nil && start == 24*60 {
+			err = fmt.Errorf("%q must be before 24:00", w.Start)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: window %d: start %v", ErrInvalid, i+1, err)
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			return fmt.Errorf("%w: window %d: end %v", ErrInvalid, i+1, err)
+		}
+		if start == end {
+			return fmt.Errorf("%w: window %d: start and end are the same", ErrInvalid, i+1)
+		}
+	}
+	return nil
+}
+
+// Open reports whether t falls in one of the windows. Invalid schedules
+// are never open.
+func (s *Schedule) Open(t time.Time) bool {
+	loc, err := s.Location()
+	if err != nil {
+		return false
+	}
+	local := t.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	// Windows that started yesterday may still be open
+	for _, d := range []time.Time{day.AddDate(0, 0, -1), day} {
+		for _, w := range s.Windows {
+			start, end, ok := w.on(d, loc)
+			if ok && !t.Before(start) && t.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Next returns the next time after t that a window opens, within a week. It
+// reports false for invalid schedules.
+func (s *Schedule) Next(t time.Time) (time.Time, bool) {
+	loc, err := s.Location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	local := t.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	var next time.Time
+	for i := 0; i <= 7; i++ {
+		d := day.AddDate(0, 0, i)
+		for _, w := range s.Windows {
+			start, _, ok := w.on(d, loc)
+			if ok && start.After(t) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+		if !next.IsZero() {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Location returns the time zone of the schedule
+func (s *Schedule) Location() (*time.Location, error) {
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	if loc, ok := locations.Load(s.Timezone); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalid, s.Timezone)
+	}
+	locations.Store(s.Timezone, loc)
+	return loc, nil
+}
+
+// on returns when the window opens and closes if it starts on day, which
+// is midnight in loc
+func (w Window) on(day time.Time, loc *time.Location) (time.Time, time.Time, bool) {
+	days, err := w.days()
+	if err != nil || (len(days) > 0 && !days[day.Weekday()]) {
+		return time.Time{}, time.Time{}, false
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endDay := day
+	if end <= start {
+		endDay = day.AddDate(0, 0, 1)
+	}
+	// Dates are built from the clock time so that days with a daylight
+	// saving change keep their local opening hours
+	at := func(d time.Time, minutes int) time.Time {
+		return time.Date(d.Year(), d.Month(), d.Day(), minutes/60, minutes%60, 0, 0, loc)
+	}
+	return at(day, start), at(endDay, end), true
+}
+
+// days returns the weekdays of a window, or nil for every day
+func (w Window) days() (map[time.Weekday]bool, error) {
+	if len(w.Days) == 0 {
+		return nil, nil
+	}
+	days := map[time.Weekday]bool{}
+	for _, name := range w.Days {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if len(name) < 3 {
+			return nil, fmt.Errorf("unknown day %q", name)
+		}
+		day, ok := weekdays[name[:3]]
+		if !ok || !strings.HasPrefix(strings.ToLower(day.String()), name) {
+			return nil, fmt.Errorf("unknown day %q", name)
+		}
+		days[day] = true
+	}
+	return days, nil
+}
+
+// parseClock returns the minutes since midnight of an HH:MM time
+func parseClock(value string) (int, error) {
+	var h, m int
+	if len(value) != 5 || value[2] != ':' {
+		return 0, fmt.Errorf("%q must be HH:MM", value)
+	}
+	if _, err := fmt.Sscanf(value, "%02d:%02d", &h, &m); err != nil || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%q must be HH:MM", value)
+	}
+	return h*60 + m, nil
+}